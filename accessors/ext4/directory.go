@@ -0,0 +1,212 @@
+package ext4
+
+import (
+	"encoding/binary"
+	"errors"
+	"strings"
+)
+
+const (
+	// Maximum size of a directory we are prepared to read.
+	MAX_DIRECTORY_SIZE = 64 * 1024 * 1024
+
+	// Checksum tail entries have this file type.
+	DIRENT_TAIL_FILE_TYPE = 0xDE
+)
+
+type DirEntry struct {
+	Inode    uint64
+	Name     string
+	FileType uint8
+
+	// Deleted entries are recovered from the slack space of
+	// directory blocks. Their inode may since have been reused.
+	Deleted bool
+}
+
+// ReadDir lists the directory, optionally recovering deleted
+// entries.
+func (self *Inode) ReadDir(include_deleted bool) ([]*DirEntry, error) {
+	if !self.IsDir() {
+		return nil, errors.New("Not a directory")
+	}
+
+	parser := &direntParser{
+		fs:              self.fs,
+		include_deleted: include_deleted,
+		has_file_type:   self.fs.HasIncompat(INCOMPAT_FILETYPE),
+	}
+
+	if self.HasFlag(EXT4_INLINE_DATA_FL) {
+		data, err := self.inlineData()
+		if err != nil {
+			return nil, err
+		}
+
+		// Inline directories start with the parent's inode and
+		// store no "." or ".." entries. The entries in the
+		// system.data attribute form a separate list.
+		if len(data) < 4 {
+			return nil, errors.New("Inline directory too short")
+		}
+		parser.parse(data[4:I_BLOCK_SIZE])
+		if len(data) > I_BLOCK_SIZE {
+			parser.parse(data[I_BLOCK_SIZE:])
+		}
+		return parser.entries, nil
+	}
+
+	size := self.Size()
+	if size > MAX_DIRECTORY_SIZE {
+		return nil, errors.New("Directory too large")
+	}
+
+	reader, err := self.Reader()
+	if err != nil {
+		return nil, err
+	}
+
+	block_size := self.fs.BlockSize()
+	block := make([]byte, block_size)
+	indexed := self.HasFlag(EXT4_INDEX_FL)
+
+	for offset := int64(0); offset < size; offset += block_size {
+		n, err := reader.ReadAt(block, offset)
+		if n < len(block) {
+			return nil, err
+		}
+
+		// Hash tree directories keep their index in the slack of
+		// the ".." entry in the first block, and in empty entries
+		// spanning interior blocks. Do not mistake these for
+		// deleted entries.
+		parser.skip_slack = indexed && (offset == 0 ||
+			(binary.LittleEndian.Uint32(block) == 0 &&
+				int64(parser.recLen(block[4:])) == block_size))
+
+		parser.parse(block)
+	}
+
+	return parser.entries, nil
+}
+
+type direntParser struct {
+	fs              *Ext4FS
+	include_deleted bool
+	has_file_type   bool
+	skip_slack      bool
+
+	entries []*DirEntry
+}
+
+func (self *direntParser) recLen(buf []byte) int {
+	rec_len := int(binary.LittleEndian.Uint16(buf))
+
+	// 64kb blocks can not express their length in 16 bits.
+	if rec_len == 0 || rec_len == 0xFFFF {
+		return MAX_BLOCK_SIZE
+	}
+	return rec_len
+}
+
+// Decode the entry header at the start of buf.
+func (self *direntParser) header(buf []byte) (
+	inode uint64, rec_len, name_len int, file_type uint8) {
+	inode = uint64(binary.LittleEndian.Uint32(buf))
+	rec_len = self.recLen(buf[4:])
+	if self.has_file_type {
+		name_len = int(buf[6])
+		file_type = buf[7]
+	} else {
+		name_len = int(binary.LittleEndian.Uint16(buf[6:]))
+	}
+	return inode, rec_len, name_len, file_type
+}
+
+func direntSize(name_len int) int {
+	return (8 + name_len + 3) &^ 3
+}
+
+func (self *direntParser) parse(buf []byte) {
+	offset := 0
+	for offset+8 <= len(buf) {
+		inode, rec_len, name_len, file_type := self.header(buf[offset:])
+		if rec_len < 8 || offset+rec_len > len(buf) ||
+			8+name_len > rec_len {
+			return
+		}
+
+		name := string(buf[offset+8 : offset+8+name_len])
+
+		switch {
+		// Checksum tail
+		case inode == 0 && name_len == 0 &&
+			file_type == DIRENT_TAIL_FILE_TYPE:
+
+		// Deleting the first entry in a block clears its inode
+		// number.
+		case inode == 0:
+			if self.include_deleted && name_len > 0 &&
+				!self.skip_slack && validName(name) {
+				self.entries = append(self.entries, &DirEntry{
+					Name:     name,
+					FileType: file_type,
+					Deleted:  true,
+				})
+			}
+
+		default:
+			self.entries = append(self.entries, &DirEntry{
+				Inode:    inode,
+				Name:     name,
+				FileType: file_type,
+			})
+		}
+
+		if self.include_deleted && !self.skip_slack {
+			self.recoverSlack(buf[offset+direntSize(name_len) : offset+rec_len])
+		}
+
+		offset += rec_len
+	}
+}
+
+// Deleting an entry merges its space into the previous entry, so
+// deleted entries remain intact in the slack after a live entry.
+func (self *direntParser) recoverSlack(slack []byte) {
+	inodes_count := uint64(self.fs.Superblock.InodesCount)
+
+	offset := 0
+	for offset+8 <= len(slack) {
+		inode, rec_len, name_len, file_type := self.header(slack[offset:])
+		if inode == 0 || inode > inodes_count || name_len == 0 ||
+			rec_len%4 != 0 || rec_len < direntSize(name_len) ||
+			offset+8+name_len > len(slack) ||
+			(self.has_file_type && file_type > 7) {
+			offset += 4
+			continue
+		}
+
+		name := string(slack[offset+8 : offset+8+name_len])
+		if !validName(name) {
+			offset += 4
+			continue
+		}
+
+		self.entries = append(self.entries, &DirEntry{
+			Inode:    inode,
+			Name:     name,
+			FileType: file_type,
+			Deleted:  true,
+		})
+
+		// The recovered entry may itself have absorbed older
+		// deleted entries.
+		offset += direntSize(name_len)
+	}
+}
+
+func validName(name string) bool {
+	return name != "" && name != "." && name != ".." &&
+		!strings.ContainsAny(name, "/\x00")
+}
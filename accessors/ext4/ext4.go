@@ -0,0 +1,280 @@
+// A parser for the ext2/3/4 family of Linux filesystems.
+
+// This parser is designed to work on raw images or devices so we do
+// not rely on the kernel at all. It supports extent mapped and legacy
+// block mapped files, inline data, fast symlinks and extended
+// attributes, and can recover deleted directory entries from the
+// slack space of directory blocks.
+
+// Reference: https://www.kernel.org/doc/html/latest/filesystems/ext4/
+
+package ext4
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	SUPERBLOCK_OFFSET = 1024
+	SUPERBLOCK_SIZE   = 1024
+	EXT4_MAGIC        = 0xEF53
+
+	ROOT_INODE = 2
+
+	// Feature flags
+	INCOMPAT_FILETYPE    = 0x2
+	INCOMPAT_META_BG     = 0x10
+	INCOMPAT_EXTENTS     = 0x40
+	INCOMPAT_64BIT       = 0x80
+	INCOMPAT_INLINE_DATA = 0x8000
+
+	RO_COMPAT_SPARSE_SUPER = 0x1
+
+	// Inode flags
+	EXT4_INDEX_FL       = 0x1000
+	EXT4_EXTENTS_FL     = 0x80000
+	EXT4_EA_INODE_FL    = 0x200000
+	EXT4_INLINE_DATA_FL = 0x10000000
+
+	// The largest block size we accept (64kb).
+	MAX_BLOCK_SIZE = 0x10000
+)
+
+var (
+	NotExt4Error = errors.New("No ext4 magic")
+)
+
+type Superblock struct {
+	InodesCount     uint32
+	BlocksCount     uint64
+	FirstDataBlock  uint32
+	BlockSize       int64
+	BlocksPerGroup  uint32
+	InodesPerGroup  uint32
+	InodeSize       int64
+	FeatureCompat   uint32
+	FeatureIncompat uint32
+	FeatureRoCompat uint32
+	UUID            string
+	VolumeName      string
+	LastMounted     string
+	DescSize        int64
+	FirstMetaBg     uint32
+}
+
+// Ext4FS represents an opened filesystem.
+type Ext4FS struct {
+	reader io.ReaderAt
+
+	Superblock Superblock
+
+	group_count uint64
+}
+
+func GetExt4FS(reader io.ReaderAt) (*Ext4FS, error) {
+	buf := make([]byte, SUPERBLOCK_SIZE)
+	n, err := reader.ReadAt(buf, SUPERBLOCK_OFFSET)
+	if n < SUPERBLOCK_SIZE {
+		if err == nil {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+
+	if binary.LittleEndian.Uint16(buf[0x38:]) != EXT4_MAGIC {
+		return nil, NotExt4Error
+	}
+
+	sb := Superblock{
+		InodesCount:     binary.LittleEndian.Uint32(buf[0x0:]),
+		BlocksCount:     uint64(binary.LittleEndian.Uint32(buf[0x4:])),
+		FirstDataBlock:  binary.LittleEndian.Uint32(buf[0x14:]),
+		BlocksPerGroup:  binary.LittleEndian.Uint32(buf[0x20:]),
+		InodesPerGroup:  binary.LittleEndian.Uint32(buf[0x28:]),
+		InodeSize:       128,
+		FeatureCompat:   binary.LittleEndian.Uint32(buf[0x5C:]),
+		FeatureIncompat: binary.LittleEndian.Uint32(buf[0x60:]),
+		FeatureRoCompat: binary.LittleEndian.Uint32(buf[0x64:]),
+		UUID:            formatUUID(buf[0x68:0x78]),
+		VolumeName:      cString(buf[0x78:0x88]),
+		LastMounted:     cString(buf[0x88:0xC8]),
+		DescSize:        32,
+		FirstMetaBg:     binary.LittleEndian.Uint32(buf[0x104:]),
+	}
+
+	log_block_size := binary.LittleEndian.Uint32(buf[0x18:])
+	if log_block_size > 6 {
+		return nil, fmt.Errorf("Invalid block size: %v", log_block_size)
+	}
+	sb.BlockSize = 1024 << log_block_size
+
+	// Revision 0 filesystems always have 128 byte inodes.
+	if binary.LittleEndian.Uint32(buf[0x4C:]) > 0 {
+		sb.InodeSize = int64(binary.LittleEndian.Uint16(buf[0x58:]))
+	}
+
+	if sb.FeatureIncompat&INCOMPAT_64BIT != 0 {
+		sb.BlocksCount |= uint64(binary.LittleEndian.Uint32(buf[0x150:])) << 32
+		sb.DescSize = int64(binary.LittleEndian.Uint16(buf[0xFE:]))
+	}
+
+	if sb.InodeSize < 128 || sb.InodeSize > sb.BlockSize ||
+		sb.InodeSize&(sb.InodeSize-1) != 0 {
+		return nil, fmt.Errorf("Invalid inode size: %v", sb.InodeSize)
+	}
+
+	if sb.DescSize < 32 || sb.DescSize > 1024 {
+		return nil, fmt.Errorf("Invalid group descriptor size: %v", sb.DescSize)
+	}
+
+	if sb.BlocksPerGroup == 0 || sb.InodesPerGroup == 0 {
+		return nil, errors.New("Invalid group geometry")
+	}
+
+	result := &Ext4FS{
+		reader:     reader,
+		Superblock: sb,
+		group_count: (sb.BlocksCount - uint64(sb.FirstDataBlock) +
+			uint64(sb.BlocksPerGroup) - 1) / uint64(sb.BlocksPerGroup),
+	}
+
+	return result, nil
+}
+
+func (self *Ext4FS) BlockSize() int64 {
+	return self.Superblock.BlockSize
+}
+
+func (self *Ext4FS) HasIncompat(flag uint32) bool {
+	return self.Superblock.FeatureIncompat&flag != 0
+}
+
+// Read exactly len(buf) bytes from the device at offset.
+func (self *Ext4FS) readAt(buf []byte, offset int64) error {
+	n, err := self.reader.ReadAt(buf, offset)
+	if n < len(buf) {
+		if err == nil || errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	return nil
+}
+
+func (self *Ext4FS) ReadBlock(block uint64) ([]byte, error) {
+	if block >= self.Superblock.BlocksCount {
+		return nil, fmt.Errorf("Block %v out of range", block)
+	}
+
+	buf := make([]byte, self.Superblock.BlockSize)
+	err := self.readAt(buf, int64(block)*self.Superblock.BlockSize)
+	return buf, err
+}
+
+// Does the group hold a superblock backup? This determines the
+// layout of META_BG descriptors.
+func (self *Ext4FS) groupHasSuper(group uint64) bool {
+	if group <= 1 ||
+		self.Superblock.FeatureRoCompat&RO_COMPAT_SPARSE_SUPER == 0 {
+		return true
+	}
+
+	for _, base := range []uint64{3, 5, 7} {
+		n := base
+		for n < group {
+			n *= base
+		}
+		if n == group {
+			return true
+		}
+	}
+	return false
+}
+
+// Find the block holding the descriptor of the group.
+func (self *Ext4FS) descriptorLocation(group uint64) (uint64, int64) {
+	sb := &self.Superblock
+	per_block := uint64(sb.BlockSize / sb.DescSize)
+	desc_block := group / per_block
+	offset := int64(group%per_block) * sb.DescSize
+
+	if self.HasIncompat(INCOMPAT_META_BG) &&
+		desc_block >= uint64(sb.FirstMetaBg) {
+		// Each meta group keeps its own descriptors in its first
+		// group, after the superblock backup if any.
+		first_group := desc_block * per_block
+		block := uint64(sb.FirstDataBlock) +
+			first_group*uint64(sb.BlocksPerGroup)
+		if self.groupHasSuper(first_group) {
+			block++
+		}
+		return block, offset
+	}
+
+	return uint64(sb.FirstDataBlock) + 1 + desc_block, offset
+}
+
+func (self *Ext4FS) inodeTable(group uint64) (uint64, error) {
+	if group >= self.group_count {
+		return 0, fmt.Errorf("Group %v out of range", group)
+	}
+
+	block, offset := self.descriptorLocation(group)
+	desc := make([]byte, self.Superblock.DescSize)
+	err := self.readAt(desc, int64(block)*self.Superblock.BlockSize+offset)
+	if err != nil {
+		return 0, err
+	}
+
+	table := uint64(binary.LittleEndian.Uint32(desc[0x8:]))
+	if self.Superblock.DescSize >= 64 {
+		table |= uint64(binary.LittleEndian.Uint32(desc[0x28:])) << 32
+	}
+	return table, nil
+}
+
+// Read the raw inode record.
+func (self *Ext4FS) GetInode(number uint64) (*Inode, error) {
+	sb := &self.Superblock
+	if number == 0 || number > uint64(sb.InodesCount) {
+		return nil, fmt.Errorf("Inode %v out of range", number)
+	}
+
+	group := (number - 1) / uint64(sb.InodesPerGroup)
+	index := (number - 1) % uint64(sb.InodesPerGroup)
+
+	table, err := self.inodeTable(group)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, sb.InodeSize)
+	err = self.readAt(buf,
+		int64(table)*sb.BlockSize+int64(index)*sb.InodeSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Inode{
+		fs:     self,
+		Number: number,
+		raw:    buf,
+	}, nil
+}
+
+func cString(buf []byte) string {
+	idx := strings.IndexByte(string(buf), 0)
+	if idx >= 0 {
+		buf = buf[:idx]
+	}
+	return string(buf)
+}
+
+func formatUUID(buf []byte) string {
+	return fmt.Sprintf("%x-%x-%x-%x-%x",
+		buf[0:4], buf[4:6], buf[6:8], buf[8:10], buf[10:16])
+}
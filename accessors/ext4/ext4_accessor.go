@@ -0,0 +1,653 @@
+// An accessor for the ext2/3/4 filesystems inside images and raw
+// devices.
+
+// Paths are pathspecs with a delegate opening the raw filesystem,
+// for example a partition inside a disk image accessed through the
+// offset accessor. Symlinks are resolved within the filesystem.
+
+package ext4
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Velocidex/ordereddict"
+
+	"www.velocidex.com/golang/velociraptor/accessors"
+	"www.velocidex.com/golang/velociraptor/constants"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/third_party/cache"
+	"www.velocidex.com/golang/velociraptor/uploads"
+	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
+	"www.velocidex.com/golang/velociraptor/vql/readers"
+	"www.velocidex.com/golang/vfilter"
+)
+
+const (
+	// Scope cache tag for the ext4 parser
+	Ext4FileSystemTag = "_EXT4"
+
+	// Like the kernel's limit on symlinks in a path lookup.
+	MAX_SYMLINK_DEPTH = 40
+)
+
+type Ext4FileInfo struct {
+	// The root directory has no entry.
+	entry *DirEntry
+
+	// May be nil for deleted entries.
+	inode *Inode
+
+	_full_path *accessors.OSPath
+	ctx        *accessorContext
+}
+
+func (self *Ext4FileInfo) IsDir() bool {
+	if self.entry != nil && self.entry.Deleted {
+		return false
+	}
+	return self.inode != nil && self.inode.IsDir()
+}
+
+func (self *Ext4FileInfo) Size() int64 {
+	if self.inode == nil {
+		return 0
+	}
+	return self.inode.Size()
+}
+
+func (self *Ext4FileInfo) Data() *ordereddict.Dict {
+	result := ordereddict.NewDict()
+	if self.entry != nil {
+		result.Set("inode", self.entry.Inode)
+		if self.entry.Deleted {
+			result.Set("deleted", true)
+		}
+	} else if self.inode != nil {
+		result.Set("inode", self.inode.Number)
+	}
+
+	if self.inode == nil {
+		return result
+	}
+
+	result.Set("uid", self.inode.Uid()).
+		Set("gid", self.inode.Gid()).
+		Set("links", self.inode.Links()).
+		Set("flags", self.inode.Flags())
+
+	// A deleted entry's inode may have been reused since.
+	if self.entry != nil && self.entry.Deleted {
+		result.Set("allocated", self.inode.IsAllocated())
+	}
+
+	if !self.inode.Dtime().IsZero() {
+		result.Set("dtime", self.inode.Dtime())
+	}
+
+	if self.inode.IsSymlink() {
+		target, err := self.inode.LinkTarget()
+		if err == nil {
+			result.Set("Link", target)
+		}
+	}
+
+	attrs, err := self.inode.Xattrs()
+	if err == nil {
+		xattrs := ordereddict.NewDict()
+		for _, attr := range attrs {
+			if attr.Name != "system.data" {
+				xattrs.Set(attr.Name, attr.String())
+			}
+		}
+		if xattrs.Len() > 0 {
+			result.Set("xattr", xattrs)
+		}
+	}
+
+	return result
+}
+
+func (self *Ext4FileInfo) Name() string {
+	if self.entry == nil {
+		return ""
+	}
+	return self.entry.Name
+}
+
+func (self *Ext4FileInfo) Mode() os.FileMode {
+	if self.inode == nil {
+		return 0
+	}
+	return self.inode.Mode()
+}
+
+func (self *Ext4FileInfo) ModTime() time.Time {
+	return self.Mtime()
+}
+
+func (self *Ext4FileInfo) FullPath() string {
+	return self._full_path.String()
+}
+
+func (self *Ext4FileInfo) OSPath() *accessors.OSPath {
+	return self._full_path
+}
+
+func (self *Ext4FileInfo) Btime() time.Time {
+	if self.inode == nil {
+		return time.Time{}
+	}
+	return self.inode.Crtime()
+}
+
+func (self *Ext4FileInfo) Mtime() time.Time {
+	if self.inode == nil {
+		return time.Time{}
+	}
+	return self.inode.Mtime()
+}
+
+func (self *Ext4FileInfo) Ctime() time.Time {
+	if self.inode == nil {
+		return time.Time{}
+	}
+	return self.inode.Ctime()
+}
+
+func (self *Ext4FileInfo) Atime() time.Time {
+	if self.inode == nil {
+		return time.Time{}
+	}
+	return self.inode.Atime()
+}
+
+func (self *Ext4FileInfo) IsLink() bool {
+	return self.inode != nil && self.inode.IsSymlink() &&
+		(self.entry == nil || !self.entry.Deleted)
+}
+
+// Links are resolved relative to the root of the filesystem.
+func (self *Ext4FileInfo) GetLink() (*accessors.OSPath, error) {
+	if !self.IsLink() {
+		return nil, errors.New("Not a symlink")
+	}
+
+	if self.ctx.WasLinkVisited(self.inode) {
+		return nil, errors.New("Symlink cycle detected")
+	}
+	self.ctx.LinkVisited(self.inode)
+
+	target, err := self.inode.LinkTarget()
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(target, "/") {
+		target = "/" + strings.Join(
+			self._full_path.Dirname().Components, "/") + "/" + target
+	}
+
+	result := self._full_path.Copy()
+	result.Components = nil
+	for _, component := range strings.Split(path.Clean(target), "/") {
+		if component != "" {
+			result.Components = append(result.Components, component)
+		}
+	}
+	return result, nil
+}
+
+type _link struct {
+	fs    *Ext4FS
+	inode uint64
+}
+
+// Keeps track of symlinks followed by the globber.
+type accessorContext struct {
+	mu    sync.Mutex
+	links map[_link]bool
+}
+
+func (self *accessorContext) LinkVisited(inode *Inode) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	self.links[_link{inode.fs, inode.Number}] = true
+}
+
+func (self *accessorContext) WasLinkVisited(inode *Inode) bool {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	_, pres := self.links[_link{inode.fs, inode.Number}]
+	return pres
+}
+
+type dirCacheElement struct {
+	entries []*DirEntry
+}
+
+func (self dirCacheElement) Size() int {
+	return 1
+}
+
+// A parsed filesystem is cached in the scope together with recent
+// directory listings to speed up path resolution.
+type ext4Context struct {
+	fs        *Ext4FS
+	dir_cache *cache.LRUCache
+}
+
+func (self *ext4Context) listDir(inode *Inode) ([]*DirEntry, error) {
+	key := fmt.Sprintf("%d", inode.Number)
+	cached, pres := self.dir_cache.Get(key)
+	if pres {
+		return cached.(dirCacheElement).entries, nil
+	}
+
+	entries, err := inode.ReadDir(false)
+	if err != nil {
+		return nil, err
+	}
+	self.dir_cache.Set(key, dirCacheElement{entries: entries})
+	return entries, nil
+}
+
+type ext4Cache struct {
+	mu       sync.Mutex
+	contexts map[string]*ext4Context
+}
+
+func getExt4Cache(scope vfilter.Scope) *ext4Cache {
+	result_any := vql_subsystem.CacheGet(scope, Ext4FileSystemTag)
+	if result_any != nil {
+		cached, ok := result_any.(*ext4Cache)
+		if ok {
+			return cached
+		}
+	}
+
+	result := &ext4Cache{
+		contexts: make(map[string]*ext4Context),
+	}
+	vql_subsystem.CacheSet(scope, Ext4FileSystemTag, result)
+
+	return result
+}
+
+func getExt4Context(scope vfilter.Scope,
+	device *accessors.OSPath, accessor string) (*ext4Context, error) {
+	cache_key := accessor + "://" + device.String()
+
+	ext4_cache := getExt4Cache(scope)
+	ext4_cache.mu.Lock()
+	defer ext4_cache.mu.Unlock()
+
+	ctx, pres := ext4_cache.contexts[cache_key]
+	if pres {
+		return ctx, nil
+	}
+
+	lru_size := vql_subsystem.GetIntFromRow(
+		scope, scope, constants.EXT4_CACHE_SIZE)
+	paged_reader, err := readers.NewPagedReader(
+		scope, accessor, device, int(lru_size))
+	if err != nil {
+		return nil, err
+	}
+
+	fs, err := GetExt4FS(paged_reader)
+	if err != nil {
+		paged_reader.Close()
+		return nil, err
+	}
+
+	ctx = &ext4Context{
+		fs:        fs,
+		dir_cache: cache.NewLRUCache(200),
+	}
+	ext4_cache.contexts[cache_key] = ctx
+
+	return ctx, nil
+}
+
+// Get the filesystem on the device, parsing it if needed.
+func GetExt4Filesystem(scope vfilter.Scope,
+	device *accessors.OSPath, accessor string) (*Ext4FS, error) {
+	ctx, err := getExt4Context(scope, device, accessor)
+	if err != nil {
+		return nil, err
+	}
+	return ctx.fs, nil
+}
+
+// The filesystem is opened from the delegate of the path.
+func getDelegateContext(scope vfilter.Scope,
+	full_path *accessors.OSPath) (*ext4Context, error) {
+	accessor := full_path.DelegateAccessor()
+	if accessor == "" {
+		return nil, errors.New(
+			"ext4: a delegate is required to open the device, did you provide a pathspec?")
+	}
+
+	device, err := full_path.Delegate(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return getExt4Context(scope, device, accessor)
+}
+
+type Ext4FileSystemAccessor struct {
+	scope vfilter.Scope
+	root  *accessors.OSPath
+	ctx   *accessorContext
+}
+
+func (self *Ext4FileSystemAccessor) New(scope vfilter.Scope) (
+	accessors.FileSystemAccessor, error) {
+	return &Ext4FileSystemAccessor{
+		scope: scope,
+		root:  self.root,
+		ctx: &accessorContext{
+			links: make(map[_link]bool),
+		},
+	}, nil
+}
+
+func (self Ext4FileSystemAccessor) ParsePath(path string) (
+	*accessors.OSPath, error) {
+	return self.root.Parse(path)
+}
+
+// Walk the path from the root directory. Symlinks in intermediate
+// components are always followed, the final component only if
+// follow_last is set.
+func (self *Ext4FileSystemAccessor) resolve(
+	ctx *ext4Context, components []string, follow_last bool) (
+	*DirEntry, *Inode, error) {
+
+	root, err := ctx.fs.GetInode(ROOT_INODE)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The chain of directories leading to the current one.
+	var entry *DirEntry
+	stack := []*Inode{root}
+	remaining := append([]string{}, components...)
+	links := 0
+
+	for len(remaining) > 0 {
+		name := remaining[0]
+		remaining = remaining[1:]
+
+		switch name {
+		case "", ".":
+			continue
+		case "..":
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+			entry = nil
+			continue
+		}
+
+		dir := stack[len(stack)-1]
+		if !dir.IsDir() {
+			return nil, nil, errors.New("Not a directory")
+		}
+
+		entries, err := ctx.listDir(dir)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var child *DirEntry
+		for _, e := range entries {
+			if e.Name == name {
+				child = e
+				break
+			}
+		}
+		if child == nil {
+			return nil, nil, os.ErrNotExist
+		}
+
+		inode, err := ctx.fs.GetInode(child.Inode)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if inode.IsSymlink() && (len(remaining) > 0 || follow_last) {
+			links++
+			if links > MAX_SYMLINK_DEPTH {
+				return nil, nil, errors.New("Too many levels of symbolic links")
+			}
+
+			target, err := inode.LinkTarget()
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if strings.HasPrefix(target, "/") {
+				stack = stack[:1]
+			}
+			remaining = append(strings.Split(target, "/"), remaining...)
+			continue
+		}
+
+		entry = child
+		stack = append(stack, inode)
+	}
+
+	return entry, stack[len(stack)-1], nil
+}
+
+func (self *Ext4FileSystemAccessor) ReadDir(path string) (
+	[]accessors.FileInfo, error) {
+	full_path, err := self.ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return self.ReadDirWithOSPath(full_path)
+}
+
+func (self *Ext4FileSystemAccessor) ReadDirWithOSPath(
+	full_path *accessors.OSPath) ([]accessors.FileInfo, error) {
+	ctx, err := getDelegateContext(self.scope, full_path)
+	if err != nil {
+		return nil, err
+	}
+
+	_, dir, err := self.resolve(ctx, full_path.Components, true)
+	if err != nil {
+		return nil, err
+	}
+
+	include_deleted := vql_subsystem.GetBoolFromRow(
+		self.scope, self.scope, constants.EXT4_INCLUDE_DELETED)
+
+	var entries []*DirEntry
+	if include_deleted {
+		entries, err = dir.ReadDir(true)
+	} else {
+		entries, err = ctx.listDir(dir)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result []accessors.FileInfo
+	for _, entry := range entries {
+		if entry.Name == "." || entry.Name == ".." {
+			continue
+		}
+
+		info := &Ext4FileInfo{
+			entry:      entry,
+			_full_path: full_path.Append(entry.Name),
+			ctx:        self.ctx,
+		}
+
+		if entry.Inode != 0 {
+			inode, err := ctx.fs.GetInode(entry.Inode)
+			if err != nil && !entry.Deleted {
+				continue
+			}
+			info.inode = inode
+		}
+
+		result = append(result, info)
+	}
+
+	return result, nil
+}
+
+// Adapt the inode reader to a ReadSeekCloser.
+type readAdapter struct {
+	sync.Mutex
+
+	reader *InodeReader
+	pos    int64
+}
+
+func (self *readAdapter) Ranges() []uploads.Range {
+	return self.reader.Ranges()
+}
+
+func (self *readAdapter) Read(buf []byte) (int, error) {
+	self.Lock()
+	defer self.Unlock()
+
+	n, err := self.reader.ReadAt(buf, self.pos)
+	self.pos += int64(n)
+	return n, err
+}
+
+func (self *readAdapter) ReadAt(buf []byte, offset int64) (int, error) {
+	self.Lock()
+	defer self.Unlock()
+
+	return self.reader.ReadAt(buf, offset)
+}
+
+func (self *readAdapter) Seek(offset int64, whence int) (int64, error) {
+	self.Lock()
+	defer self.Unlock()
+
+	switch whence {
+	case io.SeekStart:
+		self.pos = offset
+	case io.SeekCurrent:
+		self.pos += offset
+	case io.SeekEnd:
+		self.pos = self.reader.Size() + offset
+	}
+	return self.pos, nil
+}
+
+func (self *readAdapter) Close() error {
+	return nil
+}
+
+func (self *Ext4FileSystemAccessor) Open(path string) (
+	accessors.ReadSeekCloser, error) {
+	full_path, err := self.ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return self.OpenWithOSPath(full_path)
+}
+
+func (self *Ext4FileSystemAccessor) OpenWithOSPath(
+	full_path *accessors.OSPath) (accessors.ReadSeekCloser, error) {
+	ctx, err := getDelegateContext(self.scope, full_path)
+	if err != nil {
+		return nil, err
+	}
+
+	_, inode, err := self.resolve(ctx, full_path.Components, true)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := inode.Reader()
+	if err != nil {
+		return nil, err
+	}
+
+	return &readAdapter{reader: reader}, nil
+}
+
+func (self *Ext4FileSystemAccessor) Lstat(path string) (
+	accessors.FileInfo, error) {
+	full_path, err := self.ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return self.LstatWithOSPath(full_path)
+}
+
+func (self *Ext4FileSystemAccessor) LstatWithOSPath(
+	full_path *accessors.OSPath) (accessors.FileInfo, error) {
+	ctx, err := getDelegateContext(self.scope, full_path)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, inode, err := self.resolve(ctx, full_path.Components, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Ext4FileInfo{
+		entry:      entry,
+		inode:      inode,
+		_full_path: full_path,
+		ctx:        self.ctx,
+	}, nil
+}
+
+func init() {
+	accessors.Register("ext4", &Ext4FileSystemAccessor{
+		root: accessors.MustNewGenericOSPath(""),
+		ctx: &accessorContext{
+			links: make(map[_link]bool),
+		},
+	},
+		`Access files inside an ext2/3/4 filesystem by parsing it.
+
+This accessor is designed to operate on images or raw devices. It
+requires a delegate accessor to get the raw filesystem (for example
+the offset accessor to open a partition inside a disk image).
+
+The Data field of each file reports the inode number, ownership,
+flags and extended attributes. Btime is the inode creation time
+(crtime) on filesystems with large inodes.
+
+Set the EXT4_INCLUDE_DELETED variable to also list deleted
+directory entries recovered from the directory slack space. These
+are marked as deleted and their inode may have been reused.
+
+## Example
+
+SELECT * FROM glob(globs="/etc/*",
+  root=pathspec(DelegateAccessor="offset",
+    Delegate=pathspec(DelegateAccessor="file",
+       DelegatePath="/images/disk.dd", Path="1048576")),
+  accessor="ext4")
+`)
+
+	json.RegisterCustomEncoder(&Ext4FileInfo{}, accessors.MarshalGlobFileInfo)
+}
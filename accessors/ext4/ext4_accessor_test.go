@@ -0,0 +1,162 @@
+package ext4
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/Velocidex/ordereddict"
+	"github.com/sebdah/goldie"
+	"www.velocidex.com/golang/velociraptor/accessors"
+	"www.velocidex.com/golang/velociraptor/config"
+	"www.velocidex.com/golang/velociraptor/constants"
+	"www.velocidex.com/golang/velociraptor/glob"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/logging"
+	"www.velocidex.com/golang/velociraptor/uploads"
+	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
+	"www.velocidex.com/golang/velociraptor/vql/acl_managers"
+	"www.velocidex.com/golang/velociraptor/vtesting/assert"
+	"www.velocidex.com/golang/vfilter"
+
+	_ "www.velocidex.com/golang/velociraptor/accessors/file"
+)
+
+func getRoot(t *testing.T, image string) *accessors.OSPath {
+	abs_path, _ := filepath.Abs("../../artifacts/testdata/files/" + image)
+	root := &accessors.PathSpec{
+		DelegateAccessor: "file",
+		DelegatePath:     abs_path,
+	}
+	root_path, err := accessors.NewGenericOSPath(root.String())
+	assert.NoError(t, err)
+	return root_path
+}
+
+func makeScope(vars *ordereddict.Dict) vfilter.Scope {
+	config_obj := config.GetDefaultConfig()
+	scope := vql_subsystem.MakeScope().AppendVars(
+		vars.Set(vql_subsystem.ACL_MANAGER_VAR, acl_managers.NullACLManager{}))
+	scope.SetLogger(logging.NewPlainLogger(
+		config_obj, &logging.FrontendComponent))
+	return scope
+}
+
+func globImage(t *testing.T, scope vfilter.Scope, image string) []*ordereddict.Dict {
+	accessor, err := accessors.GetAccessor("ext4", scope)
+	assert.NoError(t, err)
+
+	globber := glob.NewGlobber()
+	globber.Add(accessors.MustNewGenericOSPath("/**"))
+
+	result := []*ordereddict.Dict{}
+	for hit := range globber.ExpandWithContext(
+		context.Background(), scope, config.GetDefaultConfig(),
+		getRoot(t, image), accessor) {
+		result = append(result, ordereddict.NewDict().
+			Set("Path", hit.OSPath().Path()).
+			Set("Size", hit.Size()).
+			Set("Mode", hit.Mode().String()).
+			Set("Btime", hit.Btime()).
+			Set("Mtime", hit.Mtime()).
+			Set("Data", hit.Data()))
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		a, _ := result[i].GetString("Path")
+		b, _ := result[j].GetString("Path")
+		return a < b
+	})
+	return result
+}
+
+func readFile(t *testing.T, scope vfilter.Scope,
+	image, path string) (string, []uploads.Range) {
+	accessor, err := accessors.GetAccessor("ext4", scope)
+	assert.NoError(t, err)
+
+	fd, err := accessor.OpenWithOSPath(getRoot(t, image).Append(
+		strings.Split(path, "/")...))
+	assert.NoError(t, err)
+	defer fd.Close()
+
+	data, err := ioutil.ReadAll(fd)
+	assert.NoError(t, err)
+
+	return string(data), fd.(*readAdapter).Ranges()
+}
+
+func TestExt4Accessor(t *testing.T) {
+	scope := makeScope(ordereddict.NewDict())
+	defer scope.Close()
+
+	golden := ordereddict.NewDict().
+		Set("Glob", globImage(t, scope, "test.ext4.dd"))
+
+	// Inline data
+	data, _ := readFile(t, scope, "test.ext4.dd", "hello.txt")
+	assert.Equal(t, "hello world\n", data)
+
+	// Follows symlinks
+	data, _ = readFile(t, scope, "test.ext4.dd", "link.txt")
+	assert.Equal(t, "hello world\n", data)
+
+	// Extents
+	data, _ = readFile(t, scope, "test.ext4.dd", "big.bin")
+	assert.Equal(t, 204800, len(data))
+	assert.Equal(t, "BLOCK00199", data[199*1024:199*1024+10])
+
+	// Sparse files
+	data, ranges := readFile(t, scope, "test.ext4.dd", "sparse.bin")
+	assert.Equal(t, strings.Repeat("\x00", 40960)+"SPARSEDATA", data)
+	golden.Set("SparseRanges", ranges)
+
+	goldie.Assert(t, "TestExt4Accessor", json.MustMarshalIndent(golden))
+}
+
+func TestExt4AccessorDeleted(t *testing.T) {
+	scope := makeScope(ordereddict.NewDict().
+		Set(constants.EXT4_INCLUDE_DELETED, true))
+	defer scope.Close()
+
+	accessor, err := accessors.GetAccessor("ext4", scope)
+	assert.NoError(t, err)
+
+	result := ordereddict.NewDict()
+	for _, dir := range []string{"dir1", "dir2"} {
+		children, err := accessor.ReadDirWithOSPath(
+			getRoot(t, "test.ext4.dd").Append(dir))
+		assert.NoError(t, err)
+
+		for _, child := range children {
+			deleted, _ := child.Data().GetBool("deleted")
+			if deleted {
+				result.Set(child.OSPath().Path(), child.Data())
+			}
+		}
+	}
+
+	goldie.Assert(t, "TestExt4AccessorDeleted", json.MustMarshalIndent(result))
+}
+
+// Legacy block mapped files.
+func TestExt2Accessor(t *testing.T) {
+	scope := makeScope(ordereddict.NewDict())
+	defer scope.Close()
+
+	golden := ordereddict.NewDict().
+		Set("Glob", globImage(t, scope, "test.ext2.dd"))
+
+	data, _ := readFile(t, scope, "test.ext2.dd", "big.bin")
+	assert.Equal(t, 204800, len(data))
+	assert.Equal(t, "BLOCK00012", data[12*1024:12*1024+10])
+	assert.Equal(t, "BLOCK00199", data[199*1024:199*1024+10])
+
+	data, _ = readFile(t, scope, "test.ext2.dd", "dir1/subdir/nested.txt")
+	assert.Equal(t, "nested\n", data)
+
+	goldie.Assert(t, "TestExt2Accessor", json.MustMarshalIndent(golden))
+}
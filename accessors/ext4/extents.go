@@ -0,0 +1,367 @@
+package ext4
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"www.velocidex.com/golang/velociraptor/uploads"
+)
+
+const (
+	EXTENT_MAGIC = 0xF30A
+
+	// Extents longer than this are uninitialized (allocated but
+	// read as zeros).
+	EXTENT_INIT_MAX_LEN = 32768
+
+	MAX_EXTENT_DEPTH = 5
+
+	// Block map slots in i_block
+	DIRECT_BLOCKS   = 12
+	INDIRECT_BLOCK  = 12
+	DINDIRECT_BLOCK = 13
+	TINDIRECT_BLOCK = 14
+)
+
+// A run maps a range of logical file blocks onto the disk.
+type Run struct {
+	Logical  uint64
+	Physical uint64
+	Length   uint64
+
+	// Sparse runs are read as zeros.
+	Sparse bool
+}
+
+func (self *Inode) numBlocks() uint64 {
+	block_size := uint64(self.fs.BlockSize())
+	return (uint64(self.Size()) + block_size - 1) / block_size
+}
+
+// Runs maps the file's data onto the disk.
+func (self *Inode) Runs() ([]*Run, error) {
+	var runs []*Run
+	var err error
+
+	if self.HasFlag(EXT4_EXTENTS_FL) {
+		runs, err = self.fs.walkExtents(self.iBlock(), 0, nil)
+	} else {
+		runs, err = self.blockMapRuns()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(runs, func(i, j int) bool {
+		return runs[i].Logical < runs[j].Logical
+	})
+	return runs, nil
+}
+
+func (self *Ext4FS) walkExtents(
+	node []byte, depth int, runs []*Run) ([]*Run, error) {
+	if depth > MAX_EXTENT_DEPTH {
+		return nil, errors.New("Extent tree too deep")
+	}
+
+	if len(node) < 12 ||
+		binary.LittleEndian.Uint16(node[0:]) != EXTENT_MAGIC {
+		return nil, errors.New("Invalid extent header")
+	}
+
+	entries := int(binary.LittleEndian.Uint16(node[2:]))
+	tree_depth := binary.LittleEndian.Uint16(node[6:])
+
+	for i := 0; i < entries; i++ {
+		if 12+(i+1)*12 > len(node) {
+			return nil, errors.New("Extent entries overflow node")
+		}
+		entry := node[12+i*12:]
+
+		logical := uint64(binary.LittleEndian.Uint32(entry[0:]))
+
+		// Leaf extent
+		if tree_depth == 0 {
+			length := uint64(binary.LittleEndian.Uint16(entry[4:]))
+			sparse := false
+			if length > EXTENT_INIT_MAX_LEN {
+				length -= EXTENT_INIT_MAX_LEN
+				sparse = true
+			}
+
+			runs = append(runs, &Run{
+				Logical: logical,
+				Physical: uint64(binary.LittleEndian.Uint16(entry[6:]))<<32 |
+					uint64(binary.LittleEndian.Uint32(entry[8:])),
+				Length: length,
+				Sparse: sparse,
+			})
+			continue
+		}
+
+		// Index node points to the next level of the tree.
+		leaf := uint64(binary.LittleEndian.Uint32(entry[4:])) |
+			uint64(binary.LittleEndian.Uint16(entry[8:]))<<32
+
+		block, err := self.ReadBlock(leaf)
+		if err != nil {
+			return nil, err
+		}
+
+		runs, err = self.walkExtents(block, depth+1, runs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return runs, nil
+}
+
+// Legacy ext2/3 files map blocks through direct and indirect block
+// pointers.
+func (self *Inode) blockMapRuns() ([]*Run, error) {
+	walker := &blockMapWalker{
+		fs:         self.fs,
+		max_blocks: self.numBlocks(),
+	}
+
+	i_block := self.iBlock()
+	for i := 0; i < DIRECT_BLOCKS; i++ {
+		walker.add(uint64(binary.LittleEndian.Uint32(i_block[i*4:])))
+	}
+
+	for level, slot := range []int{
+		INDIRECT_BLOCK, DINDIRECT_BLOCK, TINDIRECT_BLOCK} {
+		err := walker.walk(
+			uint64(binary.LittleEndian.Uint32(i_block[slot*4:])), level)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return walker.runs, nil
+}
+
+type blockMapWalker struct {
+	fs         *Ext4FS
+	runs       []*Run
+	logical    uint64
+	max_blocks uint64
+}
+
+func (self *blockMapWalker) done() bool {
+	return self.logical >= self.max_blocks
+}
+
+// Add the next logical block, merging contiguous blocks into runs.
+func (self *blockMapWalker) add(physical uint64) {
+	if self.done() {
+		return
+	}
+
+	logical := self.logical
+	self.logical++
+
+	// Holes are simply not mapped.
+	if physical == 0 {
+		return
+	}
+
+	if len(self.runs) > 0 {
+		last := self.runs[len(self.runs)-1]
+		if last.Logical+last.Length == logical &&
+			last.Physical+last.Length == physical {
+			last.Length++
+			return
+		}
+	}
+
+	self.runs = append(self.runs, &Run{
+		Logical:  logical,
+		Physical: physical,
+		Length:   1,
+	})
+}
+
+// Walk an indirect block of the given level (0 is singly indirect).
+func (self *blockMapWalker) walk(block uint64, level int) error {
+	if self.done() {
+		return nil
+	}
+
+	per_block := uint64(self.fs.BlockSize() / 4)
+
+	// A hole covering the entire indirect tree.
+	if block == 0 {
+		span := per_block
+		for i := 0; i < level; i++ {
+			span *= per_block
+		}
+		self.logical += span
+		return nil
+	}
+
+	data, err := self.fs.ReadBlock(block)
+	if err != nil {
+		return err
+	}
+
+	for i := uint64(0); i < per_block && !self.done(); i++ {
+		pointer := uint64(binary.LittleEndian.Uint32(data[i*4:]))
+		if level == 0 {
+			self.add(pointer)
+			continue
+		}
+
+		err = self.walk(pointer, level-1)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// InodeReader reads the data of an inode.
+type InodeReader struct {
+	fs   *Ext4FS
+	runs []*Run
+	size int64
+
+	// Inline data is kept in memory.
+	inline []byte
+}
+
+func newInlineReader(data []byte, size int64) *InodeReader {
+	if int64(len(data)) > size {
+		data = data[:size]
+	}
+	return &InodeReader{
+		inline: data,
+		size:   size,
+	}
+}
+
+func (self *InodeReader) Size() int64 {
+	return self.size
+}
+
+// Find the run containing the logical block, or nil if the block is
+// not mapped.
+func (self *InodeReader) findRun(block uint64) *Run {
+	idx := sort.Search(len(self.runs), func(i int) bool {
+		return self.runs[i].Logical+self.runs[i].Length > block
+	})
+	if idx < len(self.runs) && self.runs[idx].Logical <= block {
+		return self.runs[idx]
+	}
+	return nil
+}
+
+func (self *InodeReader) ReadAt(buf []byte, offset int64) (int, error) {
+	if offset < 0 {
+		return 0, fmt.Errorf("Invalid offset %v", offset)
+	}
+
+	if offset >= self.size {
+		return 0, io.EOF
+	}
+
+	to_read := int64(len(buf))
+	if offset+to_read > self.size {
+		to_read = self.size - offset
+	}
+
+	if self.inline != nil || self.fs == nil {
+		n := 0
+		if offset < int64(len(self.inline)) {
+			n = copy(buf[:to_read], self.inline[offset:])
+		}
+
+		// Anything past the inline data reads as zeros.
+		for i := int64(n); i < to_read; i++ {
+			buf[i] = 0
+		}
+		return int(to_read), nil
+	}
+
+	block_size := self.fs.BlockSize()
+	total := int64(0)
+	for total < to_read {
+		current := offset + total
+		block := uint64(current / block_size)
+		block_offset := current % block_size
+
+		// Read up to the end of the run.
+		available := block_size - block_offset
+		run := self.findRun(block)
+		if run != nil {
+			available += int64(run.Logical+run.Length-block-1) * block_size
+		}
+
+		length := to_read - total
+		if length > available {
+			length = available
+		}
+		chunk := buf[total : total+length]
+
+		if run == nil || run.Sparse {
+			for i := range chunk {
+				chunk[i] = 0
+			}
+		} else {
+			disk_offset := int64(run.Physical+block-run.Logical)*block_size +
+				block_offset
+			err := self.fs.readAt(chunk, disk_offset)
+			if err != nil {
+				return int(total), err
+			}
+		}
+		total += length
+	}
+
+	return int(total), nil
+}
+
+// Report sparse regions so uploads can skip them.
+func (self *InodeReader) Ranges() []uploads.Range {
+	if self.fs == nil {
+		return []uploads.Range{{Offset: 0, Length: self.size}}
+	}
+
+	result := []uploads.Range{}
+	add := func(offset, length int64, sparse bool) {
+		if offset >= self.size || length <= 0 {
+			return
+		}
+		if offset+length > self.size {
+			length = self.size - offset
+		}
+
+		if len(result) > 0 {
+			last := &result[len(result)-1]
+			if last.IsSparse == sparse && last.Offset+last.Length == offset {
+				last.Length += length
+				return
+			}
+		}
+		result = append(result, uploads.Range{
+			Offset: offset, Length: length, IsSparse: sparse})
+	}
+
+	block_size := self.fs.BlockSize()
+	next := int64(0)
+	for _, run := range self.runs {
+		start := int64(run.Logical) * block_size
+		if start > next {
+			add(next, start-next, true)
+		}
+		add(start, int64(run.Length)*block_size, run.Sparse)
+		next = start + int64(run.Length)*block_size
+	}
+	add(next, self.size-next, true)
+
+	return result
+}
@@ -0,0 +1,321 @@
+package ext4
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/Velocidex/ordereddict"
+)
+
+const (
+	S_IFMT   = 0xF000
+	S_IFSOCK = 0xC000
+	S_IFLNK  = 0xA000
+	S_IFREG  = 0x8000
+	S_IFBLK  = 0x6000
+	S_IFDIR  = 0x4000
+	S_IFCHR  = 0x2000
+	S_IFIFO  = 0x1000
+
+	// Offset of the extended inode fields.
+	EXTRA_ISIZE_OFFSET = 0x80
+
+	// Size of the i_block array.
+	I_BLOCK_SIZE = 60
+)
+
+type Inode struct {
+	fs *Ext4FS
+
+	Number uint64
+	raw    []byte
+}
+
+func (self *Inode) le16(offset int) uint16 {
+	if offset+2 > len(self.raw) {
+		return 0
+	}
+	return binary.LittleEndian.Uint16(self.raw[offset:])
+}
+
+func (self *Inode) le32(offset int) uint32 {
+	if offset+4 > len(self.raw) {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(self.raw[offset:])
+}
+
+func (self *Inode) RawMode() uint16 {
+	return self.le16(0x0)
+}
+
+func (self *Inode) Type() uint16 {
+	return self.RawMode() & S_IFMT
+}
+
+func (self *Inode) IsDir() bool {
+	return self.Type() == S_IFDIR
+}
+
+func (self *Inode) IsSymlink() bool {
+	return self.Type() == S_IFLNK
+}
+
+func (self *Inode) IsRegular() bool {
+	return self.Type() == S_IFREG
+}
+
+// Convert the ext4 mode to a go FileMode.
+func (self *Inode) Mode() os.FileMode {
+	mode := self.RawMode()
+	result := os.FileMode(mode & 0777)
+
+	switch mode & S_IFMT {
+	case S_IFDIR:
+		result |= os.ModeDir
+	case S_IFLNK:
+		result |= os.ModeSymlink
+	case S_IFBLK:
+		result |= os.ModeDevice
+	case S_IFCHR:
+		result |= os.ModeDevice | os.ModeCharDevice
+	case S_IFIFO:
+		result |= os.ModeNamedPipe
+	case S_IFSOCK:
+		result |= os.ModeSocket
+	}
+
+	if mode&0x800 != 0 {
+		result |= os.ModeSetuid
+	}
+	if mode&0x400 != 0 {
+		result |= os.ModeSetgid
+	}
+	if mode&0x200 != 0 {
+		result |= os.ModeSticky
+	}
+	return result
+}
+
+func (self *Inode) Uid() uint32 {
+	return uint32(self.le16(0x2)) | uint32(self.le16(0x78))<<16
+}
+
+func (self *Inode) Gid() uint32 {
+	return uint32(self.le16(0x18)) | uint32(self.le16(0x7A))<<16
+}
+
+func (self *Inode) Size() int64 {
+	return int64(uint64(self.le32(0x4)) | uint64(self.le32(0x6C))<<32)
+}
+
+func (self *Inode) Links() uint16 {
+	return self.le16(0x1A)
+}
+
+func (self *Inode) Flags() uint32 {
+	return self.le32(0x20)
+}
+
+func (self *Inode) Generation() uint32 {
+	return self.le32(0x64)
+}
+
+// The block holding the external extended attributes.
+func (self *Inode) FileACL() uint64 {
+	return uint64(self.le32(0x68)) | uint64(self.le16(0x76))<<32
+}
+
+func (self *Inode) HasFlag(flag uint32) bool {
+	return self.Flags()&flag != 0
+}
+
+// The inode is in use if it has links. Deleted inodes have their
+// dtime set.
+func (self *Inode) IsAllocated() bool {
+	return self.Links() > 0 && self.le32(0x14) == 0
+}
+
+func (self *Inode) extraSize() int {
+	if len(self.raw) <= EXTRA_ISIZE_OFFSET {
+		return 0
+	}
+	return int(self.le16(EXTRA_ISIZE_OFFSET))
+}
+
+// Is the extended field at offset present in this inode?
+func (self *Inode) hasExtra(offset int) bool {
+	end := EXTRA_ISIZE_OFFSET + self.extraSize()
+	return offset+4 <= end && end <= len(self.raw)
+}
+
+// Timestamps consist of a signed 32 bit seconds field extended by 2
+// epoch bits and 30 bits of nanoseconds in the extra fields.
+func (self *Inode) timestamp(offset, extra_offset int) time.Time {
+	seconds := int64(int32(self.le32(offset)))
+	var nanoseconds int64
+
+	if self.hasExtra(extra_offset) {
+		extra := self.le32(extra_offset)
+		seconds += int64(extra&3) << 32
+		nanoseconds = int64(extra >> 2)
+	}
+	return time.Unix(seconds, nanoseconds).UTC()
+}
+
+func (self *Inode) Atime() time.Time {
+	return self.timestamp(0x8, 0x8C)
+}
+
+func (self *Inode) Ctime() time.Time {
+	return self.timestamp(0xC, 0x84)
+}
+
+func (self *Inode) Mtime() time.Time {
+	return self.timestamp(0x10, 0x88)
+}
+
+// The creation time is only present in large inodes.
+func (self *Inode) Crtime() time.Time {
+	if !self.hasExtra(0x90) {
+		return time.Time{}
+	}
+	return self.timestamp(0x90, 0x94)
+}
+
+func (self *Inode) Dtime() time.Time {
+	dtime := self.le32(0x14)
+	if dtime == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(dtime), 0).UTC()
+}
+
+func (self *Inode) iBlock() []byte {
+	return self.raw[0x28 : 0x28+I_BLOCK_SIZE]
+}
+
+// Fast symlinks store their target in the i_block array.
+func (self *Inode) IsFastSymlink() bool {
+	return self.IsSymlink() &&
+		!self.HasFlag(EXT4_EXTENTS_FL|EXT4_INLINE_DATA_FL) &&
+		self.Size() < I_BLOCK_SIZE
+}
+
+// Returns the target of a symlink.
+func (self *Inode) LinkTarget() (string, error) {
+	if !self.IsSymlink() {
+		return "", errors.New("Not a symlink")
+	}
+
+	if self.IsFastSymlink() {
+		return string(self.iBlock()[:self.Size()]), nil
+	}
+
+	reader, err := self.Reader()
+	if err != nil {
+		return "", err
+	}
+
+	size := self.Size()
+	if size > 4096 {
+		return "", errors.New("Symlink target too long")
+	}
+
+	buf := make([]byte, size)
+	n, err := reader.ReadAt(buf, 0)
+	if int64(n) < size {
+		return "", err
+	}
+	return string(buf), nil
+}
+
+// Returns a reader over the data of the inode.
+func (self *Inode) Reader() (*InodeReader, error) {
+	if self.HasFlag(EXT4_INLINE_DATA_FL) {
+		data, err := self.inlineData()
+		if err != nil {
+			return nil, err
+		}
+		return newInlineReader(data, self.Size()), nil
+	}
+
+	if self.IsFastSymlink() {
+		return newInlineReader(self.iBlock(), self.Size()), nil
+	}
+
+	runs, err := self.Runs()
+	if err != nil {
+		return nil, err
+	}
+
+	return &InodeReader{
+		fs:   self.fs,
+		runs: runs,
+		size: self.Size(),
+	}, nil
+}
+
+// Inline data is stored in i_block and continues in the system.data
+// extended attribute.
+func (self *Inode) inlineData() ([]byte, error) {
+	result := append([]byte{}, self.iBlock()...)
+
+	attrs, err := self.Xattrs()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, attr := range attrs {
+		if attr.Name == "system.data" {
+			result = append(result, attr.Value...)
+			break
+		}
+	}
+	return result, nil
+}
+
+// A summary of the inode's metadata.
+func (self *Inode) Stat() *ordereddict.Dict {
+	result := ordereddict.NewDict().
+		Set("inode", self.Number).
+		Set("mode", self.Mode().String()).
+		Set("uid", self.Uid()).
+		Set("gid", self.Gid()).
+		Set("size", self.Size()).
+		Set("links", self.Links()).
+		Set("flags", self.Flags()).
+		Set("generation", self.Generation()).
+		Set("atime", self.Atime()).
+		Set("mtime", self.Mtime()).
+		Set("ctime", self.Ctime()).
+		Set("crtime", self.Crtime())
+
+	if !self.Dtime().IsZero() {
+		result.Set("dtime", self.Dtime())
+	}
+
+	if self.IsSymlink() {
+		target, err := self.LinkTarget()
+		if err == nil {
+			result.Set("link", target)
+		}
+	}
+
+	attrs, err := self.Xattrs()
+	if err == nil && len(attrs) > 0 {
+		xattrs := ordereddict.NewDict()
+		for _, attr := range attrs {
+			// This is the inline data itself.
+			if attr.Name == "system.data" {
+				continue
+			}
+			xattrs.Set(attr.Name, attr.String())
+		}
+		result.Set("xattr", xattrs)
+	}
+
+	return result
+}
@@ -0,0 +1,165 @@
+package ext4
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	XATTR_MAGIC = 0xEA020000
+
+	// Size of the external xattr block header.
+	XATTR_BLOCK_HEADER_SIZE = 32
+
+	// Values stored in separate inodes may be large - we only read
+	// this much.
+	MAX_XATTR_VALUE_SIZE = 64 * 1024
+)
+
+var xattrPrefixes = map[uint8]string{
+	1: "user.",
+	2: "system.posix_acl_access",
+	3: "system.posix_acl_default",
+	4: "trusted.",
+	6: "security.",
+	7: "system.",
+	8: "system.richacl",
+}
+
+type Xattr struct {
+	Name  string
+	Value []byte
+}
+
+// Render the value as a string if it is printable.
+func (self *Xattr) String() string {
+	value := strings.TrimRight(string(self.Value), "\x00")
+	if utf8.ValidString(value) &&
+		strings.IndexFunc(value, func(r rune) bool {
+			return !unicode.IsPrint(r) && !unicode.IsSpace(r)
+		}) < 0 {
+		return value
+	}
+	return fmt.Sprintf("%x", self.Value)
+}
+
+// Xattrs returns the extended attributes stored inside the inode and
+// in the external attribute block.
+func (self *Inode) Xattrs() ([]*Xattr, error) {
+	var result []*Xattr
+
+	// In-inode attributes follow the extra fields.
+	start := EXTRA_ISIZE_OFFSET + self.extraSize()
+	if len(self.raw) > EXTRA_ISIZE_OFFSET && start+4 <= len(self.raw) &&
+		binary.LittleEndian.Uint32(self.raw[start:]) == XATTR_MAGIC {
+		entries := self.raw[start+4:]
+		attrs, err := self.fs.parseXattrs(entries, entries)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, attrs...)
+	}
+
+	file_acl := self.FileACL()
+	if file_acl != 0 {
+		block, err := self.fs.ReadBlock(file_acl)
+		if err != nil {
+			return nil, err
+		}
+
+		if binary.LittleEndian.Uint32(block) != XATTR_MAGIC {
+			return nil, errors.New("Invalid xattr block magic")
+		}
+
+		attrs, err := self.fs.parseXattrs(
+			block[XATTR_BLOCK_HEADER_SIZE:], block)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, attrs...)
+	}
+
+	return result, nil
+}
+
+// Parse a list of xattr entries. Value offsets are relative to
+// value_base.
+func (self *Ext4FS) parseXattrs(entries, value_base []byte) ([]*Xattr, error) {
+	var result []*Xattr
+
+	offset := 0
+	for offset+16 <= len(entries) {
+		entry := entries[offset:]
+
+		// The list is terminated by 4 null bytes.
+		if binary.LittleEndian.Uint32(entry) == 0 {
+			break
+		}
+
+		name_len := int(entry[0])
+		name_index := entry[1]
+		value_offset := int(binary.LittleEndian.Uint16(entry[2:]))
+		value_inode := binary.LittleEndian.Uint32(entry[4:])
+		value_size := int(binary.LittleEndian.Uint32(entry[8:]))
+
+		if 16+name_len > len(entry) {
+			return nil, errors.New("Xattr name overflows entry")
+		}
+
+		attr := &Xattr{
+			Name: xattrPrefixes[name_index] + string(entry[16:16+name_len]),
+		}
+
+		if value_inode != 0 {
+			value, err := self.readXattrInode(uint64(value_inode), value_size)
+			if err != nil {
+				return nil, err
+			}
+			attr.Value = value
+
+		} else {
+			if value_offset+value_size > len(value_base) {
+				return nil, errors.New("Xattr value out of range")
+			}
+			attr.Value = append([]byte{},
+				value_base[value_offset:value_offset+value_size]...)
+		}
+
+		result = append(result, attr)
+		offset += (16 + name_len + 3) &^ 3
+	}
+
+	return result, nil
+}
+
+// Large values may be stored in their own inode (ea_inode feature).
+func (self *Ext4FS) readXattrInode(number uint64, size int) ([]byte, error) {
+	if size > MAX_XATTR_VALUE_SIZE {
+		size = MAX_XATTR_VALUE_SIZE
+	}
+
+	inode, err := self.GetInode(number)
+	if err != nil {
+		return nil, err
+	}
+
+	if !inode.HasFlag(EXT4_EA_INODE_FL) {
+		return nil, errors.New("Xattr value inode is not an EA inode")
+	}
+
+	reader, err := inode.Reader()
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, size)
+	n, err := reader.ReadAt(buf, 0)
+	if n < size {
+		return nil, err
+	}
+	return buf, nil
+}
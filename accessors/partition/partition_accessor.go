@@ -0,0 +1,316 @@
+// An accessor exposing the partitions of a disk.
+
+// The disk is opened through the delegate of the pathspec and its
+// GPT or MBR partition table is parsed. Each partition is listed as a
+// directory in the root named after its partition number. Opening the
+// partition reads its raw data so it can be delegated to a filesystem
+// accessor like raw_ntfs or ext4, instead of calculating the offset
+// of the partition by hand.
+
+package partition
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/Velocidex/ordereddict"
+	"www.velocidex.com/golang/velociraptor/accessors"
+	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
+	"www.velocidex.com/golang/velociraptor/vql/readers"
+	"www.velocidex.com/golang/vfilter"
+)
+
+const (
+	// Scope cache tag for parsed partition tables
+	PartitionTag = "_PARTITIONS"
+)
+
+type partitionContext struct {
+	reader     io.ReaderAt
+	partitions []*Partition
+
+	// The filesystem detected in each partition.
+	filesystems map[int]string
+}
+
+type partitionCache struct {
+	mu       sync.Mutex
+	contexts map[string]*partitionContext
+}
+
+func getPartitionCache(scope vfilter.Scope) *partitionCache {
+	result_any := vql_subsystem.CacheGet(scope, PartitionTag)
+	if result_any != nil {
+		cached, ok := result_any.(*partitionCache)
+		if ok {
+			return cached
+		}
+	}
+
+	result := &partitionCache{
+		contexts: make(map[string]*partitionContext),
+	}
+	vql_subsystem.CacheSet(scope, PartitionTag, result)
+
+	return result
+}
+
+func getPartitionContext(scope vfilter.Scope,
+	device *accessors.OSPath, accessor string) (*partitionContext, error) {
+	cache_key := accessor + "://" + device.String()
+
+	partition_cache := getPartitionCache(scope)
+	partition_cache.mu.Lock()
+	defer partition_cache.mu.Unlock()
+
+	ctx, pres := partition_cache.contexts[cache_key]
+	if pres {
+		return ctx, nil
+	}
+
+	paged_reader, err := readers.NewPagedReader(scope, accessor, device, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	partitions, err := ParsePartitionTable(paged_reader)
+	if err != nil {
+		paged_reader.Close()
+		return nil, err
+	}
+
+	ctx = &partitionContext{
+		reader:      paged_reader,
+		partitions:  partitions,
+		filesystems: make(map[int]string),
+	}
+	for _, partition := range partitions {
+		ctx.filesystems[partition.Number] = DetectFilesystem(
+			paged_reader, partition.StartOffset)
+	}
+	partition_cache.contexts[cache_key] = ctx
+
+	return ctx, nil
+}
+
+// Get the partitions of the disk, parsing the partition table if
+// needed.
+func GetPartitions(scope vfilter.Scope,
+	device *accessors.OSPath, accessor string) ([]*Partition, error) {
+	ctx, err := getPartitionContext(scope, device, accessor)
+	if err != nil {
+		return nil, err
+	}
+	return ctx.partitions, nil
+}
+
+func getDelegateContext(scope vfilter.Scope,
+	full_path *accessors.OSPath) (*partitionContext, error) {
+	accessor := full_path.DelegateAccessor()
+	if accessor == "" {
+		return nil, errors.New(
+			"partition: a delegate is required to open the disk, did you provide a pathspec?")
+	}
+
+	device, err := full_path.Delegate(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return getPartitionContext(scope, device, accessor)
+}
+
+func (self *partitionContext) getPartition(name string) (*Partition, error) {
+	number, err := strconv.Atoi(name)
+	if err != nil {
+		return nil, os.ErrNotExist
+	}
+
+	for _, partition := range self.partitions {
+		if partition.Number == number {
+			return partition, nil
+		}
+	}
+	return nil, os.ErrNotExist
+}
+
+func (self *partitionContext) fileInfo(
+	partition *Partition, full_path *accessors.OSPath) accessors.FileInfo {
+	data := ordereddict.NewDict().
+		Set("number", partition.Number).
+		Set("scheme", partition.Scheme).
+		Set("type", partition.Type).
+		Set("start_offset", partition.StartOffset).
+		Set("end_offset", partition.EndOffset()).
+		Set("size", partition.Size).
+		Set("filesystem", self.filesystems[partition.Number])
+
+	if partition.Scheme == "gpt" {
+		data.Set("type_guid", partition.TypeGUID).
+			Set("guid", partition.GUID).
+			Set("label", partition.Label)
+	} else {
+		data.Set("mbr_type", fmt.Sprintf("%#02x", partition.MBRType)).
+			Set("bootable", partition.Bootable).
+			Set("logical", partition.Logical)
+	}
+
+	return &accessors.VirtualFileInfo{
+		IsDir_: true,
+		Size_:  partition.Size,
+		Data_:  data,
+		Path:   full_path,
+	}
+}
+
+type PartitionFileSystemAccessor struct {
+	scope vfilter.Scope
+	root  *accessors.OSPath
+}
+
+func (self *PartitionFileSystemAccessor) New(scope vfilter.Scope) (
+	accessors.FileSystemAccessor, error) {
+	return &PartitionFileSystemAccessor{
+		scope: scope,
+		root:  self.root,
+	}, nil
+}
+
+func (self PartitionFileSystemAccessor) ParsePath(path string) (
+	*accessors.OSPath, error) {
+	return self.root.Parse(path)
+}
+
+func (self *PartitionFileSystemAccessor) ReadDir(path string) (
+	[]accessors.FileInfo, error) {
+	full_path, err := self.ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return self.ReadDirWithOSPath(full_path)
+}
+
+func (self *PartitionFileSystemAccessor) ReadDirWithOSPath(
+	full_path *accessors.OSPath) ([]accessors.FileInfo, error) {
+	ctx, err := getDelegateContext(self.scope, full_path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Partitions have no children.
+	if len(full_path.Components) > 0 {
+		return nil, nil
+	}
+
+	var result []accessors.FileInfo
+	for _, partition := range ctx.partitions {
+		result = append(result, ctx.fileInfo(partition,
+			full_path.Append(strconv.Itoa(partition.Number))))
+	}
+	return result, nil
+}
+
+// Reads the partition's data.
+type partitionReader struct {
+	*io.SectionReader
+}
+
+func (self partitionReader) Close() error {
+	return nil
+}
+
+func (self *PartitionFileSystemAccessor) Open(path string) (
+	accessors.ReadSeekCloser, error) {
+	full_path, err := self.ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return self.OpenWithOSPath(full_path)
+}
+
+func (self *PartitionFileSystemAccessor) OpenWithOSPath(
+	full_path *accessors.OSPath) (accessors.ReadSeekCloser, error) {
+	ctx, err := getDelegateContext(self.scope, full_path)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(full_path.Components) != 1 {
+		return nil, errors.New("partition: path must be a partition number")
+	}
+
+	partition, err := ctx.getPartition(full_path.Components[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return partitionReader{io.NewSectionReader(
+		ctx.reader, partition.StartOffset, partition.Size)}, nil
+}
+
+func (self *PartitionFileSystemAccessor) Lstat(path string) (
+	accessors.FileInfo, error) {
+	full_path, err := self.ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return self.LstatWithOSPath(full_path)
+}
+
+func (self *PartitionFileSystemAccessor) LstatWithOSPath(
+	full_path *accessors.OSPath) (accessors.FileInfo, error) {
+	ctx, err := getDelegateContext(self.scope, full_path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(full_path.Components) {
+	case 0:
+		return &accessors.VirtualFileInfo{
+			IsDir_: true,
+			Path:   full_path,
+		}, nil
+
+	case 1:
+		partition, err := ctx.getPartition(full_path.Components[0])
+		if err != nil {
+			return nil, err
+		}
+		return ctx.fileInfo(partition, full_path), nil
+	}
+
+	return nil, os.ErrNotExist
+}
+
+func init() {
+	accessors.Register("partition", &PartitionFileSystemAccessor{
+		root: accessors.MustNewGenericOSPath(""),
+	},
+		`Access the partitions of a disk by parsing its partition table.
+
+GPT and MBR partition tables (including logical partitions inside
+extended partitions) are supported. Each partition is listed in the
+root directory, named after its partition number, with its type, label
+and offsets in the Data field. Opening a partition reads its raw data.
+
+## Example
+
+SELECT OSPath.Path AS Partition, Data
+FROM glob(globs="/*", accessor="partition",
+  root=pathspec(DelegateAccessor="file", DelegatePath="/images/disk.dd"))
+
+The partition may then be used as the delegate of a filesystem accessor:
+
+SELECT * FROM glob(globs="/*", accessor="raw_ntfs",
+  root=pathspec(DelegateAccessor="partition",
+    Delegate=pathspec(DelegateAccessor="file",
+       DelegatePath="/images/disk.dd", Path="/2")))
+`)
+}
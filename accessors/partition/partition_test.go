@@ -0,0 +1,174 @@
+package partition
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/Velocidex/ordereddict"
+	"github.com/sebdah/goldie"
+	"www.velocidex.com/golang/velociraptor/accessors"
+	"www.velocidex.com/golang/velociraptor/config"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/logging"
+	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
+	"www.velocidex.com/golang/velociraptor/vql/acl_managers"
+	"www.velocidex.com/golang/velociraptor/vtesting/assert"
+	"www.velocidex.com/golang/vfilter"
+
+	_ "www.velocidex.com/golang/velociraptor/accessors/file"
+)
+
+func makeScope() vfilter.Scope {
+	config_obj := config.GetDefaultConfig()
+	scope := vql_subsystem.MakeScope().AppendVars(ordereddict.NewDict().
+		Set(vql_subsystem.ACL_MANAGER_VAR, acl_managers.NullACLManager{}))
+	scope.SetLogger(logging.NewPlainLogger(
+		config_obj, &logging.FrontendComponent))
+	return scope
+}
+
+func mbrEntryBytes(sector []byte, slot int, partition_type uint8,
+	start, sectors uint32) {
+	entry := sector[MBR_PARTITION_OFFSET+slot*16:]
+	entry[4] = partition_type
+	binary.LittleEndian.PutUint32(entry[8:], start)
+	binary.LittleEndian.PutUint32(entry[12:], sectors)
+	binary.LittleEndian.PutUint16(sector[510:], MBR_SIGNATURE)
+}
+
+// A 4MB disk with an NTFS primary partition and an extended
+// partition holding two logical partitions.
+func buildMBRDisk() []byte {
+	disk := make([]byte, 4*1024*1024)
+	mbrEntryBytes(disk, 0, 0x07, 2048, 2048)
+	disk[MBR_PARTITION_OFFSET] = 0x80
+	mbrEntryBytes(disk, 1, 0x05, 4096, 4096)
+	copy(disk[2048*512+3:], "NTFS    ")
+
+	// The first EBR describes a logical partition 1MB after it and
+	// links to the next EBR.
+	ebr := disk[4096*512:]
+	mbrEntryBytes(ebr, 0, 0x83, 2, 1022)
+	mbrEntryBytes(ebr, 1, 0x05, 2048, 2048)
+
+	ebr = disk[(4096+2048)*512:]
+	mbrEntryBytes(ebr, 0, 0x82, 2, 1024)
+	copy(disk[(4096+2048+2)*512+4086:], "SWAPSPACE2")
+
+	// ext4 superblock magic
+	copy(disk[(4096+2)*512+0x438:], "\x53\xef")
+	return disk
+}
+
+func guidBytes(guid string) []byte {
+	raw, _ := hex.DecodeString(strings.Replace(guid, "-", "", -1))
+	result := append([]byte{}, raw...)
+	binary.LittleEndian.PutUint32(result[0:], binary.BigEndian.Uint32(raw[0:]))
+	binary.LittleEndian.PutUint16(result[4:], binary.BigEndian.Uint16(raw[4:]))
+	binary.LittleEndian.PutUint16(result[6:], binary.BigEndian.Uint16(raw[6:]))
+	return result
+}
+
+func gptEntry(disk []byte, index int, type_guid, guid string,
+	first, last uint64, label string) {
+	entry := disk[2*512+index*128:]
+	copy(entry, guidBytes(type_guid))
+	copy(entry[16:], guidBytes(guid))
+	binary.LittleEndian.PutUint64(entry[32:], first)
+	binary.LittleEndian.PutUint64(entry[40:], last)
+	for i, c := range utf16.Encode([]rune(label)) {
+		binary.LittleEndian.PutUint16(entry[56+i*2:], c)
+	}
+}
+
+// A 4MB GPT disk with an EFI partition and a basic data partition.
+func buildGPTDisk() []byte {
+	disk := make([]byte, 4*1024*1024)
+	mbrEntryBytes(disk, 0, MBR_TYPE_GPT_PROTECTIVE, 1, 8191)
+
+	header := disk[512:]
+	copy(header, GPT_SIGNATURE)
+	binary.LittleEndian.PutUint64(header[72:], 2)
+	binary.LittleEndian.PutUint32(header[80:], 128)
+	binary.LittleEndian.PutUint32(header[84:], 128)
+
+	gptEntry(disk, 0, "C12A7328-F81F-11D2-BA4B-00A0C93EC93B",
+		"6E5A4B3C-2D1E-4F00-8A9B-0C1D2E3F4A5B", 2048, 4095,
+		"EFI system partition")
+
+	// Entry 2 is unused so the data partition is number 3.
+	gptEntry(disk, 2, "EBD0A0A2-B9E5-4433-87C0-68B6B72699C7",
+		"1A2B3C4D-5E6F-4A0B-9C8D-7E6F5A4B3C2D", 4096, 8158,
+		"Basic data partition")
+	copy(disk[4096*512+3:], "NTFS    ")
+	return disk
+}
+
+func listPartitions(t *testing.T, scope vfilter.Scope,
+	disk string) []*ordereddict.Dict {
+	accessor, err := accessors.GetAccessor("partition", scope)
+	assert.NoError(t, err)
+
+	root := &accessors.PathSpec{
+		DelegateAccessor: "file",
+		DelegatePath:     disk,
+	}
+
+	hits, err := accessor.ReadDir(root.String())
+	assert.NoError(t, err)
+
+	result := []*ordereddict.Dict{}
+	for _, hit := range hits {
+		result = append(result, ordereddict.NewDict().
+			Set("Name", hit.Name()).
+			Set("IsDir", hit.IsDir()).
+			Set("Size", hit.Size()).
+			Set("Data", hit.Data()))
+	}
+	return result
+}
+
+func TestPartitionAccessor(t *testing.T) {
+	dir := t.TempDir()
+	mbr_disk := filepath.Join(dir, "mbr.dd")
+	gpt_disk := filepath.Join(dir, "gpt.dd")
+	assert.NoError(t, ioutil.WriteFile(mbr_disk, buildMBRDisk(), 0600))
+	assert.NoError(t, ioutil.WriteFile(gpt_disk, buildGPTDisk(), 0600))
+
+	scope := makeScope()
+	golden := ordereddict.NewDict().
+		Set("MBR", listPartitions(t, scope, mbr_disk)).
+		Set("GPT", listPartitions(t, scope, gpt_disk))
+
+	goldie.Assert(t, "TestPartitionAccessor",
+		json.MustMarshalIndent(golden))
+
+	// Opening a partition reads its data.
+	accessor, err := accessors.GetAccessor("partition", scope)
+	assert.NoError(t, err)
+
+	fd, err := accessor.Open((&accessors.PathSpec{
+		DelegateAccessor: "file",
+		DelegatePath:     gpt_disk,
+		Path:             "/3",
+	}).String())
+	assert.NoError(t, err)
+
+	buf := make([]byte, 11)
+	_, err = fd.Read(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "\x00\x00\x00NTFS    ", string(buf))
+
+	// Missing partitions
+	_, err = accessor.Open((&accessors.PathSpec{
+		DelegateAccessor: "file",
+		DelegatePath:     gpt_disk,
+		Path:             "/2",
+	}).String())
+	assert.Error(t, err)
+}
@@ -0,0 +1,360 @@
+package partition
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	MBR_SIGNATURE        = 0xAA55
+	MBR_PARTITION_OFFSET = 0x1BE
+
+	MBR_TYPE_GPT_PROTECTIVE = 0xEE
+
+	GPT_SIGNATURE = "EFI PART"
+
+	// Linux numbers logical partitions from 5
+	FIRST_LOGICAL_PARTITION = 5
+
+	MAX_GPT_ENTRIES     = 1024
+	MAX_LOGICAL_ENTRIES = 128
+)
+
+var (
+	NoPartitionTableError = errors.New("No partition table found")
+
+	gptTypes = map[string]string{
+		"C12A7328-F81F-11D2-BA4B-00A0C93EC93B": "EFI System",
+		"E3C9E316-0B5C-4DB8-817D-F92DF00215AE": "Microsoft Reserved",
+		"EBD0A0A2-B9E5-4433-87C0-68B6B72699C7": "Microsoft Basic Data",
+		"DE94BBA4-06D1-4D40-A16A-BFD50179D6AC": "Windows Recovery Environment",
+		"5808C8AA-7E8F-42E0-85D2-E1E90434CFB3": "LDM Metadata",
+		"AF9B60A0-1431-4F62-BC68-3311714A69AD": "LDM Data",
+		"E75CAF8F-F680-4CEE-AFA3-B001E56EFC2D": "Storage Spaces",
+		"0FC63DAF-8483-4772-8E79-3D69D8477DE4": "Linux Filesystem",
+		"44479540-F297-41B2-9AF7-D131D5F0458A": "Linux Root (x86)",
+		"4F68BCE3-E8CD-4DB1-96E7-FBCAF984B709": "Linux Root (x86-64)",
+		"B921B045-1DF0-41C3-AF44-4C6F280D3FAE": "Linux Root (ARM64)",
+		"933AC7E1-2EB4-4F13-B844-0E14E2AEF915": "Linux Home",
+		"BC13C2FF-59E6-4262-A352-B275FD6F7172": "Linux Extended Boot",
+		"0657FD6D-A4AB-43C4-84E5-0933C84B4F4F": "Linux Swap",
+		"E6D6D379-F507-44C2-A23C-238F2A3DF928": "Linux LVM",
+		"A19D880F-05FC-4D3B-A006-743F0F84911E": "Linux RAID",
+		"21686148-6449-6E6F-744E-656564454649": "BIOS Boot",
+		"48465300-0000-11AA-AA11-00306543ECAC": "Apple HFS+",
+		"7C3457EF-0000-11AA-AA11-00306543ECAC": "Apple APFS",
+		"6A898CC3-1DD2-11B2-99A6-080020736631": "ZFS",
+		"516E7CB4-6ECF-11D6-8FF8-00022D09712B": "FreeBSD Data",
+		"83BD6B9D-7F41-11DC-BE0B-001560B84F0F": "FreeBSD Boot",
+	}
+
+	mbrTypes = map[uint8]string{
+		0x01: "FAT12",
+		0x04: "FAT16 (<32M)",
+		0x05: "Extended",
+		0x06: "FAT16",
+		0x07: "NTFS / exFAT",
+		0x0B: "FAT32",
+		0x0C: "FAT32 (LBA)",
+		0x0E: "FAT16 (LBA)",
+		0x0F: "Extended (LBA)",
+		0x12: "Hibernation",
+		0x27: "Windows Recovery Environment",
+		0x42: "Windows Dynamic",
+		0x82: "Linux Swap",
+		0x83: "Linux",
+		0x85: "Linux Extended",
+		0x8E: "Linux LVM",
+		0xA5: "FreeBSD",
+		0xAF: "Apple HFS+",
+		0xEE: "GPT Protective",
+		0xEF: "EFI System",
+		0xFD: "Linux RAID",
+	}
+)
+
+type Partition struct {
+	// The partition number as the Linux kernel would assign it.
+	Number int
+
+	// gpt or mbr
+	Scheme string
+
+	// In bytes from the start of the disk
+	StartOffset int64
+	Size        int64
+
+	// A description of the type
+	Type string
+
+	// GPT partitions are identified by GUIDs.
+	TypeGUID string
+	GUID     string
+	Label    string
+
+	// MBR partitions have a type byte.
+	MBRType  uint8
+	Bootable bool
+
+	// A logical partition inside an extended partition.
+	Logical bool
+}
+
+func (self *Partition) EndOffset() int64 {
+	return self.StartOffset + self.Size
+}
+
+func isExtended(partition_type uint8) bool {
+	return partition_type == 0x05 || partition_type == 0x0F ||
+		partition_type == 0x85
+}
+
+// GUIDs are stored with the first three fields little endian.
+func parseGUID(buf []byte) string {
+	return strings.ToUpper(fmt.Sprintf("%08x-%04x-%04x-%x-%x",
+		binary.LittleEndian.Uint32(buf[0:]),
+		binary.LittleEndian.Uint16(buf[4:]),
+		binary.LittleEndian.Uint16(buf[6:]),
+		buf[8:10], buf[10:16]))
+}
+
+func parseUTF16(buf []byte) string {
+	chars := make([]uint16, 0, len(buf)/2)
+	for i := 0; i+1 < len(buf); i += 2 {
+		c := binary.LittleEndian.Uint16(buf[i:])
+		if c == 0 {
+			break
+		}
+		chars = append(chars, c)
+	}
+	return string(utf16.Decode(chars))
+}
+
+// Parse the GPT or MBR partition table on the disk.
+func ParsePartitionTable(reader io.ReaderAt) ([]*Partition, error) {
+	mbr := make([]byte, 512)
+	_, err := reader.ReadAt(mbr, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// The GPT header is in the second sector, which may be 512 or
+	// 4096 bytes.
+	for _, sector_size := range []int64{512, 4096} {
+		partitions, err := parseGPT(reader, sector_size)
+		if err == nil {
+			return partitions, nil
+		}
+	}
+
+	if binary.LittleEndian.Uint16(mbr[510:]) != MBR_SIGNATURE {
+		return nil, NoPartitionTableError
+	}
+
+	return parseMBR(reader, mbr)
+}
+
+func parseGPT(reader io.ReaderAt, sector_size int64) ([]*Partition, error) {
+	header := make([]byte, 92)
+	_, err := reader.ReadAt(header, sector_size)
+	if err != nil {
+		return nil, err
+	}
+
+	if string(header[:8]) != GPT_SIGNATURE {
+		return nil, NoPartitionTableError
+	}
+
+	entries_lba := int64(binary.LittleEndian.Uint64(header[72:]))
+	count := int(binary.LittleEndian.Uint32(header[80:]))
+	entry_size := int(binary.LittleEndian.Uint32(header[84:]))
+
+	if count > MAX_GPT_ENTRIES || entry_size < 128 || entry_size > 4096 {
+		return nil, fmt.Errorf("Invalid GPT entries (%v of size %v)",
+			count, entry_size)
+	}
+
+	entries := make([]byte, count*entry_size)
+	_, err = reader.ReadAt(entries, entries_lba*sector_size)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*Partition{}
+	for i := 0; i < count; i++ {
+		entry := entries[i*entry_size:]
+		type_guid := parseGUID(entry[0:])
+		if type_guid == "00000000-0000-0000-0000-000000000000" {
+			continue
+		}
+
+		first_lba := int64(binary.LittleEndian.Uint64(entry[32:]))
+		last_lba := int64(binary.LittleEndian.Uint64(entry[40:]))
+		if last_lba < first_lba {
+			continue
+		}
+
+		partition := &Partition{
+			Number:      i + 1,
+			Scheme:      "gpt",
+			StartOffset: first_lba * sector_size,
+			Size:        (last_lba - first_lba + 1) * sector_size,
+			TypeGUID:    type_guid,
+			GUID:        parseGUID(entry[16:]),
+			Label:       parseUTF16(entry[56:128]),
+			Type:        gptTypes[type_guid],
+		}
+		if partition.Type == "" {
+			partition.Type = "Unknown"
+		}
+		result = append(result, partition)
+	}
+
+	return result, nil
+}
+
+type mbrEntry struct {
+	bootable       bool
+	partition_type uint8
+	start          int64
+	sectors        int64
+}
+
+func parseMBREntries(sector []byte) []mbrEntry {
+	result := make([]mbrEntry, 4)
+	for i := range result {
+		entry := sector[MBR_PARTITION_OFFSET+i*16:]
+		result[i] = mbrEntry{
+			bootable:       entry[0] == 0x80,
+			partition_type: entry[4],
+			start:          int64(binary.LittleEndian.Uint32(entry[8:])),
+			sectors:        int64(binary.LittleEndian.Uint32(entry[12:])),
+		}
+	}
+	return result
+}
+
+func newMBRPartition(number int, entry mbrEntry, start int64) *Partition {
+	result := &Partition{
+		Number:      number,
+		Scheme:      "mbr",
+		StartOffset: start * 512,
+		Size:        entry.sectors * 512,
+		MBRType:     entry.partition_type,
+		Bootable:    entry.bootable,
+		Type:        mbrTypes[entry.partition_type],
+	}
+	if result.Type == "" {
+		result.Type = fmt.Sprintf("Unknown (%#02x)", entry.partition_type)
+	}
+	return result
+}
+
+func parseMBR(reader io.ReaderAt, mbr []byte) ([]*Partition, error) {
+	result := []*Partition{}
+	var extended []mbrEntry
+
+	for i, entry := range parseMBREntries(mbr) {
+		if entry.partition_type == 0 || entry.sectors == 0 {
+			continue
+		}
+
+		if isExtended(entry.partition_type) {
+			extended = append(extended, entry)
+			continue
+		}
+
+		result = append(result, newMBRPartition(i+1, entry, entry.start))
+	}
+
+	next_logical := FIRST_LOGICAL_PARTITION
+	for _, entry := range extended {
+		logical, err := parseExtended(reader, entry.start, &next_logical)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, logical...)
+	}
+
+	return result, nil
+}
+
+// Extended partitions contain a chain of extended boot records, each
+// describing a logical partition (relative to the EBR) and the next
+// EBR (relative to the start of the extended partition).
+func parseExtended(reader io.ReaderAt,
+	extended_start int64, next_logical *int) ([]*Partition, error) {
+	result := []*Partition{}
+	seen := make(map[int64]bool)
+	ebr_lba := extended_start
+
+	for i := 0; i < MAX_LOGICAL_ENTRIES; i++ {
+		if seen[ebr_lba] {
+			break
+		}
+		seen[ebr_lba] = true
+
+		ebr := make([]byte, 512)
+		_, err := reader.ReadAt(ebr, ebr_lba*512)
+		if err != nil {
+			return nil, err
+		}
+
+		if binary.LittleEndian.Uint16(ebr[510:]) != MBR_SIGNATURE {
+			break
+		}
+
+		entries := parseMBREntries(ebr)
+		logical := entries[0]
+		if logical.partition_type != 0 && logical.sectors > 0 {
+			partition := newMBRPartition(
+				*next_logical, logical, ebr_lba+logical.start)
+			partition.Logical = true
+			result = append(result, partition)
+			*next_logical++
+		}
+
+		next := entries[1]
+		if !isExtended(next.partition_type) || next.start == 0 {
+			break
+		}
+		ebr_lba = extended_start + next.start
+	}
+
+	return result, nil
+}
+
+// Guess the filesystem in the partition from its magic.
+func DetectFilesystem(reader io.ReaderAt, offset int64) string {
+	buf := make([]byte, 4096)
+	n, _ := reader.ReadAt(buf, offset)
+	buf = buf[:n]
+
+	has := func(at int, magic string) bool {
+		return len(buf) >= at+len(magic) && string(buf[at:at+len(magic)]) == magic
+	}
+
+	switch {
+	case has(3, "NTFS    "):
+		return "ntfs"
+	case has(3, "-FVE-FS-"):
+		return "bitlocker"
+	case has(3, "EXFAT   "):
+		return "exfat"
+	case has(0x52, "FAT32   "), has(0x36, "FAT16   "), has(0x36, "FAT12   "):
+		return "fat"
+	case has(0x438, "\x53\xef"):
+		return "ext4"
+	case has(0, "XFSB"):
+		return "xfs"
+	case has(0x218, "LVM2 001"):
+		return "lvm2"
+	case has(4086, "SWAPSPACE2"):
+		return "swap"
+	}
+	return ""
+}
@@ -0,0 +1,444 @@
+// Accessors presenting virtual disk images as a flat device.
+
+// The image is opened through the delegate of the pathspec. Extent
+// files and parent images are searched for relative to the image
+// using the same delegate accessor. For example:
+
+// pathspec(DelegateAccessor="vmdk",
+//          DelegatePath="/vms/disk.vmdk",
+//          Path="/")
+
+// is the disk presented by the VMDK image. This may be further
+// delegated to an offset accessor to read a partition and then to
+// raw_ntfs or ext4.
+
+package vdisk
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/Velocidex/ordereddict"
+	"www.velocidex.com/golang/velociraptor/accessors"
+	"www.velocidex.com/golang/velociraptor/accessors/zip"
+	"www.velocidex.com/golang/velociraptor/constants"
+	"www.velocidex.com/golang/velociraptor/uploads"
+	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
+	"www.velocidex.com/golang/velociraptor/vql/readers"
+	"www.velocidex.com/golang/vfilter"
+)
+
+const (
+	// Scope cache tag for parsed images
+	VDiskTag = "_VDISK"
+)
+
+var (
+	absolutePathRegex = regexp.MustCompile(`^([a-zA-Z]:|\\\\|/)`)
+)
+
+type imageCache struct {
+	mu     sync.Mutex
+	images map[string]*Image
+}
+
+func getImageCache(scope vfilter.Scope) *imageCache {
+	result_any := vql_subsystem.CacheGet(scope, VDiskTag)
+	if result_any != nil {
+		cached, ok := result_any.(*imageCache)
+		if ok {
+			return cached
+		}
+	}
+
+	result := &imageCache{
+		images: make(map[string]*Image),
+	}
+	vql_subsystem.CacheSet(scope, VDiskTag, result)
+
+	return result
+}
+
+// Opens the files making up an image chain through the delegate
+// accessor.
+type imageContext struct {
+	scope         vfilter.Scope
+	accessor_name string
+	accessor      accessors.FileSystemAccessor
+	lru_size      int
+}
+
+func (self *imageContext) openFile(
+	path *accessors.OSPath) (io.ReaderAt, int64, error) {
+	stat, err := self.accessor.LstatWithOSPath(path)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	reader, err := readers.NewPagedReader(
+		self.scope, self.accessor_name, path, self.lru_size)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return reader, stat.Size(), nil
+}
+
+// Join a name relative to the directory of base. Names may use
+// either path separator.
+func relativePath(base *accessors.OSPath, name string) *accessors.OSPath {
+	result := base.Dirname()
+	for _, component := range strings.FieldsFunc(name, func(r rune) bool {
+		return r == '/' || r == '\\'
+	}) {
+		switch component {
+		case ".":
+		case "..":
+			result = result.Dirname()
+		default:
+			result = result.Append(component)
+		}
+	}
+	return result
+}
+
+// Images refer to other files by names which are often absolute
+// paths on the system that created them. Try to find the file next
+// to the image first.
+func (self *imageContext) resolve(
+	base *accessors.OSPath, names ...string) (*accessors.OSPath, error) {
+	var candidates []*accessors.OSPath
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+
+		if !absolutePathRegex.MatchString(name) {
+			candidates = append(candidates, relativePath(base, name))
+		}
+
+		basename := name
+		idx := strings.LastIndexAny(name, `/\`)
+		if idx >= 0 {
+			basename = name[idx+1:]
+		}
+		candidates = append(candidates, base.Dirname().Append(basename))
+
+		absolute, err := self.accessor.ParsePath(name)
+		if err == nil {
+			candidates = append(candidates, absolute)
+		}
+	}
+
+	for _, candidate := range candidates {
+		_, err := self.accessor.LstatWithOSPath(candidate)
+		if err == nil {
+			return candidate, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Unable to find %v relative to %v",
+		strings.Join(names, ", "), base.String())
+}
+
+func (self *imageContext) opener(base *accessors.OSPath) FileOpener {
+	return func(name string) (io.ReaderAt, int64, error) {
+		path, err := self.resolve(base, name)
+		if err != nil {
+			return nil, 0, err
+		}
+		return self.openFile(path)
+	}
+}
+
+// Detect the format of a parent image by its magic.
+func detectFormat(reader io.ReaderAt) string {
+	header := make([]byte, 1024)
+	n, _ := reader.ReadAt(header, 0)
+	header = header[:n]
+
+	switch {
+	case bytes.HasPrefix(header, []byte("QFI\xfb")):
+		return "qcow2"
+	case bytes.HasPrefix(header, []byte(VHDX_SIGNATURE)):
+		return "vhdx"
+	case bytes.HasPrefix(header, []byte("KDMV")),
+		bytes.Contains(header, []byte("# Disk DescriptorFile")):
+		return "vmdk"
+	}
+	return "raw"
+}
+
+// A raw backing file.
+type rawImage struct {
+	reader io.ReaderAt
+	size   int64
+}
+
+func (self *rawImage) Size() int64 {
+	return self.size
+}
+
+func (self *rawImage) MapOffset(offset int64) (*Run, error) {
+	return &Run{
+		Kind:   RUN_DATA,
+		Reader: self.reader,
+		Offset: offset,
+		Length: self.size - offset,
+	}, nil
+}
+
+func (self *imageContext) openImage(
+	format string, path *accessors.OSPath, depth int) (*Image, error) {
+	if depth > MAX_PARENT_DEPTH {
+		return nil, errors.New("Image parent chain too deep")
+	}
+
+	reader, size, err := self.openFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if format == "" {
+		format = detectFormat(reader)
+	}
+
+	result := &Image{Format: format}
+	var parent_format string
+	var parent_names []string
+
+	switch format {
+	case "qcow2":
+		qcow2, err := OpenQCOW2(reader)
+		if err != nil {
+			return nil, err
+		}
+		result.mapper = qcow2
+		parent_names = []string{qcow2.BackingFile}
+
+	case "vhdx":
+		vhdx, err := OpenVHDX(reader)
+		if err != nil {
+			return nil, err
+		}
+		result.mapper = vhdx
+		if vhdx.LogPending {
+			self.scope.Log("vhdx: %v has unapplied log entries, data may be stale",
+				path.String())
+		}
+
+		if vhdx.HasParent {
+			parent_format = "vhdx"
+			parent_names = []string{
+				vhdx.ParentLocator["relative_path"],
+				vhdx.ParentLocator["absolute_win32_path"],
+				vhdx.ParentLocator["volume_path"],
+			}
+		}
+
+	case "vmdk":
+		vmdk, err := OpenVMDK(reader, size, self.opener(path))
+		if err != nil {
+			return nil, err
+		}
+		result.mapper = vmdk
+		if vmdk.Descriptor != nil && vmdk.Descriptor.HasParent() {
+			parent_format = "vmdk"
+			parent_names = []string{vmdk.Descriptor.ParentFileNameHint}
+		}
+
+	case "raw":
+		result.mapper = &rawImage{reader: reader, size: size}
+
+	default:
+		return nil, fmt.Errorf("Unsupported image format %v", format)
+	}
+
+	if len(parent_names) == 0 || strings.Join(parent_names, "") == "" {
+		return result, nil
+	}
+
+	parent_path, err := self.resolve(path, parent_names...)
+	if err != nil {
+		return nil, fmt.Errorf("%v: parent image: %w", format, err)
+	}
+
+	result.parent, err = self.openImage(parent_format, parent_path, depth+1)
+	if err != nil {
+		return nil, fmt.Errorf("%v: parent image %v: %w",
+			format, parent_path.String(), err)
+	}
+	result.ParentName = parent_path.String()
+
+	return result, nil
+}
+
+// Get the parsed image from the scope cache.
+func GetImage(scope vfilter.Scope, format string,
+	accessor_name string, path *accessors.OSPath) (*Image, error) {
+	cache_key := format + "://" + accessor_name + "://" + path.String()
+
+	image_cache := getImageCache(scope)
+	image_cache.mu.Lock()
+	defer image_cache.mu.Unlock()
+
+	image, pres := image_cache.images[cache_key]
+	if pres {
+		return image, nil
+	}
+
+	accessor, err := accessors.GetAccessor(accessor_name, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := &imageContext{
+		scope:         scope,
+		accessor_name: accessor_name,
+		accessor:      accessor,
+		lru_size: int(vql_subsystem.GetIntFromRow(
+			scope, scope, constants.VDISK_CACHE_SIZE)),
+	}
+
+	image, err = ctx.openImage(format, path, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	image_cache.images[cache_key] = image
+	return image, nil
+}
+
+// A reader over the flat device.
+type ImageReader struct {
+	image  *Image
+	info   accessors.FileInfo
+	offset int64
+}
+
+func (self *ImageReader) Read(buf []byte) (int, error) {
+	n, err := self.image.ReadAt(buf, self.offset)
+	self.offset += int64(n)
+	return n, err
+}
+
+func (self *ImageReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case os.SEEK_SET:
+		self.offset = offset
+	case os.SEEK_CUR:
+		self.offset += offset
+	case os.SEEK_END:
+		self.offset = self.image.Size() + offset
+	default:
+		return 0, errors.New("Unsupported whence")
+	}
+	return self.offset, nil
+}
+
+// The image is cached in the scope and stays open.
+func (self *ImageReader) Close() error {
+	return nil
+}
+
+func (self *ImageReader) LStat() (accessors.FileInfo, error) {
+	return self.info, nil
+}
+
+func (self *ImageReader) Ranges() []uploads.Range {
+	return self.image.Ranges()
+}
+
+func getter(format string) zip.FileGetter {
+	return func(full_path *accessors.OSPath, scope vfilter.Scope) (
+		zip.ReaderStat, error) {
+		pathspec := full_path.PathSpec()
+
+		// If a delegate is not provided, we use the "auto"
+		// accessor to open the image file.
+		if pathspec.DelegateAccessor == "" && pathspec.GetDelegatePath() == "" {
+			pathspec.DelegatePath = pathspec.Path
+			pathspec.DelegateAccessor = "auto"
+		}
+
+		accessor, err := accessors.GetAccessor(pathspec.DelegateAccessor, scope)
+		if err != nil {
+			scope.Log("%v: did you provide a URL or PathSpec?", err)
+			return nil, err
+		}
+
+		delegate_path, err := accessor.ParsePath(pathspec.GetDelegatePath())
+		if err != nil {
+			return nil, err
+		}
+
+		stat, err := accessor.LstatWithOSPath(delegate_path)
+		if err != nil {
+			return nil, err
+		}
+
+		image, err := GetImage(scope, format,
+			pathspec.DelegateAccessor, delegate_path)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ImageReader{
+			image: image,
+			info: &accessors.VirtualFileInfo{
+				Path:   full_path,
+				Size_:  image.Size(),
+				Mtime_: stat.ModTime(),
+				Data_: ordereddict.NewDict().
+					Set("format", image.Format).
+					Set("parents", image.Parents()),
+			},
+		}, nil
+	}
+}
+
+func init() {
+	accessors.Register("vmdk", zip.NewGzipFileSystemAccessor(
+		accessors.MustNewPathspecOSPath(""), getter("vmdk")),
+		`Read the disk inside a VMDK image as a flat device.
+
+Monolithic and split sparse (including streamOptimized) and flat
+extents are supported. Snapshots are read through their parent
+images (parentFileNameHint) which are searched for next to the image.
+
+For example:
+
+FileName = pathspec(DelegateAccessor="file", DelegatePath="/vms/disk.vmdk")
+`)
+
+	accessors.Register("vhdx", zip.NewGzipFileSystemAccessor(
+		accessors.MustNewPathspecOSPath(""), getter("vhdx")),
+		`Read the disk inside a VHDX image as a flat device.
+
+Fixed, dynamic and differencing images are supported. The parent of a
+differencing image is found through its parent locator, preferring
+the relative path.
+
+For example:
+
+FileName = pathspec(DelegateAccessor="file", DelegatePath="/vms/disk.vhdx")
+`)
+
+	accessors.Register("qcow2", zip.NewGzipFileSystemAccessor(
+		accessors.MustNewPathspecOSPath(""), getter("qcow2")),
+		`Read the disk inside a QCOW2 image as a flat device.
+
+Compressed clusters and backing files are supported. Encrypted images
+and external data files are not.
+
+For example:
+
+FileName = pathspec(DelegateAccessor="file", DelegatePath="/vms/disk.qcow2")
+`)
+}
@@ -0,0 +1,375 @@
+package vdisk
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash/crc32"
+	"strings"
+	"unicode/utf16"
+)
+
+// Helpers to build small images for testing.
+
+type imageBuilder struct {
+	buf []byte
+}
+
+func (self *imageBuilder) grow(size int) {
+	if len(self.buf) < size {
+		self.buf = append(self.buf, make([]byte, size-len(self.buf))...)
+	}
+}
+
+func (self *imageBuilder) write(offset int, data []byte) {
+	self.grow(offset + len(data))
+	copy(self.buf[offset:], data)
+}
+
+func (self *imageBuilder) le16(offset int, value uint16) {
+	self.grow(offset + 2)
+	binary.LittleEndian.PutUint16(self.buf[offset:], value)
+}
+
+func (self *imageBuilder) le32(offset int, value uint32) {
+	self.grow(offset + 4)
+	binary.LittleEndian.PutUint32(self.buf[offset:], value)
+}
+
+func (self *imageBuilder) le64(offset int, value uint64) {
+	self.grow(offset + 8)
+	binary.LittleEndian.PutUint64(self.buf[offset:], value)
+}
+
+func (self *imageBuilder) be32(offset int, value uint32) {
+	self.grow(offset + 4)
+	binary.BigEndian.PutUint32(self.buf[offset:], value)
+}
+
+func (self *imageBuilder) be64(offset int, value uint64) {
+	self.grow(offset + 8)
+	binary.BigEndian.PutUint64(self.buf[offset:], value)
+}
+
+func fill(c byte, size int) []byte {
+	return bytes.Repeat([]byte{c}, size)
+}
+
+// QCOW2 images with 4k clusters.
+const testClusterBits = 12
+const testClusterSize = 1 << testClusterBits
+
+type qcow2Cluster struct {
+	data       []byte
+	compressed bool
+	zero       bool
+}
+
+func buildQCOW2(size int64, backing string,
+	clusters map[int]qcow2Cluster) []byte {
+	b := &imageBuilder{}
+	b.be32(0, QCOW2_MAGIC)
+	b.be32(4, 3)
+	if backing != "" {
+		b.be64(8, 512)
+		b.be32(16, uint32(len(backing)))
+		b.write(512, []byte(backing))
+	}
+	b.be32(20, testClusterBits)
+	b.be64(24, uint64(size))
+
+	// L1 table in cluster 1, L2 table in cluster 2
+	b.be32(36, 1)
+	b.be64(40, testClusterSize)
+	b.be32(100, 104)
+	b.be64(testClusterSize, 2*testClusterSize)
+
+	l2 := 2 * testClusterSize
+	next := 3 * testClusterSize
+	for idx, cluster := range clusters {
+		entry_offset := l2 + idx*8
+		switch {
+		case cluster.zero:
+			b.be64(entry_offset, QCOW2_ZERO_FLAG)
+
+		case cluster.compressed:
+			compressed := &bytes.Buffer{}
+			w, _ := flate.NewWriter(compressed, flate.BestCompression)
+			w.Write(cluster.data)
+			w.Close()
+
+			// Deliberately not sector aligned.
+			host := uint64(next + 100)
+			sectors := (host%512+uint64(compressed.Len())+511)/512 - 1
+			b.be64(entry_offset, QCOW2_COMPRESSED_FLAG|
+				sectors<<(62-(testClusterBits-8))|host)
+			b.write(int(host), compressed.Bytes())
+
+		default:
+			b.be64(entry_offset, uint64(next))
+			b.write(next, cluster.data)
+		}
+		next += testClusterSize
+	}
+	b.grow(next)
+	return b.buf
+}
+
+// VMDK sparse extents with 4k grains.
+const testGrainSectors = 8
+
+func vmdkDescriptor(create_type, parent string, extents ...string) string {
+	parent_cid := "ffffffff"
+	if parent != "" {
+		parent_cid = "12345678"
+	}
+
+	result := fmt.Sprintf(`# Disk DescriptorFile
+version=1
+CID=fffffffe
+parentCID=%v
+createType="%v"
+`, parent_cid, create_type)
+
+	if parent != "" {
+		result += fmt.Sprintf("parentFileNameHint=\"%v\"\n", parent)
+	}
+
+	result += "\n# Extent description\n"
+	for _, extent := range extents {
+		result += extent + "\n"
+	}
+	return result
+}
+
+func vmdkHeader(b *imageBuilder, offset int, flags uint32,
+	capacity int64, gd_offset uint64) {
+	b.le32(offset, VMDK_MAGIC)
+	b.le32(offset+4, 1)
+	b.le32(offset+8, flags)
+	b.le64(offset+12, uint64(capacity))
+	b.le64(offset+20, testGrainSectors)
+	b.le32(offset+44, 512)
+	b.le64(offset+56, gd_offset)
+	b.write(offset+73, []byte("\n \r\n"))
+}
+
+// Layout: header, 2 sectors of descriptor, grain directory (one
+// entry), grain table and then the grains.
+func buildVMDKSparse(capacity int64, descriptor string,
+	grains map[int][]byte) []byte {
+	b := &imageBuilder{}
+	vmdkHeader(b, 0, 1, capacity, 3)
+	b.le64(28, 1)
+	b.le64(36, 2)
+	b.write(SECTOR_SIZE, []byte(descriptor))
+
+	b.le32(3*SECTOR_SIZE, 4)
+	next := 8
+	for idx, data := range grains {
+		if data == nil {
+			b.le32(4*SECTOR_SIZE+idx*4, VMDK_GTE_ZERO)
+			continue
+		}
+		b.le32(4*SECTOR_SIZE+idx*4, uint32(next))
+		b.write(next*SECTOR_SIZE, data)
+		next += testGrainSectors
+	}
+	b.grow(next * SECTOR_SIZE)
+	return b.buf
+}
+
+// Stream optimized extents store compressed grains and the grain
+// directory is found through the footer.
+func buildVMDKStream(capacity int64, descriptor string,
+	grains map[int][]byte) []byte {
+	b := &imageBuilder{}
+	vmdkHeader(b, 0, 1|VMDK_FLAG_COMPRESSED|VMDK_FLAG_MARKERS,
+		capacity, VMDK_GD_AT_END)
+	b.le64(28, 1)
+	b.le64(36, 2)
+	b.write(SECTOR_SIZE, []byte(descriptor))
+
+	next := 3
+	gt := map[int]int{}
+	for idx, data := range grains {
+		compressed := &bytes.Buffer{}
+		w := zlib.NewWriter(compressed)
+		w.Write(data)
+		w.Close()
+
+		b.le64(next*SECTOR_SIZE, uint64(idx*testGrainSectors))
+		b.le32(next*SECTOR_SIZE+8, uint32(compressed.Len()))
+		b.write(next*SECTOR_SIZE+12, compressed.Bytes())
+		gt[idx] = next
+		next += (12 + compressed.Len() + SECTOR_SIZE - 1) / SECTOR_SIZE
+	}
+
+	gt_sector := next
+	for idx, sector := range gt {
+		b.le32(gt_sector*SECTOR_SIZE+idx*4, uint32(sector))
+	}
+	gd_sector := gt_sector + 4
+	b.le32(gd_sector*SECTOR_SIZE, uint32(gt_sector))
+
+	// Footer marker, footer and end of stream marker.
+	footer := gd_sector + 2
+	vmdkHeader(b, footer*SECTOR_SIZE, 1|VMDK_FLAG_COMPRESSED|VMDK_FLAG_MARKERS,
+		capacity, uint64(gd_sector))
+	b.grow((footer + 2) * SECTOR_SIZE)
+	return b.buf
+}
+
+// VHDX images with 1MB blocks and 512 byte sectors.
+const testBlockSize = VHDX_MB
+
+func guidBytes(guid string) []byte {
+	raw, _ := hex.DecodeString(strings.Replace(guid, "-", "", -1))
+	result := append([]byte{}, raw...)
+	binary.LittleEndian.PutUint32(result[0:], binary.BigEndian.Uint32(raw[0:]))
+	binary.LittleEndian.PutUint16(result[4:], binary.BigEndian.Uint16(raw[4:]))
+	binary.LittleEndian.PutUint16(result[6:], binary.BigEndian.Uint16(raw[6:]))
+	return result
+}
+
+func utf16Bytes(value string) []byte {
+	result := []byte{}
+	for _, c := range utf16.Encode([]rune(value)) {
+		result = append(result, byte(c), byte(c>>8))
+	}
+	return result
+}
+
+func setCRC32C(b *imageBuilder, offset, length int) {
+	b.grow(offset + length)
+	b.le32(offset+4, 0)
+	b.le32(offset+4, crc32.Checksum(b.buf[offset:offset+length], crc32c))
+}
+
+type vhdxBlock struct {
+	data  []byte
+	state uint64
+
+	// For partially present blocks the sectors present in the
+	// image.
+	sectors []int
+}
+
+func buildVHDX(size int64, parent string, blocks map[int]vhdxBlock) []byte {
+	b := &imageBuilder{}
+	b.write(0, []byte(VHDX_SIGNATURE))
+
+	b.write(VHDX_HEADER1_OFFSET, []byte("head"))
+	b.le64(VHDX_HEADER1_OFFSET+8, 1)
+	b.le16(VHDX_HEADER1_OFFSET+66, 1)
+	setCRC32C(b, VHDX_HEADER1_OFFSET, VHDX_HEADER_SIZE)
+
+	// Metadata at 2MB and BAT at 3MB
+	b.write(VHDX_REGION_TABLE_OFFSET, []byte("regi"))
+	b.le32(VHDX_REGION_TABLE_OFFSET+8, 2)
+	b.write(VHDX_REGION_TABLE_OFFSET+16, guidBytes(VHDX_BAT_GUID))
+	b.le64(VHDX_REGION_TABLE_OFFSET+32, 3*VHDX_MB)
+	b.le32(VHDX_REGION_TABLE_OFFSET+40, VHDX_MB)
+	b.write(VHDX_REGION_TABLE_OFFSET+48, guidBytes(VHDX_METADATA_GUID))
+	b.le64(VHDX_REGION_TABLE_OFFSET+64, 2*VHDX_MB)
+	b.le32(VHDX_REGION_TABLE_OFFSET+72, VHDX_MB)
+	setCRC32C(b, VHDX_REGION_TABLE_OFFSET, VHDX_REGION_TABLE_SIZE)
+
+	flags := uint32(0)
+	if parent != "" {
+		flags = VHDX_FILE_PARAMS_HAS_PARENT
+	}
+
+	file_parameters := make([]byte, 8)
+	binary.LittleEndian.PutUint32(file_parameters, testBlockSize)
+	binary.LittleEndian.PutUint32(file_parameters[4:], flags)
+
+	disk_size := make([]byte, 8)
+	binary.LittleEndian.PutUint64(disk_size, uint64(size))
+
+	sector_size := make([]byte, 4)
+	binary.LittleEndian.PutUint32(sector_size, 512)
+
+	items := []struct {
+		guid string
+		data []byte
+	}{
+		{VHDX_FILE_PARAMETERS_GUID, file_parameters},
+		{VHDX_VIRTUAL_DISK_SIZE, disk_size},
+		{VHDX_LOGICAL_SECTOR_SIZE, sector_size},
+	}
+
+	if parent != "" {
+		locator := &imageBuilder{}
+		locator.write(0, guidBytes(VHDX_PARENT_LOCATOR_TYPE))
+		locator.le16(18, 2)
+		next := 20 + 2*12
+		for i, kv := range [][]string{
+			{"parent_linkage", "{83A1E2B7-6F7E-4F5C-9E3A-3C1A2B3C4D5E}"},
+			{"relative_path", parent},
+		} {
+			key := utf16Bytes(kv[0])
+			value := utf16Bytes(kv[1])
+			locator.le32(20+i*12, uint32(next))
+			locator.le32(20+i*12+4, uint32(next+len(key)))
+			locator.le16(20+i*12+8, uint16(len(key)))
+			locator.le16(20+i*12+10, uint16(len(value)))
+			locator.write(next, key)
+			locator.write(next+len(key), value)
+			next += len(key) + len(value)
+		}
+		items = append(items, struct {
+			guid string
+			data []byte
+		}{VHDX_PARENT_LOCATOR_GUID, locator.buf})
+	}
+
+	metadata := 2 * VHDX_MB
+	b.write(metadata, []byte("metadata"))
+	b.le16(metadata+10, uint16(len(items)))
+	item_offset := 64 * 1024
+	for i, item := range items {
+		entry := metadata + 32 + i*32
+		b.write(entry, guidBytes(item.guid))
+		b.le32(entry+16, uint32(item_offset))
+		b.le32(entry+20, uint32(len(item.data)))
+		b.write(metadata+item_offset, item.data)
+		item_offset += len(item.data)
+	}
+
+	// Data blocks are stored from 4MB with the sector bitmap last.
+	bat := 3 * VHDX_MB
+	next_mb := uint64(4)
+	var bitmap []byte
+	for idx, block := range blocks {
+		state := block.state
+		if block.data != nil {
+			b.write(int(next_mb)*VHDX_MB, block.data)
+			b.le64(bat+idx*8, state|next_mb<<20)
+			next_mb++
+		} else {
+			b.le64(bat+idx*8, state)
+		}
+
+		for _, sector := range block.sectors {
+			if bitmap == nil {
+				bitmap = make([]byte, VHDX_MB)
+			}
+			bit := idx*testBlockSize/512 + sector
+			bitmap[bit/8] |= 1 << uint(bit%8)
+		}
+	}
+
+	if bitmap != nil {
+		chunk_ratio := (1 << 23) * 512 / testBlockSize
+		b.write(int(next_mb)*VHDX_MB, bitmap)
+		b.le64(bat+chunk_ratio*8, SB_BLOCK_PRESENT|next_mb<<20)
+		next_mb++
+	}
+
+	b.grow(int(next_mb) * VHDX_MB)
+	return b.buf
+}
@@ -0,0 +1,250 @@
+// Virtual disk images (VMDK, VHDX and QCOW2) store the disk's
+// sectors sparsely in one or more files, possibly deferring
+// unallocated regions to a parent image. This package presents such
+// images as a flat device.
+
+package vdisk
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"www.velocidex.com/golang/velociraptor/uploads"
+)
+
+const (
+	// The data is read from Run.Reader
+	RUN_DATA = iota
+
+	// The run reads as zeros.
+	RUN_ZERO
+
+	// The run is not allocated in this image and is read from the
+	// parent (or backing) image.
+	RUN_PARENT
+)
+
+const (
+	// Protect against parent loops.
+	MAX_PARENT_DEPTH = 32
+)
+
+// A Run maps a range of the virtual disk onto its storage.
+type Run struct {
+	Kind int
+
+	// For RUN_DATA runs the data is read from Reader at Offset.
+	Reader io.ReaderAt
+	Offset int64
+
+	Length int64
+}
+
+// Each image format maps virtual disk offsets onto its files.
+type mapper interface {
+	Size() int64
+
+	// Map the range starting at the virtual offset. The returned
+	// run must start at offset and have a positive length.
+	MapOffset(offset int64) (*Run, error)
+}
+
+// An Image is a flat view of a virtual disk.
+type Image struct {
+	Format string
+
+	// The name the parent image was found under.
+	ParentName string
+
+	mapper mapper
+	parent *Image
+
+	mu     sync.Mutex
+	ranges []uploads.Range
+}
+
+func (self *Image) Size() int64 {
+	return self.mapper.Size()
+}
+
+func (self *Image) Parent() *Image {
+	return self.parent
+}
+
+// The chain of parent images.
+func (self *Image) Parents() []string {
+	result := []string{}
+	for image := self; image.parent != nil; image = image.parent {
+		result = append(result, image.ParentName)
+	}
+	return result
+}
+
+func (self *Image) ReadAt(buf []byte, offset int64) (int, error) {
+	if offset < 0 {
+		return 0, fmt.Errorf("Invalid offset %v", offset)
+	}
+
+	size := self.Size()
+	if offset >= size {
+		return 0, io.EOF
+	}
+
+	to_read := int64(len(buf))
+	if offset+to_read > size {
+		to_read = size - offset
+	}
+
+	total := int64(0)
+	for total < to_read {
+		current := offset + total
+		run, err := self.mapper.MapOffset(current)
+		if err != nil {
+			return int(total), err
+		}
+
+		if run.Length <= 0 {
+			return int(total), errors.New("Invalid image run")
+		}
+
+		length := to_read - total
+		if length > run.Length {
+			length = run.Length
+		}
+		chunk := buf[total : total+length]
+
+		switch run.Kind {
+		case RUN_DATA:
+			n, err := run.Reader.ReadAt(chunk, run.Offset)
+			if n < len(chunk) {
+				// Data past the end of the file reads as zeros.
+				if err != nil && !errors.Is(err, io.EOF) {
+					return int(total), err
+				}
+				zero(chunk[n:])
+			}
+
+		case RUN_PARENT:
+			err := self.readParent(chunk, current)
+			if err != nil {
+				return int(total), err
+			}
+
+		default:
+			zero(chunk)
+		}
+
+		total += length
+	}
+
+	return int(total), nil
+}
+
+// Parents may be smaller than their children if the disk was grown.
+func (self *Image) readParent(buf []byte, offset int64) error {
+	if self.parent == nil {
+		zero(buf)
+		return nil
+	}
+
+	n, err := self.parent.ReadAt(buf, offset)
+	if n < len(buf) {
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		zero(buf[n:])
+	}
+	return nil
+}
+
+// Report unallocated regions of the image chain so uploads can skip
+// them.
+func (self *Image) Ranges() []uploads.Range {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	if self.ranges != nil {
+		return self.ranges
+	}
+
+	result := []uploads.Range{}
+	add := func(offset, length int64, sparse bool) {
+		if length <= 0 {
+			return
+		}
+
+		if len(result) > 0 {
+			last := &result[len(result)-1]
+			if last.IsSparse == sparse && last.Offset+last.Length == offset {
+				last.Length += length
+				return
+			}
+		}
+		result = append(result, uploads.Range{
+			Offset: offset, Length: length, IsSparse: sparse})
+	}
+
+	var parent_ranges []uploads.Range
+	if self.parent != nil {
+		parent_ranges = self.parent.Ranges()
+	}
+
+	size := self.Size()
+	offset := int64(0)
+	for offset < size {
+		run, err := self.mapper.MapOffset(offset)
+
+		// We can not tell so assume the rest is data.
+		if err != nil || run.Length <= 0 {
+			add(offset, size-offset, false)
+			break
+		}
+
+		length := run.Length
+		if offset+length > size {
+			length = size - offset
+		}
+
+		switch run.Kind {
+		case RUN_DATA:
+			add(offset, length, false)
+
+		case RUN_PARENT:
+			end := offset + length
+			idx := sort.Search(len(parent_ranges), func(i int) bool {
+				return parent_ranges[i].Offset+parent_ranges[i].Length > offset
+			})
+
+			next := offset
+			for ; idx < len(parent_ranges) && next < end; idx++ {
+				r := parent_ranges[idx]
+				r_end := r.Offset + r.Length
+				if r_end > end {
+					r_end = end
+				}
+				add(next, r_end-next, r.IsSparse)
+				next = r_end
+			}
+
+			// Past the end of the parent.
+			add(next, end-next, true)
+
+		default:
+			add(offset, length, true)
+		}
+
+		offset += length
+	}
+
+	self.ranges = result
+	return result
+}
+
+func zero(buf []byte) {
+	for i := range buf {
+		buf[i] = 0
+	}
+}
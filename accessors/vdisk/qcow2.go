@@ -0,0 +1,227 @@
+package vdisk
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+const (
+	QCOW2_MAGIC = 0x514649fb
+
+	// Incompatible feature bits
+	QCOW2_INCOMPAT_DIRTY         = 1 << 0
+	QCOW2_INCOMPAT_CORRUPT       = 1 << 1
+	QCOW2_INCOMPAT_DATA_FILE     = 1 << 2
+	QCOW2_INCOMPAT_COMPRESSION   = 1 << 3
+	QCOW2_INCOMPAT_EXTENDED_L2   = 1 << 4
+	QCOW2_SUPPORTED_INCOMPATIBLE = QCOW2_INCOMPAT_DIRTY | QCOW2_INCOMPAT_CORRUPT
+
+	QCOW2_OFFSET_MASK     = 0x00fffffffffffe00
+	QCOW2_COMPRESSED_FLAG = 1 << 62
+	QCOW2_ZERO_FLAG       = 1 << 0
+
+	MAX_QCOW2_CLUSTER_BITS = 21
+	MAX_QCOW2_L1_SIZE      = 32 * 1024 * 1024
+)
+
+// A QCOW2 image maps clusters through a two level table. Unallocated
+// clusters are read from the backing file.
+type QCOW2 struct {
+	reader io.ReaderAt
+
+	Version     uint32
+	VirtualSize int64
+	ClusterBits uint32
+	BackingFile string
+
+	cluster_size int64
+	l2_entries   int64
+	l1           []uint64
+
+	// Cache the last decompressed cluster.
+	mu                 sync.Mutex
+	compressed_offset  uint64
+	compressed_cluster []byte
+}
+
+func (self *QCOW2) Size() int64 {
+	return self.VirtualSize
+}
+
+func (self *QCOW2) MapOffset(offset int64) (*Run, error) {
+	cluster := offset / self.cluster_size
+	cluster_offset := offset % self.cluster_size
+	length := self.cluster_size - cluster_offset
+
+	l1_index := cluster / self.l2_entries
+	l2_index := cluster % self.l2_entries
+
+	if l1_index >= int64(len(self.l1)) {
+		return &Run{Kind: RUN_PARENT, Length: length}, nil
+	}
+
+	// An unallocated L2 table covers many clusters.
+	l2_offset := int64(self.l1[l1_index] & QCOW2_OFFSET_MASK)
+	if l2_offset == 0 {
+		return &Run{
+			Kind:   RUN_PARENT,
+			Length: (self.l2_entries-l2_index)*self.cluster_size - cluster_offset,
+		}, nil
+	}
+
+	buf := make([]byte, 8)
+	_, err := self.reader.ReadAt(buf, l2_offset+l2_index*8)
+	if err != nil {
+		return nil, err
+	}
+	entry := binary.BigEndian.Uint64(buf)
+
+	if entry&QCOW2_COMPRESSED_FLAG != 0 {
+		data, err := self.readCompressed(entry)
+		if err != nil {
+			return nil, err
+		}
+		return &Run{
+			Kind:   RUN_DATA,
+			Reader: bytes.NewReader(data),
+			Offset: cluster_offset,
+			Length: length,
+		}, nil
+	}
+
+	if self.Version >= 3 && entry&QCOW2_ZERO_FLAG != 0 {
+		return &Run{Kind: RUN_ZERO, Length: length}, nil
+	}
+
+	host_offset := int64(entry & QCOW2_OFFSET_MASK)
+	if host_offset == 0 {
+		return &Run{Kind: RUN_PARENT, Length: length}, nil
+	}
+
+	return &Run{
+		Kind:   RUN_DATA,
+		Reader: self.reader,
+		Offset: host_offset + cluster_offset,
+		Length: length,
+	}, nil
+}
+
+// Compressed clusters are raw deflate streams spanning a number of
+// 512 byte sectors.
+func (self *QCOW2) readCompressed(entry uint64) ([]byte, error) {
+	offset_bits := 62 - (self.ClusterBits - 8)
+	host_offset := entry & (1<<offset_bits - 1)
+	sectors := (entry&(1<<62-1))>>offset_bits + 1
+	compressed_size := int64(sectors*512 - host_offset%512)
+
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	if self.compressed_cluster != nil && self.compressed_offset == host_offset {
+		return self.compressed_cluster, nil
+	}
+
+	compressed := make([]byte, compressed_size)
+	n, err := self.reader.ReadAt(compressed, int64(host_offset))
+	if n == 0 && err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, self.cluster_size)
+	decompressor := flate.NewReader(bytes.NewReader(compressed[:n]))
+	_, err = io.ReadFull(decompressor, data)
+	if err != nil {
+		return nil, fmt.Errorf("qcow2: decompressing cluster at %#x: %w",
+			host_offset, err)
+	}
+
+	self.compressed_offset = host_offset
+	self.compressed_cluster = data
+	return data, nil
+}
+
+func OpenQCOW2(reader io.ReaderAt) (*QCOW2, error) {
+	header := make([]byte, 112)
+	n, err := reader.ReadAt(header, 0)
+	if n < 72 {
+		return nil, fmt.Errorf("qcow2: reading header: %w", err)
+	}
+
+	if binary.BigEndian.Uint32(header) != QCOW2_MAGIC {
+		return nil, errors.New("qcow2: invalid magic")
+	}
+
+	result := &QCOW2{
+		reader:      reader,
+		Version:     binary.BigEndian.Uint32(header[4:]),
+		ClusterBits: binary.BigEndian.Uint32(header[20:]),
+		VirtualSize: int64(binary.BigEndian.Uint64(header[24:])),
+	}
+
+	if result.Version != 2 && result.Version != 3 {
+		return nil, fmt.Errorf("qcow2: unsupported version %v", result.Version)
+	}
+
+	if result.ClusterBits < 9 || result.ClusterBits > MAX_QCOW2_CLUSTER_BITS {
+		return nil, fmt.Errorf("qcow2: invalid cluster bits %v",
+			result.ClusterBits)
+	}
+
+	if binary.BigEndian.Uint32(header[32:]) != 0 {
+		return nil, errors.New("qcow2: encrypted images are not supported")
+	}
+
+	if result.Version >= 3 {
+		if n < 104 {
+			return nil, errors.New("qcow2: header too short")
+		}
+
+		incompatible := binary.BigEndian.Uint64(header[72:])
+		if incompatible&^QCOW2_SUPPORTED_INCOMPATIBLE != 0 {
+			return nil, fmt.Errorf(
+				"qcow2: unsupported incompatible features %#x", incompatible)
+		}
+	}
+
+	result.cluster_size = int64(1) << result.ClusterBits
+	result.l2_entries = result.cluster_size / 8
+
+	backing_offset := int64(binary.BigEndian.Uint64(header[8:]))
+	backing_size := binary.BigEndian.Uint32(header[16:])
+	if backing_offset != 0 && backing_size > 0 {
+		if backing_size > 1023 {
+			return nil, errors.New("qcow2: backing file name too long")
+		}
+
+		name := make([]byte, backing_size)
+		_, err := reader.ReadAt(name, backing_offset)
+		if err != nil {
+			return nil, fmt.Errorf("qcow2: reading backing file name: %w", err)
+		}
+		result.BackingFile = string(name)
+	}
+
+	l1_size := binary.BigEndian.Uint32(header[36:])
+	if l1_size > MAX_QCOW2_L1_SIZE {
+		return nil, fmt.Errorf("qcow2: L1 table too large (%v)", l1_size)
+	}
+
+	l1_offset := int64(binary.BigEndian.Uint64(header[40:]))
+	l1 := make([]byte, 8*int(l1_size))
+	_, err = reader.ReadAt(l1, l1_offset)
+	if err != nil && l1_size > 0 {
+		return nil, fmt.Errorf("qcow2: reading L1 table: %w", err)
+	}
+
+	result.l1 = make([]uint64, l1_size)
+	for i := range result.l1 {
+		result.l1[i] = binary.BigEndian.Uint64(l1[i*8:])
+	}
+
+	return result, nil
+}
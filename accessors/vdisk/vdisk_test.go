@@ -0,0 +1,240 @@
+package vdisk
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/Velocidex/ordereddict"
+	"www.velocidex.com/golang/velociraptor/accessors"
+	"www.velocidex.com/golang/velociraptor/config"
+	"www.velocidex.com/golang/velociraptor/logging"
+	"www.velocidex.com/golang/velociraptor/uploads"
+	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
+	"www.velocidex.com/golang/velociraptor/vql/acl_managers"
+	"www.velocidex.com/golang/velociraptor/vtesting/assert"
+	"www.velocidex.com/golang/vfilter"
+
+	_ "www.velocidex.com/golang/velociraptor/accessors/file"
+	_ "www.velocidex.com/golang/velociraptor/accessors/offset"
+)
+
+func makeScope() vfilter.Scope {
+	config_obj := config.GetDefaultConfig()
+	scope := vql_subsystem.MakeScope().AppendVars(ordereddict.NewDict().
+		Set(vql_subsystem.ACL_MANAGER_VAR, acl_managers.NullACLManager{}))
+	scope.SetLogger(logging.NewPlainLogger(
+		config_obj, &logging.FrontendComponent))
+	return scope
+}
+
+func writeFiles(t *testing.T, files map[string][]byte) string {
+	dir := t.TempDir()
+	for name, data := range files {
+		err := ioutil.WriteFile(filepath.Join(dir, name), data, 0600)
+		assert.NoError(t, err)
+	}
+	return dir
+}
+
+func readImage(t *testing.T, scope vfilter.Scope,
+	accessor_name, path string) ([]byte, accessors.FileInfo, []uploads.Range) {
+	accessor, err := accessors.GetAccessor(accessor_name, scope)
+	assert.NoError(t, err)
+
+	pathspec := &accessors.PathSpec{
+		DelegateAccessor: "file",
+		DelegatePath:     path,
+	}
+
+	fd, err := accessor.Open(pathspec.String())
+	assert.NoError(t, err)
+	defer fd.Close()
+
+	data, err := ioutil.ReadAll(fd)
+	assert.NoError(t, err)
+
+	stat, err := accessor.Lstat(pathspec.String())
+	assert.NoError(t, err)
+
+	return data, stat, fd.(uploads.RangeReader).Ranges()
+}
+
+func TestQCOW2(t *testing.T) {
+	dir := writeFiles(t, map[string][]byte{
+		"base.qcow2": buildQCOW2(8*testClusterSize, "", map[int]qcow2Cluster{
+			0: {data: fill('A', testClusterSize)},
+			1: {data: fill('X', testClusterSize)},
+			2: {data: fill('B', testClusterSize), compressed: true},
+			3: {zero: true},
+		}),
+		"overlay.qcow2": buildQCOW2(8*testClusterSize, "base.qcow2",
+			map[int]qcow2Cluster{
+				1: {data: fill('C', testClusterSize)},
+			}),
+	})
+
+	data, stat, ranges := readImage(t, makeScope(), "qcow2",
+		filepath.Join(dir, "overlay.qcow2"))
+
+	expected := bytes.Join([][]byte{
+		fill('A', testClusterSize),
+		fill('C', testClusterSize),
+		fill('B', testClusterSize),
+		fill(0, 5*testClusterSize),
+	}, nil)
+	assert.Equal(t, expected, data)
+	assert.Equal(t, int64(8*testClusterSize), stat.Size())
+
+	parents, _ := stat.Data().Get("parents")
+	assert.Equal(t, []string{filepath.Join(dir, "base.qcow2")}, parents)
+
+	// Data comes from both images.
+	assert.Equal(t, []uploads.Range{
+		{Offset: 0, Length: 3 * testClusterSize},
+		{Offset: 3 * testClusterSize, Length: 5 * testClusterSize,
+			IsSparse: true},
+	}, ranges)
+}
+
+func TestVMDKSparse(t *testing.T) {
+	grain := testGrainSectors * SECTOR_SIZE
+	capacity := int64(16 * testGrainSectors)
+
+	dir := writeFiles(t, map[string][]byte{
+		"base.vmdk": buildVMDKSparse(capacity,
+			vmdkDescriptor("monolithicSparse", "",
+				`RW 128 SPARSE "base.vmdk"`),
+			map[int][]byte{
+				0: fill('A', grain),
+				1: fill('B', grain),
+				3: fill('D', grain),
+			}),
+
+		// A snapshot of the base disk.
+		"snapshot.vmdk": buildVMDKStream(capacity,
+			vmdkDescriptor("monolithicSparse", "base.vmdk",
+				`RW 128 SPARSE "snapshot.vmdk"`),
+			map[int][]byte{
+				1: fill('S', grain),
+				2: fill('T', grain),
+			}),
+	})
+
+	data, stat, ranges := readImage(t, makeScope(), "vmdk",
+		filepath.Join(dir, "snapshot.vmdk"))
+
+	expected := bytes.Join([][]byte{
+		fill('A', grain),
+		fill('S', grain),
+		fill('T', grain),
+		fill('D', grain),
+		fill(0, 12*grain),
+	}, nil)
+	assert.Equal(t, expected, data)
+	format, _ := stat.Data().GetString("format")
+	assert.Equal(t, "vmdk", format)
+
+	assert.Equal(t, []uploads.Range{
+		{Offset: 0, Length: int64(4 * grain)},
+		{Offset: int64(4 * grain), Length: int64(12 * grain), IsSparse: true},
+	}, ranges)
+}
+
+func TestVMDKDescriptor(t *testing.T) {
+	flat := bytes.Join([][]byte{
+		fill('F', 16*SECTOR_SIZE),
+		fill('G', 16*SECTOR_SIZE),
+	}, nil)
+
+	dir := writeFiles(t, map[string][]byte{
+		"disk.vmdk": []byte(vmdkDescriptor("monolithicFlat", "",
+			`RW 16 FLAT "disk-flat.vmdk" 0`,
+			`RW 16 ZERO`,
+			`RDONLY 16 FLAT "disk-flat.vmdk" 16`)),
+		"disk-flat.vmdk": flat,
+	})
+
+	data, stat, ranges := readImage(t, makeScope(), "vmdk",
+		filepath.Join(dir, "disk.vmdk"))
+
+	expected := bytes.Join([][]byte{
+		fill('F', 16*SECTOR_SIZE),
+		fill(0, 16*SECTOR_SIZE),
+		fill('G', 16*SECTOR_SIZE),
+	}, nil)
+	assert.Equal(t, expected, data)
+	assert.Equal(t, int64(48*SECTOR_SIZE), stat.Size())
+
+	assert.Equal(t, []uploads.Range{
+		{Offset: 0, Length: 16 * SECTOR_SIZE},
+		{Offset: 16 * SECTOR_SIZE, Length: 16 * SECTOR_SIZE, IsSparse: true},
+		{Offset: 32 * SECTOR_SIZE, Length: 16 * SECTOR_SIZE},
+	}, ranges)
+}
+
+func TestVHDX(t *testing.T) {
+	size := int64(4 * testBlockSize)
+
+	dir := writeFiles(t, map[string][]byte{
+		"base.vhdx": buildVHDX(size, "", map[int]vhdxBlock{
+			0: {data: fill('A', testBlockSize),
+				state: PAYLOAD_BLOCK_FULLY_PRESENT},
+			1: {data: fill('B', testBlockSize),
+				state: PAYLOAD_BLOCK_FULLY_PRESENT},
+			2: {state: PAYLOAD_BLOCK_ZERO},
+		}),
+
+		// Only sectors 1 and 2 of the first block are in the
+		// differencing image.
+		"child.vhdx": buildVHDX(size, `.\base.vhdx`, map[int]vhdxBlock{
+			0: {data: fill('P', testBlockSize),
+				state:   PAYLOAD_BLOCK_PARTIALLY_PRESENT,
+				sectors: []int{1, 2}},
+			2: {data: fill('C', testBlockSize),
+				state: PAYLOAD_BLOCK_FULLY_PRESENT},
+		}),
+	})
+
+	scope := makeScope()
+	data, stat, ranges := readImage(t, scope, "vhdx",
+		filepath.Join(dir, "child.vhdx"))
+
+	expected := bytes.Join([][]byte{
+		fill('A', SECTOR_SIZE),
+		fill('P', 2*SECTOR_SIZE),
+		fill('A', testBlockSize-3*SECTOR_SIZE),
+		fill('B', testBlockSize),
+		fill('C', testBlockSize),
+		fill(0, testBlockSize),
+	}, nil)
+	assert.Equal(t, expected, data)
+	assert.Equal(t, size, stat.Size())
+
+	assert.Equal(t, []uploads.Range{
+		{Offset: 0, Length: 3 * testBlockSize},
+		{Offset: 3 * testBlockSize, Length: testBlockSize, IsSparse: true},
+	}, ranges)
+
+	// The image can be further delegated, e.g. to read a partition.
+	accessor, err := accessors.GetAccessor("offset", scope)
+	assert.NoError(t, err)
+
+	pathspec := &accessors.PathSpec{
+		DelegateAccessor: "vhdx",
+		Delegate: &accessors.PathSpec{
+			DelegateAccessor: "file",
+			DelegatePath:     filepath.Join(dir, "child.vhdx"),
+		},
+		Path: "511",
+	}
+
+	fd, err := accessor.Open(pathspec.String())
+	assert.NoError(t, err)
+
+	buf := make([]byte, 4)
+	_, err = fd.Read(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "APPP", string(buf))
+}
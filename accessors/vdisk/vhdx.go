@@ -0,0 +1,423 @@
+package vdisk
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	VHDX_SIGNATURE = "vhdxfile"
+
+	VHDX_HEADER_SIZE         = 4 * 1024
+	VHDX_REGION_TABLE_SIZE   = 64 * 1024
+	VHDX_HEADER1_OFFSET      = 64 * 1024
+	VHDX_HEADER2_OFFSET      = 128 * 1024
+	VHDX_REGION_TABLE_OFFSET = 192 * 1024
+	VHDX_REGION2_OFFSET      = 256 * 1024
+
+	VHDX_MB = 1024 * 1024
+
+	// Payload block states
+	PAYLOAD_BLOCK_NOT_PRESENT       = 0
+	PAYLOAD_BLOCK_UNDEFINED         = 1
+	PAYLOAD_BLOCK_ZERO              = 2
+	PAYLOAD_BLOCK_UNMAPPED          = 3
+	PAYLOAD_BLOCK_FULLY_PRESENT     = 6
+	PAYLOAD_BLOCK_PARTIALLY_PRESENT = 7
+
+	SB_BLOCK_PRESENT = 6
+
+	MAX_VHDX_BAT_SIZE      = 128 * 1024 * 1024
+	MAX_VHDX_METADATA_ITEM = 64 * 1024
+)
+
+// Well known GUIDs
+const (
+	VHDX_BAT_GUID               = "2DC27766-F623-4200-9D64-115E9BFD4A08"
+	VHDX_METADATA_GUID          = "8B7CA206-4790-4B9A-B8FE-575F050F886E"
+	VHDX_FILE_PARAMETERS_GUID   = "CAA16737-FA36-4D43-B3B6-33F0AA44E76B"
+	VHDX_VIRTUAL_DISK_SIZE      = "2FA54224-CD1B-4876-B211-5DBED83BF4B8"
+	VHDX_LOGICAL_SECTOR_SIZE    = "8141BF1D-A96F-4709-BA47-F233A8FAAB5F"
+	VHDX_PARENT_LOCATOR_GUID    = "A8D35F2D-B30B-454D-ABF7-D3D84834AB0C"
+	VHDX_PARENT_LOCATOR_TYPE    = "B04AEFB7-D19E-4A81-B789-25B8E9445913"
+	VHDX_ZERO_GUID              = "00000000-0000-0000-0000-000000000000"
+	VHDX_FILE_PARAMS_HAS_PARENT = 2
+)
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+// GUIDs are stored with the first three fields little endian.
+func parseGUID(buf []byte) string {
+	return strings.ToUpper(fmt.Sprintf("%08x-%04x-%04x-%x-%x",
+		binary.LittleEndian.Uint32(buf[0:]),
+		binary.LittleEndian.Uint16(buf[4:]),
+		binary.LittleEndian.Uint16(buf[6:]),
+		buf[8:10], buf[10:16]))
+}
+
+func parseUTF16(buf []byte) string {
+	chars := make([]uint16, len(buf)/2)
+	for i := range chars {
+		chars[i] = binary.LittleEndian.Uint16(buf[i*2:])
+	}
+	return string(utf16.Decode(chars))
+}
+
+// Checksums are calculated with the checksum field zeroed.
+func checkCRC32C(buf []byte, checksum_offset int) bool {
+	expected := binary.LittleEndian.Uint32(buf[checksum_offset:])
+	tmp := append([]byte{}, buf...)
+	binary.LittleEndian.PutUint32(tmp[checksum_offset:], 0)
+	return crc32.Checksum(tmp, crc32c) == expected
+}
+
+// A VHDX image maps fixed size payload blocks through the block
+// allocation table (BAT). Differencing images track which sectors of
+// partially present blocks are stored in the image using sector
+// bitmaps.
+type VHDX struct {
+	reader io.ReaderAt
+
+	VirtualSize       int64
+	BlockSize         int64
+	LogicalSectorSize int64
+	HasParent         bool
+
+	// The log contains entries which were not applied to the image.
+	LogPending bool
+
+	// Key values of the parent locator (e.g. relative_path)
+	ParentLocator map[string]string
+
+	chunk_ratio int64
+	bat         []uint64
+}
+
+func (self *VHDX) Size() int64 {
+	return self.VirtualSize
+}
+
+// Payload blocks are interleaved with a sector bitmap block after
+// every chunk.
+func (self *VHDX) batEntry(index int64) uint64 {
+	if index >= int64(len(self.bat)) {
+		return 0
+	}
+	return self.bat[index]
+}
+
+func (self *VHDX) MapOffset(offset int64) (*Run, error) {
+	block := offset / self.BlockSize
+	block_offset := offset % self.BlockSize
+	length := self.BlockSize - block_offset
+
+	entry := self.batEntry(block + block/self.chunk_ratio)
+	state := entry & 7
+	file_offset := int64(entry>>20) * VHDX_MB
+
+	switch state {
+	case PAYLOAD_BLOCK_FULLY_PRESENT:
+		return &Run{
+			Kind:   RUN_DATA,
+			Reader: self.reader,
+			Offset: file_offset + block_offset,
+			Length: length,
+		}, nil
+
+	case PAYLOAD_BLOCK_PARTIALLY_PRESENT:
+		if !self.HasParent {
+			return nil, errors.New(
+				"vhdx: partially present block in a non differencing image")
+		}
+		return self.mapPartialBlock(block, block_offset, file_offset)
+
+	case PAYLOAD_BLOCK_ZERO, PAYLOAD_BLOCK_UNMAPPED:
+		return &Run{Kind: RUN_ZERO, Length: length}, nil
+	}
+
+	// Not present blocks are read from the parent.
+	if self.HasParent {
+		return &Run{Kind: RUN_PARENT, Length: length}, nil
+	}
+	return &Run{Kind: RUN_ZERO, Length: length}, nil
+}
+
+// Each bit in the sector bitmap indicates if the sector is present in
+// this image or should be read from the parent.
+func (self *VHDX) mapPartialBlock(
+	block, block_offset, file_offset int64) (*Run, error) {
+	chunk := block / self.chunk_ratio
+	bitmap_entry := self.batEntry(chunk*(self.chunk_ratio+1) + self.chunk_ratio)
+	if bitmap_entry&7 != SB_BLOCK_PRESENT {
+		return nil, fmt.Errorf("vhdx: sector bitmap for block %v not present",
+			block)
+	}
+
+	sectors_per_block := self.BlockSize / self.LogicalSectorSize
+	first_sector := (block%self.chunk_ratio)*sectors_per_block +
+		block_offset/self.LogicalSectorSize
+	last_sector := (block%self.chunk_ratio + 1) * sectors_per_block
+
+	// Read the bitmap bytes covering the rest of the block.
+	bitmap_offset := int64(bitmap_entry>>20)*VHDX_MB + first_sector/8
+	bitmap := make([]byte, (last_sector-first_sector)/8+2)
+	n, err := self.reader.ReadAt(bitmap, bitmap_offset)
+	if n == 0 && err != nil {
+		return nil, err
+	}
+	bitmap = bitmap[:n]
+
+	is_present := func(sector int64) bool {
+		idx := sector/8 - first_sector/8
+		if idx >= int64(len(bitmap)) {
+			return false
+		}
+		return bitmap[idx]&(1<<uint(sector%8)) != 0
+	}
+
+	present := is_present(first_sector)
+	sector := first_sector + 1
+	for sector < last_sector && is_present(sector) == present {
+		sector++
+	}
+
+	sector_offset := block_offset % self.LogicalSectorSize
+	length := (sector-first_sector)*self.LogicalSectorSize - sector_offset
+	if !present {
+		return &Run{Kind: RUN_PARENT, Length: length}, nil
+	}
+
+	return &Run{
+		Kind:   RUN_DATA,
+		Reader: self.reader,
+		Offset: file_offset + block_offset,
+		Length: length,
+	}, nil
+}
+
+type vhdxRegion struct {
+	offset int64
+	length int64
+}
+
+// Pick the current header: the valid one with the highest sequence
+// number.
+func readVHDXHeader(reader io.ReaderAt) ([]byte, error) {
+	var result []byte
+	var sequence uint64
+
+	for _, offset := range []int64{VHDX_HEADER1_OFFSET, VHDX_HEADER2_OFFSET} {
+		header := make([]byte, VHDX_HEADER_SIZE)
+		_, err := reader.ReadAt(header, offset)
+		if err != nil || string(header[:4]) != "head" ||
+			!checkCRC32C(header, 4) {
+			continue
+		}
+
+		header_sequence := binary.LittleEndian.Uint64(header[8:])
+		if result == nil || header_sequence > sequence {
+			result = header
+			sequence = header_sequence
+		}
+	}
+
+	if result == nil {
+		return nil, errors.New("vhdx: no valid header found")
+	}
+	return result, nil
+}
+
+func readVHDXRegions(reader io.ReaderAt) (map[string]vhdxRegion, error) {
+	for _, offset := range []int64{VHDX_REGION_TABLE_OFFSET, VHDX_REGION2_OFFSET} {
+		table := make([]byte, VHDX_REGION_TABLE_SIZE)
+		_, err := reader.ReadAt(table, offset)
+		if err != nil || string(table[:4]) != "regi" ||
+			!checkCRC32C(table, 4) {
+			continue
+		}
+
+		count := int(binary.LittleEndian.Uint32(table[8:]))
+		if 16+count*32 > len(table) {
+			continue
+		}
+
+		result := make(map[string]vhdxRegion)
+		for i := 0; i < count; i++ {
+			entry := table[16+i*32:]
+			result[parseGUID(entry)] = vhdxRegion{
+				offset: int64(binary.LittleEndian.Uint64(entry[16:])),
+				length: int64(binary.LittleEndian.Uint32(entry[24:])),
+			}
+		}
+		return result, nil
+	}
+
+	return nil, errors.New("vhdx: no valid region table found")
+}
+
+// Returns the metadata items by GUID.
+func readVHDXMetadata(
+	reader io.ReaderAt, region vhdxRegion) (map[string][]byte, error) {
+	header := make([]byte, 32)
+	_, err := reader.ReadAt(header, region.offset)
+	if err != nil {
+		return nil, err
+	}
+
+	if string(header[:8]) != "metadata" {
+		return nil, errors.New("vhdx: invalid metadata table signature")
+	}
+
+	count := int(binary.LittleEndian.Uint16(header[10:]))
+	entries := make([]byte, 32*count)
+	_, err = reader.ReadAt(entries, region.offset+32)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]byte)
+	for i := 0; i < count; i++ {
+		entry := entries[i*32:]
+		offset := int64(binary.LittleEndian.Uint32(entry[16:]))
+		length := int64(binary.LittleEndian.Uint32(entry[20:]))
+		if length > MAX_VHDX_METADATA_ITEM {
+			return nil, errors.New("vhdx: metadata item too large")
+		}
+
+		item := make([]byte, length)
+		_, err := reader.ReadAt(item, region.offset+offset)
+		if err != nil {
+			return nil, err
+		}
+		result[parseGUID(entry)] = item
+	}
+
+	return result, nil
+}
+
+func parseVHDXParentLocator(item []byte) (map[string]string, error) {
+	if len(item) < 20 || parseGUID(item) != VHDX_PARENT_LOCATOR_TYPE {
+		return nil, errors.New("vhdx: unsupported parent locator")
+	}
+
+	result := make(map[string]string)
+	count := int(binary.LittleEndian.Uint16(item[18:]))
+	for i := 0; i < count; i++ {
+		if 20+(i+1)*12 > len(item) {
+			return nil, errors.New("vhdx: parent locator overflow")
+		}
+		entry := item[20+i*12:]
+		key_offset := int(binary.LittleEndian.Uint32(entry[0:]))
+		value_offset := int(binary.LittleEndian.Uint32(entry[4:]))
+		key_length := int(binary.LittleEndian.Uint16(entry[8:]))
+		value_length := int(binary.LittleEndian.Uint16(entry[10:]))
+
+		if key_offset+key_length > len(item) ||
+			value_offset+value_length > len(item) {
+			return nil, errors.New("vhdx: parent locator overflow")
+		}
+
+		key := parseUTF16(item[key_offset : key_offset+key_length])
+		result[key] = parseUTF16(item[value_offset : value_offset+value_length])
+	}
+	return result, nil
+}
+
+func OpenVHDX(reader io.ReaderAt) (*VHDX, error) {
+	signature := make([]byte, 8)
+	_, err := reader.ReadAt(signature, 0)
+	if err != nil || string(signature) != VHDX_SIGNATURE {
+		return nil, errors.New("vhdx: invalid file signature")
+	}
+
+	header, err := readVHDXHeader(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &VHDX{
+		reader:     reader,
+		LogPending: parseGUID(header[48:]) != VHDX_ZERO_GUID,
+	}
+
+	regions, err := readVHDXRegions(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata_region, pres := regions[VHDX_METADATA_GUID]
+	if !pres {
+		return nil, errors.New("vhdx: no metadata region")
+	}
+
+	metadata, err := readVHDXMetadata(reader, metadata_region)
+	if err != nil {
+		return nil, err
+	}
+
+	file_parameters := metadata[VHDX_FILE_PARAMETERS_GUID]
+	virtual_disk_size := metadata[VHDX_VIRTUAL_DISK_SIZE]
+	sector_size := metadata[VHDX_LOGICAL_SECTOR_SIZE]
+	if len(file_parameters) < 8 || len(virtual_disk_size) < 8 ||
+		len(sector_size) < 4 {
+		return nil, errors.New("vhdx: required metadata missing")
+	}
+
+	result.BlockSize = int64(binary.LittleEndian.Uint32(file_parameters))
+	result.HasParent = binary.LittleEndian.Uint32(file_parameters[4:])&
+		VHDX_FILE_PARAMS_HAS_PARENT != 0
+	result.VirtualSize = int64(binary.LittleEndian.Uint64(virtual_disk_size))
+	result.LogicalSectorSize = int64(binary.LittleEndian.Uint32(sector_size))
+
+	if result.LogicalSectorSize != 512 && result.LogicalSectorSize != 4096 {
+		return nil, fmt.Errorf("vhdx: invalid logical sector size %v",
+			result.LogicalSectorSize)
+	}
+
+	if result.BlockSize < VHDX_MB || result.BlockSize > 256*VHDX_MB ||
+		result.BlockSize&(result.BlockSize-1) != 0 {
+		return nil, fmt.Errorf("vhdx: invalid block size %v", result.BlockSize)
+	}
+
+	result.chunk_ratio = (int64(1) << 23) * result.LogicalSectorSize /
+		result.BlockSize
+
+	if result.HasParent {
+		locator, pres := metadata[VHDX_PARENT_LOCATOR_GUID]
+		if !pres {
+			return nil, errors.New("vhdx: differencing image without parent locator")
+		}
+
+		result.ParentLocator, err = parseVHDXParentLocator(locator)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bat_region, pres := regions[VHDX_BAT_GUID]
+	if !pres {
+		return nil, errors.New("vhdx: no BAT region")
+	}
+
+	if bat_region.length > MAX_VHDX_BAT_SIZE {
+		return nil, errors.New("vhdx: BAT too large")
+	}
+
+	bat := make([]byte, bat_region.length)
+	_, err = reader.ReadAt(bat, bat_region.offset)
+	if err != nil {
+		return nil, fmt.Errorf("vhdx: reading BAT: %w", err)
+	}
+
+	result.bat = make([]uint64, len(bat)/8)
+	for i := range result.bat {
+		result.bat[i] = binary.LittleEndian.Uint64(bat[i*8:])
+	}
+
+	return result, nil
+}
@@ -0,0 +1,515 @@
+package vdisk
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	VMDK_MAGIC  = 0x564d444b
+	SECTOR_SIZE = 512
+
+	VMDK_FLAG_COMPRESSED = 1 << 16
+	VMDK_FLAG_MARKERS    = 1 << 17
+
+	// The grain directory is stored in the footer (streamOptimized).
+	VMDK_GD_AT_END = 0xffffffffffffffff
+
+	// The grain is allocated but reads as zeros.
+	VMDK_GTE_ZERO = 1
+
+	MAX_VMDK_DESCRIPTOR_SIZE = 1024 * 1024
+	MAX_VMDK_GRAIN_SIZE      = 2048
+	MAX_VMDK_GD_ENTRIES      = 1024 * 1024
+)
+
+var (
+	vmdkExtentRegex = regexp.MustCompile(
+		`^(RW|RDONLY|NOACCESS)\s+(\d+)\s+(\w+)(?:\s+"([^"]*)"(?:\s+(\d+))?)?`)
+)
+
+// The extents of the disk are listed in a text descriptor which is
+// either a separate file or embedded in a sparse extent.
+type VMDKDescriptor struct {
+	CreateType         string
+	CID                string
+	ParentCID          string
+	ParentFileNameHint string
+	Extents            []*VMDKExtentDescriptor
+}
+
+type VMDKExtentDescriptor struct {
+	Access string
+
+	// In sectors
+	Size int64
+	Type string
+
+	Filename string
+
+	// Offset of the data in flat extents in sectors.
+	Offset int64
+}
+
+func (self *VMDKDescriptor) HasParent() bool {
+	return self.ParentFileNameHint != "" &&
+		strings.ToLower(self.ParentCID) != "ffffffff"
+}
+
+func ParseVMDKDescriptor(data []byte) (*VMDKDescriptor, error) {
+	result := &VMDKDescriptor{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(strings.TrimRight(scanner.Text(), "\x00"))
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		match := vmdkExtentRegex.FindStringSubmatch(line)
+		if match != nil {
+			extent := &VMDKExtentDescriptor{
+				Access:   match[1],
+				Type:     strings.ToUpper(match[3]),
+				Filename: match[4],
+			}
+			extent.Size, _ = strconv.ParseInt(match[2], 10, 64)
+			if match[5] != "" {
+				extent.Offset, _ = strconv.ParseInt(match[5], 10, 64)
+			}
+			result.Extents = append(result.Extents, extent)
+			continue
+		}
+
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		value := strings.Trim(strings.TrimSpace(parts[1]), `"`)
+		switch strings.TrimSpace(parts[0]) {
+		case "createType":
+			result.CreateType = value
+		case "CID":
+			result.CID = value
+		case "parentCID":
+			result.ParentCID = value
+		case "parentFileNameHint":
+			result.ParentFileNameHint = value
+		}
+	}
+
+	if len(result.Extents) == 0 {
+		return nil, errors.New("vmdk: no extents in descriptor")
+	}
+
+	return result, nil
+}
+
+// A hosted sparse extent stores grains through a grain directory and
+// grain tables.
+type VMDKSparseExtent struct {
+	reader io.ReaderAt
+
+	Flags uint32
+
+	// In sectors
+	Capacity  int64
+	GrainSize int64
+
+	gte_per_gt int64
+	gd         []uint32
+
+	// Embedded descriptor, if present.
+	Descriptor []byte
+
+	// Cache the last decompressed grain.
+	mu               sync.Mutex
+	compressed_grain int64
+	grain_data       []byte
+}
+
+func (self *VMDKSparseExtent) Size() int64 {
+	return self.Capacity * SECTOR_SIZE
+}
+
+func (self *VMDKSparseExtent) MapOffset(offset int64) (*Run, error) {
+	grain_bytes := self.GrainSize * SECTOR_SIZE
+	grain := offset / grain_bytes
+	grain_offset := offset % grain_bytes
+	length := grain_bytes - grain_offset
+
+	gd_index := grain / self.gte_per_gt
+	gt_index := grain % self.gte_per_gt
+
+	if gd_index >= int64(len(self.gd)) || self.gd[gd_index] == 0 {
+		return &Run{
+			Kind:   RUN_PARENT,
+			Length: (self.gte_per_gt-gt_index)*grain_bytes - grain_offset,
+		}, nil
+	}
+
+	buf := make([]byte, 4)
+	_, err := self.reader.ReadAt(buf,
+		int64(self.gd[gd_index])*SECTOR_SIZE+gt_index*4)
+	if err != nil {
+		return nil, err
+	}
+
+	gte := int64(binary.LittleEndian.Uint32(buf))
+	switch gte {
+	case 0:
+		return &Run{Kind: RUN_PARENT, Length: length}, nil
+	case VMDK_GTE_ZERO:
+		return &Run{Kind: RUN_ZERO, Length: length}, nil
+	}
+
+	if self.Flags&VMDK_FLAG_COMPRESSED != 0 {
+		data, err := self.readCompressed(gte)
+		if err != nil {
+			return nil, err
+		}
+		return &Run{
+			Kind:   RUN_DATA,
+			Reader: bytes.NewReader(data),
+			Offset: grain_offset,
+			Length: length,
+		}, nil
+	}
+
+	return &Run{
+		Kind:   RUN_DATA,
+		Reader: self.reader,
+		Offset: gte*SECTOR_SIZE + grain_offset,
+		Length: length,
+	}, nil
+}
+
+// Compressed grains start with the LBA and the size of the zlib
+// stream.
+func (self *VMDKSparseExtent) readCompressed(sector int64) ([]byte, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	if self.grain_data != nil && self.compressed_grain == sector {
+		return self.grain_data, nil
+	}
+
+	header := make([]byte, 12)
+	_, err := self.reader.ReadAt(header, sector*SECTOR_SIZE)
+	if err != nil {
+		return nil, err
+	}
+
+	size := int64(binary.LittleEndian.Uint32(header[8:]))
+	grain_bytes := self.GrainSize * SECTOR_SIZE
+	if size > 2*grain_bytes+SECTOR_SIZE {
+		return nil, fmt.Errorf("vmdk: compressed grain too large (%v)", size)
+	}
+
+	compressed := make([]byte, size)
+	_, err = self.reader.ReadAt(compressed, sector*SECTOR_SIZE+12)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	decompressor, err := zlib.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("vmdk: decompressing grain: %w", err)
+	}
+
+	// The last grain may be short.
+	data := make([]byte, grain_bytes)
+	_, err = io.ReadFull(decompressor, data)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, fmt.Errorf("vmdk: decompressing grain: %w", err)
+	}
+
+	self.compressed_grain = sector
+	self.grain_data = data
+	return data, nil
+}
+
+// Checks for the sparse extent magic.
+func IsVMDKSparseExtent(reader io.ReaderAt) bool {
+	buf := make([]byte, 4)
+	_, err := reader.ReadAt(buf, 0)
+	return err == nil && binary.LittleEndian.Uint32(buf) == VMDK_MAGIC
+}
+
+func parseVMDKSparseHeader(header []byte) (*VMDKSparseExtent, uint64, error) {
+	if binary.LittleEndian.Uint32(header) != VMDK_MAGIC {
+		return nil, 0, errors.New("vmdk: invalid sparse extent magic")
+	}
+
+	result := &VMDKSparseExtent{
+		Flags:      binary.LittleEndian.Uint32(header[8:]),
+		Capacity:   int64(binary.LittleEndian.Uint64(header[12:])),
+		GrainSize:  int64(binary.LittleEndian.Uint64(header[20:])),
+		gte_per_gt: int64(binary.LittleEndian.Uint32(header[44:])),
+	}
+
+	if result.GrainSize == 0 || result.GrainSize > MAX_VMDK_GRAIN_SIZE {
+		return nil, 0, fmt.Errorf("vmdk: invalid grain size %v",
+			result.GrainSize)
+	}
+
+	if result.gte_per_gt == 0 {
+		return nil, 0, errors.New("vmdk: invalid grain table size")
+	}
+
+	return result, binary.LittleEndian.Uint64(header[56:]), nil
+}
+
+// file_size is needed to find the footer of streamOptimized images.
+func OpenVMDKSparseExtent(
+	reader io.ReaderAt, file_size int64) (*VMDKSparseExtent, error) {
+	header := make([]byte, SECTOR_SIZE)
+	_, err := reader.ReadAt(header, 0)
+	if err != nil {
+		return nil, fmt.Errorf("vmdk: reading header: %w", err)
+	}
+
+	result, gd_offset, err := parseVMDKSparseHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	descriptor_offset := int64(binary.LittleEndian.Uint64(header[28:]))
+	descriptor_size := int64(binary.LittleEndian.Uint64(header[36:]))
+
+	// The real header is in the footer which is followed by the end
+	// of stream marker.
+	if gd_offset == VMDK_GD_AT_END {
+		_, err := reader.ReadAt(header, file_size-2*SECTOR_SIZE)
+		if err != nil {
+			return nil, fmt.Errorf("vmdk: reading footer: %w", err)
+		}
+
+		result, gd_offset, err = parseVMDKSparseHeader(header)
+		if err != nil {
+			return nil, err
+		}
+	}
+	result.reader = reader
+
+	if descriptor_offset > 0 && descriptor_size > 0 {
+		if descriptor_size*SECTOR_SIZE > MAX_VMDK_DESCRIPTOR_SIZE {
+			return nil, errors.New("vmdk: embedded descriptor too large")
+		}
+
+		result.Descriptor = make([]byte, descriptor_size*SECTOR_SIZE)
+		_, err := reader.ReadAt(result.Descriptor,
+			descriptor_offset*SECTOR_SIZE)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("vmdk: reading descriptor: %w", err)
+		}
+	}
+
+	grains := (result.Capacity + result.GrainSize - 1) / result.GrainSize
+	gd_entries := (grains + result.gte_per_gt - 1) / result.gte_per_gt
+	if gd_entries > MAX_VMDK_GD_ENTRIES {
+		return nil, fmt.Errorf("vmdk: grain directory too large (%v)",
+			gd_entries)
+	}
+
+	gd := make([]byte, 4*gd_entries)
+	_, err = reader.ReadAt(gd, int64(gd_offset)*SECTOR_SIZE)
+	if err != nil {
+		return nil, fmt.Errorf("vmdk: reading grain directory: %w", err)
+	}
+
+	result.gd = make([]uint32, gd_entries)
+	for i := range result.gd {
+		result.gd[i] = binary.LittleEndian.Uint32(gd[i*4:])
+	}
+
+	return result, nil
+}
+
+// A flat extent stores the data contiguously.
+type vmdkFlatExtent struct {
+	reader io.ReaderAt
+	offset int64
+	size   int64
+}
+
+func (self *vmdkFlatExtent) Size() int64 {
+	return self.size
+}
+
+func (self *vmdkFlatExtent) MapOffset(offset int64) (*Run, error) {
+	return &Run{
+		Kind:   RUN_DATA,
+		Reader: self.reader,
+		Offset: self.offset + offset,
+		Length: self.size - offset,
+	}, nil
+}
+
+// A zero extent has no storage.
+type vmdkZeroExtent struct {
+	size int64
+}
+
+func (self *vmdkZeroExtent) Size() int64 {
+	return self.size
+}
+
+func (self *vmdkZeroExtent) MapOffset(offset int64) (*Run, error) {
+	return &Run{Kind: RUN_ZERO, Length: self.size - offset}, nil
+}
+
+// A VMDK disk is the concatenation of its extents.
+type VMDK struct {
+	Descriptor *VMDKDescriptor
+
+	extents []mapper
+
+	// The virtual offset each extent starts at.
+	starts []int64
+	size   int64
+}
+
+func (self *VMDK) Size() int64 {
+	return self.size
+}
+
+func (self *VMDK) MapOffset(offset int64) (*Run, error) {
+	idx := sort.Search(len(self.starts), func(i int) bool {
+		return self.starts[i] > offset
+	}) - 1
+	if idx < 0 {
+		return nil, fmt.Errorf("vmdk: offset %v is not mapped", offset)
+	}
+
+	extent := self.extents[idx]
+	extent_offset := offset - self.starts[idx]
+	if extent_offset >= extent.Size() {
+		return nil, fmt.Errorf("vmdk: offset %v is not mapped", offset)
+	}
+
+	run, err := extent.MapOffset(extent_offset)
+	if err != nil {
+		return nil, err
+	}
+
+	if run.Length > extent.Size()-extent_offset {
+		run.Length = extent.Size() - extent_offset
+	}
+	return run, nil
+}
+
+func (self *VMDK) addExtent(extent mapper) {
+	self.starts = append(self.starts, self.size)
+	self.extents = append(self.extents, extent)
+	self.size += extent.Size()
+}
+
+// Opens the files making up the extents.
+type FileOpener func(name string) (reader io.ReaderAt, size int64, err error)
+
+// The main file is either a text descriptor or a sparse extent with
+// an embedded descriptor.
+func OpenVMDK(reader io.ReaderAt, size int64, opener FileOpener) (*VMDK, error) {
+	if IsVMDKSparseExtent(reader) {
+		extent, err := OpenVMDKSparseExtent(reader, size)
+		if err != nil {
+			return nil, err
+		}
+
+		result := &VMDK{}
+		if len(extent.Descriptor) > 0 {
+			result.Descriptor, _ = ParseVMDKDescriptor(extent.Descriptor)
+		}
+		result.addExtent(extent)
+		return result, nil
+	}
+
+	if size > MAX_VMDK_DESCRIPTOR_SIZE {
+		return nil, errors.New("vmdk: not a sparse extent or descriptor")
+	}
+
+	data := make([]byte, size)
+	_, err := reader.ReadAt(data, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	if !bytes.Contains(data, []byte("createType")) {
+		return nil, errors.New("vmdk: not a sparse extent or descriptor")
+	}
+
+	descriptor, err := ParseVMDKDescriptor(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &VMDK{Descriptor: descriptor}
+	for _, extent := range descriptor.Extents {
+		extent_size := extent.Size * SECTOR_SIZE
+
+		switch extent.Type {
+		case "ZERO":
+			result.addExtent(&vmdkZeroExtent{size: extent_size})
+
+		case "FLAT", "VMFS", "VMFSRAW":
+			extent_reader, _, err := opener(extent.Filename)
+			if err != nil {
+				return nil, err
+			}
+			result.addExtent(&vmdkFlatExtent{
+				reader: extent_reader,
+				offset: extent.Offset * SECTOR_SIZE,
+				size:   extent_size,
+			})
+
+		case "SPARSE":
+			extent_reader, extent_file_size, err := opener(extent.Filename)
+			if err != nil {
+				return nil, err
+			}
+
+			sparse, err := OpenVMDKSparseExtent(extent_reader, extent_file_size)
+			if err != nil {
+				return nil, fmt.Errorf("%v: %w", extent.Filename, err)
+			}
+
+			// The descriptor is authoritative for the extent size.
+			result.addExtent(&vmdkSizedExtent{
+				mapper: sparse, size: extent_size})
+
+		default:
+			return nil, fmt.Errorf("vmdk: unsupported extent type %v",
+				extent.Type)
+		}
+	}
+
+	return result, nil
+}
+
+type vmdkSizedExtent struct {
+	mapper
+	size int64
+}
+
+func (self *vmdkSizedExtent) Size() int64 {
+	return self.size
+}
+
+func (self *vmdkSizedExtent) MapOffset(offset int64) (*Run, error) {
+	if offset >= self.mapper.Size() {
+		return &Run{Kind: RUN_ZERO, Length: self.size - offset}, nil
+	}
+	return self.mapper.MapOffset(offset)
+}
@@ -32,6 +32,7 @@
 package zip
 
 import (
+	"bytes"
 	"compress/bzip2"
 	"compress/gzip"
 	"errors"
@@ -42,6 +43,8 @@ import (
 	"time"
 
 	"github.com/Velocidex/ordereddict"
+	"github.com/klauspost/compress/zstd"
+	"github.com/ulikunitz/xz"
 	"www.velocidex.com/golang/velociraptor/accessors"
 	"www.velocidex.com/golang/velociraptor/json"
 	"www.velocidex.com/golang/vfilter"
@@ -230,13 +233,14 @@ func (self *SeekableGzip) LStat() (accessors.FileInfo, error) {
 type FileGetter func(full_path *accessors.OSPath,
 	scope vfilter.Scope) (ReaderStat, error)
 
-func GetBzip2File(full_path *accessors.OSPath, scope vfilter.Scope) (
+// Open the file through the delegate and decompress it.
+func getCompressedFile(full_path *accessors.OSPath, scope vfilter.Scope,
+	decompress func(reader io.Reader) (io.ReadCloser, error)) (
 	ReaderStat, error) {
 	pathspec := full_path.PathSpec()
 
-	// The gzip accessor must use a delegate but if one is not
-	// provided we use the "auto" accessor, to open the underlying
-	// file.
+	// The accessor must use a delegate but if one is not provided we
+	// use the "auto" accessor, to open the underlying file.
 	if pathspec.DelegateAccessor == "" && pathspec.DelegatePath == "" {
 		pathspec.DelegatePath = pathspec.Path
 		pathspec.DelegateAccessor = "auto"
@@ -256,12 +260,18 @@ func GetBzip2File(full_path *accessors.OSPath, scope vfilter.Scope) (
 
 	stat, err := accessor.Lstat(delegate_path)
 	if err != nil {
+		fd.Close()
+		return nil, err
+	}
+
+	zr, err := decompress(fd)
+	if err != nil {
+		fd.Close()
 		return nil, err
 	}
 
-	zr := bzip2.NewReader(fd)
 	return &SeekableGzip{reader: fd,
-		gz: ioutil.NopCloser(zr),
+		gz: zr,
 		info: &GzipFileInfo{
 			_modtime:   stat.ModTime(),
 			_name:      stat.Name(),
@@ -269,6 +279,65 @@ func GetBzip2File(full_path *accessors.OSPath, scope vfilter.Scope) (
 		}}, nil
 }
 
+func GetBzip2File(full_path *accessors.OSPath, scope vfilter.Scope) (
+	ReaderStat, error) {
+	return getCompressedFile(full_path, scope,
+		func(reader io.Reader) (io.ReadCloser, error) {
+			return ioutil.NopCloser(bzip2.NewReader(reader)), nil
+		})
+}
+
+func GetXzFile(full_path *accessors.OSPath, scope vfilter.Scope) (
+	ReaderStat, error) {
+	return getCompressedFile(full_path, scope, newXzReader)
+}
+
+func GetZstdFile(full_path *accessors.OSPath, scope vfilter.Scope) (
+	ReaderStat, error) {
+	return getCompressedFile(full_path, scope, newZstdReader)
+}
+
+func newXzReader(reader io.Reader) (io.ReadCloser, error) {
+	xzr, err := xz.NewReader(reader)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.NopCloser(xzr), nil
+}
+
+func newZstdReader(reader io.Reader) (io.ReadCloser, error) {
+	zr, err := zstd.NewReader(reader)
+	if err != nil {
+		return nil, err
+	}
+	return zr.IOReadCloser(), nil
+}
+
+// Detect the compression from the magic at the start of the file and
+// return a decompressing reader, or nil if the file is not
+// compressed.
+func newDecompressor(reader io.ReaderAt) (io.ReadCloser, error) {
+	magic := make([]byte, 6)
+	n, _ := reader.ReadAt(magic, 0)
+	magic = magic[:n]
+
+	stream := io.NewSectionReader(reader, 0, 1<<62)
+	switch {
+	case bytes.HasPrefix(magic, []byte{0x1f, 0x8b}):
+		return gzip.NewReader(stream)
+
+	case bytes.HasPrefix(magic, []byte("BZh")):
+		return ioutil.NopCloser(bzip2.NewReader(stream)), nil
+
+	case bytes.HasPrefix(magic, []byte{0xfd, '7', 'z', 'X', 'Z', 0x00}):
+		return newXzReader(stream)
+
+	case bytes.HasPrefix(magic, []byte{0x28, 0xb5, 0x2f, 0xfd}):
+		return newZstdReader(stream)
+	}
+	return nil, nil
+}
+
 func GetGzipFile(full_path *accessors.OSPath, scope vfilter.Scope) (ReaderStat, error) {
 	pathspec := full_path.PathSpec()
 
@@ -338,5 +407,13 @@ func init() {
 		accessors.MustNewLinuxOSPath(""), GetBzip2File),
 		`Access the content of gzip files. The filename is a pathspec with a delegate accessor opening the actual gzip file.`)
 
+	accessors.Register("xz", NewGzipFileSystemAccessor(
+		accessors.MustNewLinuxOSPath(""), GetXzFile),
+		`Access the content of xz compressed files. The filename is a pathspec with a delegate accessor opening the actual xz file.`)
+
+	accessors.Register("zstd", NewGzipFileSystemAccessor(
+		accessors.MustNewLinuxOSPath(""), GetZstdFile),
+		`Access the content of zstd compressed files. The filename is a pathspec with a delegate accessor opening the actual zstd file.`)
+
 	json.RegisterCustomEncoder(&GzipFileInfo{}, accessors.MarshalGlobFileInfo)
 }
@@ -54,3 +54,26 @@ func TestAccessorBzip2(t *testing.T) {
 
 	assert.Equal(t, "goodbye world\n", string(data))
 }
+
+func TestAccessorXzAndZstd(t *testing.T) {
+	scope := vql_subsystem.MakeScope().AppendVars(ordereddict.NewDict().
+		Set(vql_subsystem.ACL_MANAGER_VAR, acl_managers.NullACLManager{}))
+	scope.SetLogger(log.New(os.Stderr, " ", 0))
+
+	for _, accessor_name := range []string{"xz", "zstd"} {
+		accessor, err := accessors.GetAccessor(accessor_name, scope)
+		assert.NoError(t, err)
+
+		abs_path, _ := filepath.Abs("../../artifacts/testdata/files/hi." +
+			map[string]string{"xz": "xz", "zstd": "zst"}[accessor_name])
+
+		fd, err := accessor.Open(abs_path)
+		assert.NoError(t, err)
+
+		data, err := ioutil.ReadAll(fd)
+		assert.NoError(t, err)
+		fd.Close()
+
+		assert.Equal(t, "hello "+accessor_name+"\n", string(data))
+	}
+}
@@ -0,0 +1,1029 @@
+// A 7z accessor.
+
+// 7z archives keep their member data in "folders" - each folder is a
+// stream compressed by a chain of coders, usually holding several
+// members back to back (solid compression). Since these streams are
+// not seekable, the archive is unpacked into a tmp file when it is
+// first opened and the members are served from there, exactly like a
+// compressed tar file. This accessor therefore reuses the tar
+// accessor's cache and only provides a different loader.
+
+// The Copy, LZMA, LZMA2, Deflate and BZip2 methods are supported,
+// which covers archives made with the default settings. Encrypted
+// archives and branch converting filters (BCJ/BCJ2 used for
+// executables) are rejected with an error.
+
+package zip
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"time"
+	"unicode/utf16"
+
+	"github.com/Velocidex/ordereddict"
+	"github.com/ulikunitz/xz/lzma"
+	"www.velocidex.com/golang/velociraptor/accessors"
+)
+
+const (
+	SevenZipFileSystemAccessorTag = "_7zFS"
+
+	// Refuse to load headers larger than this.
+	MAX_7Z_HEADER_SIZE = 64 * 1024 * 1024
+
+	// Symlink targets are stored as the member's data.
+	MAX_7Z_LINK_SIZE = 4096
+)
+
+// Property ids as named in the 7z format specification.
+const (
+	k7zEnd                   = 0x00
+	k7zHeader                = 0x01
+	k7zArchiveProperties     = 0x02
+	k7zAdditionalStreamsInfo = 0x03
+	k7zMainStreamsInfo       = 0x04
+	k7zFilesInfo             = 0x05
+	k7zPackInfo              = 0x06
+	k7zUnpackInfo            = 0x07
+	k7zSubStreamsInfo        = 0x08
+	k7zSize                  = 0x09
+	k7zCRC                   = 0x0A
+	k7zFolder                = 0x0B
+	k7zCodersUnpackSize      = 0x0C
+	k7zNumUnpackStream       = 0x0D
+	k7zEmptyStream           = 0x0E
+	k7zEmptyFile             = 0x0F
+	k7zName                  = 0x11
+	k7zATime                 = 0x13
+	k7zMTime                 = 0x14
+	k7zWinAttributes         = 0x15
+	k7zEncodedHeader         = 0x17
+)
+
+const (
+	FILE_ATTRIBUTE_READONLY  = 0x01
+	FILE_ATTRIBUTE_DIRECTORY = 0x10
+
+	// The high 16 bits of the attributes hold the unix mode.
+	FILE_ATTRIBUTE_UNIX_EXTENSION = 0x8000
+)
+
+var (
+	sevenZipMagic = []byte{'7', 'z', 0xBC, 0xAF, 0x27, 0x1C}
+
+	errInvalid7z = errors.New("7z: Invalid archive")
+)
+
+// A reader over the header data. Errors are sticky so parsing can
+// check them once per structure.
+type sevenZipBuffer struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func (self *sevenZipBuffer) readByte() byte {
+	if self.err != nil {
+		return 0
+	}
+
+	if self.pos >= len(self.data) {
+		self.err = errInvalid7z
+		return 0
+	}
+	self.pos++
+	return self.data[self.pos-1]
+}
+
+func (self *sevenZipBuffer) readBytes(length uint64) []byte {
+	if self.err != nil {
+		return nil
+	}
+
+	if length > uint64(len(self.data)-self.pos) {
+		self.err = errInvalid7z
+		return nil
+	}
+	result := self.data[self.pos : self.pos+int(length)]
+	self.pos += int(length)
+	return result
+}
+
+// Numbers are encoded with the number of extra bytes given by the
+// leading set bits of the first byte.
+func (self *sevenZipBuffer) readNumber() uint64 {
+	first := self.readByte()
+	mask := byte(0x80)
+	var result uint64
+
+	for i := 0; i < 8; i++ {
+		if first&mask == 0 {
+			high := uint64(first & (mask - 1))
+			return result | high<<(8*i)
+		}
+		result |= uint64(self.readByte()) << (8 * i)
+		mask >>= 1
+	}
+	return result
+}
+
+// A number counting items which each take at least a bit of the
+// header - larger counts can not be valid.
+func (self *sevenZipBuffer) readCount() int {
+	count := self.readNumber()
+	if count > uint64(len(self.data))*8 {
+		self.err = errInvalid7z
+		return 0
+	}
+	return int(count)
+}
+
+func (self *sevenZipBuffer) readUint32() uint32 {
+	data := self.readBytes(4)
+	if data == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(data)
+}
+
+func (self *sevenZipBuffer) readUint64() uint64 {
+	data := self.readBytes(8)
+	if data == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint64(data)
+}
+
+func (self *sevenZipBuffer) readBits(count int) []bool {
+	data := self.readBytes(uint64((count + 7) / 8))
+	if data == nil {
+		return make([]bool, count)
+	}
+
+	result := make([]bool, count)
+	for i := range result {
+		result[i] = data[i/8]&(0x80>>(i%8)) != 0
+	}
+	return result
+}
+
+// Bit vectors preceded by an "all defined" flag.
+func (self *sevenZipBuffer) readOptionalBits(count int) []bool {
+	if self.readByte() == 0 {
+		return self.readBits(count)
+	}
+
+	result := make([]bool, count)
+	for i := range result {
+		result[i] = true
+	}
+	return result
+}
+
+// CRCs are not verified for member data, but must be parsed.
+func (self *sevenZipBuffer) skipDigests(count int) {
+	for _, defined := range self.readOptionalBits(count) {
+		if defined {
+			self.readUint32()
+		}
+	}
+}
+
+func (self *sevenZipBuffer) expect(id byte) {
+	if self.readByte() != id && self.err == nil {
+		self.err = errInvalid7z
+	}
+}
+
+type sevenZipCoder struct {
+	id         []byte
+	properties []byte
+	num_in     int
+	num_out    int
+}
+
+type sevenZipBindPair struct {
+	in_index  uint64
+	out_index uint64
+}
+
+type sevenZipFolder struct {
+	coders     []*sevenZipCoder
+	bind_pairs []sevenZipBindPair
+
+	// The coder input streams fed from pack streams.
+	packed_streams []uint64
+
+	// The size of each coder's output.
+	unpack_sizes []uint64
+
+	has_crc            bool
+	first_pack_stream  int
+	num_unpack_streams int
+}
+
+// The folder's output is the coder output not bound to another
+// coder's input.
+func (self *sevenZipFolder) mainOutput() (int, error) {
+outer:
+	for i := range self.unpack_sizes {
+		for _, pair := range self.bind_pairs {
+			if pair.out_index == uint64(i) {
+				continue outer
+			}
+		}
+		return i, nil
+	}
+	return 0, errInvalid7z
+}
+
+func (self *sevenZipFolder) unpackSize() uint64 {
+	idx, err := self.mainOutput()
+	if err != nil {
+		return 0
+	}
+	return self.unpack_sizes[idx]
+}
+
+type sevenZipStreamsInfo struct {
+	pack_pos   uint64
+	pack_sizes []uint64
+	folders    []*sevenZipFolder
+
+	// The size of each member stream, in the order they appear in
+	// the folders.
+	stream_sizes []uint64
+}
+
+func (self *sevenZipBuffer) readPackInfo(info *sevenZipStreamsInfo) {
+	info.pack_pos = self.readNumber()
+	count := self.readCount()
+
+	id := self.readByte()
+	if id == k7zSize {
+		info.pack_sizes = make([]uint64, count)
+		for i := range info.pack_sizes {
+			info.pack_sizes[i] = self.readNumber()
+		}
+		id = self.readByte()
+	}
+
+	if id == k7zCRC {
+		self.skipDigests(count)
+		id = self.readByte()
+	}
+
+	if id != k7zEnd || len(info.pack_sizes) != count {
+		self.err = errInvalid7z
+	}
+}
+
+func (self *sevenZipBuffer) readFolder() *sevenZipFolder {
+	folder := &sevenZipFolder{num_unpack_streams: 1}
+
+	num_coders := self.readCount()
+	if num_coders == 0 || num_coders > 64 {
+		self.err = errInvalid7z
+		return folder
+	}
+
+	total_in, total_out := 0, 0
+	for i := 0; i < num_coders && self.err == nil; i++ {
+		flags := self.readByte()
+
+		// Alternative methods were never used.
+		if flags&0x80 != 0 {
+			self.err = errInvalid7z
+			break
+		}
+
+		coder := &sevenZipCoder{
+			id:      self.readBytes(uint64(flags & 0x0F)),
+			num_in:  1,
+			num_out: 1,
+		}
+
+		if flags&0x10 != 0 {
+			coder.num_in = self.readCount()
+			coder.num_out = self.readCount()
+		}
+
+		if flags&0x20 != 0 {
+			coder.properties = self.readBytes(self.readNumber())
+		}
+
+		total_in += coder.num_in
+		total_out += coder.num_out
+		folder.coders = append(folder.coders, coder)
+	}
+
+	if total_out == 0 || total_in < total_out-1 || total_in > 64 {
+		self.err = errInvalid7z
+		return folder
+	}
+
+	for i := 0; i < total_out-1; i++ {
+		folder.bind_pairs = append(folder.bind_pairs, sevenZipBindPair{
+			in_index:  self.readNumber(),
+			out_index: self.readNumber(),
+		})
+	}
+
+	num_packed := total_in - len(folder.bind_pairs)
+	if num_packed == 1 {
+	outer:
+		for i := 0; i < total_in; i++ {
+			for _, pair := range folder.bind_pairs {
+				if pair.in_index == uint64(i) {
+					continue outer
+				}
+			}
+			folder.packed_streams = append(folder.packed_streams, uint64(i))
+			break
+		}
+
+	} else {
+		for i := 0; i < num_packed; i++ {
+			folder.packed_streams = append(
+				folder.packed_streams, self.readNumber())
+		}
+	}
+
+	folder.unpack_sizes = make([]uint64, total_out)
+	return folder
+}
+
+func (self *sevenZipBuffer) readUnpackInfo(info *sevenZipStreamsInfo) {
+	self.expect(k7zFolder)
+	count := self.readCount()
+
+	// External folder definitions are not used by 7-Zip.
+	if self.readByte() != 0 {
+		self.err = errInvalid7z
+		return
+	}
+
+	pack_stream := 0
+	for i := 0; i < count && self.err == nil; i++ {
+		folder := self.readFolder()
+		folder.first_pack_stream = pack_stream
+		pack_stream += len(folder.packed_streams)
+		info.folders = append(info.folders, folder)
+	}
+
+	self.expect(k7zCodersUnpackSize)
+	for _, folder := range info.folders {
+		for i := range folder.unpack_sizes {
+			folder.unpack_sizes[i] = self.readNumber()
+		}
+	}
+
+	id := self.readByte()
+	if id == k7zCRC {
+		for i, defined := range self.readOptionalBits(count) {
+			if defined && i < len(info.folders) {
+				info.folders[i].has_crc = true
+				self.readUint32()
+			}
+		}
+		id = self.readByte()
+	}
+
+	if id != k7zEnd {
+		self.err = errInvalid7z
+	}
+}
+
+func (self *sevenZipBuffer) readSubStreamsInfo(info *sevenZipStreamsInfo) {
+	id := self.readByte()
+	if id == k7zNumUnpackStream {
+		for _, folder := range info.folders {
+			folder.num_unpack_streams = self.readCount()
+		}
+		id = self.readByte()
+	}
+
+	// The size of the last stream in each folder is implied.
+	for _, folder := range info.folders {
+		if folder.num_unpack_streams == 0 {
+			continue
+		}
+
+		if folder.num_unpack_streams > 1 && id != k7zSize {
+			self.err = errInvalid7z
+			return
+		}
+
+		var sum uint64
+		for i := 1; i < folder.num_unpack_streams && self.err == nil; i++ {
+			size := self.readNumber()
+			info.stream_sizes = append(info.stream_sizes, size)
+			sum += size
+		}
+
+		if sum > folder.unpackSize() {
+			self.err = errInvalid7z
+			return
+		}
+		info.stream_sizes = append(info.stream_sizes, folder.unpackSize()-sum)
+	}
+
+	if id == k7zSize {
+		id = self.readByte()
+	}
+
+	if id == k7zCRC {
+		count := 0
+		for _, folder := range info.folders {
+			if folder.num_unpack_streams != 1 || !folder.has_crc {
+				count += folder.num_unpack_streams
+			}
+		}
+		self.skipDigests(count)
+		id = self.readByte()
+	}
+
+	if id != k7zEnd {
+		self.err = errInvalid7z
+	}
+}
+
+func (self *sevenZipBuffer) readStreamsInfo() *sevenZipStreamsInfo {
+	info := &sevenZipStreamsInfo{}
+
+	id := self.readByte()
+	if id == k7zPackInfo {
+		self.readPackInfo(info)
+		id = self.readByte()
+	}
+
+	if id == k7zUnpackInfo {
+		self.readUnpackInfo(info)
+		id = self.readByte()
+	}
+
+	if id == k7zSubStreamsInfo {
+		self.readSubStreamsInfo(info)
+		id = self.readByte()
+
+	} else {
+		for _, folder := range info.folders {
+			info.stream_sizes = append(info.stream_sizes, folder.unpackSize())
+		}
+	}
+
+	if id != k7zEnd {
+		self.err = errInvalid7z
+	}
+	return info
+}
+
+type sevenZipEntry struct {
+	name       string
+	is_dir     bool
+	has_stream bool
+	size       uint64
+	mtime      time.Time
+	atime      time.Time
+
+	has_attributes bool
+	attributes     uint32
+}
+
+func filetimeToTime(filetime uint64) time.Time {
+	return time.Unix(0, (int64(filetime)-116444736000000000)*100).UTC()
+}
+
+func (self *sevenZipBuffer) readTimes(count int) []time.Time {
+	result := make([]time.Time, count)
+	defined := self.readOptionalBits(count)
+	if self.readByte() != 0 {
+		self.err = errInvalid7z
+		return result
+	}
+
+	for i := range result {
+		if defined[i] {
+			result[i] = filetimeToTime(self.readUint64())
+		}
+	}
+	return result
+}
+
+// Names are null terminated UTF16 strings.
+func (self *sevenZipBuffer) readNames(count int) []string {
+	result := make([]string, 0, count)
+	if self.readByte() != 0 {
+		self.err = errInvalid7z
+		return result
+	}
+
+	var name []uint16
+	for len(result) < count && self.err == nil {
+		char := uint16(self.readByte()) | uint16(self.readByte())<<8
+		if char != 0 {
+			name = append(name, char)
+			continue
+		}
+		result = append(result, string(utf16.Decode(name)))
+		name = nil
+	}
+	return result
+}
+
+func (self *sevenZipBuffer) readFilesInfo(
+	info *sevenZipStreamsInfo) []*sevenZipEntry {
+	count := self.readCount()
+	entries := make([]*sevenZipEntry, count)
+	for i := range entries {
+		entries[i] = &sevenZipEntry{}
+	}
+
+	var empty_stream, empty_file []bool
+	num_empty := 0
+
+	for self.err == nil {
+		id := self.readByte()
+		if id == k7zEnd {
+			break
+		}
+
+		// Each property is self contained so unknown properties
+		// are skipped.
+		property := &sevenZipBuffer{data: self.readBytes(self.readNumber())}
+		switch id {
+		case k7zEmptyStream:
+			empty_stream = property.readBits(count)
+			num_empty = 0
+			for _, empty := range empty_stream {
+				if empty {
+					num_empty++
+				}
+			}
+
+		case k7zEmptyFile:
+			empty_file = property.readBits(num_empty)
+
+		case k7zName:
+			for i, name := range property.readNames(count) {
+				entries[i].name = name
+			}
+
+		case k7zMTime:
+			for i, mtime := range property.readTimes(count) {
+				entries[i].mtime = mtime
+			}
+
+		case k7zATime:
+			for i, atime := range property.readTimes(count) {
+				entries[i].atime = atime
+			}
+
+		case k7zWinAttributes:
+			defined := property.readOptionalBits(count)
+			if property.readByte() != 0 {
+				property.err = errInvalid7z
+			}
+			for i, entry := range entries {
+				if defined[i] {
+					entry.has_attributes = true
+					entry.attributes = property.readUint32()
+				}
+			}
+		}
+
+		if property.err != nil {
+			self.err = property.err
+		}
+	}
+
+	// Members with data take the streams in order.
+	stream_idx, empty_idx := 0, 0
+	for i, entry := range entries {
+		if i < len(empty_stream) && empty_stream[i] {
+			entry.is_dir = empty_idx >= len(empty_file) || !empty_file[empty_idx]
+			empty_idx++
+			continue
+		}
+
+		if stream_idx >= len(info.stream_sizes) {
+			self.err = errInvalid7z
+			break
+		}
+		entry.has_stream = true
+		entry.size = info.stream_sizes[stream_idx]
+		stream_idx++
+	}
+
+	for _, entry := range entries {
+		if entry.has_attributes &&
+			entry.attributes&FILE_ATTRIBUTE_DIRECTORY != 0 {
+			entry.is_dir = true
+		}
+	}
+
+	return entries
+}
+
+type sevenZipArchive struct {
+	reader  io.ReaderAt
+	streams *sevenZipStreamsInfo
+	entries []*sevenZipEntry
+}
+
+func (self *sevenZipArchive) readHeader(buf *sevenZipBuffer) error {
+	id := buf.readByte()
+	if id == k7zArchiveProperties {
+		for buf.err == nil && buf.readByte() != k7zEnd {
+			buf.readBytes(buf.readNumber())
+		}
+		id = buf.readByte()
+	}
+
+	if id == k7zAdditionalStreamsInfo {
+		buf.readStreamsInfo()
+		id = buf.readByte()
+	}
+
+	self.streams = &sevenZipStreamsInfo{}
+	if id == k7zMainStreamsInfo {
+		self.streams = buf.readStreamsInfo()
+		id = buf.readByte()
+	}
+
+	if id == k7zFilesInfo {
+		self.entries = buf.readFilesInfo(self.streams)
+		id = buf.readByte()
+	}
+
+	if id != k7zEnd && buf.err == nil {
+		return errInvalid7z
+	}
+	return buf.err
+}
+
+func parse7zArchive(reader io.ReaderAt) (*sevenZipArchive, error) {
+	header := make([]byte, 32)
+	_, err := reader.ReadAt(header, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(header[:6], sevenZipMagic) {
+		return nil, errors.New("7z: Not a 7z archive")
+	}
+
+	next_offset := binary.LittleEndian.Uint64(header[12:])
+	next_size := binary.LittleEndian.Uint64(header[20:])
+	next_crc := binary.LittleEndian.Uint32(header[28:])
+
+	if next_size > MAX_7Z_HEADER_SIZE || next_offset > 1<<62 {
+		return nil, errInvalid7z
+	}
+
+	data := make([]byte, next_size)
+	_, err = reader.ReadAt(data, int64(32+next_offset))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	if crc32.ChecksumIEEE(data) != next_crc {
+		return nil, errors.New("7z: Header CRC mismatch")
+	}
+
+	archive := &sevenZipArchive{reader: reader}
+
+	// The header is normally compressed and stored in a folder of
+	// its own.
+	for i := 0; i < 4; i++ {
+		buf := &sevenZipBuffer{data: data}
+		switch buf.readByte() {
+		case k7zHeader:
+			return archive, archive.readHeader(buf)
+
+		case k7zEncodedHeader:
+			streams := buf.readStreamsInfo()
+			if buf.err != nil {
+				return nil, buf.err
+			}
+
+			if len(streams.folders) == 0 ||
+				streams.folders[0].unpackSize() > MAX_7Z_HEADER_SIZE {
+				return nil, errInvalid7z
+			}
+
+			folder_reader, err := archive.folderReader(streams, 0)
+			if err != nil {
+				return nil, err
+			}
+
+			data = make([]byte, streams.folders[0].unpackSize())
+			_, err = io.ReadFull(folder_reader, data)
+			if err != nil {
+				return nil, err
+			}
+
+		default:
+			return nil, errInvalid7z
+		}
+	}
+
+	return nil, errInvalid7z
+}
+
+// The LZMA dictionary never needs to be larger than the data so we
+// do not allocate huge buffers for small members.
+func lzmaDictCap(dict_size, unpack_size uint64) int {
+	if dict_size > unpack_size {
+		dict_size = unpack_size
+	}
+	if dict_size < lzma.MinDictCap {
+		dict_size = lzma.MinDictCap
+	}
+	return int(dict_size)
+}
+
+func newSevenZipDecoder(coder *sevenZipCoder,
+	input io.Reader, unpack_size uint64) (io.Reader, error) {
+	switch string(coder.id) {
+	case "\x00":
+		return input, nil
+
+	case "\x03\x01\x01":
+		if len(coder.properties) != 5 {
+			return nil, errInvalid7z
+		}
+
+		// Make a .lzma stream header from the coder properties
+		// and the known size.
+		header := make([]byte, 13)
+		header[0] = coder.properties[0]
+		binary.LittleEndian.PutUint32(header[1:], uint32(lzmaDictCap(
+			uint64(binary.LittleEndian.Uint32(coder.properties[1:])),
+			unpack_size)))
+		binary.LittleEndian.PutUint64(header[5:], unpack_size)
+
+		return lzma.NewReader(io.MultiReader(bytes.NewReader(header), input))
+
+	case "\x21":
+		if len(coder.properties) != 1 || coder.properties[0] > 40 {
+			return nil, errInvalid7z
+		}
+
+		bits := coder.properties[0]
+		dict_size := uint64(1<<32 - 1)
+		if bits < 40 {
+			dict_size = uint64(2|bits&1) << (bits/2 + 11)
+		}
+
+		return lzma.Reader2Config{
+			DictCap: lzmaDictCap(dict_size, unpack_size),
+		}.NewReader2(input)
+
+	case "\x04\x01\x08":
+		return flate.NewReader(input), nil
+
+	case "\x04\x02\x02":
+		return bzip2.NewReader(input), nil
+
+	case "\x06\xF1\x07\x01":
+		return nil, errors.New("7z: Encrypted archives are not supported")
+	}
+
+	return nil, fmt.Errorf("7z: Unsupported compression method %x", coder.id)
+}
+
+// Returns the output of the coder, decoding its inputs first.
+func (self *sevenZipArchive) coderReader(folder *sevenZipFolder,
+	idx int, packed io.Reader, depth int) (io.Reader, error) {
+	if depth > len(folder.coders) {
+		return nil, errInvalid7z
+	}
+
+	coder := folder.coders[idx]
+	if coder.num_in != 1 || coder.num_out != 1 {
+		return nil, fmt.Errorf(
+			"7z: Unsupported compression method %x", coder.id)
+	}
+
+	// Coders with a single input and output use the same index
+	// for both.
+	input := packed
+	if folder.packed_streams[0] != uint64(idx) {
+		input = nil
+		for _, pair := range folder.bind_pairs {
+			if pair.in_index == uint64(idx) &&
+				pair.out_index < uint64(len(folder.coders)) {
+				var err error
+				input, err = self.coderReader(folder,
+					int(pair.out_index), packed, depth+1)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+		if input == nil {
+			return nil, errInvalid7z
+		}
+	}
+
+	unpack_size := folder.unpack_sizes[idx]
+	reader, err := newSevenZipDecoder(coder, input, unpack_size)
+	if err != nil {
+		return nil, err
+	}
+	return io.LimitReader(reader, int64(unpack_size)), nil
+}
+
+func (self *sevenZipArchive) folderReader(
+	streams *sevenZipStreamsInfo, idx int) (io.Reader, error) {
+	folder := streams.folders[idx]
+	if len(folder.packed_streams) != 1 {
+		return nil, errors.New("7z: Unsupported compression method")
+	}
+
+	if folder.first_pack_stream >= len(streams.pack_sizes) {
+		return nil, errInvalid7z
+	}
+
+	offset := 32 + streams.pack_pos
+	for _, size := range streams.pack_sizes[:folder.first_pack_stream] {
+		offset += size
+	}
+
+	packed := bufio.NewReader(io.NewSectionReader(self.reader,
+		int64(offset), int64(streams.pack_sizes[folder.first_pack_stream])))
+
+	main_output, err := folder.mainOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return self.coderReader(folder, main_output, packed, 0)
+}
+
+// Reads all the folders in turn, failing if any is truncated so the
+// members line up with their offsets.
+type sevenZipReader struct {
+	archive *sevenZipArchive
+
+	idx       int
+	current   io.Reader
+	remaining uint64
+}
+
+func (self *sevenZipReader) Read(buf []byte) (int, error) {
+	for {
+		if self.current == nil {
+			if self.idx >= len(self.archive.streams.folders) {
+				return 0, io.EOF
+			}
+
+			reader, err := self.archive.folderReader(
+				self.archive.streams, self.idx)
+			if err != nil {
+				return 0, err
+			}
+
+			self.current = reader
+			self.remaining = self.archive.streams.folders[self.idx].unpackSize()
+			self.idx++
+		}
+
+		n, err := self.current.Read(buf)
+		self.remaining -= uint64(n)
+		if errors.Is(err, io.EOF) {
+			if self.remaining > 0 {
+				return n, io.ErrUnexpectedEOF
+			}
+			self.current = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+func (self *sevenZipReader) Close() error {
+	return nil
+}
+
+func (self *sevenZipEntry) tarHeader() *tar.Header {
+	header := &tar.Header{
+		Name:       self.name,
+		Size:       int64(self.size),
+		ModTime:    self.mtime,
+		AccessTime: self.atime,
+		Typeflag:   tar.TypeReg,
+		Mode:       0644,
+	}
+
+	if self.is_dir {
+		header.Typeflag = tar.TypeDir
+		header.Mode = 0755
+	}
+
+	if self.has_attributes {
+		if self.attributes&FILE_ATTRIBUTE_READONLY != 0 {
+			header.Mode &^= 0222
+		}
+
+		if self.attributes&FILE_ATTRIBUTE_UNIX_EXTENSION != 0 {
+			header.Mode = int64(self.attributes >> 16)
+			if header.Mode&0170000 == 0120000 {
+				header.Typeflag = tar.TypeSymlink
+			}
+		}
+	}
+
+	return header
+}
+
+// Unpack the 7z archive to a tmp file and index its members.
+func (self *TarFileCache) load7z(full_path *accessors.OSPath) error {
+	archive, err := parse7zArchive(self.reader)
+	if err != nil {
+		return err
+	}
+
+	self.tmp_file, err = unpackToTmpFile(&sevenZipReader{archive: archive})
+	if err != nil {
+		return err
+	}
+	self.reader = self.tmp_file
+
+	var offset int64
+	for _, entry := range archive.entries {
+		header := entry.tarHeader()
+		member := &tarMember{
+			header: header,
+			offset: offset,
+			data:   ordereddict.NewDict(),
+		}
+		if entry.has_attributes {
+			member.data.Set("Attributes", entry.attributes)
+		}
+		offset += header.Size
+
+		if header.Typeflag == tar.TypeSymlink &&
+			header.Size < MAX_7Z_LINK_SIZE {
+			target := make([]byte, header.Size)
+			_, err := self.reader.ReadAt(target, member.offset)
+			if err != nil {
+				return err
+			}
+			header.Linkname = string(target)
+			header.Size = 0
+			member.data.Set("Link", header.Linkname)
+		}
+
+		member_path, err := full_path.Parse(entry.name)
+		if err != nil {
+			continue
+		}
+
+		components := normalizeComponents(member_path)
+		if len(components) == 0 {
+			continue
+		}
+
+		member.full_path = full_path.Copy()
+		member.full_path.Components = components
+		self.lookup = append(self.lookup, member)
+	}
+
+	return nil
+}
+
+func init() {
+	accessors.Register("7z", &TarFileSystemAccessor{
+		tag:    SevenZipFileSystemAccessorTag,
+		loader: (*TarFileCache).load7z,
+	}, `Open a 7z archive as if it was a directory.
+
+Filename is a pathspec with a delegate accessor opening the 7z file,
+and the Path representing the file within the archive. The archive is
+unpacked to a tmp file when it is first opened. Archives using the
+Copy, LZMA, LZMA2, Deflate or BZip2 methods are supported, encrypted
+archives are not.
+
+Example:
+
+       select OSPath, Mtime, Size from glob(
+         globs='/**/*.log',
+         root=pathspec(DelegateAccessor='file',
+              DelegatePath="triage.7z"),
+         accessor='7z')
+`)
+}
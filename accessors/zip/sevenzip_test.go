@@ -0,0 +1,90 @@
+package zip
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Velocidex/ordereddict"
+	"github.com/alecthomas/assert"
+	"github.com/sebdah/goldie"
+	"github.com/stretchr/testify/suite"
+	"www.velocidex.com/golang/velociraptor/accessors"
+	"www.velocidex.com/golang/velociraptor/file_store/test_utils"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/vtesting"
+)
+
+type SevenZipTestSuite struct {
+	test_utils.TestSuite
+}
+
+func (self *SevenZipTestSuite) glob7z(filename string) (
+	[]*ordereddict.Dict, error) {
+	archive, _ := filepath.Abs("../../artifacts/testdata/files/" + filename)
+	return test_utils.RunQuery(self.ConfigObj, `
+SELECT OSPath.Path AS Path, IsDir, IsLink, Size, Mode.String AS Mode,
+    Mtime, Data,
+    if(condition=NOT IsDir AND NOT IsLink,
+       then=read_file(filename=OSPath, accessor='7z')) AS Content
+FROM glob(globs="**", root=Root, accessor='7z')
+ORDER BY Path`, ordereddict.NewDict().
+		Set("Root", accessors.PathSpec{
+			DelegateAccessor: "file",
+			DelegatePath:     archive,
+		}))
+}
+
+// The same archive compressed with different methods gives the same
+// result.
+func (self *SevenZipTestSuite) TestCompressionMethods() {
+	snapshot := vtesting.GetMetrics(self.T(), "accessor_tar_")
+
+	golden, err := self.glob7z("test.7z")
+	assert.NoError(self.T(), err)
+
+	for _, filename := range []string{
+		"test_lzma.7z", "test_deflate.7z", "test_bzip2.7z", "test_copy.7z"} {
+		rows, err := self.glob7z(filename)
+		assert.NoError(self.T(), err)
+		assert.Equal(self.T(), json.MustMarshalString(golden),
+			json.MustMarshalString(rows), filename)
+	}
+
+	state := vtesting.GetMetricsDifference(self.T(), "accessor_tar_", snapshot)
+
+	// All the files must be closed and the tmp files removed.
+	value, _ := state.GetInt64("accessor_tar_current_open")
+	assert.Equal(self.T(), int64(0), value)
+
+	value, _ = state.GetInt64("accessor_tar_current_references")
+	assert.Equal(self.T(), int64(0), value)
+
+	value, _ = state.GetInt64("accessor_tar_current_tmp_conversions")
+	assert.Equal(self.T(), int64(0), value)
+
+	value, _ = state.GetInt64("accessor_tar_total_tmp_conversions")
+	assert.Equal(self.T(), int64(5), value)
+
+	goldie.Assert(self.T(), "TestSevenZip", json.MustMarshalIndent(golden))
+}
+
+// Unsupported methods are reported rather than giving an empty
+// archive.
+func (self *SevenZipTestSuite) TestUnsupportedMethod() {
+	fd, err := os.Open("../../artifacts/testdata/files/test_ppmd.7z")
+	assert.NoError(self.T(), err)
+	defer fd.Close()
+
+	archive, err := parse7zArchive(fd)
+	assert.NoError(self.T(), err)
+
+	_, err = ioutil.ReadAll(&sevenZipReader{archive: archive})
+	assert.Error(self.T(), err)
+	assert.Contains(self.T(), err.Error(), "Unsupported compression method 030401")
+}
+
+func Test7zAccessor(t *testing.T) {
+	suite.Run(t, &SevenZipTestSuite{})
+}
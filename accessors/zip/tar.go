@@ -0,0 +1,816 @@
+// A Tar accessor.
+
+// This accessor provides access to tar archives, optionally
+// compressed with gzip, bzip2, xz or zstd. Like the zip accessor, the
+// filename is a pathspec with a delegate accessor opening the
+// archive, and the Path representing the member within it. The 7z
+// accessor shares this implementation with a different loader (see
+// sevenzip.go).
+
+// Tar files have no central directory so the archive is scanned once
+// to build an index of members. Uncompressed archives are read in
+// place, while compressed archives are unpacked to a tmp file first
+// since compressed streams can not be seeked. Like zip files, the
+// parsed archives are cached in the root scope and reference counted.
+
+package zip
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Velocidex/ordereddict"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"www.velocidex.com/golang/velociraptor/accessors"
+	"www.velocidex.com/golang/velociraptor/constants"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/utils"
+	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
+	"www.velocidex.com/golang/vfilter"
+)
+
+const (
+	TarFileSystemAccessorTag = "_TarFS"
+)
+
+var (
+	tarAccessorCurrentOpened = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "accessor_tar_current_open",
+		Help: "Number of currently opened TAR and 7z files",
+	})
+
+	tarAccessorTotalOpened = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "accessor_tar_total_open",
+		Help: "Total Number of opened TAR and 7z files",
+	})
+
+	tarAccessorCurrentReferences = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "accessor_tar_current_references",
+		Help: "Number of currently referenced TAR and 7z files",
+	})
+
+	tarAccessorTotalTmpConversions = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "accessor_tar_total_tmp_conversions",
+		Help: "Total Number of compressed TAR and 7z files that we unpacked to tmp files",
+	})
+
+	tarAccessorCurrentTmpConversions = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "accessor_tar_current_tmp_conversions",
+		Help: "Number of currently opened TAR and 7z files that exist in tmp files.",
+	})
+
+	tar_mu sync.Mutex
+)
+
+type TarFileInfo struct {
+	// nil for directories without their own header.
+	header     *tar.Header
+	_full_path *accessors.OSPath
+
+	// Set by archive formats which do not have tar's ownership
+	// information.
+	data *ordereddict.Dict
+}
+
+func (self *TarFileInfo) IsDir() bool {
+	return self.header == nil || self.header.Typeflag == tar.TypeDir
+}
+
+func (self *TarFileInfo) Size() int64 {
+	if self.header == nil {
+		return 0
+	}
+	return self.header.Size
+}
+
+func (self *TarFileInfo) Data() *ordereddict.Dict {
+	if self.data != nil {
+		return self.data
+	}
+
+	result := ordereddict.NewDict()
+	if self.header != nil {
+		result.Set("Uid", self.header.Uid).
+			Set("Gid", self.header.Gid).
+			Set("Uname", self.header.Uname).
+			Set("Gname", self.header.Gname)
+
+		if self.header.Linkname != "" {
+			result.Set("Link", self.header.Linkname)
+		}
+	}
+	return result
+}
+
+func (self *TarFileInfo) Name() string {
+	return self._full_path.Basename()
+}
+
+func (self *TarFileInfo) Mode() os.FileMode {
+	if self.header == nil {
+		return 0755 | os.ModeDir
+	}
+	return self.header.FileInfo().Mode()
+}
+
+func (self *TarFileInfo) ModTime() time.Time {
+	return self.Mtime()
+}
+
+func (self *TarFileInfo) FullPath() string {
+	return self._full_path.String()
+}
+
+func (self *TarFileInfo) OSPath() *accessors.OSPath {
+	return self._full_path.Copy()
+}
+
+func (self *TarFileInfo) Mtime() time.Time {
+	if self.header == nil {
+		return time.Time{}
+	}
+	return self.header.ModTime
+}
+
+func (self *TarFileInfo) Ctime() time.Time {
+	if self.header == nil || self.header.ChangeTime.IsZero() {
+		return self.Mtime()
+	}
+	return self.header.ChangeTime
+}
+
+func (self *TarFileInfo) Btime() time.Time {
+	return self.Mtime()
+}
+
+func (self *TarFileInfo) Atime() time.Time {
+	if self.header == nil || self.header.AccessTime.IsZero() {
+		return self.Mtime()
+	}
+	return self.header.AccessTime
+}
+
+func (self *TarFileInfo) IsLink() bool {
+	return self.header != nil && self.header.Typeflag == tar.TypeSymlink
+}
+
+// Symlinks are resolved relative to the directory containing the
+// link.
+func (self *TarFileInfo) GetLink() (*accessors.OSPath, error) {
+	if !self.IsLink() {
+		return nil, errors.New("Not a link")
+	}
+
+	target, err := self._full_path.Parse(self.header.Linkname)
+	if err != nil {
+		return nil, err
+	}
+
+	result := self._full_path.Dirname()
+	if strings.HasPrefix(self.header.Linkname, "/") {
+		result.Components = nil
+	}
+	result.Components = append(result.Components, target.Components...)
+	return result, nil
+}
+
+type tarMember struct {
+	full_path *accessors.OSPath
+	header    *tar.Header
+	data      *ordereddict.Dict
+
+	// Offset of the member's data within the (uncompressed) archive.
+	offset int64
+}
+
+// A Reference counter around a parsed tar file. Each member opened
+// holds a reference which is released when it is closed. When the
+// references are exhausted the underlying file is closed and any tmp
+// file removed.
+type TarFileCache struct {
+	mu sync.Mutex
+
+	// The uncompressed archive data.
+	reader io.ReaderAt
+
+	// Underlying file - will be closed when the references are zero.
+	fd accessors.ReadSeekCloser
+
+	// Compressed archives are unpacked into this file.
+	tmp_file *os.File
+
+	is_closed bool
+	refs      int
+
+	lookup []*tarMember
+}
+
+func (self *TarFileCache) getMember(full_path *accessors.OSPath) *tarMember {
+	// Later members replace earlier ones with the same name.
+	for i := len(self.lookup) - 1; i >= 0; i-- {
+		member := self.lookup[i]
+		if utils.StringSliceEq(member.full_path.Components,
+			full_path.Components) {
+			return member
+		}
+	}
+	return nil
+}
+
+func (self *TarFileCache) GetTarInfo(full_path *accessors.OSPath) (
+	*TarFileInfo, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	member := self.getMember(full_path)
+	if member != nil {
+		return &TarFileInfo{
+			header:     member.header,
+			_full_path: full_path.Copy(),
+			data:       member.data,
+		}, nil
+	}
+
+	// Directories may be implied by the members below them.
+	if len(full_path.Components) == 0 || self.hasChildren(full_path) {
+		return &TarFileInfo{_full_path: full_path.Copy()}, nil
+	}
+
+	return nil, fmt.Errorf("Tar: Not found: %v: %w",
+		full_path.String(), os.ErrNotExist)
+}
+
+func (self *TarFileCache) hasChildren(full_path *accessors.OSPath) bool {
+	depth := len(full_path.Components)
+	for _, member := range self.lookup {
+		if len(member.full_path.Components) > depth &&
+			utils.StringSliceEq(member.full_path.Components[:depth],
+				full_path.Components) {
+			return true
+		}
+	}
+	return false
+}
+
+func (self *TarFileCache) GetChildren(
+	full_path *accessors.OSPath) []*TarFileInfo {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	depth := len(full_path.Components)
+	seen := make(map[string]*TarFileInfo)
+	names := []string{}
+
+	for _, member := range self.lookup {
+		components := member.full_path.Components
+		if len(components) <= depth ||
+			!utils.StringSliceEq(components[:depth], full_path.Components) {
+			continue
+		}
+
+		name := components[depth]
+		old_result, pres := seen[name]
+		if !pres {
+			names = append(names, name)
+		}
+
+		// It is the member itself if the components are an exact
+		// match - otherwise it is an implied directory.
+		if len(components) == depth+1 {
+			seen[name] = &TarFileInfo{
+				header:     member.header,
+				_full_path: member.full_path.Copy(),
+				data:       member.data,
+			}
+
+		} else if !pres || !old_result.IsDir() {
+			seen[name] = &TarFileInfo{
+				_full_path: full_path.Append(name),
+			}
+		}
+	}
+
+	result := make([]*TarFileInfo, 0, len(names))
+	for _, name := range names {
+		result = append(result, seen[name])
+	}
+	return result
+}
+
+// Open the member, leaking a reference to the cache which is released
+// when the member is closed.
+func (self *TarFileCache) Open(full_path *accessors.OSPath) (
+	accessors.ReadSeekCloser, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	member := self.getMember(full_path)
+	if member == nil {
+		return nil, fmt.Errorf("Tar: Not found: %v: %w",
+			full_path.String(), os.ErrNotExist)
+	}
+
+	switch member.header.Typeflag {
+	case tar.TypeReg, tar.TypeRegA, tar.TypeLink:
+	default:
+		return nil, fmt.Errorf("Tar: %v is not a regular file",
+			full_path.String())
+	}
+
+	self.refs++
+	tarAccessorCurrentReferences.Inc()
+
+	return &TarMemberReader{
+		SectionReader: io.NewSectionReader(
+			self.reader, member.offset, member.header.Size),
+		tar_file: self,
+	}, nil
+}
+
+func (self *TarFileCache) IncRef() {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	self.refs++
+	tarAccessorCurrentReferences.Inc()
+}
+
+func (self *TarFileCache) IsClosed() bool {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	return self.is_closed
+}
+
+func (self *TarFileCache) Close() {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	self.refs--
+	tarAccessorCurrentReferences.Dec()
+	if self.refs == 0 {
+		self.fd.Close()
+		if self.tmp_file != nil {
+			self.tmp_file.Close()
+			os.Remove(self.tmp_file.Name())
+			tarAccessorCurrentTmpConversions.Dec()
+		}
+		self.is_closed = true
+		tarAccessorCurrentOpened.Dec()
+	}
+}
+
+// Tar members are stored contiguously in the uncompressed archive so
+// they are directly seekable.
+type TarMemberReader struct {
+	*io.SectionReader
+
+	mu       sync.Mutex
+	closed   bool
+	tar_file *TarFileCache
+}
+
+func (self *TarMemberReader) Close() error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	if !self.closed {
+		self.closed = true
+		self.tar_file.Close()
+	}
+	return nil
+}
+
+// Counts the bytes read so we can tell where each member's data
+// starts.
+type countingReader struct {
+	reader io.Reader
+	offset int64
+}
+
+func (self *countingReader) Read(buf []byte) (int, error) {
+	n, err := self.reader.Read(buf)
+	self.offset += int64(n)
+	return n, err
+}
+
+// Unpack the compressed archive into a tmp file.
+func unpackToTmpFile(reader io.Reader) (*os.File, error) {
+	tmp_file, err := ioutil.TempFile("", "tar*.tmp")
+	if err != nil {
+		return nil, err
+	}
+
+	tarAccessorCurrentTmpConversions.Inc()
+	tarAccessorTotalTmpConversions.Inc()
+
+	_, err = io.Copy(tmp_file, reader)
+	if err != nil {
+		tmp_file.Close()
+		os.Remove(tmp_file.Name())
+		tarAccessorCurrentTmpConversions.Dec()
+		return nil, err
+	}
+
+	return tmp_file, nil
+}
+
+// Member names are often prefixed with ./ or /
+func normalizeComponents(path *accessors.OSPath) []string {
+	result := []string{}
+	for _, c := range path.Components {
+		if c != "" && c != "." {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+func (self *TarFileCache) resolveHardLink(member *tarMember) {
+	link_path, err := member.full_path.Parse(member.header.Linkname)
+	if err != nil {
+		return
+	}
+	link_path.Components = normalizeComponents(link_path)
+
+	target := self.getMember(link_path)
+	if target == nil {
+		return
+	}
+
+	header := *member.header
+	header.Size = target.header.Size
+	member.header = &header
+	member.offset = target.offset
+}
+
+// Compressed tar archives are unpacked to a tmp file before they are
+// indexed.
+func (self *TarFileCache) loadTar(full_path *accessors.OSPath) error {
+	decompressor, err := newDecompressor(self.reader)
+	if err != nil {
+		return err
+	}
+
+	if decompressor != nil {
+		self.tmp_file, err = unpackToTmpFile(decompressor)
+		decompressor.Close()
+		if err != nil {
+			return err
+		}
+		self.reader = self.tmp_file
+	}
+
+	return self.buildIndex(full_path)
+}
+
+// Scan the archive for its members.
+func (self *TarFileCache) buildIndex(full_path *accessors.OSPath) error {
+	counter := &countingReader{
+		reader: io.NewSectionReader(self.reader, 0, 1<<62),
+	}
+	tar_reader := tar.NewReader(counter)
+
+	for {
+		header, err := tar_reader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			// Truncated archives still expose the members we
+			// already found.
+			if len(self.lookup) > 0 {
+				return nil
+			}
+			return err
+		}
+
+		member_path, err := full_path.Parse(header.Name)
+		if err != nil {
+			continue
+		}
+
+		components := normalizeComponents(member_path)
+		if len(components) == 0 {
+			continue
+		}
+
+		next_path := full_path.Copy()
+		next_path.Components = components
+
+		member := &tarMember{
+			full_path: next_path,
+			header:    header,
+			offset:    counter.offset,
+		}
+
+		// Hard links refer to the data of an earlier member.
+		if header.Typeflag == tar.TypeLink {
+			self.resolveHardLink(member)
+		}
+
+		self.lookup = append(self.lookup, member)
+	}
+}
+
+// Populates the cache's index from the archive.
+type archiveLoader func(
+	self *TarFileCache, full_path *accessors.OSPath) error
+
+// The TarFileSystemAccessor is cached in the root scope and keeps
+// the parsed archives around for quick access.
+type TarFileSystemAccessor struct {
+	fd_cache map[string]*TarFileCache
+	scope    vfilter.Scope
+
+	// The scope cache tag and loader differ between the tar and 7z
+	// accessors.
+	tag    string
+	loader archiveLoader
+}
+
+func (self *TarFileSystemAccessor) Copy(
+	scope vfilter.Scope) *TarFileSystemAccessor {
+	tar_mu.Lock()
+	defer tar_mu.Unlock()
+
+	return &TarFileSystemAccessor{
+		fd_cache: self.fd_cache,
+		scope:    scope,
+		tag:      self.tag,
+		loader:   self.loader,
+	}
+}
+
+// Try to remove any file caches with no references.
+func (self *TarFileSystemAccessor) Trim() {
+	tar_mu.Lock()
+	defer tar_mu.Unlock()
+
+	cache_size := vql_subsystem.GetIntFromRow(
+		self.scope, self.scope, constants.ZIP_FILE_CACHE_SIZE)
+	if cache_size == 0 {
+		cache_size = 5
+	}
+
+	for key, fd := range self.fd_cache {
+		if fd == nil {
+			continue
+		}
+
+		if uint64(len(self.fd_cache)) > cache_size {
+			fd.mu.Lock()
+			refs := fd.refs
+			fd.mu.Unlock()
+
+			if refs == 1 {
+				fd.Close()
+			}
+		}
+
+		if fd.IsClosed() {
+			delete(self.fd_cache, key)
+		}
+	}
+}
+
+// Close all the items - called when root scope destroys
+func (self *TarFileSystemAccessor) CloseAll() {
+	tar_mu.Lock()
+	defer tar_mu.Unlock()
+
+	for key, fd := range self.fd_cache {
+		if fd != nil {
+			fd.Close()
+		}
+		delete(self.fd_cache, key)
+	}
+}
+
+func (self *TarFileSystemAccessor) getCachedTarFile(cache_key string) (
+	*TarFileCache, error) {
+	tar_file_cache, pres := self.fd_cache[cache_key]
+	if pres &&
+		tar_file_cache != nil &&
+		!tar_file_cache.IsClosed() {
+		tar_file_cache.IncRef()
+		return tar_file_cache, nil
+	}
+
+	// Store a nil in the map as a place holder, while we build
+	// something.
+	if !pres {
+		self.fd_cache[cache_key] = nil
+		return nil, nil
+	}
+
+	return nil, os.ErrNotExist
+}
+
+// Returns a TarFileCache for the archive. Be sure to close it when
+// done. When the query completes, the tar file will be closed.
+func (self *TarFileSystemAccessor) getTarFile(
+	full_path *accessors.OSPath) (result *TarFileCache, err error) {
+	pathspec := full_path.PathSpec()
+
+	base_pathspec := accessors.PathSpec{
+		DelegateAccessor: pathspec.DelegateAccessor,
+		DelegatePath:     pathspec.GetDelegatePath(),
+	}
+	cache_key := base_pathspec.String()
+
+	for {
+		tar_mu.Lock()
+		tar_file_cache, err := self.getCachedTarFile(cache_key)
+		tar_mu.Unlock()
+		if err == nil {
+			if tar_file_cache == nil {
+				break
+			}
+			return tar_file_cache, nil
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	defer func() {
+		if err != nil {
+			tar_mu.Lock()
+			defer tar_mu.Unlock()
+			delete(self.fd_cache, cache_key)
+		}
+	}()
+
+	accessor, err := accessors.GetAccessor(
+		pathspec.DelegateAccessor, self.scope)
+	if err != nil {
+		self.scope.Log("%v: did you provide a URL or PathSpec?", err)
+		return nil, err
+	}
+
+	filename := pathspec.GetDelegatePath()
+	fd, err := accessor.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	tar_file_cache := &TarFileCache{
+		fd:     fd,
+		reader: utils.MakeReaderAtter(fd),
+
+		// One reference to the scope.
+		refs: 1,
+	}
+
+	err = self.loader(tar_file_cache, full_path)
+	if err != nil {
+		fd.Close()
+		if tar_file_cache.tmp_file != nil {
+			tar_file_cache.tmp_file.Close()
+			os.Remove(tar_file_cache.tmp_file.Name())
+			tarAccessorCurrentTmpConversions.Dec()
+		}
+		return nil, err
+	}
+
+	tarAccessorCurrentOpened.Inc()
+	tarAccessorCurrentReferences.Inc()
+	tarAccessorTotalOpened.Inc()
+
+	// Leaking the tar file from this function, increase its
+	// reference - callers have to close it.
+	tar_file_cache.IncRef()
+
+	tar_mu.Lock()
+	self.fd_cache[cache_key] = tar_file_cache
+	tar_mu.Unlock()
+
+	return tar_file_cache, nil
+}
+
+func (self *TarFileSystemAccessor) Lstat(file_path string) (
+	accessors.FileInfo, error) {
+	full_path, err := self.ParsePath(file_path)
+	if err != nil {
+		return nil, err
+	}
+
+	return self.LstatWithOSPath(full_path)
+}
+
+func (self *TarFileSystemAccessor) LstatWithOSPath(
+	full_path *accessors.OSPath) (accessors.FileInfo, error) {
+	root, err := self.getTarFile(full_path)
+	if err != nil {
+		return nil, err
+	}
+	defer root.Close()
+
+	return root.GetTarInfo(full_path)
+}
+
+func (self *TarFileSystemAccessor) Open(
+	filename string) (accessors.ReadSeekCloser, error) {
+	full_path, err := self.ParsePath(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return self.OpenWithOSPath(full_path)
+}
+
+func (self *TarFileSystemAccessor) OpenWithOSPath(
+	full_path *accessors.OSPath) (accessors.ReadSeekCloser, error) {
+	root, err := self.getTarFile(full_path)
+	if err != nil {
+		return nil, err
+	}
+	defer root.Close()
+
+	return root.Open(full_path)
+}
+
+func (self *TarFileSystemAccessor) ReadDir(
+	file_path string) ([]accessors.FileInfo, error) {
+	full_path, err := self.ParsePath(file_path)
+	if err != nil {
+		return nil, err
+	}
+
+	return self.ReadDirWithOSPath(full_path)
+}
+
+func (self *TarFileSystemAccessor) ReadDirWithOSPath(
+	full_path *accessors.OSPath) ([]accessors.FileInfo, error) {
+	root, err := self.getTarFile(full_path)
+	if err != nil {
+		return nil, err
+	}
+	defer root.Close()
+
+	result := []accessors.FileInfo{}
+	for _, item := range root.GetChildren(full_path) {
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+// Tar files use / path separators.
+func (self TarFileSystemAccessor) ParsePath(path string) (
+	*accessors.OSPath, error) {
+	return accessors.NewGenericOSPath(path)
+}
+
+func (self *TarFileSystemAccessor) New(scope vfilter.Scope) (
+	accessors.FileSystemAccessor, error) {
+	result_any := vql_subsystem.CacheGet(scope, self.tag)
+	if result_any == nil {
+		result := &TarFileSystemAccessor{
+			fd_cache: make(map[string]*TarFileCache),
+			scope:    scope,
+			tag:      self.tag,
+			loader:   self.loader,
+		}
+		vql_subsystem.CacheSet(scope, self.tag, result)
+
+		vql_subsystem.GetRootScope(scope).AddDestructor(func() {
+			result.CloseAll()
+		})
+		return result, nil
+	}
+
+	res := result_any.(*TarFileSystemAccessor)
+	res.Trim()
+
+	return res.Copy(scope), nil
+}
+
+func init() {
+	accessors.Register("tar", &TarFileSystemAccessor{
+		tag:    TarFileSystemAccessorTag,
+		loader: (*TarFileCache).loadTar,
+	},
+		`Open a tar file as if it was a directory.
+
+Filename is a pathspec with a delegate accessor opening the tar file,
+and the Path representing the file within the tar file. Archives
+compressed with gzip, bzip2, xz or zstd (e.g. .tar.gz, .tgz, .tar.xz)
+are detected and decompressed automatically.
+
+Example:
+
+       select OSPath, Mtime, Size from glob(
+         globs='/**/*.log',
+         root=pathspec(DelegateAccessor='file',
+              DelegatePath="triage.tar.gz"),
+         accessor='tar')
+`)
+
+	json.RegisterCustomEncoder(&TarFileInfo{}, accessors.MarshalGlobFileInfo)
+}
@@ -0,0 +1,75 @@
+package zip
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/Velocidex/ordereddict"
+	"github.com/alecthomas/assert"
+	"github.com/sebdah/goldie"
+	"github.com/stretchr/testify/suite"
+	"www.velocidex.com/golang/velociraptor/accessors"
+	"www.velocidex.com/golang/velociraptor/file_store/test_utils"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/vtesting"
+)
+
+type TarTestSuite struct {
+	test_utils.TestSuite
+}
+
+func (self *TarTestSuite) globTar(filename string) []*ordereddict.Dict {
+	tar_file, _ := filepath.Abs("../../artifacts/testdata/files/" + filename)
+	rows, err := test_utils.RunQuery(self.ConfigObj, `
+SELECT OSPath.Path AS Path, IsDir, IsLink, Size, Mode.String AS Mode,
+    Mtime, Data,
+    if(condition=NOT IsDir AND NOT IsLink,
+       then=read_file(filename=OSPath, accessor='tar')) AS Content
+FROM glob(globs="**", root=Root, accessor='tar')
+ORDER BY Path`, ordereddict.NewDict().
+		Set("Root", accessors.PathSpec{
+			DelegateAccessor: "file",
+			DelegatePath:     tar_file,
+		}))
+	assert.NoError(self.T(), err)
+	return rows
+}
+
+// The same archive compressed in different ways gives the same
+// result.
+func (self *TarTestSuite) TestCompressedTar() {
+	snapshot := vtesting.GetMetrics(self.T(), "accessor_tar_")
+
+	golden := self.globTar("test.tar")
+	for _, filename := range []string{
+		"test.tar.gz", "test.tar.xz", "test.tar.zst"} {
+		assert.Equal(self.T(), json.MustMarshalString(golden),
+			json.MustMarshalString(self.globTar(filename)), filename)
+	}
+
+	state := vtesting.GetMetricsDifference(self.T(), "accessor_tar_", snapshot)
+
+	// All the files must be closed now.
+	value, _ := state.GetInt64("accessor_tar_current_open")
+	assert.Equal(self.T(), int64(0), value)
+
+	value, _ = state.GetInt64("accessor_tar_current_references")
+	assert.Equal(self.T(), int64(0), value)
+
+	value, _ = state.GetInt64("accessor_tar_current_tmp_conversions")
+	assert.Equal(self.T(), int64(0), value)
+
+	// Each archive was opened exactly once and the compressed ones
+	// were unpacked to tmp files.
+	value, _ = state.GetInt64("accessor_tar_total_open")
+	assert.Equal(self.T(), int64(4), value)
+
+	value, _ = state.GetInt64("accessor_tar_total_tmp_conversions")
+	assert.Equal(self.T(), int64(3), value)
+
+	goldie.Assert(self.T(), "TestCompressedTar", json.MustMarshalIndent(golden))
+}
+
+func TestTarAccessor(t *testing.T) {
+	suite.Run(t, &TarTestSuite{})
+}
@@ -0,0 +1,195 @@
+package acls
+
+// Scoped grants give a principal a permission only on some
+// resources. For example a helpdesk user may be allowed to collect
+// Windows.Triage.* artifacts only from clients labelled Helpdesk, or
+// a contractor may only read the results of the hunts they created.
+
+// Scoped grants are only consulted when the principal does not hold
+// the permission outright, and only by checks which describe the
+// resource being accessed.
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	acl_proto "www.velocidex.com/golang/velociraptor/acls/proto"
+)
+
+var (
+	SCOPED_PERMISSIONS = []ACL_PERMISSION{
+		COLLECT_CLIENT, COLLECT_SERVER, READ_RESULTS,
+	}
+)
+
+// Describes the resource a permission is exercised on. Fields
+// which are not known are left empty and never match a grant
+// restricted on them.
+type Resource struct {
+	// The artifacts collected or read.
+	Artifacts []string
+
+	// The client the collection targets. The ACL manager fills in
+	// ClientLabels when needed.
+	ClientId     string
+	ClientLabels []string
+
+	// A hunt runs on clients carrying any of these labels.
+	HuntLabels []string
+
+	// Who created the hunt or flow.
+	Creator string
+}
+
+func (self *Resource) String() string {
+	if self == nil {
+		return "any resource"
+	}
+
+	var parts []string
+	if len(self.Artifacts) > 0 {
+		parts = append(parts, "artifacts "+strings.Join(self.Artifacts, ", "))
+	}
+	if self.ClientId != "" {
+		parts = append(parts, "client "+self.ClientId)
+	}
+	if len(self.HuntLabels) > 0 {
+		parts = append(parts, "labels "+strings.Join(self.HuntLabels, ", "))
+	}
+	if self.Creator != "" {
+		parts = append(parts, "created by "+self.Creator)
+	}
+	return strings.Join(parts, " ")
+}
+
+func ValidateScopedGrant(grant *acl_proto.ScopedGrant) error {
+	if len(grant.Permissions) == 0 {
+		return fmt.Errorf("Scoped grant has no permissions")
+	}
+
+	for _, name := range grant.Permissions {
+		permission := GetPermission(name)
+		if !isScopedPermission(permission) {
+			return fmt.Errorf("Permission %v can not be scoped", name)
+		}
+	}
+
+	for _, pattern := range grant.Artifacts {
+		_, err := path.Match(pattern, "")
+		if err != nil {
+			return fmt.Errorf("Invalid artifact pattern %v: %w", pattern, err)
+		}
+	}
+
+	if len(grant.Artifacts) == 0 && len(grant.Labels) == 0 && !grant.OwnOnly {
+		return fmt.Errorf("Scoped grant has no restrictions: grant the permission directly")
+	}
+
+	return nil
+}
+
+func isScopedPermission(permission ACL_PERMISSION) bool {
+	for _, p := range SCOPED_PERMISSIONS {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Does the grant give the principal the permission on the resource?
+func ScopedGrantMatches(grant *acl_proto.ScopedGrant,
+	permission ACL_PERMISSION, principal string, resource *Resource) bool {
+	if resource == nil || !isScopedPermission(permission) {
+		return false
+	}
+
+	granted := false
+	for _, name := range grant.Permissions {
+		if GetPermission(name) == permission {
+			granted = true
+			break
+		}
+	}
+	if !granted {
+		return false
+	}
+
+	// Every artifact must match one of the patterns.
+	if len(grant.Artifacts) > 0 {
+		if len(resource.Artifacts) == 0 {
+			return false
+		}
+
+		for _, artifact := range resource.Artifacts {
+			if !artifactMatches(grant.Artifacts, artifact) {
+				return false
+			}
+		}
+	}
+
+	if len(grant.Labels) > 0 && !labelsMatch(grant.Labels, resource) {
+		return false
+	}
+
+	if grant.OwnOnly &&
+		(resource.Creator == "" || resource.Creator != principal) {
+		return false
+	}
+
+	return true
+}
+
+func artifactMatches(patterns []string, artifact string) bool {
+	for _, pattern := range patterns {
+		matched, err := path.Match(pattern, artifact)
+		if err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+func labelsMatch(allowed []string, resource *Resource) bool {
+	// A hunt only stays within the allowed clients if all its
+	// labels are allowed.
+	if len(resource.HuntLabels) > 0 {
+		for _, label := range resource.HuntLabels {
+			if !inLabels(allowed, label) {
+				return false
+			}
+		}
+		return true
+	}
+
+	// A client needs to carry any of the allowed labels.
+	for _, label := range resource.ClientLabels {
+		if inLabels(allowed, label) {
+			return true
+		}
+	}
+	return false
+}
+
+// Labels are case insensitive.
+func inLabels(labels []string, label string) bool {
+	for _, l := range labels {
+		if strings.EqualFold(l, label) {
+			return true
+		}
+	}
+	return false
+}
+
+// Does the token hold the permission on at least some resources?
+func HasScopedGrant(token *acl_proto.ApiClientACL, permission ACL_PERMISSION) bool {
+	for _, grant := range token.ScopedGrants {
+		for _, name := range grant.Permissions {
+			if GetPermission(name) == permission {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package acls
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	acl_proto "www.velocidex.com/golang/velociraptor/acls/proto"
+)
+
+func TestScopedGrantMatches(t *testing.T) {
+	helpdesk := &acl_proto.ScopedGrant{
+		Permissions: []string{"COLLECT_CLIENT"},
+		Artifacts:   []string{"Windows.Triage.*"},
+		Labels:      []string{"Helpdesk"},
+	}
+
+	own_hunts := &acl_proto.ScopedGrant{
+		Permissions: []string{"read_results"},
+		OwnOnly:     true,
+	}
+
+	for _, test := range []struct {
+		name       string
+		grant      *acl_proto.ScopedGrant
+		permission ACL_PERMISSION
+		resource   *Resource
+		expected   bool
+	}{
+		{"No resource", helpdesk, COLLECT_CLIENT, nil, false},
+		{"Matching client", helpdesk, COLLECT_CLIENT, &Resource{
+			Artifacts:    []string{"Windows.Triage.Targets"},
+			ClientLabels: []string{"Finance", "helpdesk"},
+		}, true},
+		{"Wrong permission", helpdesk, READ_RESULTS, &Resource{
+			Artifacts:    []string{"Windows.Triage.Targets"},
+			ClientLabels: []string{"Helpdesk"},
+		}, false},
+		{"Unlabelled client", helpdesk, COLLECT_CLIENT, &Resource{
+			Artifacts: []string{"Windows.Triage.Targets"},
+		}, false},
+		{"One artifact does not match", helpdesk, COLLECT_CLIENT, &Resource{
+			Artifacts: []string{"Windows.Triage.Targets",
+				"Windows.System.Pslist"},
+			ClientLabels: []string{"Helpdesk"},
+		}, false},
+		{"Hunt on allowed labels", helpdesk, COLLECT_CLIENT, &Resource{
+			Artifacts:  []string{"Windows.Triage.Targets"},
+			HuntLabels: []string{"Helpdesk"},
+		}, true},
+		{"Hunt on other labels", helpdesk, COLLECT_CLIENT, &Resource{
+			Artifacts:  []string{"Windows.Triage.Targets"},
+			HuntLabels: []string{"Helpdesk", "Servers"},
+		}, false},
+		{"Own hunt", own_hunts, READ_RESULTS, &Resource{
+			Creator: "bob",
+		}, true},
+		{"Other hunt", own_hunts, READ_RESULTS, &Resource{
+			Creator: "alice",
+		}, false},
+	} {
+		assert.Equal(t, test.expected,
+			ScopedGrantMatches(test.grant, test.permission, "bob", test.resource),
+			test.name)
+	}
+}
+
+func TestValidateScopedGrant(t *testing.T) {
+	assert.NoError(t, ValidateScopedGrant(&acl_proto.ScopedGrant{
+		Permissions: []string{"COLLECT_CLIENT", "READ_RESULTS"},
+		Artifacts:   []string{"Windows.*"},
+	}))
+
+	// Only some permissions can be scoped.
+	assert.Error(t, ValidateScopedGrant(&acl_proto.ScopedGrant{
+		Permissions: []string{"EXECVE"},
+		OwnOnly:     true,
+	}))
+
+	assert.Error(t, ValidateScopedGrant(&acl_proto.ScopedGrant{
+		Permissions: []string{"COLLECT_CLIENT"},
+		Artifacts:   []string{"Windows.["},
+	}))
+
+	// Unrestricted grants are just permissions.
+	assert.Error(t, ValidateScopedGrant(&acl_proto.ScopedGrant{
+		Permissions: []string{"COLLECT_CLIENT"},
+	}))
+}
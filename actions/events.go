@@ -27,17 +27,28 @@
 package actions
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"google.golang.org/protobuf/proto"
 	actions_proto "www.velocidex.com/golang/velociraptor/actions/proto"
 	config "www.velocidex.com/golang/velociraptor/config"
 	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	"www.velocidex.com/golang/velociraptor/constants"
 	crypto_proto "www.velocidex.com/golang/velociraptor/crypto/proto"
+	"www.velocidex.com/golang/velociraptor/json"
 	"www.velocidex.com/golang/velociraptor/logging"
 	"www.velocidex.com/golang/velociraptor/responder"
+	"www.velocidex.com/golang/velociraptor/utils"
+)
+
+var (
+	// How often to report events dropped from the ring buffer or
+	// suppressed by the event table.
+	EventStatsReportPeriod = time.Minute
 )
 
 type EventTable struct {
@@ -59,6 +70,13 @@ type EventTable struct {
 	config_obj *config_proto.Config
 
 	monitoring_manager *responder.MonitoringManager
+
+	// Shared with the ring buffer which applies the buffer policies
+	// of the event queries.
+	flow_manager *responder.FlowManager
+
+	// Applies the suppression rules of the event queries.
+	suppressor *responder.EventSuppressor
 }
 
 // Determine if the current table is the same as the new set of
@@ -183,6 +201,8 @@ func (self *EventTable) StartQueries(
 
 	// Start a new query for each event.
 	action_obj := &VQLClientAction{}
+	policies := make(map[string]*responder.EventBufferPolicy)
+	self.suppressor = responder.NewEventSuppressor()
 	for _, event := range self.Events {
 
 		// Name of the query we are running. There must be at least
@@ -192,10 +212,32 @@ func (self *EventTable) StartQueries(
 			continue
 		}
 
+		// Responses carry the name of each source query.
+		if event.BufferPriority != "" || event.MaxBufferBytes > 0 {
+			policy := &responder.EventBufferPolicy{
+				Priority:   responder.ParseBufferPriority(event.BufferPriority),
+				MaxBytes:   event.MaxBufferBytes,
+				SampleRate: event.BufferSampleRate,
+			}
+			for _, query := range event.Query {
+				if query.Name != "" {
+					policies[query.Name] = policy
+				}
+			}
+		}
+
+		var suppressor *responder.EventSuppressor
+		for _, query := range event.Query {
+			if query.Name != "" && query.Suppression != nil {
+				self.suppressor.AddRule(query.Name, query.Suppression)
+				suppressor = self.suppressor
+			}
+		}
+
 		logger.Info("<green>Starting</> monitoring query %s", artifact_name)
 		query_responder := responder.NewMonitoringResponder(
 			ctx, config_obj, self.monitoring_manager,
-			output_chan, artifact_name)
+			output_chan, artifact_name, suppressor)
 
 		self.wg.Add(1)
 		go func(event *actions_proto.VQLCollectorArgs) {
@@ -221,6 +263,112 @@ func (self *EventTable) StartQueries(
 			}
 		}(proto.Clone(event).(*actions_proto.VQLCollectorArgs))
 	}
+
+	if self.flow_manager != nil {
+		self.flow_manager.SetEventBufferPolicies(policies)
+	}
+
+	if self.flow_manager == nil && !self.suppressor.HasRules() {
+		return
+	}
+
+	// Periodically report events dropped from the ring buffer or
+	// suppressed.
+	self.wg.Add(1)
+	go func(ctx context.Context, suppressor *responder.EventSuppressor) {
+		defer self.wg.Done()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case <-time.After(EventStatsReportPeriod):
+				self.ReportDroppedEvents(ctx, output_chan)
+				reportSuppressedEvents(ctx, output_chan, suppressor)
+			}
+		}
+	}(self.Ctx, self.suppressor)
+}
+
+// Send the events dropped from the ring buffer since the last report
+// to the server as a System.Client.EventBufferStats event.
+func (self *EventTable) ReportDroppedEvents(
+	ctx context.Context, output_chan chan *crypto_proto.VeloMessage) {
+	if self.flow_manager == nil {
+		return
+	}
+
+	stats := self.flow_manager.GetDroppedEventStats()
+	if len(stats) == 0 {
+		return
+	}
+
+	jsonl := &bytes.Buffer{}
+	for _, stat := range stats {
+		jsonl.WriteString(json.MustMarshalString(stat))
+		jsonl.WriteString("\n")
+	}
+
+	sendEventStats(ctx, output_chan,
+		constants.CLIENT_EVENT_BUFFER_STATS_ARTIFACT,
+		jsonl.String(), len(stats))
+}
+
+// Send the number of rows suppressed by the event table since the
+// last report to the server as a System.Client.EventSuppressionStats
+// event.
+func (self *EventTable) ReportSuppressedEvents(
+	ctx context.Context, output_chan chan *crypto_proto.VeloMessage) {
+	self.mu.Lock()
+	suppressor := self.suppressor
+	self.mu.Unlock()
+
+	reportSuppressedEvents(ctx, output_chan, suppressor)
+}
+
+func reportSuppressedEvents(
+	ctx context.Context, output_chan chan *crypto_proto.VeloMessage,
+	suppressor *responder.EventSuppressor) {
+	if suppressor == nil {
+		return
+	}
+
+	stats := suppressor.GetSuppressedEventStats()
+	if len(stats) == 0 {
+		return
+	}
+
+	jsonl := &bytes.Buffer{}
+	for _, stat := range stats {
+		jsonl.WriteString(json.MustMarshalString(stat))
+		jsonl.WriteString("\n")
+	}
+
+	sendEventStats(ctx, output_chan,
+		constants.CLIENT_EVENT_SUPPRESSION_STATS_ARTIFACT,
+		jsonl.String(), len(stats))
+}
+
+func sendEventStats(
+	ctx context.Context, output_chan chan *crypto_proto.VeloMessage,
+	artifact string, jsonl string, total_rows int) {
+	message := &crypto_proto.VeloMessage{
+		SessionId: constants.MONITORING_WELL_KNOWN_FLOW,
+		VQLResponse: &actions_proto.VQLResponse{
+			JSONLResponse: jsonl,
+			TotalRows:     uint64(total_rows),
+			Timestamp:     uint64(utils.GetTime().Now().UnixNano() / 1000),
+			Query: &actions_proto.VQLRequest{
+				Name: artifact,
+			},
+		},
+	}
+
+	select {
+	case <-ctx.Done():
+	case output_chan <- message:
+	}
 }
 
 func (self *EventTable) StartFromWriteback(
@@ -292,7 +440,8 @@ func update_writeback(
 func NewEventTable(
 	ctx context.Context,
 	wg *sync.WaitGroup,
-	config_obj *config_proto.Config) *EventTable {
+	config_obj *config_proto.Config,
+	flow_manager *responder.FlowManager) *EventTable {
 
 	sub_ctx, cancel := context.WithCancel(ctx)
 	self := &EventTable{
@@ -303,6 +452,7 @@ func NewEventTable(
 		wg:                 &sync.WaitGroup{},
 		config_obj:         config_obj,
 		monitoring_manager: responder.NewMonitoringManager(ctx),
+		flow_manager:       flow_manager,
 	}
 
 	return self
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -76,7 +77,7 @@ func (self *EventsTestSuite) SetupTest() {
 	self.responder = responder.TestResponderWithFlowId(
 		self.ConfigObj, "EventsTestSuite")
 	self.event_table = actions.NewEventTable(
-		self.Ctx, self.Wg, self.ConfigObj)
+		self.Ctx, self.Wg, self.ConfigObj, nil)
 	self.event_table.UpdateEventTable(
 		self.Ctx, self.Wg, self.ConfigObj,
 		self.responder.Output(),
@@ -85,7 +86,7 @@ func (self *EventsTestSuite) SetupTest() {
 
 func (self *EventsTestSuite) InitializeEventTable(ctx context.Context,
 	wg *sync.WaitGroup, output_chan chan *crypto_proto.VeloMessage) *actions.EventTable {
-	result := actions.NewEventTable(ctx, wg, self.ConfigObj)
+	result := actions.NewEventTable(ctx, wg, self.ConfigObj, nil)
 	result.UpdateEventTable(ctx, wg, self.ConfigObj,
 		output_chan, &actions_proto.VQLEventTable{})
 
@@ -260,6 +261,193 @@ func (self *EventsTestSuite) TestEventTableUpdate() {
 	assert.Contains(self.T(), string(data), "EventArtifact2")
 }
 
+func (self *EventsTestSuite) TestEventBufferPolicies() {
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*60)
+	defer cancel()
+
+	output_chan, _ := responder.NewMessageDrain(ctx)
+	flow_manager := responder.NewFlowManager(ctx, self.ConfigObj)
+	table := actions.NewEventTable(ctx, wg, self.ConfigObj, flow_manager)
+	defer table.Close()
+
+	table.UpdateEventTable(ctx, wg, self.ConfigObj, output_chan,
+		&actions_proto.VQLEventTable{
+			Version: 1,
+			Event: []*actions_proto.VQLCollectorArgs{{
+				BufferPriority:   "LOW",
+				MaxBufferBytes:   1000,
+				BufferSampleRate: 5,
+				Query: []*actions_proto.VQLRequest{{
+					Name: "LowEvent",
+					VQL:  "SELECT * FROM info()",
+				}},
+			}, {
+				Query: []*actions_proto.VQLRequest{{
+					Name: "HighEvent",
+					VQL:  "SELECT * FROM info()",
+				}},
+			}},
+		})
+
+	// Only queries with buffer options get a policy.
+	policy, pres := flow_manager.GetEventBufferPolicy("LowEvent")
+	assert.True(self.T(), pres)
+	assert.Equal(self.T(), &responder.EventBufferPolicy{
+		Priority:   responder.BUFFER_PRIORITY_LOW,
+		MaxBytes:   1000,
+		SampleRate: 5,
+	}, policy)
+
+	_, pres = flow_manager.GetEventBufferPolicy("HighEvent")
+	assert.False(self.T(), pres)
+
+	// Dropped events are reported as a monitoring event.
+	flow_manager.RecordDroppedEvents(
+		"LowEvent", responder.BUFFER_PRIORITY_LOW, 10, 200)
+
+	stats_chan := make(chan *crypto_proto.VeloMessage, 1)
+	table.ReportDroppedEvents(ctx, stats_chan)
+
+	message := <-stats_chan
+	assert.Equal(self.T(), "System.Client.EventBufferStats",
+		message.VQLResponse.Query.Name)
+	assert.Equal(self.T(), uint64(1), message.VQLResponse.TotalRows)
+	assert.Equal(self.T(), `{"Artifact":"LowEvent","Priority":"LOW","DroppedRows":10,"DroppedBytes":200}`+"\n",
+		message.VQLResponse.JSONLResponse)
+
+	// The counters are reset after each report.
+	table.ReportDroppedEvents(ctx, stats_chan)
+	assert.Equal(self.T(), 0, len(stats_chan))
+}
+
+func (self *EventsTestSuite) TestEventSuppression() {
+	clock := &utils.MockClock{MockNow: time.Unix(10, 0)}
+	closer := utils.MockTime(clock)
+	defer closer()
+
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*60)
+	defer cancel()
+
+	output_chan := make(chan *crypto_proto.VeloMessage, 100)
+	table := actions.NewEventTable(ctx, wg, self.ConfigObj, nil)
+	defer table.Close()
+
+	// Only 2 rows with the same Name are forwarded.
+	table.UpdateEventTable(ctx, wg, self.ConfigObj, output_chan,
+		&actions_proto.VQLEventTable{
+			Version: 1,
+			Event: []*actions_proto.VQLCollectorArgs{{
+				Query: []*actions_proto.VQLRequest{{
+					Name: "SuppressedEvent",
+					VQL: `SELECT if(condition=_value < 6, then="A", else="B") AS Name,
+                     _value AS Count FROM range(end=10)`,
+					Suppression: &actions_proto.EventSuppression{
+						KeyColumns: []string{"Name"},
+						Window:     60,
+						MaxCount:   2,
+					},
+				}},
+			}},
+		})
+
+	var response *actions_proto.VQLResponse
+	vtesting.WaitUntil(5*time.Second, self.T(), func() bool {
+		select {
+		case message := <-output_chan:
+			if message.VQLResponse != nil {
+				response = message.VQLResponse
+				return true
+			}
+		default:
+		}
+		return false
+	})
+
+	assert.Equal(self.T(), uint64(4), response.TotalRows)
+	assert.Equal(self.T(), `{"Name":"A","Count":0}
+{"Name":"A","Count":1}
+{"Name":"B","Count":6}
+{"Name":"B","Count":7}
+`, response.JSONLResponse)
+
+	// The suppressed rows are reported to the server.
+	stats_chan := make(chan *crypto_proto.VeloMessage, 1)
+	table.ReportSuppressedEvents(ctx, stats_chan)
+
+	message := <-stats_chan
+	assert.Equal(self.T(), "System.Client.EventSuppressionStats",
+		message.VQLResponse.Query.Name)
+	assert.Equal(self.T(), `{"Artifact":"SuppressedEvent","SuppressedRows":6,"ActiveKeys":2}`+"\n",
+		message.VQLResponse.JSONLResponse)
+
+	// Rows are forwarded again once the window expires.
+	suppressor := responder.NewEventSuppressor()
+	suppressor.AddRule("SuppressedEvent", &actions_proto.EventSuppression{
+		Window: 60,
+	})
+
+	make_response := func() *actions_proto.VQLResponse {
+		return &actions_proto.VQLResponse{
+			Query:         &actions_proto.VQLRequest{Name: "SuppressedEvent"},
+			JSONLResponse: "{\"Name\":\"A\"}\n{\"Name\":\"A\"}\n",
+			TotalRows:     2,
+		}
+	}
+
+	// Without key columns the entire row is the key.
+	response = make_response()
+	assert.True(self.T(), suppressor.Filter(response))
+	assert.Equal(self.T(), uint64(1), response.TotalRows)
+
+	clock.MockNow = clock.MockNow.Add(30 * time.Second)
+	assert.False(self.T(), suppressor.Filter(make_response()))
+
+	clock.MockNow = clock.MockNow.Add(30 * time.Second)
+	response = make_response()
+	assert.True(self.T(), suppressor.Filter(response))
+	assert.Equal(self.T(), uint64(1), response.TotalRows)
+
+	// When too many keys are tracked the oldest window is evicted.
+	suppressor.AddRule("ManyKeys", &actions_proto.EventSuppression{
+		KeyColumns: []string{"Key"},
+		Window:     60,
+	})
+
+	make_keys := func(start, end int) *actions_proto.VQLResponse {
+		lines := &strings.Builder{}
+		for i := start; i < end; i++ {
+			fmt.Fprintf(lines, "{\"Key\":%d}\n", i)
+		}
+		return &actions_proto.VQLResponse{
+			Query:         &actions_proto.VQLRequest{Name: "ManyKeys"},
+			JSONLResponse: lines.String(),
+			TotalRows:     uint64(end - start),
+		}
+	}
+
+	assert.True(self.T(), suppressor.Filter(make_keys(0, 1)))
+
+	clock.MockNow = clock.MockNow.Add(time.Second)
+	response = make_keys(1, responder.MAX_SUPPRESSION_KEYS+1)
+	assert.True(self.T(), suppressor.Filter(response))
+	assert.Equal(self.T(), uint64(responder.MAX_SUPPRESSION_KEYS),
+		response.TotalRows)
+
+	// Key 0 was evicted so it is forwarded again.
+	clock.MockNow = clock.MockNow.Add(time.Second)
+	assert.True(self.T(), suppressor.Filter(make_keys(0, 1)))
+
+	// Newer windows are still in effect.
+	assert.False(self.T(), suppressor.Filter(make_keys(
+		responder.MAX_SUPPRESSION_KEYS, responder.MAX_SUPPRESSION_KEYS+1)))
+}
+
 func TestEventsTestSuite(t *testing.T) {
 	suite.Run(t, &EventsTestSuite{})
 }
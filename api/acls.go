@@ -0,0 +1,129 @@
+package api
+
+import (
+	"context"
+	"strings"
+
+	"www.velocidex.com/golang/velociraptor/acls"
+	api_proto "www.velocidex.com/golang/velociraptor/api/proto"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/services/hunt_dispatcher"
+)
+
+// Describe the hunt or flow whose results are accessed so scoped
+// grants may be checked. Returns nil if the results do not belong to
+// a known hunt or flow.
+func getResultsResource(
+	ctx context.Context,
+	config_obj *config_proto.Config,
+	client_id, flow_id, hunt_id string) *acls.Resource {
+
+	if hunt_id != "" {
+		hunt_dispatcher_service, err := services.GetHuntDispatcher(config_obj)
+		if err != nil {
+			return nil
+		}
+
+		hunt, pres := hunt_dispatcher_service.GetHunt(hunt_id)
+		if !pres {
+			return nil
+		}
+		return hunt_dispatcher.GetHuntResource(hunt)
+	}
+
+	if client_id == "" || flow_id == "" {
+		return nil
+	}
+
+	launcher, err := services.GetLauncher(config_obj)
+	if err != nil {
+		return nil
+	}
+
+	details, err := launcher.GetFlowDetails(config_obj, client_id, flow_id)
+	if err != nil || details.Context == nil ||
+		details.Context.Request == nil {
+		return nil
+	}
+
+	resource := &acls.Resource{
+		Artifacts: details.Context.Request.Artifacts,
+		ClientId:  client_id,
+		Creator:   details.Context.Request.Creator,
+	}
+
+	// Flows created by a hunt belong to the hunt's creator.
+	if strings.HasPrefix(resource.Creator, "H.") {
+		hunt_resource := getResultsResource(
+			ctx, config_obj, "", "", resource.Creator)
+		if hunt_resource != nil {
+			resource.Creator = hunt_resource.Creator
+		}
+	}
+
+	return resource
+}
+
+// Describe the results a GetTable request actually reads. This
+// follows tables.GetPathSpec so the ids which do not select the path
+// are ignored: a flow is checked whenever the flow id selects the
+// table and a hunt only for the hunt's own tables. Event, timeline
+// and notebook tables are not covered by scoped grants so they have
+// no resource.
+func getTableResource(
+	ctx context.Context,
+	config_obj *config_proto.Config,
+	in *api_proto.GetTableRequest) *acls.Resource {
+
+	switch in.Type {
+	case "TIMELINE", "CLIENT_EVENT", "SERVER_EVENT",
+		"CLIENT_EVENT_LOGS", "SERVER_EVENT_LOGS":
+		return nil
+	}
+
+	if in.FlowId != "" && (in.Artifact != "" || in.Type != "") {
+		return getResultsResource(ctx, config_obj, in.ClientId, in.FlowId, "")
+	}
+
+	if in.HuntId != "" && (in.Type == "clients" || in.Type == "hunt_status") {
+		return getResultsResource(ctx, config_obj, "", "", in.HuntId)
+	}
+
+	return nil
+}
+
+func checkTableAccess(
+	ctx context.Context,
+	config_obj *config_proto.Config,
+	principal string, in *api_proto.GetTableRequest) (bool, error) {
+	resource := getTableResource(ctx, config_obj, in)
+	return services.CheckAccessWithResource(
+		ctx, config_obj, principal, resource, acls.READ_RESULTS)
+}
+
+func checkResultsAccess(
+	ctx context.Context,
+	config_obj *config_proto.Config,
+	principal, client_id, flow_id, hunt_id string) (bool, error) {
+	resource := getResultsResource(ctx, config_obj, client_id, flow_id, hunt_id)
+	return services.CheckAccessWithResource(
+		ctx, config_obj, principal, resource, acls.READ_RESULTS)
+}
+
+// Users who may only read some hunts only see those hunts.
+func filterReadableHunts(
+	ctx context.Context,
+	config_obj *config_proto.Config,
+	principal string, hunts []*api_proto.Hunt) []*api_proto.Hunt {
+	result := make([]*api_proto.Hunt, 0, len(hunts))
+	for _, hunt := range hunts {
+		perm, err := services.CheckAccessWithResource(ctx, config_obj,
+			principal, hunt_dispatcher.GetHuntResource(hunt),
+			acls.READ_RESULTS)
+		if perm && err == nil {
+			result = append(result, hunt)
+		}
+	}
+	return result
+}
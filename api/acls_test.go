@@ -0,0 +1,142 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert"
+	"github.com/stretchr/testify/suite"
+	acl_proto "www.velocidex.com/golang/velociraptor/acls/proto"
+	api_proto "www.velocidex.com/golang/velociraptor/api/proto"
+	"www.velocidex.com/golang/velociraptor/file_store/test_utils"
+	flows_proto "www.velocidex.com/golang/velociraptor/flows/proto"
+	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/vql/acl_managers"
+)
+
+type ACLTestSuite struct {
+	test_utils.TestSuite
+}
+
+func (self *ACLTestSuite) SetupTest() {
+	self.ConfigObj = self.LoadConfig()
+	self.ConfigObj.Services.HuntDispatcher = true
+	self.LoadArtifacts([]string{`
+name: Test.Artifact
+sources:
+- query: SELECT * FROM info()
+`})
+	self.TestSuite.SetupTest()
+}
+
+// A principal who may only read their own hunts can not use the
+// hunt id to read other tables.
+func (self *ACLTestSuite) TestScopedTableAccess() {
+	err := services.SetPolicy(self.ConfigObj, "UserScoped",
+		&acl_proto.ApiClientACL{
+			ScopedGrants: []*acl_proto.ScopedGrant{{
+				Permissions: []string{"READ_RESULTS"},
+				OwnOnly:     true,
+			}},
+		})
+	assert.NoError(self.T(), err)
+
+	hunt_dispatcher, err := services.GetHuntDispatcher(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	hunt_id, err := hunt_dispatcher.CreateHunt(self.Ctx, self.ConfigObj,
+		acl_managers.NullACLManager{}, &api_proto.Hunt{
+			Creator: "UserScoped",
+			StartRequest: &flows_proto.ArtifactCollectorArgs{
+				Artifacts: []string{"Test.Artifact"},
+			},
+		})
+	assert.NoError(self.T(), err)
+
+	// Another user's flow.
+	manager, err := services.GetRepositoryManager(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	repository, err := manager.GetGlobalRepository(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	launcher, err := services.GetLauncher(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	flow_id, err := launcher.ScheduleArtifactCollection(self.Ctx,
+		self.ConfigObj, acl_managers.NullACLManager{}, repository,
+		&flows_proto.ArtifactCollectorArgs{
+			Creator:   "UserOther",
+			ClientId:  "C.1234",
+			Artifacts: []string{"Test.Artifact"},
+		}, nil)
+	assert.NoError(self.T(), err)
+
+	check := func(in *api_proto.GetTableRequest) bool {
+		ok, err := checkTableAccess(self.Ctx, self.ConfigObj, "UserScoped", in)
+		assert.NoError(self.T(), err)
+		return ok
+	}
+
+	// The hunt's own tables are allowed.
+	for _, table_type := range []string{"clients", "hunt_status"} {
+		assert.True(self.T(), check(&api_proto.GetTableRequest{
+			HuntId: hunt_id,
+			Type:   table_type,
+		}))
+	}
+
+	// The flow selects the table so the hunt id does not help.
+	assert.False(self.T(), check(&api_proto.GetTableRequest{
+		HuntId:   hunt_id,
+		ClientId: "C.1234",
+		FlowId:   flow_id,
+		Artifact: "Test.Artifact",
+	}))
+
+	assert.False(self.T(), check(&api_proto.GetTableRequest{
+		HuntId:   hunt_id,
+		ClientId: "C.1234",
+		FlowId:   flow_id,
+		Type:     "log",
+	}))
+
+	// Event, timeline and notebook tables are never covered.
+	for _, table_type := range []string{
+		"CLIENT_EVENT", "SERVER_EVENT", "CLIENT_EVENT_LOGS", "TIMELINE"} {
+		assert.False(self.T(), check(&api_proto.GetTableRequest{
+			HuntId:   hunt_id,
+			ClientId: "C.1234",
+			Artifact: "Test.Artifact",
+			Type:     table_type,
+		}))
+	}
+
+	assert.False(self.T(), check(&api_proto.GetTableRequest{
+		HuntId:     hunt_id,
+		NotebookId: "N.1234",
+		CellId:     "NC.1234",
+	}))
+
+	// The owner of the flow may read it.
+	err = services.SetPolicy(self.ConfigObj, "UserOther",
+		&acl_proto.ApiClientACL{
+			ScopedGrants: []*acl_proto.ScopedGrant{{
+				Permissions: []string{"READ_RESULTS"},
+				OwnOnly:     true,
+			}},
+		})
+	assert.NoError(self.T(), err)
+
+	ok, err := checkTableAccess(self.Ctx, self.ConfigObj, "UserOther",
+		&api_proto.GetTableRequest{
+			ClientId: "C.1234",
+			FlowId:   flow_id,
+			Artifact: "Test.Artifact",
+		})
+	assert.NoError(self.T(), err)
+	assert.True(self.T(), ok)
+}
+
+func TestACLs(t *testing.T) {
+	suite.Run(t, &ACLTestSuite{})
+}
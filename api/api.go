@@ -180,7 +180,11 @@ func (self *ApiServer) CollectArtifact(
 		acl_manager = acl_managers.NewServerACLManager(
 			org_config_obj, principal)
 
-		perm, err := acl_manager.CheckAccess(permissions)
+		perm, err := services.CheckAccessWithResource(ctx, org_config_obj,
+			principal, &acls.Resource{
+				Artifacts: in.Artifacts,
+				ClientId:  in.ClientId,
+			}, permissions)
 		if !perm || err != nil {
 			return nil, status.Error(codes.PermissionDenied,
 				"User is not allowed to launch flows.")
@@ -362,8 +366,8 @@ func (self *ApiServer) GetFlowDetails(
 	}
 	principal := user_record.Name
 
-	permissions := acls.READ_RESULTS
-	perm, err := services.CheckAccess(org_config_obj, principal, permissions)
+	perm, err := checkResultsAccess(ctx, org_config_obj, principal,
+		in.ClientId, in.FlowId, "")
 	if !perm || err != nil {
 		return nil, status.Error(codes.PermissionDenied,
 			"User is not allowed to launch flows.")
@@ -393,8 +397,8 @@ func (self *ApiServer) GetFlowRequests(
 	}
 	principal := user_record.Name
 
-	permissions := acls.READ_RESULTS
-	perm, err := services.CheckAccess(org_config_obj, principal, permissions)
+	perm, err := checkResultsAccess(ctx, org_config_obj, principal,
+		in.ClientId, in.FlowId, "")
 	if !perm || err != nil {
 		return nil, status.Error(codes.PermissionDenied,
 			"User is not allowed to view flows.")
@@ -646,8 +650,7 @@ func (self *ApiServer) GetTable(
 	}
 	principal := user_record.Name
 
-	permissions := acls.READ_RESULTS
-	perm, err := services.CheckAccess(org_config_obj, principal, permissions)
+	perm, err := checkTableAccess(ctx, org_config_obj, principal, in)
 	if !perm || err != nil {
 		return nil, status.Error(codes.PermissionDenied,
 			"User is not allowed to view results.")
@@ -735,7 +738,13 @@ func (self *ApiServer) GetArtifactFile(
 			"User is not allowed to view custom artifacts.")
 	}
 
-	artifact, err := getArtifactFile(ctx, org_config_obj, in.Name)
+	var artifact string
+	if in.Revision != "" {
+		artifact, err = getArtifactRevision(ctx, org_config_obj,
+			in.Name, in.Revision)
+	} else {
+		artifact, err = getArtifactFile(ctx, org_config_obj, in.Name)
+	}
 	if err != nil {
 		return nil, Status(self.verbose, err)
 	}
@@ -743,6 +752,13 @@ func (self *ApiServer) GetArtifactFile(
 	result := &api_proto.GetArtifactResponse{
 		Artifact: artifact,
 	}
+
+	if in.History {
+		result.Revisions, err = getArtifactHistory(ctx, org_config_obj, in.Name)
+		if err != nil {
+			return nil, Status(self.verbose, err)
+		}
+	}
 	return result, nil
 }
 
@@ -768,9 +784,20 @@ func (self *ApiServer) SetArtifactFile(
 		return nil, Status(self.verbose, err)
 	}
 
+	// When restoring an old revision, check permissions against
+	// the restored definition.
+	definition_to_check := in.Artifact
+	if in.Op == api_proto.SetArtifactRequest_RESTORE {
+		definition_to_check, err = getArtifactRevision(
+			ctx, org_config_obj, in.Name, in.Revision)
+		if err != nil {
+			return nil, Status(self.verbose, err)
+		}
+	}
+
 	tmp_repository := manager.NewRepository()
 	artifact_definition, err := tmp_repository.LoadYaml(
-		in.Artifact, true /* validate */, false /* built_in */)
+		definition_to_check, true /* validate */, false /* built_in */)
 	if err != nil {
 		return nil, Status(self.verbose, err)
 	}
@@ -800,6 +827,7 @@ func (self *ApiServer) SetArtifactFile(
 	logging.LogAudit(org_config_obj, principal, "SetArtifactFile",
 		logrus.Fields{
 			"artifact": definition.Name,
+			"revision": definition.Revision,
 			"details":  fmt.Sprintf("%v", in.Artifact),
 		})
 
@@ -96,6 +96,48 @@ func getArtifactFile(
 	return artifact.Raw, nil
 }
 
+// Get the definition of an old revision of the artifact.
+func getArtifactRevision(
+	ctx context.Context, config_obj *config_proto.Config,
+	name, revision string) (string, error) {
+
+	manager, err := services.GetRepositoryManager(config_obj)
+	if err != nil {
+		return "", err
+	}
+
+	artifact_revision, err := manager.GetArtifactRevision(
+		ctx, config_obj, name, revision)
+	if err != nil {
+		return "", err
+	}
+
+	return artifact_revision.Definition, nil
+}
+
+// Get the revision history of the artifact. Definitions are not
+// included to keep the response small.
+func getArtifactHistory(
+	ctx context.Context, config_obj *config_proto.Config,
+	name string) ([]*artifacts_proto.ArtifactRevision, error) {
+
+	manager, err := services.GetRepositoryManager(config_obj)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := manager.ListArtifactRevisions(ctx, config_obj, name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, revision := range revisions {
+		revision.Definition = ""
+	}
+
+	return revisions, nil
+}
+
 func ensureArtifactPrefix(definition, prefix string) string {
 	return utils.ReplaceAllStringSubmatchFunc(
 		name_regex, definition,
@@ -140,6 +182,16 @@ func setArtifactFile(
 	case api_proto.SetArtifactRequest_SET:
 		return manager.SetArtifactFile(ctx,
 			config_obj, principal, in.Artifact, required_prefix)
+
+	case api_proto.SetArtifactRequest_RESTORE:
+		if !strings.HasPrefix(in.Name, required_prefix) {
+			return nil, InvalidStatus(
+				"Modified or custom artifact names must start with '" +
+					required_prefix + "'")
+		}
+
+		return manager.RestoreArtifactRevision(ctx,
+			config_obj, principal, in.Name, in.Revision)
 	}
 
 	return nil, InvalidStatus("Unknown op")
@@ -119,11 +119,12 @@ func init() {
 
 	RegisterAuthenticator("basic", func(config_obj *config_proto.Config,
 		auth_config *config_proto.Authenticator) (Authenticator, error) {
-		return &BasicAuthenticator{
-			config_obj: config_obj,
-			base:       getBasePath(config_obj),
-			public_url: getPublicURL(config_obj),
-		}, nil
+		return NewBasicAuthenticator(config_obj, auth_config)
+	})
+
+	RegisterAuthenticator("ldap", func(config_obj *config_proto.Config,
+		auth_config *config_proto.Authenticator) (Authenticator, error) {
+		return NewLDAPAuthenticator(config_obj, auth_config)
 	})
 
 	RegisterAuthenticator("oidc", func(config_obj *config_proto.Config,
@@ -2,7 +2,12 @@ package authenticators
 
 import (
 	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/gorilla/csrf"
 	"github.com/sirupsen/logrus"
@@ -12,23 +17,55 @@ import (
 	"www.velocidex.com/golang/velociraptor/json"
 	"www.velocidex.com/golang/velociraptor/logging"
 	"www.velocidex.com/golang/velociraptor/services"
-	"www.velocidex.com/golang/velociraptor/services/users"
 )
 
 // Implement basic authentication.
 type BasicAuthenticator struct {
 	config_obj       *config_proto.Config
+	authenticator    *config_proto.Authenticator
 	base, public_url string
+
+	// Logins using a second factor are remembered for the session
+	// duration because the browser keeps presenting the same
+	// credentials.
+	mu           sync.Mutex
+	session_salt []byte
+	mfa_sessions map[string]time.Time
+}
+
+func NewBasicAuthenticator(
+	config_obj *config_proto.Config,
+	auth_config *config_proto.Authenticator) (*BasicAuthenticator, error) {
+	result := &BasicAuthenticator{
+		config_obj:    config_obj,
+		authenticator: auth_config,
+		base:          getBasePath(config_obj),
+		public_url:    getPublicURL(config_obj),
+		session_salt:  make([]byte, 32),
+		mfa_sessions:  make(map[string]time.Time),
+	}
+
+	_, err := rand.Read(result.session_salt)
+	return result, err
 }
 
-// Basic auth does not need any special handlers.
+// Basic auth only needs a handler for users to enroll a second
+// factor.
 func (self *BasicAuthenticator) AddHandlers(mux *http.ServeMux) error {
+	mux.Handle(self.base+"auth/mfa/enroll", self.mfaEnrollHandler())
 	return nil
 }
 
 func (self *BasicAuthenticator) AddLogoff(mux *http.ServeMux) error {
-	homepage := self.base + "app/index.html"
-	mux.Handle(self.base+"app/logoff.html",
+	installBasicLogoff(self.base, mux)
+	return nil
+}
+
+// Logging off with basic auth requires the browser to forget the
+// credentials - we do this by rejecting the current credentials.
+func installBasicLogoff(base string, mux *http.ServeMux) {
+	homepage := base + "app/index.html"
+	mux.Handle(base+"app/logoff.html",
 		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			username, _, ok := r.BasicAuth()
 			if !ok {
@@ -49,8 +86,6 @@ func (self *BasicAuthenticator) AddLogoff(mux *http.ServeMux) error {
 			w.Header().Set("WWW-Authenticate", `Basic realm="Restricted"`)
 			http.Error(w, "authorization failed", http.StatusUnauthorized)
 		}))
-
-	return nil
 }
 
 func (self *BasicAuthenticator) IsPasswordLess() bool {
@@ -88,13 +123,21 @@ func (self *BasicAuthenticator) AuthenticateUserHandler(
 			return
 		}
 
-		if !users.VerifyPassword(user_record, password) {
-			logging.LogAudit(self.config_obj, username, "Invalid password",
+		// Check the password and the second factor if needed.
+		err = self.verifyCredentials(r.Context(), user_record, password)
+		if err != nil {
+			logging.LogAudit(self.config_obj, username, err.Error(),
 				logrus.Fields{
 					"remote": r.RemoteAddr,
 					"status": http.StatusUnauthorized,
 				})
-			http.Error(w, "authorization failed", http.StatusUnauthorized)
+
+			message := "authorization failed"
+			if errors.Is(err, mfaEnrollmentRequiredError) {
+				message = fmt.Sprintf("%v: enroll at %vauth/mfa/enroll",
+					message, self.base)
+			}
+			http.Error(w, message, http.StatusUnauthorized)
 			return
 		}
 
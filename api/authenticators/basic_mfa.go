@@ -0,0 +1,336 @@
+package authenticators
+
+// TOTP second factor for the basic authenticator.
+
+// Browsers only support a username and password for basic auth, so
+// users with a second factor append the current TOTP code (or one
+// of their recovery codes) to their password. Users enroll by
+// visiting auth/mfa/enroll which returns a provisioning URI and
+// recovery codes. The enrollment is confirmed by the first login
+// using a valid code.
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	api_proto "www.velocidex.com/golang/velociraptor/api/proto"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/logging"
+	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/services/users"
+	"www.velocidex.com/golang/velociraptor/utils"
+)
+
+var (
+	mfaEnrollmentRequiredError = errors.New("MFA enrollment required")
+
+	// Serializes updates to each user's MFA record so concurrent
+	// logins can not both use the same code, or restore a
+	// recovery code the other one used.
+	mfa_locks = &mfaLocks{locks: make(map[string]*mfaLock)}
+)
+
+type mfaLock struct {
+	sync.Mutex
+	refs int
+}
+
+type mfaLocks struct {
+	mu    sync.Mutex
+	locks map[string]*mfaLock
+}
+
+// Lock the user's MFA record and return a function to unlock it.
+func (self *mfaLocks) Lock(username string) func() {
+	self.mu.Lock()
+	lock, pres := self.locks[username]
+	if !pres {
+		lock = &mfaLock{}
+		self.locks[username] = lock
+	}
+	lock.refs++
+	self.mu.Unlock()
+
+	lock.Lock()
+
+	return func() {
+		lock.Unlock()
+
+		self.mu.Lock()
+		defer self.mu.Unlock()
+
+		lock.refs--
+		if lock.refs == 0 {
+			delete(self.locks, username)
+		}
+	}
+}
+
+// Verify the password and second factor. The user record must
+// contain the password hashes.
+func (self *BasicAuthenticator) verifyCredentials(
+	ctx context.Context,
+	user_record *api_proto.VelociraptorUser, password string) error {
+	username := user_record.Name
+
+	defer mfa_locks.Lock(username)()
+
+	users_manager := services.GetUserManager()
+	mfa, err := users_manager.GetUserMFA(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	// Never enrolled - only the password is checked.
+	if mfa.Secret == "" {
+		if !users.VerifyPassword(user_record, password) {
+			return errors.New("Invalid password")
+		}
+
+		if self.mfaRequired(user_record) {
+			return mfaEnrollmentRequiredError
+		}
+		return nil
+	}
+
+	// The browser keeps presenting the same credentials for the
+	// entire session.
+	session_key := self.getSessionKey(user_record, mfa, password)
+	if self.checkSession(session_key) {
+		return nil
+	}
+
+	// While enrollment is pending the password alone is
+	// sufficient, unless a second factor is required.
+	if !mfa.Enabled && users.VerifyPassword(user_record, password) {
+		if self.mfaRequired(user_record) {
+			return mfaEnrollmentRequiredError
+		}
+		return nil
+	}
+
+	// Split the code from the end of the password.
+	password_ok := false
+	method := ""
+	now := utils.GetTime().Now()
+
+	if len(password) > users.TOTP_DIGITS {
+		split := len(password) - users.TOTP_DIGITS
+		if users.VerifyPassword(user_record, password[:split]) {
+			password_ok = true
+			if users.VerifyTOTP(mfa, password[split:], now) {
+				method = "totp"
+			}
+		}
+	}
+
+	if method == "" && len(password) > users.RECOVERY_CODE_LENGTH {
+		split := len(password) - users.RECOVERY_CODE_LENGTH
+		if users.VerifyPassword(user_record, password[:split]) {
+			password_ok = true
+			if users.VerifyRecoveryCode(mfa, password[split:]) {
+				method = "recovery_code"
+			}
+		}
+	}
+
+	if !password_ok {
+		return errors.New("Invalid password")
+	}
+
+	if method == "" {
+		return errors.New("Invalid MFA code")
+	}
+
+	if method == "recovery_code" {
+		logging.LogAudit(self.config_obj, username, "MFA recovery code used",
+			logrus.Fields{
+				"remaining": len(mfa.RecoveryCodes),
+			})
+	}
+
+	if !mfa.Enabled {
+		mfa.Enabled = true
+		mfa.EnrolledTime = uint64(now.Unix())
+		logging.LogAudit(self.config_obj, username, "MFA enrollment confirmed",
+			logrus.Fields{})
+	}
+
+	// Record the used code so it can not be used again.
+	err = users_manager.SetUserMFA(ctx, username, mfa)
+	if err != nil {
+		return err
+	}
+
+	self.addSession(session_key)
+	return nil
+}
+
+// Is the user required to use a second factor?
+func (self *BasicAuthenticator) mfaRequired(
+	user_record *api_proto.VelociraptorUser) bool {
+	if self.authenticator == nil {
+		return false
+	}
+
+	if self.authenticator.MfaRequired {
+		return true
+	}
+
+	org_manager, err := services.GetOrgManager()
+	if err != nil {
+		return false
+	}
+
+	for _, org := range user_record.Orgs {
+		if utils.InString(self.authenticator.MfaRequiredOrgs, org.Id) {
+			return true
+		}
+
+		if len(self.authenticator.MfaRequiredRoles) == 0 {
+			continue
+		}
+
+		org_config_obj, err := org_manager.GetOrgConfig(org.Id)
+		if err != nil {
+			continue
+		}
+
+		policy, err := services.GetPolicy(org_config_obj, user_record.Name)
+		if err != nil {
+			continue
+		}
+
+		for _, role := range policy.Roles {
+			if utils.InString(self.authenticator.MfaRequiredRoles, role) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Sessions are invalidated when the password or second factor
+// changes.
+func (self *BasicAuthenticator) getSessionKey(
+	user_record *api_proto.VelociraptorUser,
+	mfa *api_proto.UserMFA, password string) string {
+	hash := sha256.New()
+	for _, item := range [][]byte{
+		self.session_salt, []byte(user_record.Name),
+		user_record.PasswordHash, []byte(mfa.Secret), []byte(password)} {
+		_, _ = hash.Write(item)
+		_, _ = hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func (self *BasicAuthenticator) checkSession(key string) bool {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	expires, pres := self.mfa_sessions[key]
+	return pres && utils.GetTime().Now().Before(expires)
+}
+
+func (self *BasicAuthenticator) addSession(key string) {
+	expiry_min := uint64(0)
+	if self.authenticator != nil {
+		expiry_min = self.authenticator.DefaultSessionExpiryMin
+	}
+	if expiry_min == 0 {
+		expiry_min = 60 * 24 // 1 Day by default
+	}
+
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	now := utils.GetTime().Now()
+
+	// Expire old sessions.
+	for k, expires := range self.mfa_sessions {
+		if now.After(expires) {
+			delete(self.mfa_sessions, k)
+		}
+	}
+
+	self.mfa_sessions[key] = now.Add(time.Duration(expiry_min) * time.Minute)
+}
+
+// Start a new enrollment for the authenticated user. Users who
+// already have a confirmed second factor need an administrator to
+// reset it first.
+func (self *BasicAuthenticator) mfaEnrollHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="Restricted"`)
+
+		username, password, ok := r.BasicAuth()
+		if !ok {
+			http.Error(w, "Not authorized", http.StatusUnauthorized)
+			return
+		}
+
+		users_manager := services.GetUserManager()
+		user_record, err := users_manager.GetUserWithHashes(r.Context(), username)
+		if err != nil || user_record.Name != username ||
+			user_record.Locked ||
+			!users.VerifyPassword(user_record, password) {
+			logging.LogAudit(self.config_obj, username, "MFA enrollment rejected",
+				logrus.Fields{
+					"remote": r.RemoteAddr,
+					"status": http.StatusUnauthorized,
+				})
+			http.Error(w, "authorization failed", http.StatusUnauthorized)
+			return
+		}
+
+		unlock := mfa_locks.Lock(username)
+		defer unlock()
+
+		mfa, err := users_manager.GetUserMFA(r.Context(), username)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if mfa.Enabled {
+			http.Error(w, "Already enrolled - ask an administrator to reset",
+				http.StatusForbidden)
+			return
+		}
+
+		issuer := ""
+		if self.authenticator != nil {
+			issuer = self.authenticator.MfaIssuer
+		}
+
+		mfa, enrollment, err := users.NewMFAEnrollment(issuer, username)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		err = users_manager.SetUserMFA(r.Context(), username, mfa)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		logging.LogAudit(self.config_obj, username, "MFA enrollment started",
+			logrus.Fields{
+				"remote": r.RemoteAddr,
+			})
+
+		serialized, _ := json.MarshalIndent(enrollment)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		_, _ = w.Write(serialized)
+	})
+}
@@ -0,0 +1,236 @@
+package authenticators
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	api_proto "www.velocidex.com/golang/velociraptor/api/proto"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	"www.velocidex.com/golang/velociraptor/file_store/test_utils"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/services/users"
+	"www.velocidex.com/golang/velociraptor/utils"
+)
+
+type BasicMFATestSuite struct {
+	test_utils.TestSuite
+
+	clock *utils.MockClock
+	close func()
+}
+
+func (self *BasicMFATestSuite) SetupTest() {
+	self.TestSuite.SetupTest()
+
+	self.clock = &utils.MockClock{MockNow: time.Unix(1700000000, 0)}
+	self.close = utils.MockTime(self.clock)
+
+	for _, user := range []struct {
+		name, role string
+	}{{"alice", "investigator"}, {"bob", "reader"}} {
+		user_record := &api_proto.VelociraptorUser{Name: user.name}
+		users.SetPassword(user_record, "secret")
+
+		err := services.GetUserManager().SetUser(self.Ctx, user_record)
+		assert.NoError(self.T(), err)
+
+		err = services.GrantRoles(self.ConfigObj, user.name, []string{user.role})
+		assert.NoError(self.T(), err)
+	}
+}
+
+func (self *BasicMFATestSuite) TearDownTest() {
+	self.close()
+	self.TestSuite.TearDownTest()
+}
+
+func (self *BasicMFATestSuite) makeAuthenticator() *BasicAuthenticator {
+	authenticator, err := NewBasicAuthenticator(self.ConfigObj,
+		&config_proto.Authenticator{
+			Type:             "basic",
+			MfaRequiredRoles: []string{"investigator"},
+		})
+	assert.NoError(self.T(), err)
+	return authenticator
+}
+
+func (self *BasicMFATestSuite) enroll(authenticator *BasicAuthenticator,
+	username, password string) (*users.MFAEnrollment, int) {
+	mux := http.NewServeMux()
+	assert.NoError(self.T(), authenticator.AddHandlers(mux))
+
+	r := httptest.NewRequest("GET", authenticator.base+"auth/mfa/enroll", nil)
+	r.SetBasicAuth(username, password)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	enrollment := &users.MFAEnrollment{}
+	if w.Code == http.StatusOK {
+		assert.NoError(self.T(), json.Unmarshal(w.Body.Bytes(), enrollment))
+	}
+	return enrollment, w.Code
+}
+
+func (self *BasicMFATestSuite) currentCode(secret string) string {
+	code, err := users.GetTOTPCode(secret, users.GetTOTPStep(self.clock.Now()))
+	assert.NoError(self.T(), err)
+	return code
+}
+
+func (self *BasicMFATestSuite) TestNotRequired() {
+	authenticator := self.makeAuthenticator()
+
+	// Bob is not required to use a second factor.
+	assert.Equal(self.T(), http.StatusOK,
+		authenticatedRequest(authenticator, "bob", "secret").Code)
+	assert.Equal(self.T(), http.StatusUnauthorized,
+		authenticatedRequest(authenticator, "bob", "wrong").Code)
+
+	// While enrollment is pending the password is still enough.
+	enrollment, status := self.enroll(authenticator, "bob", "secret")
+	assert.Equal(self.T(), http.StatusOK, status)
+	assert.Equal(self.T(), http.StatusOK,
+		authenticatedRequest(authenticator, "bob", "secret").Code)
+
+	// Once confirmed the code is always required.
+	code := self.currentCode(enrollment.Secret)
+	assert.Equal(self.T(), http.StatusOK,
+		authenticatedRequest(authenticator, "bob", "secret"+code).Code)
+	assert.Equal(self.T(), http.StatusUnauthorized,
+		authenticatedRequest(authenticator, "bob", "secret").Code)
+}
+
+func (self *BasicMFATestSuite) TestRequired() {
+	authenticator := self.makeAuthenticator()
+
+	// Alice is an investigator so must enroll.
+	response := authenticatedRequest(authenticator, "alice", "secret")
+	assert.Equal(self.T(), http.StatusUnauthorized, response.Code)
+	assert.Contains(self.T(), response.Body.String(), "auth/mfa/enroll")
+
+	// Enrolling requires the correct password.
+	_, status := self.enroll(authenticator, "alice", "wrong")
+	assert.Equal(self.T(), http.StatusUnauthorized, status)
+
+	enrollment, status := self.enroll(authenticator, "alice", "secret")
+	assert.Equal(self.T(), http.StatusOK, status)
+	assert.Equal(self.T(), users.RECOVERY_CODE_COUNT, len(enrollment.RecoveryCodes))
+
+	// The password alone is not enough to confirm the enrollment.
+	assert.Equal(self.T(), http.StatusUnauthorized,
+		authenticatedRequest(authenticator, "alice", "secret").Code)
+
+	// Wrong code or password.
+	code := self.currentCode(enrollment.Secret)
+	assert.Equal(self.T(), http.StatusUnauthorized,
+		authenticatedRequest(authenticator, "alice", "secret000000").Code)
+	assert.Equal(self.T(), http.StatusUnauthorized,
+		authenticatedRequest(authenticator, "alice", "wrong"+code).Code)
+
+	// Password followed by the code confirms the enrollment.
+	assert.Equal(self.T(), http.StatusOK,
+		authenticatedRequest(authenticator, "alice", "secret"+code).Code)
+
+	mfa, err := services.GetUserManager().GetUserMFA(self.Ctx, "alice")
+	assert.NoError(self.T(), err)
+	assert.True(self.T(), mfa.Enabled)
+
+	// The browser keeps presenting the same credentials during
+	// the session.
+	self.clock.Sleep(10 * time.Minute)
+	assert.Equal(self.T(), http.StatusOK,
+		authenticatedRequest(authenticator, "alice", "secret"+code).Code)
+
+	// Can not enroll again without a reset.
+	_, status = self.enroll(authenticator, "alice", "secret")
+	assert.Equal(self.T(), http.StatusForbidden, status)
+
+	// A new session (e.g. server restarted) can not replay the
+	// old code.
+	authenticator = self.makeAuthenticator()
+	assert.Equal(self.T(), http.StatusUnauthorized,
+		authenticatedRequest(authenticator, "alice", "secret"+code).Code)
+
+	code = self.currentCode(enrollment.Secret)
+	assert.Equal(self.T(), http.StatusOK,
+		authenticatedRequest(authenticator, "alice", "secret"+code).Code)
+
+	// Recovery codes work only once.
+	recovery_code := enrollment.RecoveryCodes[0]
+	assert.Equal(self.T(), http.StatusOK,
+		authenticatedRequest(authenticator, "alice", "secret"+recovery_code).Code)
+
+	authenticator = self.makeAuthenticator()
+	assert.Equal(self.T(), http.StatusUnauthorized,
+		authenticatedRequest(authenticator, "alice", "secret"+recovery_code).Code)
+
+	// After a reset the user has to enroll again.
+	err = services.GetUserManager().SetUserMFA(self.Ctx, "alice", nil)
+	assert.NoError(self.T(), err)
+
+	assert.Equal(self.T(), http.StatusUnauthorized,
+		authenticatedRequest(authenticator, "alice", "secret").Code)
+	_, status = self.enroll(authenticator, "alice", "secret")
+	assert.Equal(self.T(), http.StatusOK, status)
+}
+
+// Concurrent logins must not lose each other's updates to the MFA
+// record.
+func (self *BasicMFATestSuite) TestConcurrentRecoveryCodes() {
+	authenticator := self.makeAuthenticator()
+
+	enrollment, status := self.enroll(authenticator, "alice", "secret")
+	assert.Equal(self.T(), http.StatusOK, status)
+
+	code := self.currentCode(enrollment.Secret)
+	assert.Equal(self.T(), http.StatusOK,
+		authenticatedRequest(authenticator, "alice", "secret"+code).Code)
+
+	// A login waits while another holds the user's record.
+	unlock := mfa_locks.Lock("alice")
+	done := make(chan int)
+	go func() {
+		done <- authenticatedRequest(authenticator, "alice",
+			"secret"+enrollment.RecoveryCodes[0]).Code
+	}()
+
+	waited := true
+	select {
+	case <-done:
+		waited = false
+	case <-time.After(100 * time.Millisecond):
+	}
+	unlock()
+	assert.True(self.T(), waited, "Login did not wait for the MFA lock")
+	if waited {
+		assert.Equal(self.T(), http.StatusOK, <-done)
+	}
+
+	wg := &sync.WaitGroup{}
+	for _, recovery_code := range enrollment.RecoveryCodes[1:] {
+		wg.Add(1)
+		go func(recovery_code string) {
+			defer wg.Done()
+
+			assert.Equal(self.T(), http.StatusOK,
+				authenticatedRequest(authenticator, "alice",
+					"secret"+recovery_code).Code)
+		}(recovery_code)
+	}
+	wg.Wait()
+
+	// All the codes were used up.
+	mfa, err := services.GetUserManager().GetUserMFA(self.Ctx, "alice")
+	assert.NoError(self.T(), err)
+	assert.Equal(self.T(), 0, len(mfa.RecoveryCodes))
+}
+
+func TestBasicMFA(t *testing.T) {
+	suite.Run(t, &BasicMFATestSuite{})
+}
@@ -0,0 +1,632 @@
+package authenticators
+
+// Authenticate users against an LDAP directory (e.g. Active
+// Directory or OpenLDAP).
+
+// The browser presents the credentials using basic auth. We search
+// the directory for the user, verify the password by binding as the
+// user and then map the user's group membership to roles in each org
+// using the ldap_group_mappings. Group membership is re-evaluated at
+// each login so removing a user from a group in the directory
+// removes the corresponding roles. Successful logins are cached for
+// a short time to avoid hitting the directory on every request.
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/gorilla/csrf"
+	"github.com/sirupsen/logrus"
+	"www.velocidex.com/golang/velociraptor/acls"
+	acl_proto "www.velocidex.com/golang/velociraptor/acls/proto"
+	api_proto "www.velocidex.com/golang/velociraptor/api/proto"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	"www.velocidex.com/golang/velociraptor/constants"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/logging"
+	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/utils"
+)
+
+const (
+	LDAP_DEFAULT_USER_FILTER     = "(uid=%s)"
+	LDAP_DEFAULT_GROUP_ATTRIBUTE = "memberOf"
+	LDAP_DEFAULT_CACHE_SEC       = 300
+	LDAP_DEFAULT_TIMEOUT         = 30 * time.Second
+	LDAP_MAX_SEARCH_RESULTS      = 100
+)
+
+var (
+	// Attributes compared with the username in the user filter,
+	// e.g. (uid=%s)
+	ldap_username_attribute_regex = regexp.MustCompile(`\(([^()=~<>:]+)=%s\)`)
+)
+
+type ldapCacheEntry struct {
+	// The username as stored in the directory.
+	username string
+	hash     [32]byte
+	expires  time.Time
+}
+
+type LDAPAuthenticator struct {
+	config_obj    *config_proto.Config
+	authenticator *config_proto.Authenticator
+	base          string
+
+	mu    sync.Mutex
+	salt  []byte
+	cache map[string]*ldapCacheEntry
+}
+
+func NewLDAPAuthenticator(
+	config_obj *config_proto.Config,
+	auth_config *config_proto.Authenticator) (*LDAPAuthenticator, error) {
+	if auth_config.LdapUrl == "" {
+		return nil, errors.New("LDAP authenticator: ldap_url must be specified")
+	}
+
+	if auth_config.LdapBaseDn == "" {
+		return nil, errors.New("LDAP authenticator: ldap_base_dn must be specified")
+	}
+
+	result := &LDAPAuthenticator{
+		config_obj:    config_obj,
+		authenticator: auth_config,
+		base:          getBasePath(config_obj),
+		salt:          make([]byte, 32),
+		cache:         make(map[string]*ldapCacheEntry),
+	}
+
+	// Make sure the filters are valid.
+	_, err := ldap.CompileFilter(result.userFilter("test"))
+	if err != nil {
+		return nil, fmt.Errorf("LDAP authenticator: ldap_user_filter: %w", err)
+	}
+
+	if len(result.usernameAttributes()) == 0 {
+		return nil, errors.New(
+			"LDAP authenticator: ldap_user_filter must compare an attribute with %s")
+	}
+
+	if auth_config.LdapGroupFilter != "" {
+		_, err := ldap.CompileFilter(result.groupFilter("cn=test"))
+		if err != nil {
+			return nil, fmt.Errorf("LDAP authenticator: ldap_group_filter: %w", err)
+		}
+	}
+
+	for _, mapping := range auth_config.LdapGroupMappings {
+		if mapping.Group == "" {
+			return nil, errors.New("LDAP authenticator: group mapping without a group")
+		}
+
+		for _, role := range mapping.Roles {
+			if !acls.ValidateRole(role) {
+				return nil, fmt.Errorf("LDAP authenticator: invalid role %v", role)
+			}
+		}
+	}
+
+	_, err = rand.Read(result.salt)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (self *LDAPAuthenticator) AddHandlers(mux *http.ServeMux) error {
+	return nil
+}
+
+func (self *LDAPAuthenticator) AddLogoff(mux *http.ServeMux) error {
+	installBasicLogoff(self.base, mux)
+	return nil
+}
+
+// Passwords are managed by the directory - we do not store them.
+func (self *LDAPAuthenticator) IsPasswordLess() bool {
+	return true
+}
+
+func (self *LDAPAuthenticator) AuthRedirectTemplate() string {
+	return ""
+}
+
+func (self *LDAPAuthenticator) AuthenticateUserHandler(
+	parent http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-CSRF-Token", csrf.Token(r))
+		w.Header().Set("WWW-Authenticate", `Basic realm="Restricted"`)
+
+		basic_username, password, ok := r.BasicAuth()
+		if !ok {
+			http.Error(w, "Not authorized", http.StatusUnauthorized)
+			return
+		}
+
+		// The directory usually matches usernames case
+		// insensitively so always use its spelling of the name.
+		username, ok := self.checkCache(basic_username, password)
+		if !ok {
+			var err error
+			username, err = self.login(r.Context(), basic_username, password)
+			if err != nil {
+				logging.LogAudit(self.config_obj, basic_username, "LDAP login failed",
+					logrus.Fields{
+						"remote": r.RemoteAddr,
+						"status": http.StatusUnauthorized,
+						"err":    err.Error(),
+					})
+
+				http.Error(w, "authorization failed", http.StatusUnauthorized)
+				return
+			}
+			self.addCache(basic_username, username, password)
+		}
+
+		users_manager := services.GetUserManager()
+		user_record, err := users_manager.GetUser(r.Context(), username)
+		if err != nil || user_record.Name != username {
+			http.Error(w, "authorization failed", http.StatusUnauthorized)
+			return
+		}
+
+		// Does the user have access to the specified org?
+		err = CheckOrgAccess(r, user_record)
+		if err != nil {
+			logging.LogAudit(self.config_obj, username, "Unauthorized username",
+				logrus.Fields{
+					"remote": r.RemoteAddr,
+					"status": http.StatusUnauthorized,
+				})
+
+			http.Error(w, "authorization failed", http.StatusUnauthorized)
+			return
+		}
+
+		user_info := &api_proto.VelociraptorUser{
+			Name: username,
+		}
+
+		// Must use json encoding because grpc can not handle
+		// binary data in metadata.
+		serialized, _ := json.Marshal(user_info)
+		ctx := context.WithValue(
+			r.Context(), constants.GRPC_USER_CONTEXT, string(serialized))
+
+		// Need to call logging after auth so it can access
+		// the USER value in the context.
+		GetLoggingHandler(self.config_obj)(parent).ServeHTTP(
+			w, r.WithContext(ctx))
+	})
+}
+
+func (self *LDAPAuthenticator) hashPassword(password string) [32]byte {
+	return sha256.Sum256(append(append([]byte{}, self.salt...), password...))
+}
+
+// Returns the directory's username if the credentials were recently
+// verified.
+func (self *LDAPAuthenticator) checkCache(
+	basic_username, password string) (string, bool) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	entry, pres := self.cache[basic_username]
+	if !pres {
+		return "", false
+	}
+
+	if utils.GetTime().Now().After(entry.expires) {
+		delete(self.cache, basic_username)
+		return "", false
+	}
+
+	hash := self.hashPassword(password)
+	if subtle.ConstantTimeCompare(hash[:], entry.hash[:]) != 1 {
+		return "", false
+	}
+	return entry.username, true
+}
+
+func (self *LDAPAuthenticator) addCache(
+	basic_username, username, password string) {
+	cache_sec := self.authenticator.LdapCacheSec
+	if cache_sec == 0 {
+		cache_sec = LDAP_DEFAULT_CACHE_SEC
+	}
+
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	self.cache[basic_username] = &ldapCacheEntry{
+		username: username,
+		hash:     self.hashPassword(password),
+		expires:  utils.GetTime().Now().Add(time.Duration(cache_sec) * time.Second),
+	}
+}
+
+func (self *LDAPAuthenticator) rawUserFilter() string {
+	if self.authenticator.LdapUserFilter != "" {
+		return self.authenticator.LdapUserFilter
+	}
+	return LDAP_DEFAULT_USER_FILTER
+}
+
+func (self *LDAPAuthenticator) userFilter(username string) string {
+	return strings.Replace(self.rawUserFilter(),
+		"%s", ldap.EscapeFilter(username), -1)
+}
+
+// The attributes which hold the username.
+func (self *LDAPAuthenticator) usernameAttributes() []string {
+	var result []string
+	for _, hit := range ldap_username_attribute_regex.FindAllStringSubmatch(
+		self.rawUserFilter(), -1) {
+		if !utils.InString(result, hit[1]) {
+			result = append(result, hit[1])
+		}
+	}
+	return result
+}
+
+func (self *LDAPAuthenticator) groupFilter(user_dn string) string {
+	return strings.Replace(self.authenticator.LdapGroupFilter,
+		"%s", ldap.EscapeFilter(user_dn), -1)
+}
+
+func (self *LDAPAuthenticator) groupAttribute() string {
+	if self.authenticator.LdapGroupAttribute != "" {
+		return self.authenticator.LdapGroupAttribute
+	}
+	return LDAP_DEFAULT_GROUP_ATTRIBUTE
+}
+
+// Verify the user's credentials against the directory and update
+// their roles from their current group membership. Returns the
+// username as stored in the directory.
+func (self *LDAPAuthenticator) login(
+	ctx context.Context, basic_username, password string) (string, error) {
+
+	// An empty password results in an unauthenticated bind which
+	// always succeeds (RFC 4513 section 5.1.2).
+	if basic_username == "" || password == "" {
+		return "", errors.New("Empty username or password")
+	}
+
+	username, groups, err := self.getUserGroups(basic_username, password)
+	if err != nil {
+		return "", err
+	}
+
+	roles := self.getRoles(groups)
+
+	err = self.updateUser(ctx, username, roles)
+	if err != nil {
+		return "", err
+	}
+
+	logging.LogAudit(self.config_obj, username, "LDAP login",
+		logrus.Fields{
+			"groups": groups,
+			"roles":  roles,
+		})
+
+	return username, nil
+}
+
+// Connect to the server specified in the authenticator config,
+// negotiating TLS as required.
+func dialLDAP(auth_config *config_proto.Authenticator) (*ldap.Conn, error) {
+	server_url, err := url.Parse(auth_config.LdapUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	tls_config, err := getLDAPTLSConfig(auth_config, server_url.Hostname())
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := ldap.DialURL(auth_config.LdapUrl,
+		ldap.DialWithDialer(&net.Dialer{Timeout: LDAP_DEFAULT_TIMEOUT}),
+		ldap.DialWithTLSConfig(tls_config))
+	if err != nil {
+		return nil, err
+	}
+	conn.SetTimeout(LDAP_DEFAULT_TIMEOUT)
+
+	if server_url.Scheme == "ldap" && auth_config.LdapStartTls {
+		err = conn.StartTLS(tls_config)
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	return conn, nil
+}
+
+func getLDAPTLSConfig(auth_config *config_proto.Authenticator,
+	hostname string) (*tls.Config, error) {
+	result := &tls.Config{
+		ServerName:         hostname,
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: auth_config.LdapSkipVerify,
+	}
+
+	if auth_config.LdapCaCertificate != "" {
+		result.RootCAs = x509.NewCertPool()
+		if !result.RootCAs.AppendCertsFromPEM(
+			[]byte(auth_config.LdapCaCertificate)) {
+			return nil, errors.New("LDAP: unable to parse ldap_ca_certificate")
+		}
+	}
+
+	return result, nil
+}
+
+func (self *LDAPAuthenticator) search(conn *ldap.Conn,
+	filter string, attributes []string) ([]*ldap.Entry, error) {
+	result, err := conn.Search(ldap.NewSearchRequest(
+		self.authenticator.LdapBaseDn,
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases,
+		LDAP_MAX_SEARCH_RESULTS, int(LDAP_DEFAULT_TIMEOUT.Seconds()),
+		false, filter, attributes, nil))
+	if err != nil {
+		return nil, err
+	}
+	return result.Entries, nil
+}
+
+// Authenticate the user. Returns the username as stored in the
+// directory and the DNs of the groups they are a member of.
+func (self *LDAPAuthenticator) getUserGroups(
+	basic_username, password string) (string, []string, error) {
+	conn, err := dialLDAP(self.authenticator)
+	if err != nil {
+		return "", nil, err
+	}
+	defer conn.Close()
+
+	// Bind as the service account to search for the user.
+	bind_to_service := func() error {
+		if self.authenticator.LdapBindDn == "" {
+			return nil
+		}
+		err := conn.Bind(self.authenticator.LdapBindDn,
+			self.authenticator.LdapBindPassword)
+		if err != nil {
+			return fmt.Errorf("LDAP: service account bind: %w", err)
+		}
+		return nil
+	}
+
+	err = bind_to_service()
+	if err != nil {
+		return "", nil, err
+	}
+
+	group_attribute := self.groupAttribute()
+	username_attributes := self.usernameAttributes()
+	entries, err := self.search(conn, self.userFilter(basic_username),
+		append([]string{group_attribute}, username_attributes...))
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(entries) == 0 {
+		return "", nil, errors.New("User not found in directory")
+	}
+
+	if len(entries) > 1 {
+		return "", nil, fmt.Errorf("User filter matched %v entries", len(entries))
+	}
+
+	user_entry := entries[0]
+	username := getLDAPUsername(user_entry, username_attributes, basic_username)
+	if username == "" {
+		return "", nil, errors.New("Username not returned by the directory")
+	}
+
+	err = conn.Bind(user_entry.DN, password)
+	if err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return "", nil, errors.New("Invalid password")
+		}
+		return "", nil, err
+	}
+
+	groups := user_entry.GetEqualFoldAttributeValues(group_attribute)
+
+	// Search for groups which list the user as a member.
+	if self.authenticator.LdapGroupFilter != "" {
+		err = bind_to_service()
+		if err != nil {
+			return "", nil, err
+		}
+
+		group_entries, err := self.search(conn,
+			self.groupFilter(user_entry.DN), []string{"cn"})
+		if err != nil {
+			return "", nil, err
+		}
+
+		for _, entry := range group_entries {
+			if !utils.InString(groups, entry.DN) {
+				groups = append(groups, entry.DN)
+			}
+		}
+	}
+
+	return username, groups, nil
+}
+
+// Find the directory's spelling of the username the user logged in
+// with.
+func getLDAPUsername(entry *ldap.Entry,
+	attributes []string, basic_username string) string {
+	for _, attribute := range attributes {
+		for _, value := range entry.GetEqualFoldAttributeValues(attribute) {
+			if strings.EqualFold(value, basic_username) {
+				return value
+			}
+		}
+	}
+	return ""
+}
+
+// Map the user's groups to roles in each org. All orgs mentioned
+// in the mappings are present in the result, so roles the user
+// no longer qualifies for are removed.
+func (self *LDAPAuthenticator) getRoles(groups []string) map[string][]string {
+	result := make(map[string][]string)
+
+	for _, mapping := range self.authenticator.LdapGroupMappings {
+		orgs := mapping.Orgs
+		if len(orgs) == 0 {
+			orgs = []string{"root"}
+		}
+
+		member := false
+		for _, group := range groups {
+			if ldapGroupMatches(mapping.Group, group) {
+				member = true
+				break
+			}
+		}
+
+		for _, org_id := range orgs {
+			roles := result[org_id]
+			if member {
+				for _, role := range mapping.Roles {
+					if !utils.InString(roles, role) {
+						roles = append(roles, role)
+					}
+				}
+			}
+			result[org_id] = roles
+		}
+	}
+
+	for _, roles := range result {
+		sort.Strings(roles)
+	}
+
+	return result
+}
+
+// Groups may be specified by their full DN or their common name.
+func ldapGroupMatches(mapping_group, group_dn string) bool {
+	if strings.EqualFold(mapping_group, group_dn) {
+		return true
+	}
+
+	first_rdn := strings.SplitN(group_dn, ",", 2)[0]
+	parts := strings.SplitN(first_rdn, "=", 2)
+	return len(parts) == 2 &&
+		strings.EqualFold(strings.TrimSpace(parts[0]), "cn") &&
+		strings.EqualFold(strings.TrimSpace(parts[1]), mapping_group)
+}
+
+// Merge the roles granted by the LDAP group mappings into the user's
+// existing policy. Roles previously granted by LDAP which are no
+// longer mapped are revoked, but permissions, scoped grants and roles
+// granted by other means are preserved.
+func updateLDAPRoles(
+	config_obj *config_proto.Config,
+	username string, ldap_roles []string) error {
+
+	policy, err := services.GetPolicy(config_obj, username)
+	if err != nil {
+		policy = &acl_proto.ApiClientACL{}
+	}
+
+	// Do not revoke roles LDAP did not grant.
+	roles := make([]string, 0, len(policy.Roles))
+	for _, role := range policy.Roles {
+		if utils.InString(policy.LdapRoles, role) &&
+			!utils.InString(ldap_roles, role) {
+			continue
+		}
+		roles = append(roles, role)
+	}
+
+	// Only track roles which were not already granted by other
+	// means, otherwise losing the LDAP group would revoke them.
+	new_ldap_roles := []string{}
+	for _, role := range ldap_roles {
+		if !acls.ValidateRole(role) {
+			return fmt.Errorf("Invalid role %v", role)
+		}
+
+		if utils.InString(policy.LdapRoles, role) ||
+			!utils.InString(roles, role) {
+			new_ldap_roles = append(new_ldap_roles, role)
+		}
+
+		if !utils.InString(roles, role) {
+			roles = append(roles, role)
+		}
+	}
+
+	policy.Roles = roles
+	policy.LdapRoles = new_ldap_roles
+
+	return services.SetPolicy(config_obj, username, policy)
+}
+
+// Apply the roles to the user, creating the user if needed.
+func (self *LDAPAuthenticator) updateUser(ctx context.Context,
+	username string, roles map[string][]string) error {
+
+	org_manager, err := services.GetOrgManager()
+	if err != nil {
+		return err
+	}
+
+	org_ids := make([]string, 0, len(roles))
+	for org_id := range roles {
+		org_ids = append(org_ids, org_id)
+	}
+	sort.Strings(org_ids)
+
+	for _, org_id := range org_ids {
+		org_config_obj, err := org_manager.GetOrgConfig(org_id)
+		if err != nil {
+			return err
+		}
+
+		err = updateLDAPRoles(org_config_obj, username, roles[org_id])
+		if err != nil {
+			return err
+		}
+	}
+
+	users_manager := services.GetUserManager()
+	_, err = users_manager.GetUser(ctx, username)
+	if err == nil {
+		return nil
+	}
+
+	return users_manager.SetUser(ctx, &api_proto.VelociraptorUser{
+		Name: username,
+	})
+}
@@ -0,0 +1,594 @@
+package authenticators
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"www.velocidex.com/golang/velociraptor/acls"
+	acl_proto "www.velocidex.com/golang/velociraptor/acls/proto"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	"www.velocidex.com/golang/velociraptor/file_store/test_utils"
+	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/utils"
+)
+
+// An in-process LDAP server implementing just enough of the protocol
+// to test the authenticator.
+type testLDAPServer struct {
+	listener   net.Listener
+	tls_config *tls.Config
+
+	mu        sync.Mutex
+	entries   []*ldap.Entry
+	passwords map[string]string
+	searches  int
+}
+
+func newTestLDAPServer(t *testing.T, use_tls bool) (*testLDAPServer, string) {
+	cert_pem, tls_config := makeTestCertificate(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	if use_tls {
+		listener = tls.NewListener(listener, tls_config)
+	}
+
+	self := &testLDAPServer{
+		listener:   listener,
+		tls_config: tls_config,
+		passwords: map[string]string{
+			"cn=service,dc=example,dc=com": "service_password",
+		},
+	}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go self.handleConnection(conn)
+		}
+	}()
+
+	return self, cert_pem
+}
+
+func (self *testLDAPServer) Close() {
+	self.listener.Close()
+}
+
+func (self *testLDAPServer) URL(scheme string) string {
+	return scheme + "://" + self.listener.Addr().String()
+}
+
+func (self *testLDAPServer) AddEntry(dn, password string, attributes map[string][]string) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	self.entries = append(self.entries, ldap.NewEntry(dn, attributes))
+
+	if password != "" {
+		self.passwords[dn] = password
+	}
+}
+
+func (self *testLDAPServer) SetAttribute(dn, name string, values []string) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	for _, entry := range self.entries {
+		if entry.DN != dn {
+			continue
+		}
+
+		for _, attr := range entry.Attributes {
+			if strings.EqualFold(attr.Name, name) {
+				attr.Values = values
+			}
+		}
+	}
+}
+
+func (self *testLDAPServer) Searches() int {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	return self.searches
+}
+
+func berValue(packet *ber.Packet) string {
+	return packet.Data.String()
+}
+
+func (self *testLDAPServer) handleConnection(conn net.Conn) {
+	defer func() { conn.Close() }()
+
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+
+		id := packet.Children[0].Value
+		op := packet.Children[1]
+		respond := func(op *ber.Packet) {
+			envelope := ber.Encode(ber.ClassUniversal, ber.TypeConstructed,
+				ber.TagSequence, nil, "Response")
+			envelope.AppendChild(ber.NewInteger(ber.ClassUniversal,
+				ber.TypePrimitive, ber.TagInteger, id, "MessageID"))
+			envelope.AppendChild(op)
+			conn.Write(envelope.Bytes())
+		}
+		result := func(tag ber.Tag, code int) *ber.Packet {
+			result := ber.Encode(ber.ClassApplication, ber.TypeConstructed,
+				tag, nil, "Result")
+			result.AppendChild(ber.NewInteger(ber.ClassUniversal,
+				ber.TypePrimitive, ber.TagEnumerated, code, "Code"))
+			result.AppendChild(ber.NewString(ber.ClassUniversal,
+				ber.TypePrimitive, ber.TagOctetString, "", "MatchedDN"))
+			result.AppendChild(ber.NewString(ber.ClassUniversal,
+				ber.TypePrimitive, ber.TagOctetString, "", "Message"))
+			return result
+		}
+
+		switch op.Tag {
+		case ldap.ApplicationBindRequest:
+			dn := berValue(op.Children[1])
+			password := berValue(op.Children[2])
+
+			self.mu.Lock()
+			expected, pres := self.passwords[dn]
+			self.mu.Unlock()
+
+			code := ldap.LDAPResultInvalidCredentials
+			if pres && password == expected {
+				code = ldap.LDAPResultSuccess
+			}
+			respond(result(ldap.ApplicationBindResponse, code))
+
+		case ldap.ApplicationExtendedRequest:
+			respond(result(ldap.ApplicationExtendedResponse, ldap.LDAPResultSuccess))
+			tls_conn := tls.Server(conn, self.tls_config)
+			if tls_conn.Handshake() != nil {
+				return
+			}
+			conn = tls_conn
+
+		case ldap.ApplicationSearchRequest:
+			filter := op.Children[6]
+
+			var attributes []string
+			for _, attr := range op.Children[7].Children {
+				attributes = append(attributes, berValue(attr))
+			}
+
+			self.mu.Lock()
+			self.searches++
+			var matching []*ldap.Entry
+			for _, entry := range self.entries {
+				if testEvaluateFilter(filter, entry) {
+					matching = append(matching, entry)
+				}
+			}
+			self.mu.Unlock()
+
+			for _, entry := range matching {
+				response := ber.Encode(ber.ClassApplication, ber.TypeConstructed,
+					ldap.ApplicationSearchResultEntry, nil, "Entry")
+				response.AppendChild(ber.NewString(ber.ClassUniversal,
+					ber.TypePrimitive, ber.TagOctetString, entry.DN, "DN"))
+
+				attrs := ber.Encode(ber.ClassUniversal, ber.TypeConstructed,
+					ber.TagSequence, nil, "Attributes")
+				for _, name := range attributes {
+					attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed,
+						ber.TagSequence, nil, "Attribute")
+					attr.AppendChild(ber.NewString(ber.ClassUniversal,
+						ber.TypePrimitive, ber.TagOctetString, name, "Name"))
+
+					values := ber.Encode(ber.ClassUniversal, ber.TypeConstructed,
+						ber.TagSet, nil, "Values")
+					for _, value := range entry.GetEqualFoldAttributeValues(name) {
+						values.AppendChild(ber.NewString(ber.ClassUniversal,
+							ber.TypePrimitive, ber.TagOctetString, value, "Value"))
+					}
+					attr.AppendChild(values)
+					attrs.AppendChild(attr)
+				}
+				response.AppendChild(attrs)
+				respond(response)
+			}
+			respond(result(ldap.ApplicationSearchResultDone, ldap.LDAPResultSuccess))
+
+		case ldap.ApplicationUnbindRequest:
+			return
+		}
+	}
+}
+
+func testEvaluateFilter(filter *ber.Packet, entry *ldap.Entry) bool {
+	switch filter.Tag {
+	case ldap.FilterAnd:
+		for _, child := range filter.Children {
+			if !testEvaluateFilter(child, entry) {
+				return false
+			}
+		}
+		return true
+
+	case ldap.FilterOr:
+		for _, child := range filter.Children {
+			if testEvaluateFilter(child, entry) {
+				return true
+			}
+		}
+		return false
+
+	case ldap.FilterNot:
+		return !testEvaluateFilter(filter.Children[0], entry)
+
+	case ldap.FilterEqualityMatch:
+		for _, value := range entry.GetEqualFoldAttributeValues(
+			berValue(filter.Children[0])) {
+			if strings.EqualFold(value, berValue(filter.Children[1])) {
+				return true
+			}
+		}
+		return false
+
+	case ldap.FilterPresent:
+		return len(entry.GetEqualFoldAttributeValues(berValue(filter))) > 0
+	}
+
+	return false
+}
+
+func makeTestCertificate(t *testing.T) (string, *tls.Config) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test LDAP CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template,
+		&key.PublicKey, key)
+	assert.NoError(t, err)
+
+	cert_pem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	return string(cert_pem), &tls.Config{
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{der},
+			PrivateKey:  key,
+		}},
+	}
+}
+
+type LDAPTestSuite struct {
+	test_utils.TestSuite
+
+	server  *testLDAPServer
+	ca_cert string
+}
+
+func (self *LDAPTestSuite) SetupTest() {
+	self.TestSuite.SetupTest()
+
+	self.server, self.ca_cert = newTestLDAPServer(self.T(), false)
+	self.server.AddEntry("uid=alice,ou=people,dc=example,dc=com", "alice_password",
+		map[string][]string{
+			"objectClass": {"person"},
+			"uid":         {"alice"},
+			"memberOf": {
+				"cn=soc-admins,ou=groups,dc=example,dc=com",
+				"cn=other,ou=groups,dc=example,dc=com",
+			},
+		})
+	self.server.AddEntry("uid=bob,ou=people,dc=example,dc=com", "bob_password",
+		map[string][]string{
+			"objectClass": {"person"},
+			"uid":         {"bob"},
+		})
+	self.server.AddEntry("cn=soc-analysts,ou=groups,dc=example,dc=com", "",
+		map[string][]string{
+			"objectClass": {"groupOfNames"},
+			"member":      {"uid=bob,ou=people,dc=example,dc=com"},
+		})
+}
+
+func (self *LDAPTestSuite) TearDownTest() {
+	self.server.Close()
+	self.TestSuite.TearDownTest()
+}
+
+func (self *LDAPTestSuite) authConfig() *config_proto.Authenticator {
+	return &config_proto.Authenticator{
+		Type:              "ldap",
+		LdapUrl:           self.server.URL("ldap"),
+		LdapStartTls:      true,
+		LdapCaCertificate: self.ca_cert,
+		LdapBindDn:        "cn=service,dc=example,dc=com",
+		LdapBindPassword:  "service_password",
+		LdapBaseDn:        "dc=example,dc=com",
+		LdapUserFilter:    "(&(objectClass=person)(uid=%s))",
+		LdapGroupFilter:   "(&(objectClass=groupOfNames)(member=%s))",
+		LdapGroupMappings: []*config_proto.LDAPGroupMapping{{
+			Group: "cn=soc-admins,ou=groups,dc=example,dc=com",
+			Roles: []string{"administrator"},
+		}, {
+			Group: "soc-analysts",
+			Roles: []string{"analyst", "investigator"},
+		}},
+	}
+}
+
+// Make a request through the authenticator and return the response.
+func authenticatedRequest(
+	authenticator Authenticator, username, password string) *httptest.ResponseRecorder {
+	handler := authenticator.AuthenticateUserHandler(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+
+	r := httptest.NewRequest("GET", "/app/index.html", nil)
+	r.SetBasicAuth(username, password)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	return w
+}
+
+func (self *LDAPTestSuite) request(
+	authenticator Authenticator, username, password string) int {
+	return authenticatedRequest(authenticator, username, password).Code
+}
+
+func (self *LDAPTestSuite) checkPermission(
+	username string, permission acls.ACL_PERMISSION) bool {
+	ok, _ := services.CheckAccess(self.ConfigObj, username, permission)
+	return ok
+}
+
+func (self *LDAPTestSuite) TestLogin() {
+	authenticator, err := NewLDAPAuthenticator(self.ConfigObj, self.authConfig())
+	assert.NoError(self.T(), err)
+
+	// Wrong password or unknown users are rejected.
+	assert.Equal(self.T(), http.StatusUnauthorized,
+		self.request(authenticator, "alice", "wrong"))
+	assert.Equal(self.T(), http.StatusUnauthorized,
+		self.request(authenticator, "alice", ""))
+	assert.Equal(self.T(), http.StatusUnauthorized,
+		self.request(authenticator, "nobody", "alice_password"))
+
+	// Filter injection does not match other users.
+	assert.Equal(self.T(), http.StatusUnauthorized,
+		self.request(authenticator, "*", "alice_password"))
+
+	// Alice is an administrator through her memberOf attribute.
+	assert.Equal(self.T(), http.StatusOK,
+		self.request(authenticator, "alice", "alice_password"))
+	assert.True(self.T(), self.checkPermission("alice", acls.SERVER_ADMIN))
+
+	// Bob is an analyst through the group search.
+	assert.Equal(self.T(), http.StatusOK,
+		self.request(authenticator, "bob", "bob_password"))
+	assert.True(self.T(), self.checkPermission("bob", acls.COLLECT_CLIENT))
+	assert.False(self.T(), self.checkPermission("bob", acls.SERVER_ADMIN))
+
+	user_record, err := services.GetUserManager().GetUser(self.Ctx, "bob")
+	assert.NoError(self.T(), err)
+	assert.Equal(self.T(), "bob", user_record.Name)
+}
+
+// The directory matches usernames case insensitively but the user is
+// always known by the directory's spelling of the name.
+func (self *LDAPTestSuite) TestCanonicalUsername() {
+	authenticator, err := NewLDAPAuthenticator(self.ConfigObj, self.authConfig())
+	assert.NoError(self.T(), err)
+
+	var seen_user string
+	handler := authenticator.AuthenticateUserHandler(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user_info := GetUserInfo(r.Context(), self.ConfigObj)
+			seen_user = user_info.Name
+		}))
+
+	for _, username := range []string{"ALICE", "Alice"} {
+		r := httptest.NewRequest("GET", "/app/index.html", nil)
+		r.SetBasicAuth(username, "alice_password")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+
+		assert.Equal(self.T(), http.StatusOK, w.Code)
+		assert.Equal(self.T(), "alice", seen_user)
+	}
+
+	assert.True(self.T(), self.checkPermission("alice", acls.SERVER_ADMIN))
+	assert.False(self.T(), self.checkPermission("Alice", acls.SERVER_ADMIN))
+
+	_, err = services.GetUserManager().GetUser(self.Ctx, "Alice")
+	assert.Error(self.T(), err)
+}
+
+func (self *LDAPTestSuite) TestGroupMembershipReevaluated() {
+	clock := &utils.MockClock{MockNow: time.Unix(100, 0)}
+	closer := utils.MockTime(clock)
+	defer closer()
+
+	authenticator, err := NewLDAPAuthenticator(self.ConfigObj, self.authConfig())
+	assert.NoError(self.T(), err)
+
+	assert.Equal(self.T(), http.StatusOK,
+		self.request(authenticator, "alice", "alice_password"))
+	assert.True(self.T(), self.checkPermission("alice", acls.SERVER_ADMIN))
+	searches := self.server.Searches()
+
+	// Subsequent requests are served from the cache.
+	assert.Equal(self.T(), http.StatusOK,
+		self.request(authenticator, "alice", "alice_password"))
+	assert.Equal(self.T(), searches, self.server.Searches())
+
+	// Alice is removed from the admin group - once the cache
+	// expires she loses her roles and is no longer allowed in.
+	self.server.SetAttribute("uid=alice,ou=people,dc=example,dc=com",
+		"memberOf", nil)
+
+	clock.Sleep((LDAP_DEFAULT_CACHE_SEC + 1) * time.Second)
+
+	assert.Equal(self.T(), http.StatusUnauthorized,
+		self.request(authenticator, "alice", "alice_password"))
+	assert.False(self.T(), self.checkPermission("alice", acls.SERVER_ADMIN))
+	assert.True(self.T(), self.server.Searches() > searches)
+}
+
+// Logging in only adds or revokes the roles granted by LDAP and
+// preserves the rest of the user's policy.
+func (self *LDAPTestSuite) TestExistingPolicyPreserved() {
+	clock := &utils.MockClock{MockNow: time.Unix(100, 0)}
+	closer := utils.MockTime(clock)
+	defer closer()
+
+	err := services.SetPolicy(self.ConfigObj, "alice", &acl_proto.ApiClientACL{
+		Roles:           []string{"reader", "administrator"},
+		FilesystemWrite: true,
+		ScopedGrants: []*acl_proto.ScopedGrant{{
+			Permissions: []string{"COLLECT_CLIENT"},
+			Labels:      []string{"workstations"},
+		}},
+	})
+	assert.NoError(self.T(), err)
+
+	authenticator, err := NewLDAPAuthenticator(self.ConfigObj, self.authConfig())
+	assert.NoError(self.T(), err)
+
+	assert.Equal(self.T(), http.StatusOK,
+		self.request(authenticator, "alice", "alice_password"))
+
+	policy, err := services.GetPolicy(self.ConfigObj, "alice")
+	assert.NoError(self.T(), err)
+	assert.Equal(self.T(), []string{"reader", "administrator"}, policy.Roles)
+	assert.True(self.T(), policy.FilesystemWrite)
+	assert.Equal(self.T(), 1, len(policy.ScopedGrants))
+
+	// Administrator was granted manually so it is not tracked as
+	// an LDAP role.
+	assert.Empty(self.T(), policy.LdapRoles)
+
+	// Bob gets his roles from LDAP.
+	err = services.SetPolicy(self.ConfigObj, "bob", &acl_proto.ApiClientACL{
+		Roles: []string{"reader"},
+	})
+	assert.NoError(self.T(), err)
+
+	assert.Equal(self.T(), http.StatusOK,
+		self.request(authenticator, "bob", "bob_password"))
+
+	policy, err = services.GetPolicy(self.ConfigObj, "bob")
+	assert.NoError(self.T(), err)
+	assert.Equal(self.T(), []string{"reader", "analyst", "investigator"},
+		policy.Roles)
+	assert.Equal(self.T(), []string{"analyst", "investigator"}, policy.LdapRoles)
+
+	// Once Bob leaves the group only the LDAP roles are revoked.
+	self.server.SetAttribute("cn=soc-analysts,ou=groups,dc=example,dc=com",
+		"member", nil)
+	clock.Sleep((LDAP_DEFAULT_CACHE_SEC + 1) * time.Second)
+
+	assert.Equal(self.T(), http.StatusOK,
+		self.request(authenticator, "bob", "bob_password"))
+
+	policy, err = services.GetPolicy(self.ConfigObj, "bob")
+	assert.NoError(self.T(), err)
+	assert.Equal(self.T(), []string{"reader"}, policy.Roles)
+	assert.Empty(self.T(), policy.LdapRoles)
+}
+
+func (self *LDAPTestSuite) TestLDAPS() {
+	server, ca_cert := newTestLDAPServer(self.T(), true)
+	defer server.Close()
+
+	server.AddEntry("uid=carol,dc=example,dc=com", "carol_password",
+		map[string][]string{
+			"uid":      {"carol"},
+			"memberOf": {"cn=Readers,dc=example,dc=com"},
+		})
+
+	// Search anonymously.
+	auth_config := &config_proto.Authenticator{
+		Type:              "ldap",
+		LdapUrl:           server.URL("ldaps"),
+		LdapCaCertificate: ca_cert,
+		LdapBaseDn:        "dc=example,dc=com",
+		LdapGroupMappings: []*config_proto.LDAPGroupMapping{{
+			Group: "readers",
+			Roles: []string{"reader"},
+		}},
+	}
+
+	authenticator, err := NewLDAPAuthenticator(self.ConfigObj, auth_config)
+	assert.NoError(self.T(), err)
+
+	assert.Equal(self.T(), http.StatusOK,
+		self.request(authenticator, "carol", "carol_password"))
+	assert.True(self.T(), self.checkPermission("carol", acls.READ_RESULTS))
+	assert.False(self.T(), self.checkPermission("carol", acls.COLLECT_CLIENT))
+
+	// A server certificate signed by an unknown CA is rejected.
+	auth_config.LdapCaCertificate = self.ca_cert
+	authenticator, err = NewLDAPAuthenticator(self.ConfigObj, auth_config)
+	assert.NoError(self.T(), err)
+
+	assert.Equal(self.T(), http.StatusUnauthorized,
+		self.request(authenticator, "carol", "carol_password"))
+}
+
+func (self *LDAPTestSuite) TestInvalidConfig() {
+	auth_config := self.authConfig()
+	auth_config.LdapUserFilter = "(uid=%s"
+	_, err := NewLDAPAuthenticator(self.ConfigObj, auth_config)
+	assert.Error(self.T(), err)
+
+	// The username must be compared with an attribute.
+	auth_config = self.authConfig()
+	auth_config.LdapUserFilter = "(objectClass=person)"
+	_, err = NewLDAPAuthenticator(self.ConfigObj, auth_config)
+	assert.Error(self.T(), err)
+
+	auth_config = self.authConfig()
+	auth_config.LdapGroupMappings[0].Roles = []string{"no_such_role"}
+	_, err = NewLDAPAuthenticator(self.ConfigObj, auth_config)
+	assert.Error(self.T(), err)
+}
+
+func TestLDAP(t *testing.T) {
+	suite.Run(t, &LDAPTestSuite{})
+}
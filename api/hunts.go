@@ -17,6 +17,7 @@ import (
 	"www.velocidex.com/golang/velociraptor/logging"
 	"www.velocidex.com/golang/velociraptor/services"
 	"www.velocidex.com/golang/velociraptor/services/hunt_dispatcher"
+	"www.velocidex.com/golang/velociraptor/services/hunt_manager"
 	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
 	"www.velocidex.com/golang/velociraptor/vql/acl_managers"
 )
@@ -32,8 +33,8 @@ func (self *ApiServer) GetHuntFlows(
 	}
 	principal := user_record.Name
 
-	permissions := acls.READ_RESULTS
-	perm, err := services.CheckAccess(org_config_obj, principal, permissions)
+	perm, err := checkResultsAccess(ctx, org_config_obj, principal,
+		"", "", in.HuntId)
 	if !perm || err != nil {
 		return nil, status.Error(codes.PermissionDenied,
 			"User is not allowed to view hunt results.")
@@ -108,7 +109,8 @@ func (self *ApiServer) CreateHunt(
 	acl_manager := acl_managers.NewServerACLManager(org_config_obj, in.Creator)
 
 	permissions := acls.COLLECT_CLIENT
-	perm, err := services.CheckAccess(org_config_obj, in.Creator, permissions)
+	perm, err := services.CheckAccessWithResource(ctx, org_config_obj,
+		in.Creator, hunt_dispatcher.GetHuntResource(in), permissions)
 	if !perm || err != nil {
 		return nil, status.Error(codes.PermissionDenied,
 			"User is not allowed to launch hunts.")
@@ -144,7 +146,8 @@ func (self *ApiServer) CreateHunt(
 		}
 
 		// Make sure the user is allowed to collect in that org
-		perm, err := services.CheckAccess(org_config_obj, in.Creator,
+		perm, err := services.CheckAccessWithResource(ctx, org_config_obj,
+			in.Creator, hunt_dispatcher.GetHuntResource(in),
 			acls.COLLECT_CLIENT)
 		if !perm || err != nil {
 			logger.Error("CreateHunt: User is not allowed to launch hunts in "+
@@ -202,8 +205,21 @@ func (self *ApiServer) ModifyHunt(
 
 	in.Creator = principal
 
+	hunt_dispatcher_service, err := services.GetHuntDispatcher(org_config_obj)
+	if err != nil {
+		return nil, Status(self.verbose, err)
+	}
+
+	// Scoped grants are checked against the existing hunt.
+	var resource *acls.Resource
+	hunt, pres := hunt_dispatcher_service.GetHunt(in.HuntId)
+	if pres {
+		resource = hunt_dispatcher.GetHuntResource(hunt)
+	}
+
 	permissions := acls.COLLECT_CLIENT
-	perm, err := services.CheckAccess(org_config_obj, in.Creator, permissions)
+	perm, err := services.CheckAccessWithResource(ctx, org_config_obj,
+		principal, resource, permissions)
 	if !perm || err != nil {
 		return nil, status.Error(codes.PermissionDenied,
 			"User is not allowed to modify hunts.")
@@ -215,12 +231,7 @@ func (self *ApiServer) ModifyHunt(
 			"details": json.MustMarshalString(in),
 		})
 
-	hunt_dispatcher, err := services.GetHuntDispatcher(org_config_obj)
-	if err != nil {
-		return nil, Status(self.verbose, err)
-	}
-
-	err = hunt_dispatcher.ModifyHunt(ctx, org_config_obj, in, in.Creator)
+	err = hunt_dispatcher_service.ModifyHunt(ctx, org_config_obj, in, in.Creator)
 	if err != nil {
 		return nil, Status(self.verbose, err)
 	}
@@ -242,13 +253,24 @@ func (self *ApiServer) ListHunts(
 	}
 	principal := user_record.Name
 
+	// Users with only scoped grants see the hunts they may read.
 	permissions := acls.READ_RESULTS
 	perm, err := services.CheckAccess(org_config_obj, principal, permissions)
-	if !perm || err != nil {
+	if err != nil {
 		return nil, status.Error(codes.PermissionDenied,
 			"User is not allowed to view hunts.")
 	}
 
+	scoped := false
+	if !perm {
+		policy, err := services.GetEffectivePolicy(org_config_obj, principal)
+		if err != nil || !acls.HasScopedGrant(policy, permissions) {
+			return nil, status.Error(codes.PermissionDenied,
+				"User is not allowed to view hunts.")
+		}
+		scoped = true
+	}
+
 	hunt_dispatcher, err := services.GetHuntDispatcher(org_config_obj)
 	if err != nil {
 		return nil, Status(self.verbose, err)
@@ -260,6 +282,11 @@ func (self *ApiServer) ListHunts(
 		return nil, Status(self.verbose, err)
 	}
 
+	if scoped {
+		result.Items = filterReadableHunts(
+			ctx, org_config_obj, principal, result.Items)
+	}
+
 	// Provide only a summary for list hunts GUI
 	if in.Summary {
 		summary := &api_proto.ListHuntsResponse{}
@@ -273,6 +300,10 @@ func (self *ApiServer) ListHunts(
 				StartTime:       item.StartTime,
 				Stats:           item.Stats,
 				Expires:         item.Expires,
+				Schedule:        item.Schedule,
+				ParentHuntId:    item.ParentHuntId,
+				ChildHuntIds:    item.ChildHuntIds,
+				Rollout:         item.Rollout,
 			})
 		}
 
@@ -298,8 +329,8 @@ func (self *ApiServer) GetHunt(
 	}
 	principal := user_record.Name
 
-	permissions := acls.READ_RESULTS
-	perm, err := services.CheckAccess(org_config_obj, principal, permissions)
+	perm, err := checkResultsAccess(ctx, org_config_obj, principal,
+		"", "", in.HuntId)
 	if !perm || err != nil {
 		return nil, status.Error(codes.PermissionDenied,
 			"User is not allowed to view hunts.")
@@ -331,8 +362,8 @@ func (self *ApiServer) GetHuntResults(
 	}
 	principal := user_record.Name
 
-	permissions := acls.READ_RESULTS
-	perm, err := services.CheckAccess(org_config_obj, principal, permissions)
+	perm, err := checkResultsAccess(ctx, org_config_obj, principal,
+		"", "", in.HuntId)
 	if !perm || err != nil {
 		return nil, status.Error(codes.PermissionDenied,
 			"User is not allowed to view results.")
@@ -399,6 +430,29 @@ func (self *ApiServer) EstimateHunt(
 		}
 	}
 
+	// Condition trees can refer to arbitrary client properties so we
+	// need to evaluate them on every client.
+	if in.Condition != nil && in.Condition.Expression != nil {
+		err := hunt_manager.ValidateHuntCondition(in.Condition)
+		if err != nil {
+			return nil, Status(self.verbose, err)
+		}
+
+		seen := make(map[string]bool)
+		for hit := range indexer.SearchIndexWithPrefix(ctx, org_config_obj, "all") {
+			client_id := hit.Entity
+			matched, err := hunt_manager.ClientMatchesCondition(
+				ctx, org_config_obj, in.Condition, principal, client_id)
+			if err == nil && matched {
+				is_client_recent(client_id, seen)
+			}
+		}
+
+		return &api_proto.HuntStats{
+			TotalClientsScheduled: uint64(len(seen)),
+		}, nil
+	}
+
 	if in.Condition != nil {
 		labels := in.Condition.GetLabels()
 		if labels != nil && len(labels.Label) > 0 {
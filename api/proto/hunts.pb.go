@@ -123,7 +123,7 @@ func (x Hunt_State) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Hunt_State.Descriptor instead.
 func (Hunt_State) EnumDescriptor() ([]byte, []int) {
-	return file_hunts_proto_rawDescGZIP(), []int{4, 0}
+	return file_hunts_proto_rawDescGZIP(), []int{6, 0}
 }
 
 type HuntLabelCondition struct {
@@ -220,12 +220,194 @@ func (x *HuntOsCondition) GetOs() HuntOsCondition_OS {
 	return HuntOsCondition_ALL
 }
 
+type HuntMetadataCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// If empty, the condition matches when the key is set.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *HuntMetadataCondition) Reset() {
+	*x = HuntMetadataCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hunts_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HuntMetadataCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HuntMetadataCondition) ProtoMessage() {}
+
+func (x *HuntMetadataCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_hunts_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HuntMetadataCondition.ProtoReflect.Descriptor instead.
+func (*HuntMetadataCondition) Descriptor() ([]byte, []int) {
+	return file_hunts_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HuntMetadataCondition) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HuntMetadataCondition) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// A composable condition tree. All the fields that are set in a node
+// must match (i.e. they are combined with AND). An empty node always
+// matches.
+type HuntConditionExpression struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// All sub-expressions must match.
+	And []*HuntConditionExpression `protobuf:"bytes,1,rep,name=and,proto3" json:"and,omitempty"`
+	// At least one sub-expression must match.
+	Or []*HuntConditionExpression `protobuf:"bytes,2,rep,name=or,proto3" json:"or,omitempty"`
+	// The sub-expression must not match.
+	Not *HuntConditionExpression `protobuf:"bytes,3,opt,name=not,proto3" json:"not,omitempty"`
+	// The client has any of these labels.
+	Labels *HuntLabelCondition `protobuf:"bytes,4,opt,name=labels,proto3" json:"labels,omitempty"`
+	Os     *HuntOsCondition    `protobuf:"bytes,5,opt,name=os,proto3" json:"os,omitempty"`
+	// The client metadata has this key and value.
+	Metadata *HuntMetadataCondition `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// A regex matched against the client's hostname or fqdn.
+	HostnameRegex string `protobuf:"bytes,7,opt,name=hostname_regex,json=hostnameRegex,proto3" json:"hostname_regex,omitempty"`
+	// The client was seen within this many seconds.
+	LastSeenSec uint64 `protobuf:"varint,8,opt,name=last_seen_sec,json=lastSeenSec,proto3" json:"last_seen_sec,omitempty"`
+	// A VQL expression evaluated on the server. The following
+	// variables are available: ClientId, Hostname, Fqdn, OS, Labels,
+	// Metadata, LastSeen and ClientInfo.
+	Vql string `protobuf:"bytes,9,opt,name=vql,proto3" json:"vql,omitempty"`
+}
+
+func (x *HuntConditionExpression) Reset() {
+	*x = HuntConditionExpression{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hunts_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HuntConditionExpression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HuntConditionExpression) ProtoMessage() {}
+
+func (x *HuntConditionExpression) ProtoReflect() protoreflect.Message {
+	mi := &file_hunts_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HuntConditionExpression.ProtoReflect.Descriptor instead.
+func (*HuntConditionExpression) Descriptor() ([]byte, []int) {
+	return file_hunts_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HuntConditionExpression) GetAnd() []*HuntConditionExpression {
+	if x != nil {
+		return x.And
+	}
+	return nil
+}
+
+func (x *HuntConditionExpression) GetOr() []*HuntConditionExpression {
+	if x != nil {
+		return x.Or
+	}
+	return nil
+}
+
+func (x *HuntConditionExpression) GetNot() *HuntConditionExpression {
+	if x != nil {
+		return x.Not
+	}
+	return nil
+}
+
+func (x *HuntConditionExpression) GetLabels() *HuntLabelCondition {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *HuntConditionExpression) GetOs() *HuntOsCondition {
+	if x != nil {
+		return x.Os
+	}
+	return nil
+}
+
+func (x *HuntConditionExpression) GetMetadata() *HuntMetadataCondition {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *HuntConditionExpression) GetHostnameRegex() string {
+	if x != nil {
+		return x.HostnameRegex
+	}
+	return ""
+}
+
+func (x *HuntConditionExpression) GetLastSeenSec() uint64 {
+	if x != nil {
+		return x.LastSeenSec
+	}
+	return 0
+}
+
+func (x *HuntConditionExpression) GetVql() string {
+	if x != nil {
+		return x.Vql
+	}
+	return ""
+}
+
 type HuntCondition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ExcludedLabels *HuntLabelCondition `protobuf:"bytes,4,opt,name=excluded_labels,json=excludedLabels,proto3" json:"excluded_labels,omitempty"`
+	// If specified, the client must also match this condition tree.
+	Expression *HuntConditionExpression `protobuf:"bytes,5,opt,name=expression,proto3" json:"expression,omitempty"`
 	// Types that are assignable to UnionField:
 	//
 	//	*HuntCondition_Labels
@@ -236,7 +418,7 @@ type HuntCondition struct {
 func (x *HuntCondition) Reset() {
 	*x = HuntCondition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hunts_proto_msgTypes[2]
+		mi := &file_hunts_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -249,7 +431,7 @@ func (x *HuntCondition) String() string {
 func (*HuntCondition) ProtoMessage() {}
 
 func (x *HuntCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_hunts_proto_msgTypes[2]
+	mi := &file_hunts_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -262,7 +444,7 @@ func (x *HuntCondition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HuntCondition.ProtoReflect.Descriptor instead.
 func (*HuntCondition) Descriptor() ([]byte, []int) {
-	return file_hunts_proto_rawDescGZIP(), []int{2}
+	return file_hunts_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *HuntCondition) GetExcludedLabels() *HuntLabelCondition {
@@ -272,6 +454,13 @@ func (x *HuntCondition) GetExcludedLabels() *HuntLabelCondition {
 	return nil
 }
 
+func (x *HuntCondition) GetExpression() *HuntConditionExpression {
+	if x != nil {
+		return x.Expression
+	}
+	return nil
+}
+
 func (m *HuntCondition) GetUnionField() isHuntCondition_UnionField {
 	if m != nil {
 		return m.UnionField
@@ -320,12 +509,16 @@ type HuntStats struct {
 	TotalClientsWithErrors     uint64              `protobuf:"varint,15,opt,name=total_clients_with_errors,json=totalClientsWithErrors,proto3" json:"total_clients_with_errors,omitempty"`
 	Stopped                    bool                `protobuf:"varint,1,opt,name=stopped,proto3" json:"stopped,omitempty"`
 	AvailableDownloads         *AvailableDownloads `protobuf:"bytes,2,opt,name=available_downloads,json=availableDownloads,proto3" json:"available_downloads,omitempty"`
+	// Sum of the execution duration of all completed flows (in ns).
+	TotalExecutionDuration uint64 `protobuf:"varint,17,opt,name=total_execution_duration,json=totalExecutionDuration,proto3" json:"total_execution_duration,omitempty"`
+	// Sum of the CPU time used by all completed flows (in ns).
+	TotalCpuTime uint64 `protobuf:"varint,18,opt,name=total_cpu_time,json=totalCpuTime,proto3" json:"total_cpu_time,omitempty"`
 }
 
 func (x *HuntStats) Reset() {
 	*x = HuntStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hunts_proto_msgTypes[3]
+		mi := &file_hunts_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -338,7 +531,7 @@ func (x *HuntStats) String() string {
 func (*HuntStats) ProtoMessage() {}
 
 func (x *HuntStats) ProtoReflect() protoreflect.Message {
-	mi := &file_hunts_proto_msgTypes[3]
+	mi := &file_hunts_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -351,7 +544,7 @@ func (x *HuntStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HuntStats.ProtoReflect.Descriptor instead.
 func (*HuntStats) Descriptor() ([]byte, []int) {
-	return file_hunts_proto_rawDescGZIP(), []int{3}
+	return file_hunts_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *HuntStats) GetTotalClientsScheduled() uint64 {
@@ -396,6 +589,20 @@ func (x *HuntStats) GetAvailableDownloads() *AvailableDownloads {
 	return nil
 }
 
+func (x *HuntStats) GetTotalExecutionDuration() uint64 {
+	if x != nil {
+		return x.TotalExecutionDuration
+	}
+	return 0
+}
+
+func (x *HuntStats) GetTotalCpuTime() uint64 {
+	if x != nil {
+		return x.TotalCpuTime
+	}
+	return 0
+}
+
 type Hunt struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -417,12 +624,23 @@ type Hunt struct {
 	State           Hunt_State                   `protobuf:"varint,8,opt,name=state,proto3,enum=proto.Hunt_State" json:"state,omitempty"`
 	// A list of the org IDs that the hunt will be launched on
 	OrgIds []string `protobuf:"bytes,22,rep,name=org_ids,json=orgIds,proto3" json:"org_ids,omitempty"`
+	// If set this hunt is a template which is never scheduled on
+	// clients directly. Instead, child hunts are spawned from it
+	// according to the schedule.
+	Schedule *HuntSchedule `protobuf:"bytes,23,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// For hunts spawned from a scheduled template, this is the
+	// template's hunt id.
+	ParentHuntId string `protobuf:"bytes,24,opt,name=parent_hunt_id,json=parentHuntId,proto3" json:"parent_hunt_id,omitempty"`
+	// For scheduled templates, the hunt ids of the most recent runs.
+	ChildHuntIds []string `protobuf:"bytes,25,rep,name=child_hunt_ids,json=childHuntIds,proto3" json:"child_hunt_ids,omitempty"`
+	// If set, the hunt is rolled out in stages.
+	Rollout *HuntRollout `protobuf:"bytes,26,opt,name=rollout,proto3" json:"rollout,omitempty"`
 }
 
 func (x *Hunt) Reset() {
 	*x = Hunt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hunts_proto_msgTypes[4]
+		mi := &file_hunts_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -435,7 +653,7 @@ func (x *Hunt) String() string {
 func (*Hunt) ProtoMessage() {}
 
 func (x *Hunt) ProtoReflect() protoreflect.Message {
-	mi := &file_hunts_proto_msgTypes[4]
+	mi := &file_hunts_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -448,7 +666,7 @@ func (x *Hunt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Hunt.ProtoReflect.Descriptor instead.
 func (*Hunt) Descriptor() ([]byte, []int) {
-	return file_hunts_proto_rawDescGZIP(), []int{4}
+	return file_hunts_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Hunt) GetHuntId() string {
@@ -556,6 +774,344 @@ func (x *Hunt) GetOrgIds() []string {
 	return nil
 }
 
+func (x *Hunt) GetSchedule() *HuntSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *Hunt) GetParentHuntId() string {
+	if x != nil {
+		return x.ParentHuntId
+	}
+	return ""
+}
+
+func (x *Hunt) GetChildHuntIds() []string {
+	if x != nil {
+		return x.ChildHuntIds
+	}
+	return nil
+}
+
+func (x *Hunt) GetRollout() *HuntRollout {
+	if x != nil {
+		return x.Rollout
+	}
+	return nil
+}
+
+type HuntRolloutStage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The total number of clients scheduled by the end of this stage.
+	ClientCount uint64 `protobuf:"varint,1,opt,name=client_count,json=clientCount,proto3" json:"client_count,omitempty"`
+	// Alternatively a percentage of the expected clients.
+	Percentage float32 `protobuf:"fixed32,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// If set, the hunt is paused when this stage is complete and
+	// only progresses to the next stage when it is started again.
+	RequireApproval bool `protobuf:"varint,3,opt,name=require_approval,json=requireApproval,proto3" json:"require_approval,omitempty"`
+}
+
+func (x *HuntRolloutStage) Reset() {
+	*x = HuntRolloutStage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hunts_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HuntRolloutStage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HuntRolloutStage) ProtoMessage() {}
+
+func (x *HuntRolloutStage) ProtoReflect() protoreflect.Message {
+	mi := &file_hunts_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HuntRolloutStage.ProtoReflect.Descriptor instead.
+func (*HuntRolloutStage) Descriptor() ([]byte, []int) {
+	return file_hunts_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *HuntRolloutStage) GetClientCount() uint64 {
+	if x != nil {
+		return x.ClientCount
+	}
+	return 0
+}
+
+func (x *HuntRolloutStage) GetPercentage() float32 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *HuntRolloutStage) GetRequireApproval() bool {
+	if x != nil {
+		return x.RequireApproval
+	}
+	return false
+}
+
+// A staged rollout: The hunt is scheduled on a small number of
+// clients first, and only progresses to the next stage when enough
+// of those have completed. The hunt is paused automatically if the
+// error rate or the average runtime exceeds the thresholds. After
+// the last stage the hunt proceeds normally.
+type HuntRollout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stages []*HuntRolloutStage `protobuf:"bytes,1,rep,name=stages,proto3" json:"stages,omitempty"`
+	// The number of clients stage percentages refer to. Defaults to
+	// the hunt's client_limit.
+	ExpectedClients uint64 `protobuf:"varint,2,opt,name=expected_clients,json=expectedClients,proto3" json:"expected_clients,omitempty"`
+	// A stage is complete when this ratio of its clients have
+	// completed (default 0.9).
+	MinCompletedRatio float32 `protobuf:"fixed32,3,opt,name=min_completed_ratio,json=minCompletedRatio,proto3" json:"min_completed_ratio,omitempty"`
+	// Thresholds are only checked once this many clients have
+	// completed (default 1).
+	MinCompletedClients uint64 `protobuf:"varint,4,opt,name=min_completed_clients,json=minCompletedClients,proto3" json:"min_completed_clients,omitempty"`
+	// Pause the hunt when the ratio of errored clients exceeds this
+	// (0 disables).
+	MaxErrorRatio float32 `protobuf:"fixed32,5,opt,name=max_error_ratio,json=maxErrorRatio,proto3" json:"max_error_ratio,omitempty"`
+	// Pause the hunt when the average execution time exceeds this
+	// many seconds (0 disables).
+	MaxAvgDurationSec uint64 `protobuf:"varint,6,opt,name=max_avg_duration_sec,json=maxAvgDurationSec,proto3" json:"max_avg_duration_sec,omitempty"`
+	// Pause the hunt when the average CPU time used on each client
+	// exceeds this many seconds (0 disables). The CPU time is
+	// approximate since it includes all the CPU used by the client
+	// process while the collection ran.
+	MaxAvgCpuSec float32 `protobuf:"fixed32,10,opt,name=max_avg_cpu_sec,json=maxAvgCpuSec,proto3" json:"max_avg_cpu_sec,omitempty"`
+	// The following are maintained by the hunt manager.
+	CurrentStage     uint64 `protobuf:"varint,7,opt,name=current_stage,json=currentStage,proto3" json:"current_stage,omitempty"`
+	AwaitingApproval bool   `protobuf:"varint,8,opt,name=awaiting_approval,json=awaitingApproval,proto3" json:"awaiting_approval,omitempty"`
+	AbortReason      string `protobuf:"bytes,9,opt,name=abort_reason,json=abortReason,proto3" json:"abort_reason,omitempty"`
+	// Thresholds are evaluated only over the flows completed since
+	// the current stage started (or the hunt was resumed after an
+	// abort). These are the hunt stats at that point.
+	StageStartStats *HuntStats `protobuf:"bytes,11,opt,name=stage_start_stats,json=stageStartStats,proto3" json:"stage_start_stats,omitempty"`
+}
+
+func (x *HuntRollout) Reset() {
+	*x = HuntRollout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hunts_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HuntRollout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HuntRollout) ProtoMessage() {}
+
+func (x *HuntRollout) ProtoReflect() protoreflect.Message {
+	mi := &file_hunts_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HuntRollout.ProtoReflect.Descriptor instead.
+func (*HuntRollout) Descriptor() ([]byte, []int) {
+	return file_hunts_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *HuntRollout) GetStages() []*HuntRolloutStage {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+func (x *HuntRollout) GetExpectedClients() uint64 {
+	if x != nil {
+		return x.ExpectedClients
+	}
+	return 0
+}
+
+func (x *HuntRollout) GetMinCompletedRatio() float32 {
+	if x != nil {
+		return x.MinCompletedRatio
+	}
+	return 0
+}
+
+func (x *HuntRollout) GetMinCompletedClients() uint64 {
+	if x != nil {
+		return x.MinCompletedClients
+	}
+	return 0
+}
+
+func (x *HuntRollout) GetMaxErrorRatio() float32 {
+	if x != nil {
+		return x.MaxErrorRatio
+	}
+	return 0
+}
+
+func (x *HuntRollout) GetMaxAvgDurationSec() uint64 {
+	if x != nil {
+		return x.MaxAvgDurationSec
+	}
+	return 0
+}
+
+func (x *HuntRollout) GetMaxAvgCpuSec() float32 {
+	if x != nil {
+		return x.MaxAvgCpuSec
+	}
+	return 0
+}
+
+func (x *HuntRollout) GetCurrentStage() uint64 {
+	if x != nil {
+		return x.CurrentStage
+	}
+	return 0
+}
+
+func (x *HuntRollout) GetAwaitingApproval() bool {
+	if x != nil {
+		return x.AwaitingApproval
+	}
+	return false
+}
+
+func (x *HuntRollout) GetAbortReason() string {
+	if x != nil {
+		return x.AbortReason
+	}
+	return ""
+}
+
+func (x *HuntRollout) GetStageStartStats() *HuntStats {
+	if x != nil {
+		return x.StageStartStats
+	}
+	return nil
+}
+
+type HuntSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A cron style schedule: "minute hour day-of-month month
+	// day-of-week" (evaluated in UTC).
+	Cron string `protobuf:"bytes,1,opt,name=cron,proto3" json:"cron,omitempty"`
+	// Alternatively run the hunt every interval_sec seconds.
+	IntervalSec uint64 `protobuf:"varint,2,opt,name=interval_sec,json=intervalSec,proto3" json:"interval_sec,omitempty"`
+	// How many runs to retain. Older runs are archived (default 10).
+	RetainRuns uint64 `protobuf:"varint,3,opt,name=retain_runs,json=retainRuns,proto3" json:"retain_runs,omitempty"`
+	// How long each run should stay active. If not set we use the
+	// default hunt expiry.
+	RunDurationSec uint64 `protobuf:"varint,4,opt,name=run_duration_sec,json=runDurationSec,proto3" json:"run_duration_sec,omitempty"`
+	LastRunTime    uint64 `protobuf:"varint,5,opt,name=last_run_time,json=lastRunTime,proto3" json:"last_run_time,omitempty"`
+	NextRunTime    uint64 `protobuf:"varint,6,opt,name=next_run_time,json=nextRunTime,proto3" json:"next_run_time,omitempty"`
+}
+
+func (x *HuntSchedule) Reset() {
+	*x = HuntSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hunts_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HuntSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HuntSchedule) ProtoMessage() {}
+
+func (x *HuntSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_hunts_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HuntSchedule.ProtoReflect.Descriptor instead.
+func (*HuntSchedule) Descriptor() ([]byte, []int) {
+	return file_hunts_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *HuntSchedule) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *HuntSchedule) GetIntervalSec() uint64 {
+	if x != nil {
+		return x.IntervalSec
+	}
+	return 0
+}
+
+func (x *HuntSchedule) GetRetainRuns() uint64 {
+	if x != nil {
+		return x.RetainRuns
+	}
+	return 0
+}
+
+func (x *HuntSchedule) GetRunDurationSec() uint64 {
+	if x != nil {
+		return x.RunDurationSec
+	}
+	return 0
+}
+
+func (x *HuntSchedule) GetLastRunTime() uint64 {
+	if x != nil {
+		return x.LastRunTime
+	}
+	return 0
+}
+
+func (x *HuntSchedule) GetNextRunTime() uint64 {
+	if x != nil {
+		return x.NextRunTime
+	}
+	return 0
+}
+
 type HuntEstimateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -569,7 +1125,7 @@ type HuntEstimateRequest struct {
 func (x *HuntEstimateRequest) Reset() {
 	*x = HuntEstimateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hunts_proto_msgTypes[5]
+		mi := &file_hunts_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -582,7 +1138,7 @@ func (x *HuntEstimateRequest) String() string {
 func (*HuntEstimateRequest) ProtoMessage() {}
 
 func (x *HuntEstimateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hunts_proto_msgTypes[5]
+	mi := &file_hunts_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -595,7 +1151,7 @@ func (x *HuntEstimateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HuntEstimateRequest.ProtoReflect.Descriptor instead.
 func (*HuntEstimateRequest) Descriptor() ([]byte, []int) {
-	return file_hunts_proto_rawDescGZIP(), []int{5}
+	return file_hunts_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *HuntEstimateRequest) GetLastActive() uint64 {
@@ -622,12 +1178,15 @@ type ListHuntsRequest struct {
 	// If specified we return a partial structure.
 	Summary         bool `protobuf:"varint,4,opt,name=summary,proto3" json:"summary,omitempty"`
 	IncludeArchived bool `protobuf:"varint,3,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	// If specified only list the runs spawned from this scheduled
+	// hunt.
+	ParentHuntId string `protobuf:"bytes,5,opt,name=parent_hunt_id,json=parentHuntId,proto3" json:"parent_hunt_id,omitempty"`
 }
 
 func (x *ListHuntsRequest) Reset() {
 	*x = ListHuntsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hunts_proto_msgTypes[6]
+		mi := &file_hunts_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -640,7 +1199,7 @@ func (x *ListHuntsRequest) String() string {
 func (*ListHuntsRequest) ProtoMessage() {}
 
 func (x *ListHuntsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hunts_proto_msgTypes[6]
+	mi := &file_hunts_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -653,7 +1212,7 @@ func (x *ListHuntsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListHuntsRequest.ProtoReflect.Descriptor instead.
 func (*ListHuntsRequest) Descriptor() ([]byte, []int) {
-	return file_hunts_proto_rawDescGZIP(), []int{6}
+	return file_hunts_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListHuntsRequest) GetOffset() uint64 {
@@ -684,6 +1243,13 @@ func (x *ListHuntsRequest) GetIncludeArchived() bool {
 	return false
 }
 
+func (x *ListHuntsRequest) GetParentHuntId() string {
+	if x != nil {
+		return x.ParentHuntId
+	}
+	return ""
+}
+
 type ListHuntsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -695,7 +1261,7 @@ type ListHuntsResponse struct {
 func (x *ListHuntsResponse) Reset() {
 	*x = ListHuntsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hunts_proto_msgTypes[7]
+		mi := &file_hunts_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -708,7 +1274,7 @@ func (x *ListHuntsResponse) String() string {
 func (*ListHuntsResponse) ProtoMessage() {}
 
 func (x *ListHuntsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hunts_proto_msgTypes[7]
+	mi := &file_hunts_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -721,7 +1287,7 @@ func (x *ListHuntsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListHuntsResponse.ProtoReflect.Descriptor instead.
 func (*ListHuntsResponse) Descriptor() ([]byte, []int) {
-	return file_hunts_proto_rawDescGZIP(), []int{7}
+	return file_hunts_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListHuntsResponse) GetItems() []*Hunt {
@@ -742,7 +1308,7 @@ type GetHuntRequest struct {
 func (x *GetHuntRequest) Reset() {
 	*x = GetHuntRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hunts_proto_msgTypes[8]
+		mi := &file_hunts_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -755,7 +1321,7 @@ func (x *GetHuntRequest) String() string {
 func (*GetHuntRequest) ProtoMessage() {}
 
 func (x *GetHuntRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hunts_proto_msgTypes[8]
+	mi := &file_hunts_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -768,7 +1334,7 @@ func (x *GetHuntRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHuntRequest.ProtoReflect.Descriptor instead.
 func (*GetHuntRequest) Descriptor() ([]byte, []int) {
-	return file_hunts_proto_rawDescGZIP(), []int{8}
+	return file_hunts_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetHuntRequest) GetHuntId() string {
@@ -792,7 +1358,7 @@ type GetHuntResultsRequest struct {
 func (x *GetHuntResultsRequest) Reset() {
 	*x = GetHuntResultsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hunts_proto_msgTypes[9]
+		mi := &file_hunts_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -805,7 +1371,7 @@ func (x *GetHuntResultsRequest) String() string {
 func (*GetHuntResultsRequest) ProtoMessage() {}
 
 func (x *GetHuntResultsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hunts_proto_msgTypes[9]
+	mi := &file_hunts_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -818,7 +1384,7 @@ func (x *GetHuntResultsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHuntResultsRequest.ProtoReflect.Descriptor instead.
 func (*GetHuntResultsRequest) Descriptor() ([]byte, []int) {
-	return file_hunts_proto_rawDescGZIP(), []int{9}
+	return file_hunts_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetHuntResultsRequest) GetOffset() uint64 {
@@ -861,7 +1427,7 @@ type FlowAssignment struct {
 func (x *FlowAssignment) Reset() {
 	*x = FlowAssignment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hunts_proto_msgTypes[10]
+		mi := &file_hunts_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -874,7 +1440,7 @@ func (x *FlowAssignment) String() string {
 func (*FlowAssignment) ProtoMessage() {}
 
 func (x *FlowAssignment) ProtoReflect() protoreflect.Message {
-	mi := &file_hunts_proto_msgTypes[10]
+	mi := &file_hunts_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -887,7 +1453,7 @@ func (x *FlowAssignment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FlowAssignment.ProtoReflect.Descriptor instead.
 func (*FlowAssignment) Descriptor() ([]byte, []int) {
-	return file_hunts_proto_rawDescGZIP(), []int{10}
+	return file_hunts_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *FlowAssignment) GetClientId() string {
@@ -923,7 +1489,7 @@ type HuntMutation struct {
 func (x *HuntMutation) Reset() {
 	*x = HuntMutation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hunts_proto_msgTypes[11]
+		mi := &file_hunts_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -936,7 +1502,7 @@ func (x *HuntMutation) String() string {
 func (*HuntMutation) ProtoMessage() {}
 
 func (x *HuntMutation) ProtoReflect() protoreflect.Message {
-	mi := &file_hunts_proto_msgTypes[11]
+	mi := &file_hunts_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -949,7 +1515,7 @@ func (x *HuntMutation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HuntMutation.ProtoReflect.Descriptor instead.
 func (*HuntMutation) Descriptor() ([]byte, []int) {
-	return file_hunts_proto_rawDescGZIP(), []int{11}
+	return file_hunts_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *HuntMutation) GetHuntId() string {
@@ -1012,218 +1578,334 @@ var file_hunts_proto_rawDesc = []byte{
 	0x2e, 0x4f, 0x53, 0x52, 0x02, 0x6f, 0x73, 0x22, 0x2e, 0x0a, 0x02, 0x4f, 0x53, 0x12, 0x07, 0x0a,
 	0x03, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57,
 	0x53, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x49, 0x4e, 0x55, 0x58, 0x10, 0x02, 0x12, 0x07,
-	0x0a, 0x03, 0x4f, 0x53, 0x58, 0x10, 0x03, 0x22, 0xa8, 0x02, 0x0a, 0x0d, 0x48, 0x75, 0x6e, 0x74,
-	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0f, 0x65, 0x78, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x75, 0x6e, 0x74, 0x4c,
-	0x61, 0x62, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x65,
-	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x4b, 0x0a,
-	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x75, 0x6e, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x43,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x16, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x10,
-	0x22, 0x0e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x20, 0x62, 0x79, 0x20, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x48, 0x00, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x42, 0x0a, 0x02, 0x6f, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48,
-	0x75, 0x6e, 0x74, 0x4f, 0x73, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x18,
-	0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x12, 0x22, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x20, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x73, 0x3a, 0x33,
-	0xda, 0xfc, 0xe3, 0xc4, 0x01, 0x2d, 0x0a, 0x2b, 0x54, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x64,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x20, 0x68,
-	0x6f, 0x73, 0x74, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x68, 0x75,
-	0x6e, 0x74, 0x2e, 0x42, 0x0d, 0x0a, 0x0b, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x22, 0x88, 0x06, 0x0a, 0x09, 0x48, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
-	0x12, 0x8f, 0x01, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x73, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x57, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x51, 0x12, 0x3e, 0x54, 0x68, 0x65, 0x20,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x6c,
-	0x79, 0x20, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20,
-	0x74, 0x68, 0x69, 0x73, 0x20, 0x68, 0x75, 0x6e, 0x74, 0x2e, 0x22, 0x0f, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x20, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x52, 0x15, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x64, 0x12, 0x86, 0x01, 0x0a, 0x1a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x42, 0x49, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x43, 0x12,
-	0x25, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66,
-	0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x22, 0x1a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x20, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x73, 0x52, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73,
-	0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x93, 0x01, 0x0a, 0x1d,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x77, 0x69,
-	0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x50, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x4a, 0x12, 0x29, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x6f, 0x75, 0x74, 0x20, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x22, 0x1d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x20, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x1a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x73, 0x12, 0x82, 0x01, 0x0a, 0x19, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x04, 0x42, 0x47, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x41, 0x12, 0x24, 0x54,
+	0x0a, 0x03, 0x4f, 0x53, 0x58, 0x10, 0x03, 0x22, 0x3f, 0x0a, 0x15, 0x48, 0x75, 0x6e, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x9f, 0x03, 0x0a, 0x17, 0x48, 0x75, 0x6e,
+	0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x03, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x75, 0x6e, 0x74, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x03, 0x61, 0x6e, 0x64, 0x12, 0x2e, 0x0a, 0x02, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x75, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x02, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x03, 0x6e, 0x6f, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x75, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x03, 0x6e, 0x6f, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x48, 0x75, 0x6e, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x26, 0x0a, 0x02, 0x6f,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x48, 0x75, 0x6e, 0x74, 0x4f, 0x73, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x02, 0x6f, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x75,
+	0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a,
+	0x0e, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x67, 0x65, 0x78, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65,
+	0x6e, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6c, 0x61, 0x73,
+	0x74, 0x53, 0x65, 0x65, 0x6e, 0x53, 0x65, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x71, 0x6c, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x71, 0x6c, 0x22, 0xe8, 0x02, 0x0a, 0x0d, 0x48,
+	0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0f,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x75,
+	0x6e, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x12, 0x3e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x75, 0x6e,
+	0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x4b, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x75, 0x6e, 0x74, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x16, 0xe2, 0xfc, 0xe3,
+	0xc4, 0x01, 0x10, 0x22, 0x0e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x20, 0x62, 0x79, 0x20, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x42, 0x0a,
+	0x02, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x48, 0x75, 0x6e, 0x74, 0x4f, 0x73, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x18, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x12, 0x22, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x20, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x02, 0x6f,
+	0x73, 0x3a, 0x33, 0xda, 0xfc, 0xe3, 0xc4, 0x01, 0x2d, 0x0a, 0x2b, 0x54, 0x68, 0x65, 0x20, 0x63,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x69, 0x73,
+	0x20, 0x68, 0x75, 0x6e, 0x74, 0x2e, 0x42, 0x0d, 0x0a, 0x0b, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x5f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0xfd, 0x07, 0x0a, 0x09, 0x48, 0x75, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x8f, 0x01, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x04, 0x42, 0x57, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x51, 0x12, 0x3e, 0x54,
+	0x68, 0x65, 0x20, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20,
+	0x6f, 0x66, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x6c, 0x79, 0x20, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x20, 0x66,
+	0x6f, 0x72, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x68, 0x75, 0x6e, 0x74, 0x2e, 0x22, 0x0f, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x20, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x52, 0x15,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x12, 0x86, 0x01, 0x0a, 0x1a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x42, 0x49, 0xe2, 0xfc, 0xe3, 0xc4,
+	0x01, 0x43, 0x12, 0x25, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x20, 0x6f, 0x66, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68,
+	0x20, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x22, 0x1a, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x20, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x93,
+	0x01, 0x0a, 0x1d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x42, 0x50, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x4a, 0x12, 0x29,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x6f, 0x75, 0x74,
+	0x20, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x22, 0x1d, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x20, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74,
+	0x20, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x1a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x82, 0x01, 0x0a, 0x19, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x42, 0x47, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x41,
+	0x12, 0x24, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f,
+	0x66, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x22, 0x19, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x20, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x52, 0x16, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x57,
+	0x69, 0x74, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x79, 0x0a, 0x07, 0x73, 0x74, 0x6f,
+	0x70, 0x70, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x5f, 0xe2, 0xfc, 0xe3, 0xc4,
+	0x01, 0x59, 0x12, 0x57, 0x49, 0x66, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x69, 0x73, 0x20, 0x73,
+	0x65, 0x74, 0x20, 0x74, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x75, 0x6e, 0x74,
+	0x20, 0x69, 0x73, 0x20, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x2e, 0x20, 0x54, 0x68, 0x69,
+	0x73, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x69, 0x73, 0x20, 0x6d, 0x61, 0x6e, 0x69, 0x70,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x75,
+	0x6e, 0x74, 0x20, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x52, 0x07, 0x73, 0x74, 0x6f,
+	0x70, 0x70, 0x65, 0x64, 0x12, 0x4a, 0x0a, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x52, 0x12, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73,
+	0x12, 0x8a, 0x01, 0x0a, 0x18, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x50, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x4a, 0x12, 0x2e, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x69, 0x6d,
+	0x65, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x22, 0x18, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x20, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x66, 0x0a,
+	0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x70, 0x75, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x04, 0x42, 0x40, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x3a, 0x12, 0x28, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x20, 0x43, 0x50, 0x55, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x6f, 0x66,
+	0x20, 0x61, 0x6c, 0x6c, 0x20, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x20, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x22, 0x0e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x20, 0x43,
+	0x50, 0x55, 0x20, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x70,
+	0x75, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xd4, 0x0c, 0x0a, 0x04, 0x48, 0x75, 0x6e, 0x74, 0x12, 0x28,
+	0x0a, 0x07, 0x68, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0f, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x09, 0x22, 0x07, 0x48, 0x75, 0x6e, 0x74, 0x20, 0x49, 0x44,
+	0x52, 0x06, 0x68, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x60, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x3f, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x39, 0x0a,
+	0x0b, 0x52, 0x44, 0x46, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x57, 0x68,
+	0x65, 0x6e, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x68, 0x75, 0x6e, 0x74, 0x20, 0x77, 0x61, 0x73,
+	0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x22, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x20, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x17, 0x12, 0x15, 0x57,
+	0x68, 0x6f, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68,
+	0x75, 0x6e, 0x74, 0x3f, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x64, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x45, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x3f, 0x0a, 0x0b, 0x52, 0x44, 0x46, 0x44, 0x61,
+	0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x57, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x69,
+	0x73, 0x20, 0x68, 0x75, 0x6e, 0x74, 0x20, 0x77, 0x61, 0x73, 0x20, 0x61, 0x63, 0x74, 0x75, 0x61,
+	0x6c, 0x6c, 0x79, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x2e, 0x22, 0x0a, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x20, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x57, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x3d, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x37, 0x0a, 0x0b, 0x52, 0x44,
+	0x46, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x57, 0x68, 0x65, 0x6e, 0x20,
+	0x64, 0x6f, 0x65, 0x73, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x68, 0x75, 0x6e, 0x74, 0x20, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x3f, 0x22, 0x0b, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x20, 0x54,
+	0x69, 0x6d, 0x65, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x10,
+	0x68, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x14, 0x12, 0x12,
+	0x48, 0x75, 0x6e, 0x74, 0x27, 0x73, 0x20, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0f, 0x68, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x88, 0x01, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x41, 0x72, 0x67, 0x73, 0x42, 0x45, 0xe2, 0xfc, 0xe3, 0xc4, 0x01,
+	0x3f, 0x12, 0x3d, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x74, 0x72, 0x75, 0x65,
+	0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x8e,
+	0x01, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x75, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x5a, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x54,
+	0x12, 0x42, 0x54, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+	0x74, 0x68, 0x61, 0x74, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x73, 0x61, 0x74,
+	0x69, 0x73, 0x66, 0x69, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68,
+	0x75, 0x6e, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x62, 0x65, 0x20, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x2e, 0x22, 0x0e, 0x48, 0x75, 0x6e, 0x74, 0x20, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x59, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x30, 0x12, 0x2e, 0x54,
 	0x6f, 0x74, 0x61, 0x6c, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x73, 0x2e, 0x22, 0x19, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x20, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x52, 0x16,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x79, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x5f, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x59, 0x12,
-	0x57, 0x49, 0x66, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x69, 0x73, 0x20, 0x73, 0x65, 0x74, 0x20,
-	0x74, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x75, 0x6e, 0x74, 0x20, 0x69, 0x73,
-	0x20, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x2e, 0x20, 0x54, 0x68, 0x69, 0x73, 0x20, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x20, 0x69, 0x73, 0x20, 0x6d, 0x61, 0x6e, 0x69, 0x70, 0x75, 0x6c, 0x61,
-	0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x75, 0x6e, 0x74, 0x20,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65,
-	0x64, 0x12, 0x4a, 0x0a, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64,
-	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x52, 0x12, 0x61, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x22, 0xa9, 0x0b,
-	0x0a, 0x04, 0x48, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x07, 0x68, 0x75, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x09, 0x22,
-	0x07, 0x48, 0x75, 0x6e, 0x74, 0x20, 0x49, 0x44, 0x52, 0x06, 0x68, 0x75, 0x6e, 0x74, 0x49, 0x64,
-	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x60, 0x0a, 0x0b, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x3f, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x39, 0x0a, 0x0b, 0x52, 0x44, 0x46, 0x44, 0x61, 0x74, 0x65,
-	0x74, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x57, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20,
-	0x68, 0x75, 0x6e, 0x74, 0x20, 0x77, 0x61, 0x73, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x2e, 0x22, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x54, 0x69, 0x6d, 0x65,
-	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xe2,
-	0xfc, 0xe3, 0xc4, 0x01, 0x17, 0x12, 0x15, 0x57, 0x68, 0x6f, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x75, 0x6e, 0x74, 0x3f, 0x52, 0x07, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x64, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x42, 0x45, 0xe2, 0xfc, 0xe3, 0xc4, 0x01,
-	0x3f, 0x0a, 0x0b, 0x52, 0x44, 0x46, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x24,
-	0x57, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x68, 0x75, 0x6e, 0x74, 0x20, 0x77,
-	0x61, 0x73, 0x20, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x6c, 0x79, 0x20, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x65, 0x64, 0x2e, 0x22, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x20, 0x54, 0x69, 0x6d, 0x65,
-	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x57, 0x0a, 0x07, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x42, 0x3d, 0xe2, 0xfc,
-	0xe3, 0xc4, 0x01, 0x37, 0x0a, 0x0b, 0x52, 0x44, 0x46, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d,
-	0x65, 0x12, 0x1b, 0x57, 0x68, 0x65, 0x6e, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x20, 0x74, 0x68, 0x69,
-	0x73, 0x20, 0x68, 0x75, 0x6e, 0x74, 0x20, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x3f, 0x22, 0x0b,
-	0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x20, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x07, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x10, 0x68, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a,
-	0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x14, 0x12, 0x12, 0x48, 0x75, 0x6e, 0x74, 0x27, 0x73, 0x20, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x68, 0x75, 0x6e, 0x74,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x88, 0x01, 0x0a, 0x0d,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x74, 0x69,
-	0x66, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x41, 0x72, 0x67,
-	0x73, 0x42, 0x45, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x3f, 0x12, 0x3d, 0x4c, 0x61, 0x75, 0x6e, 0x63,
-	0x68, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x20, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x20,
-	0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x20, 0x69, 0x73, 0x20, 0x74, 0x72, 0x75, 0x65, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x8e, 0x01, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x48, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x5a, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x54, 0x12, 0x42, 0x54, 0x68, 0x65, 0x20, 0x63, 0x6f,
-	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x6d, 0x75, 0x73,
-	0x74, 0x20, 0x62, 0x65, 0x20, 0x73, 0x61, 0x74, 0x69, 0x73, 0x66, 0x69, 0x65, 0x64, 0x20, 0x66,
-	0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x75, 0x6e, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x62,
-	0x65, 0x20, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x2e, 0x22, 0x0e, 0x48, 0x75,
-	0x6e, 0x74, 0x20, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f,
-	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x59, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0xe2,
-	0xfc, 0xe3, 0xc4, 0x01, 0x30, 0x12, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x20, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x74,
-	0x68, 0x69, 0x73, 0x20, 0x68, 0x75, 0x6e, 0x74, 0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20, 0x72, 0x75,
-	0x6e, 0x20, 0x6f, 0x6e, 0x2e, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x75, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x09, 0x61, 0x72,
-	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x42, 0x2f, 0xe2,
-	0xfc, 0xe3, 0xc4, 0x01, 0x29, 0x12, 0x27, 0x41, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66,
-	0x20, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20,
-	0x68, 0x75, 0x6e, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x09,
-	0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0x61, 0x0a, 0x10, 0x61, 0x72, 0x74,
-	0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x13, 0x20,
-	0x03, 0x28, 0x09, 0x42, 0x36, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x30, 0x12, 0x2e, 0x41, 0x20, 0x6c,
-	0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x20,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x68, 0x75, 0x6e,
-	0x74, 0x20, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x0f, 0x61, 0x72, 0x74,
-	0x69, 0x66, 0x61, 0x63, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x71, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x75, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x48,
-	0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x42, 0x12, 0x40, 0x54, 0x68, 0x69, 0x73, 0x20, 0x69, 0x73, 0x20,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x75, 0x6e,
-	0x74, 0x2e, 0x20, 0x54, 0x68, 0x69, 0x73, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x69, 0x73,
-	0x20, 0x6d, 0x61, 0x6e, 0x75, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20,
-	0x74, 0x68, 0x65, 0x20, 0x47, 0x55, 0x49, 0x2e, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x17, 0x0a, 0x07, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x06, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x73, 0x22, 0xde, 0x01, 0x0a, 0x05, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12, 0x48, 0x0a,
-	0x06, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x3c, 0xea, 0xb9, 0xcb, 0xb9, 0x01,
-	0x36, 0x48, 0x75, 0x6e, 0x74, 0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x20, 0x6e, 0x65, 0x77, 0x20, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x73, 0x20, 0x62, 0x75, 0x74, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x62, 0x65, 0x20, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x2e, 0x12, 0x2d, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49,
-	0x4e, 0x47, 0x10, 0x02, 0x1a, 0x20, 0xea, 0xb9, 0xcb, 0xb9, 0x01, 0x1a, 0x48, 0x75, 0x6e, 0x74,
-	0x20, 0x69, 0x73, 0x20, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x6e, 0x64, 0x20,
-	0x72, 0x65, 0x61, 0x64, 0x79, 0x2e, 0x12, 0x24, 0x0a, 0x07, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45,
-	0x44, 0x10, 0x03, 0x1a, 0x17, 0xea, 0xb9, 0xcb, 0xb9, 0x01, 0x11, 0x48, 0x75, 0x6e, 0x74, 0x20,
-	0x68, 0x61, 0x73, 0x20, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x2e, 0x12, 0x2b, 0x0a, 0x08,
-	0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x44, 0x10, 0x04, 0x1a, 0x1d, 0xea, 0xb9, 0xcb, 0xb9,
-	0x01, 0x17, 0x48, 0x75, 0x6e, 0x74, 0x20, 0x68, 0x61, 0x73, 0x20, 0x62, 0x65, 0x65, 0x6e, 0x20,
-	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x2e, 0x22, 0x6a, 0x0a, 0x13, 0x48, 0x75, 0x6e,
-	0x74, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x12, 0x32, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x75, 0x6e,
-	0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x85, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x75,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d,
-	0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x22, 0x36, 0x0a,
-	0x11, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x75, 0x6e, 0x74, 0x52, 0x05,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x48, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x75, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x75, 0x6e, 0x74, 0x49, 0x64,
-	0x22, 0x7a, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x48, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x75, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x75, 0x6e, 0x74, 0x49, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x22, 0x46, 0x0a, 0x0e,
-	0x46, 0x6c, 0x6f, 0x77, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x66,
-	0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6c,
-	0x6f, 0x77, 0x49, 0x64, 0x22, 0xf0, 0x01, 0x0a, 0x0c, 0x48, 0x75, 0x6e, 0x74, 0x4d, 0x75, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x26,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x48, 0x75, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x35, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6c, 0x6f,
-	0x77, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x31, 0x5a, 0x2f, 0x77, 0x77, 0x77, 0x2e, 0x76,
-	0x65, 0x6c, 0x6f, 0x63, 0x69, 0x64, 0x65, 0x78, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6c,
-	0x61, 0x6e, 0x67, 0x2f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x72, 0x61, 0x70, 0x74, 0x6f, 0x72,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x68, 0x75, 0x6e, 0x74,
+	0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20, 0x72, 0x75, 0x6e, 0x20, 0x6f, 0x6e, 0x2e, 0x52, 0x0b, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x48, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x4d, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x18,
+	0x11, 0x20, 0x03, 0x28, 0x09, 0x42, 0x2f, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x29, 0x12, 0x27, 0x41,
+	0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x73, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x68, 0x75, 0x6e, 0x74, 0x20, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x73, 0x12, 0x61, 0x0a, 0x10, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x09, 0x42, 0x36, 0xe2, 0xfc, 0xe3,
+	0xc4, 0x01, 0x30, 0x12, 0x2e, 0x41, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x61,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x20, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x20,
+	0x74, 0x68, 0x69, 0x73, 0x20, 0x68, 0x75, 0x6e, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x65, 0x73, 0x2e, 0x52, 0x0f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x12, 0x71, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x75, 0x6e, 0x74,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x48, 0xe2, 0xfc, 0xe3, 0xc4, 0x01, 0x42, 0x12, 0x40,
+	0x54, 0x68, 0x69, 0x73, 0x20, 0x69, 0x73, 0x20, 0x73, 0x74, 0x61, 0x74, 0x65, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x75, 0x6e, 0x74, 0x2e, 0x20, 0x54, 0x68, 0x69, 0x73, 0x20,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x69, 0x73, 0x20, 0x6d, 0x61, 0x6e, 0x75, 0x70, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x47, 0x55, 0x49, 0x2e,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x72, 0x67, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x73,
+	0x12, 0x2f, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x75, 0x6e, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x75, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x48, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x5f, 0x68, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x48, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2c, 0x0a,
+	0x07, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x75, 0x74, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x22, 0xde, 0x01, 0x0a, 0x05,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x53, 0x45, 0x54, 0x10, 0x00,
+	0x12, 0x48, 0x0a, 0x06, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x3c, 0xea, 0xb9,
+	0xcb, 0xb9, 0x01, 0x36, 0x48, 0x75, 0x6e, 0x74, 0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20, 0x6e, 0x6f,
+	0x74, 0x20, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x20, 0x6e, 0x65, 0x77, 0x20, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x62, 0x75, 0x74, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x62,
+	0x65, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x2e, 0x12, 0x2d, 0x0a, 0x07, 0x52, 0x55,
+	0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x1a, 0x20, 0xea, 0xb9, 0xcb, 0xb9, 0x01, 0x1a, 0x48,
+	0x75, 0x6e, 0x74, 0x20, 0x69, 0x73, 0x20, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x72, 0x65, 0x61, 0x64, 0x79, 0x2e, 0x12, 0x24, 0x0a, 0x07, 0x53, 0x54, 0x4f,
+	0x50, 0x50, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x17, 0xea, 0xb9, 0xcb, 0xb9, 0x01, 0x11, 0x48, 0x75,
+	0x6e, 0x74, 0x20, 0x68, 0x61, 0x73, 0x20, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x2e, 0x12,
+	0x2b, 0x0a, 0x08, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x44, 0x10, 0x04, 0x1a, 0x1d, 0xea,
+	0xb9, 0xcb, 0xb9, 0x01, 0x17, 0x48, 0x75, 0x6e, 0x74, 0x20, 0x68, 0x61, 0x73, 0x20, 0x62, 0x65,
+	0x65, 0x6e, 0x20, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x2e, 0x22, 0x80, 0x01, 0x0a,
+	0x10, 0x48, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x22,
+	0x80, 0x04, 0x0a, 0x0b, 0x48, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12,
+	0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d,
+	0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x32, 0x0a, 0x15, 0x6d,
+	0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6d, 0x69, 0x6e, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x61,
+	0x76, 0x67, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x41, 0x76, 0x67, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x12, 0x25, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f,
+	0x61, 0x76, 0x67, 0x5f, 0x63, 0x70, 0x75, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x41, 0x76, 0x67, 0x43, 0x70, 0x75, 0x53, 0x65, 0x63, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x61, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x22, 0xd8, 0x01, 0x0a, 0x0c, 0x48, 0x75, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65,
+	0x74, 0x61, 0x69, 0x6e, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x72,
+	0x75, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x75, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x63, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6c, 0x61,
+	0x73, 0x74, 0x52, 0x75, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x6a, 0x0a,
+	0x13, 0x48, 0x75, 0x6e, 0x74, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x48, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xab, 0x01, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x75, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x48, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x36, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x48,
+	0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x75, 0x6e, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22,
+	0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x48, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x68, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x7a, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x48, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x68, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x22, 0x46, 0x0a, 0x0e, 0x46, 0x6c, 0x6f, 0x77, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6c, 0x6f, 0x77, 0x49, 0x64, 0x22, 0xf0,
+	0x01, 0x0a, 0x0c, 0x48, 0x75, 0x6e, 0x74, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x17, 0x0a, 0x07, 0x68, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x68, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x48, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x75, 0x6e, 0x74, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x42, 0x31, 0x5a, 0x2f, 0x77, 0x77, 0x77, 0x2e, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x64,
+	0x65, 0x78, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x76, 0x65,
+	0x6c, 0x6f, 0x63, 0x69, 0x72, 0x61, 0x70, 0x74, 0x6f, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1239,45 +1921,61 @@ func file_hunts_proto_rawDescGZIP() []byte {
 }
 
 var file_hunts_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_hunts_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_hunts_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_hunts_proto_goTypes = []interface{}{
 	(HuntOsCondition_OS)(0),             // 0: proto.HuntOsCondition.OS
 	(Hunt_State)(0),                     // 1: proto.Hunt.State
 	(*HuntLabelCondition)(nil),          // 2: proto.HuntLabelCondition
 	(*HuntOsCondition)(nil),             // 3: proto.HuntOsCondition
-	(*HuntCondition)(nil),               // 4: proto.HuntCondition
-	(*HuntStats)(nil),                   // 5: proto.HuntStats
-	(*Hunt)(nil),                        // 6: proto.Hunt
-	(*HuntEstimateRequest)(nil),         // 7: proto.HuntEstimateRequest
-	(*ListHuntsRequest)(nil),            // 8: proto.ListHuntsRequest
-	(*ListHuntsResponse)(nil),           // 9: proto.ListHuntsResponse
-	(*GetHuntRequest)(nil),              // 10: proto.GetHuntRequest
-	(*GetHuntResultsRequest)(nil),       // 11: proto.GetHuntResultsRequest
-	(*FlowAssignment)(nil),              // 12: proto.FlowAssignment
-	(*HuntMutation)(nil),                // 13: proto.HuntMutation
-	(*AvailableDownloads)(nil),          // 14: proto.AvailableDownloads
-	(*proto.ArtifactCollectorArgs)(nil), // 15: proto.ArtifactCollectorArgs
+	(*HuntMetadataCondition)(nil),       // 4: proto.HuntMetadataCondition
+	(*HuntConditionExpression)(nil),     // 5: proto.HuntConditionExpression
+	(*HuntCondition)(nil),               // 6: proto.HuntCondition
+	(*HuntStats)(nil),                   // 7: proto.HuntStats
+	(*Hunt)(nil),                        // 8: proto.Hunt
+	(*HuntRolloutStage)(nil),            // 9: proto.HuntRolloutStage
+	(*HuntRollout)(nil),                 // 10: proto.HuntRollout
+	(*HuntSchedule)(nil),                // 11: proto.HuntSchedule
+	(*HuntEstimateRequest)(nil),         // 12: proto.HuntEstimateRequest
+	(*ListHuntsRequest)(nil),            // 13: proto.ListHuntsRequest
+	(*ListHuntsResponse)(nil),           // 14: proto.ListHuntsResponse
+	(*GetHuntRequest)(nil),              // 15: proto.GetHuntRequest
+	(*GetHuntResultsRequest)(nil),       // 16: proto.GetHuntResultsRequest
+	(*FlowAssignment)(nil),              // 17: proto.FlowAssignment
+	(*HuntMutation)(nil),                // 18: proto.HuntMutation
+	(*AvailableDownloads)(nil),          // 19: proto.AvailableDownloads
+	(*proto.ArtifactCollectorArgs)(nil), // 20: proto.ArtifactCollectorArgs
 }
 var file_hunts_proto_depIdxs = []int32{
 	0,  // 0: proto.HuntOsCondition.os:type_name -> proto.HuntOsCondition.OS
-	2,  // 1: proto.HuntCondition.excluded_labels:type_name -> proto.HuntLabelCondition
-	2,  // 2: proto.HuntCondition.labels:type_name -> proto.HuntLabelCondition
-	3,  // 3: proto.HuntCondition.os:type_name -> proto.HuntOsCondition
-	14, // 4: proto.HuntStats.available_downloads:type_name -> proto.AvailableDownloads
-	15, // 5: proto.Hunt.start_request:type_name -> proto.ArtifactCollectorArgs
-	4,  // 6: proto.Hunt.condition:type_name -> proto.HuntCondition
-	5,  // 7: proto.Hunt.stats:type_name -> proto.HuntStats
-	1,  // 8: proto.Hunt.state:type_name -> proto.Hunt.State
-	4,  // 9: proto.HuntEstimateRequest.condition:type_name -> proto.HuntCondition
-	6,  // 10: proto.ListHuntsResponse.items:type_name -> proto.Hunt
-	5,  // 11: proto.HuntMutation.stats:type_name -> proto.HuntStats
-	1,  // 12: proto.HuntMutation.state:type_name -> proto.Hunt.State
-	12, // 13: proto.HuntMutation.assignment:type_name -> proto.FlowAssignment
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	5,  // 1: proto.HuntConditionExpression.and:type_name -> proto.HuntConditionExpression
+	5,  // 2: proto.HuntConditionExpression.or:type_name -> proto.HuntConditionExpression
+	5,  // 3: proto.HuntConditionExpression.not:type_name -> proto.HuntConditionExpression
+	2,  // 4: proto.HuntConditionExpression.labels:type_name -> proto.HuntLabelCondition
+	3,  // 5: proto.HuntConditionExpression.os:type_name -> proto.HuntOsCondition
+	4,  // 6: proto.HuntConditionExpression.metadata:type_name -> proto.HuntMetadataCondition
+	2,  // 7: proto.HuntCondition.excluded_labels:type_name -> proto.HuntLabelCondition
+	5,  // 8: proto.HuntCondition.expression:type_name -> proto.HuntConditionExpression
+	2,  // 9: proto.HuntCondition.labels:type_name -> proto.HuntLabelCondition
+	3,  // 10: proto.HuntCondition.os:type_name -> proto.HuntOsCondition
+	19, // 11: proto.HuntStats.available_downloads:type_name -> proto.AvailableDownloads
+	20, // 12: proto.Hunt.start_request:type_name -> proto.ArtifactCollectorArgs
+	6,  // 13: proto.Hunt.condition:type_name -> proto.HuntCondition
+	7,  // 14: proto.Hunt.stats:type_name -> proto.HuntStats
+	1,  // 15: proto.Hunt.state:type_name -> proto.Hunt.State
+	11, // 16: proto.Hunt.schedule:type_name -> proto.HuntSchedule
+	10, // 17: proto.Hunt.rollout:type_name -> proto.HuntRollout
+	9,  // 18: proto.HuntRollout.stages:type_name -> proto.HuntRolloutStage
+	7,  // 19: proto.HuntRollout.stage_start_stats:type_name -> proto.HuntStats
+	6,  // 20: proto.HuntEstimateRequest.condition:type_name -> proto.HuntCondition
+	8,  // 21: proto.ListHuntsResponse.items:type_name -> proto.Hunt
+	7,  // 22: proto.HuntMutation.stats:type_name -> proto.HuntStats
+	1,  // 23: proto.HuntMutation.state:type_name -> proto.Hunt.State
+	17, // 24: proto.HuntMutation.assignment:type_name -> proto.FlowAssignment
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_hunts_proto_init() }
@@ -1312,7 +2010,7 @@ func file_hunts_proto_init() {
 			}
 		}
 		file_hunts_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HuntCondition); i {
+			switch v := v.(*HuntMetadataCondition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1324,7 +2022,7 @@ func file_hunts_proto_init() {
 			}
 		}
 		file_hunts_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HuntStats); i {
+			switch v := v.(*HuntConditionExpression); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1336,7 +2034,7 @@ func file_hunts_proto_init() {
 			}
 		}
 		file_hunts_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Hunt); i {
+			switch v := v.(*HuntCondition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1348,7 +2046,7 @@ func file_hunts_proto_init() {
 			}
 		}
 		file_hunts_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HuntEstimateRequest); i {
+			switch v := v.(*HuntStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1360,7 +2058,7 @@ func file_hunts_proto_init() {
 			}
 		}
 		file_hunts_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListHuntsRequest); i {
+			switch v := v.(*Hunt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1372,7 +2070,7 @@ func file_hunts_proto_init() {
 			}
 		}
 		file_hunts_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListHuntsResponse); i {
+			switch v := v.(*HuntRolloutStage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1384,7 +2082,7 @@ func file_hunts_proto_init() {
 			}
 		}
 		file_hunts_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHuntRequest); i {
+			switch v := v.(*HuntRollout); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1396,7 +2094,7 @@ func file_hunts_proto_init() {
 			}
 		}
 		file_hunts_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHuntResultsRequest); i {
+			switch v := v.(*HuntSchedule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1408,7 +2106,7 @@ func file_hunts_proto_init() {
 			}
 		}
 		file_hunts_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FlowAssignment); i {
+			switch v := v.(*HuntEstimateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1420,6 +2118,66 @@ func file_hunts_proto_init() {
 			}
 		}
 		file_hunts_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHuntsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hunts_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHuntsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hunts_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHuntRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hunts_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHuntResultsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hunts_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlowAssignment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hunts_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HuntMutation); i {
 			case 0:
 				return &v.state
@@ -1432,7 +2190,7 @@ func file_hunts_proto_init() {
 			}
 		}
 	}
-	file_hunts_proto_msgTypes[2].OneofWrappers = []interface{}{
+	file_hunts_proto_msgTypes[4].OneofWrappers = []interface{}{
 		(*HuntCondition_Labels)(nil),
 		(*HuntCondition_Os)(nil),
 	}
@@ -1442,7 +2200,7 @@ func file_hunts_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_hunts_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   12,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -128,6 +128,7 @@ var (
 		"min",
 		"now",
 		"parse_binary",
+		"parse_ext4_inode",
 		"parse_float",
 		"parse_json",
 		"parse_json_array",
@@ -32,8 +32,10 @@ import (
 	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
 	"www.velocidex.com/golang/velociraptor/executor"
 	flows_proto "www.velocidex.com/golang/velociraptor/flows/proto"
+	"www.velocidex.com/golang/velociraptor/json"
 	logging "www.velocidex.com/golang/velociraptor/logging"
 	"www.velocidex.com/golang/velociraptor/services"
+	artifact_repository "www.velocidex.com/golang/velociraptor/services/repository"
 	"www.velocidex.com/golang/velociraptor/startup"
 	"www.velocidex.com/golang/velociraptor/vql/acl_managers"
 )
@@ -59,6 +61,17 @@ var (
 		"details", "Show more details (Use -d -dd for even more)").
 		Short('d').Counter()
 
+	artifact_command_verify = artifact_command.Command(
+		"verify", "Verify artifact definitions")
+
+	artifact_command_verify_paths = artifact_command_verify.Arg(
+		"paths", "Paths to artifact YAML files or directories to verify. "+
+			"If not specified, all artifacts are verified.").Strings()
+
+	artifact_command_verify_format = artifact_command_verify.Flag(
+		"format", "Output format").
+		Default("json").Enum("text", "json", "jsonl")
+
 	artifact_command_collect = artifact_command.Command(
 		"collect", "Collect all artifacts")
 
@@ -404,6 +417,94 @@ func doArtifactList() error {
 	return nil
 }
 
+func doArtifactVerify() error {
+	config_obj, err := makeDefaultConfigLoader().
+		WithNullLoader().LoadAndValidate()
+	if err != nil {
+		return fmt.Errorf("Unable to load config file: %w", err)
+	}
+
+	ctx, cancel := install_sig_handler()
+	defer cancel()
+
+	sm, err := startup.StartToolServices(ctx, config_obj)
+	defer sm.Close()
+
+	if err != nil {
+		return err
+	}
+
+	repository, err := getRepository(config_obj)
+	if err != nil {
+		return err
+	}
+
+	verifier := artifact_repository.NewArtifactVerifier(config_obj, repository)
+
+	// Only verify the artifacts loaded from the paths. If no paths
+	// are given we verify everything.
+	var names []string
+	if len(*artifact_command_verify_paths) > 0 {
+		names = []string{}
+	}
+
+	for _, path := range *artifact_command_verify_paths {
+		loaded, err := verifier.LoadPath(path)
+		if err != nil {
+			return fmt.Errorf("Loading %v: %w", path, err)
+		}
+		names = append(names, loaded...)
+	}
+
+	issues, err := verifier.Verify(ctx, names)
+	if err != nil {
+		return err
+	}
+
+	error_count := 0
+	for _, issue := range issues {
+		if issue.Severity == artifact_repository.SEVERITY_ERROR {
+			error_count++
+		}
+	}
+
+	switch *artifact_command_verify_format {
+	case "text":
+		for _, issue := range issues {
+			name := issue.Artifact
+			if issue.File != "" {
+				name = fmt.Sprintf("%v (%v)", name, issue.File)
+			}
+			fmt.Printf("%v: %v: %v: %v\n", issue.Severity, name,
+				issue.Type, issue.Message)
+		}
+
+	case "jsonl":
+		for _, issue := range issues {
+			serialized, err := json.Marshal(issue)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(serialized))
+		}
+
+	default:
+		if issues == nil {
+			issues = []*artifact_repository.ArtifactIssue{}
+		}
+		serialized, err := json.MarshalIndent(issues)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(serialized))
+	}
+
+	if error_count > 0 {
+		return fmt.Errorf("Verification found %v errors", error_count)
+	}
+	return nil
+}
+
 func maybeAddDefinitionsDirectory(config_obj *config_proto.Config) error {
 	if *artifact_definitions_dir != "" {
 		if config_obj.Defaults == nil {
@@ -429,6 +530,9 @@ func init() {
 		case artifact_command_collect.FullCommand():
 			FatalIfError(artifact_command_collect, doArtifactCollect)
 
+		case artifact_command_verify.FullCommand():
+			FatalIfError(artifact_command_verify, doArtifactVerify)
+
 		default:
 			return false
 		}
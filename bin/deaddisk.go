@@ -10,6 +10,7 @@ import (
 
 	"github.com/Velocidex/ordereddict"
 	"github.com/Velocidex/yaml/v2"
+	"www.velocidex.com/golang/velociraptor/accessors"
 	actions_proto "www.velocidex.com/golang/velociraptor/actions/proto"
 	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
 	"www.velocidex.com/golang/velociraptor/services"
@@ -41,6 +42,9 @@ var (
 	deaddisk_command_add_windows_directory = deaddisk_command.Flag(
 		"add_windows_directory", "Add a Windows mounted directory").String()
 
+	deaddisk_command_add_linux_disk = deaddisk_command.Flag(
+		"add_linux_disk", "Add a Linux Hard Disk Image with an ext2/3/4 root filesystem").String()
+
 	standardRegistryMounts = []struct {
 		prefix, path, key_path string
 	}{
@@ -163,6 +167,7 @@ func addWindowsDirectory(
 func addWindowsHardDisk(
 	image string, config_obj *config_proto.Config) error {
 
+	disk_accessor, disk_path := diskAccessor(image)
 	builder := services.ScopeBuilder{
 		Config:     config_obj,
 		ACLManager: acl_managers.NullACLManager{},
@@ -170,7 +175,8 @@ func addWindowsHardDisk(
 		Env: ordereddict.NewDict().
 			Set(vql_subsystem.ACL_MANAGER_VAR,
 				acl_managers.NewRoleACLManager(config_obj, "administrator")).
-			Set("ImagePath", image),
+			Set("ImagePath", disk_path).
+			Set("Accessor", disk_accessor),
 	}
 
 	manager, err := services.GetRepositoryManager(config_obj)
@@ -191,14 +197,15 @@ func addWindowsHardDisk(
 			// directory
 			if checkForName(scope, row, "TopLevelDirectory", "Windows") {
 				partition_start := vql_subsystem.GetIntFromRow(scope, row, "StartOffset")
-				addWindowsPartition(config_obj, scope, image, partition_start)
+				addWindowsPartition(
+					config_obj, scope, image, partition_start, "C:")
 			}
 		}
 
 	} else {
 		addWindowsPartition(
 			config_obj, scope, image,
-			uint64(*deaddisk_command_add_windows_disk_offset))
+			uint64(*deaddisk_command_add_windows_disk_offset), "C:")
 	}
 
 	addCommonShadowAccessors(config_obj)
@@ -206,6 +213,56 @@ func addWindowsHardDisk(
 	return nil
 }
 
+// Virtual disk images are read through the accessor for their format
+// which presents the disk as a flat device.
+func imageAccessor(image string) string {
+	switch strings.ToLower(filepath.Ext(image)) {
+	case ".vmdk":
+		return "vmdk"
+	case ".vhdx":
+		return "vhdx"
+	case ".qcow2", ".qcow":
+		return "qcow2"
+	}
+	return ""
+}
+
+// The accessor and path to read the raw disk with.
+func diskAccessor(image string) (string, string) {
+	accessor := imageAccessor(image)
+	if accessor == "" {
+		return "raw_file", image
+	}
+
+	return accessor, (&accessors.PathSpec{
+		DelegateAccessor: "file",
+		DelegatePath:     image,
+	}).String()
+}
+
+// A pathspec for the offset accessor opening the partition at
+// partition_start.
+func diskPathspec(image string, partition_start uint64) string {
+	pathspec := &accessors.PathSpec{
+		DelegateAccessor: "file",
+		DelegatePath:     image,
+		Path:             fmt.Sprintf("%d", partition_start),
+	}
+
+	accessor := imageAccessor(image)
+	if accessor != "" {
+		pathspec = &accessors.PathSpec{
+			DelegateAccessor: accessor,
+			Delegate: &accessors.PathSpec{
+				DelegateAccessor: "file",
+				DelegatePath:     image,
+			},
+			Path: fmt.Sprintf("%d", partition_start),
+		}
+	}
+	return pathspec.String()
+}
+
 func getPartitionOffsets(
 	scope vfilter.Scope,
 	image string,
@@ -213,7 +270,8 @@ func getPartitionOffsets(
 	scope.Log("Enumerating partitions using Windows.Forensics.PartitionTable")
 	query := `
 SELECT *
-FROM Artifact.Windows.Forensics.PartitionTable(ImagePath=ImagePath)
+FROM Artifact.Windows.Forensics.PartitionTable(
+   ImagePath=ImagePath, Accessor=Accessor)
 `
 	vqls, err := vfilter.MultiParse(query)
 	if err != nil {
@@ -264,6 +322,30 @@ func doDeadDisk() error {
 		}
 	}
 
+	if *deaddisk_command_add_linux_disk != "" {
+		abs_path, err := filepath.Abs(*deaddisk_command_add_linux_disk)
+		if err != nil {
+			return err
+		}
+
+		err = addLinuxHardDisk(abs_path, config_obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	if *deaddisk_command_add_disk != "" {
+		abs_path, err := filepath.Abs(*deaddisk_command_add_disk)
+		if err != nil {
+			return err
+		}
+
+		err = addHardDisk(abs_path, config_obj)
+		if err != nil {
+			return err
+		}
+	}
+
 	if *deaddisk_command_add_windows_directory != "" {
 		abs_path, err := filepath.Abs(*deaddisk_command_add_windows_directory)
 		if err != nil {
@@ -419,42 +501,48 @@ func addCommonPermissions(config_obj *config_proto.Config) {
 	addPermission(config_obj, "SERVER_ADMIN")
 }
 
+// The raw_ntfs mount point of the partition at partition_start.
+func ntfsMountPoint(image string, partition_start uint64) *config_proto.MountPoint {
+	return &config_proto.MountPoint{
+		Accessor: "raw_ntfs",
+		Prefix: fmt.Sprintf(`{
+  "DelegateAccessor": "offset",
+  "Delegate": %s,
+  "Path": "/"
+}
+`, diskPathspec(image, partition_start)),
+	}
+}
+
+// Mount the partition on the drive. Only the system drive C: gets
+// the registry mounts.
 func addWindowsPartition(
 	config_obj *config_proto.Config,
 	scope vfilter.Scope,
 	image string,
-	partition_start uint64) {
+	partition_start uint64, drive string) {
 	addCommonPermissions(config_obj)
 
-	scope.Log("Adding windows partition at offset %v", partition_start)
+	scope.Log("Adding windows partition at offset %v on %v",
+		partition_start, drive)
 
-	impersonationClause(config_obj, "windows", *deaddisk_command_hostname)
-
-	mount_point := &config_proto.MountPoint{
-		Accessor: "raw_ntfs",
-		Prefix: fmt.Sprintf(`{
-  "DelegateAccessor": "offset",
-  "Delegate": {
-    "DelegateAccessor": "file",
-    "DelegatePath": %q,
-    "Path":"%d"
-  },
-  "Path": "/"
-}
-`, image, partition_start),
+	if drive == "C:" {
+		impersonationClause(config_obj, "windows", *deaddisk_command_hostname)
 	}
 
+	mount_point := ntfsMountPoint(image, partition_start)
+
 	// Add an NTFS mount accessible via the "ntfs" accessor
 	config_obj.Remappings = append(config_obj.Remappings,
 		&config_proto.RemappingConfig{
 			Type: "mount",
 			Description: fmt.Sprintf(
-				"Mount the partition %v (offset %v) on the C: drive (NTFS)",
-				image, partition_start),
+				"Mount the partition %v (offset %v) on the %v drive (NTFS)",
+				image, partition_start, drive),
 			From: mount_point,
 			On: &config_proto.MountPoint{
 				Accessor: "ntfs",
-				Prefix:   "\\\\.\\C:",
+				Prefix:   "\\\\.\\" + drive,
 				PathType: "ntfs",
 			},
 		})
@@ -465,12 +553,12 @@ func addWindowsPartition(
 		&config_proto.RemappingConfig{
 			Type: "mount",
 			Description: fmt.Sprintf(
-				"Mount the partition %v (offset %v) on the C: drive (File Accessor)",
-				image, partition_start),
+				"Mount the partition %v (offset %v) on the %v drive (File Accessor)",
+				image, partition_start, drive),
 			From: mount_point,
 			On: &config_proto.MountPoint{
 				Accessor: "file",
-				Prefix:   "C:",
+				Prefix:   drive,
 				PathType: "windows",
 			},
 		})
@@ -480,16 +568,20 @@ func addWindowsPartition(
 		&config_proto.RemappingConfig{
 			Type: "mount",
 			Description: fmt.Sprintf(
-				"Mount the partition %v (offset %v) on the C: drive (Auto Accessor)",
-				image, partition_start),
+				"Mount the partition %v (offset %v) on the %v drive (Auto Accessor)",
+				image, partition_start, drive),
 			From: mount_point,
 			On: &config_proto.MountPoint{
 				Accessor: "auto",
-				Prefix:   "C:",
+				Prefix:   drive,
 				PathType: "windows",
 			},
 		})
 
+	if drive != "C:" {
+		return
+	}
+
 	// Now add some registry mounts
 	for _, definition := range standardRegistryMounts {
 		config_obj.Remappings = append(config_obj.Remappings,
@@ -505,14 +597,11 @@ func addWindowsPartition(
   "DelegateAccessor": "raw_ntfs",
   "Delegate": {
     "DelegateAccessor":"offset",
-    "Delegate": {
-      "DelegateAccessor": "file",
-      "DelegatePath": %q,
-      "Path": "%d"
-    },
+    "Delegate": %s,
     "Path":%q
   }
-}`, definition.key_path, image, partition_start, definition.path),
+}`, definition.key_path, diskPathspec(image, partition_start),
+						definition.path),
 					PathType: "registry",
 				},
 				On: &config_proto.MountPoint{
@@ -523,3 +612,141 @@ func addWindowsPartition(
 			})
 	}
 }
+
+func addLinuxHardDisk(
+	image string, config_obj *config_proto.Config) error {
+
+	disk_accessor, disk_path := diskAccessor(image)
+	builder := services.ScopeBuilder{
+		Config:     config_obj,
+		ACLManager: acl_managers.NullACLManager{},
+		Logger:     log.New(&LogWriter{config_obj}, "", 0),
+		Env: ordereddict.NewDict().
+			Set(vql_subsystem.ACL_MANAGER_VAR,
+				acl_managers.NewRoleACLManager(config_obj, "administrator")).
+			Set("ImagePath", disk_path).
+			Set("Accessor", disk_accessor),
+	}
+
+	manager, err := services.GetRepositoryManager(config_obj)
+	if err != nil {
+		return err
+	}
+	scope := manager.BuildScope(builder)
+	defer scope.Close()
+
+	if *deaddisk_command_add_windows_disk_offset < 0 {
+		rows, err := getPartitionOffsets(scope, image, config_obj)
+		if err != nil {
+			return err
+		}
+
+		// The image may also be a bare filesystem.
+		offsets := []uint64{0}
+		for _, row := range rows {
+			offsets = append(offsets,
+				vql_subsystem.GetIntFromRow(scope, row, "StartOffset"))
+		}
+
+		// Here we are looking for the root filesystem.
+		found := false
+		for _, offset := range offsets {
+			if checkForExt4Root(scope, image, offset) {
+				addLinuxPartition(config_obj, scope, image, offset, "/")
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("No ext2/3/4 root filesystem found in %v", image)
+		}
+
+	} else {
+		addLinuxPartition(
+			config_obj, scope, image,
+			uint64(*deaddisk_command_add_windows_disk_offset), "/")
+	}
+
+	addCommonShadowAccessors(config_obj)
+
+	return nil
+}
+
+func ext4PartitionPathspec(image string, partition_start uint64, path string) string {
+	return fmt.Sprintf(`{
+  "DelegateAccessor": "offset",
+  "Delegate": %s,
+  "Path": %q
+}
+`, diskPathspec(image, partition_start), path)
+}
+
+// A root filesystem has an /etc directory.
+func checkForExt4Root(
+	scope vfilter.Scope, image string, partition_start uint64) bool {
+	accessor, err := accessors.GetAccessor("ext4", scope)
+	if err != nil {
+		return false
+	}
+
+	etc_path, err := accessor.ParsePath(
+		ext4PartitionPathspec(image, partition_start, "/etc"))
+	if err != nil {
+		return false
+	}
+
+	scope.Log("Searching for an ext4 root filesystem at offset %v",
+		partition_start)
+	stat, err := accessor.LstatWithOSPath(etc_path)
+	return err == nil && stat.IsDir()
+}
+
+// Mount the partition on mount_prefix. Only the root filesystem
+// sets the impersonated OS.
+func addLinuxPartition(
+	config_obj *config_proto.Config,
+	scope vfilter.Scope,
+	image string,
+	partition_start uint64, mount_prefix string) {
+	addCommonPermissions(config_obj)
+
+	scope.Log("Adding linux partition at offset %v on %v",
+		partition_start, mount_prefix)
+
+	if mount_prefix == "/" {
+		impersonationClause(config_obj, "linux", *deaddisk_command_hostname)
+	}
+
+	mount_point := &config_proto.MountPoint{
+		Accessor: "ext4",
+		Prefix:   ext4PartitionPathspec(image, partition_start, "/"),
+	}
+
+	addFileMounts(config_obj, mount_point,
+		fmt.Sprintf("Mount the partition %v (offset %v) on %v",
+			image, partition_start, mount_prefix),
+		mount_prefix, "linux")
+}
+
+// Mount the filesystem on the prefix for the "file" and "auto"
+// accessors.
+func addFileMounts(
+	config_obj *config_proto.Config,
+	mount_point *config_proto.MountPoint,
+	description, prefix, path_type string) {
+	for _, accessor := range []string{"file", "auto"} {
+		config_obj.Remappings = append(config_obj.Remappings,
+			&config_proto.RemappingConfig{
+				Type: "mount",
+				Description: fmt.Sprintf("%v (%v Accessor)",
+					description, accessor),
+				From: mount_point,
+				On: &config_proto.MountPoint{
+					Accessor: accessor,
+					Prefix:   prefix,
+					PathType: path_type,
+				},
+			})
+	}
+}
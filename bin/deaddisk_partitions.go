@@ -0,0 +1,216 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Velocidex/ordereddict"
+	"www.velocidex.com/golang/velociraptor/accessors"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	"www.velocidex.com/golang/velociraptor/services"
+	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
+	"www.velocidex.com/golang/velociraptor/vql/acl_managers"
+	"www.velocidex.com/golang/vfilter"
+)
+
+var (
+	deaddisk_command_add_disk = deaddisk_command.Flag(
+		"add_disk", "Add a Hard Disk Image, mounting every NTFS and ext2/3/4 partition in its partition table").String()
+)
+
+type diskPartition struct {
+	number     int64
+	start      uint64
+	filesystem string
+}
+
+// Enumerate the partitions using the partition accessor.
+func getDiskPartitions(
+	scope vfilter.Scope, image string) ([]*diskPartition, error) {
+	accessor, err := accessors.GetAccessor("partition", scope)
+	if err != nil {
+		return nil, err
+	}
+
+	disk := &accessors.PathSpec{
+		DelegateAccessor: "file",
+		DelegatePath:     image,
+	}
+
+	image_accessor := imageAccessor(image)
+	if image_accessor != "" {
+		disk = &accessors.PathSpec{
+			DelegateAccessor: image_accessor,
+			Delegate:         disk,
+		}
+	}
+
+	scope.Log("Enumerating partitions using the partition accessor")
+	hits, err := accessor.ReadDir(disk.String())
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read partition table of %v: %w",
+			image, err)
+	}
+
+	result := []*diskPartition{}
+	for _, hit := range hits {
+		data := hit.Data()
+		number, _ := data.GetInt64("number")
+		start, _ := data.GetInt64("start_offset")
+		filesystem, _ := data.GetString("filesystem")
+
+		scope.Log("Found partition %v at offset %v (%v)",
+			number, start, filesystem)
+		result = append(result, &diskPartition{
+			number:     number,
+			start:      uint64(start),
+			filesystem: filesystem,
+		})
+	}
+	return result, nil
+}
+
+// A Windows system partition has a Windows directory.
+func checkForWindowsDirectory(
+	scope vfilter.Scope, image string, partition_start uint64) bool {
+	accessor, err := accessors.GetAccessor("raw_ntfs", scope)
+	if err != nil {
+		return false
+	}
+
+	windows_path, err := accessor.ParsePath(fmt.Sprintf(`{
+  "DelegateAccessor": "offset",
+  "Delegate": %s,
+  "Path": "/Windows"
+}
+`, diskPathspec(image, partition_start)))
+	if err != nil {
+		return false
+	}
+
+	scope.Log("Searching for a Windows directory at offset %v",
+		partition_start)
+	stat, err := accessor.LstatWithOSPath(windows_path)
+	return err == nil && stat.IsDir()
+}
+
+// Mount every NTFS and ext4 partition on the disk. The disk is
+// treated as a Windows disk if it has a Windows system partition
+// (which is mounted on C:) and as a Linux disk otherwise (the root
+// filesystem is mounted on /). The remaining partitions are mounted
+// on drive letters from D: or under /mnt/.
+func addHardDisk(image string, config_obj *config_proto.Config) error {
+	builder := services.ScopeBuilder{
+		Config:     config_obj,
+		ACLManager: acl_managers.NullACLManager{},
+		Logger:     log.New(&LogWriter{config_obj}, "", 0),
+		Env: ordereddict.NewDict().
+			Set(vql_subsystem.ACL_MANAGER_VAR,
+				acl_managers.NewRoleACLManager(config_obj, "administrator")),
+	}
+
+	manager, err := services.GetRepositoryManager(config_obj)
+	if err != nil {
+		return err
+	}
+	scope := manager.BuildScope(builder)
+	defer scope.Close()
+
+	partitions, err := getDiskPartitions(scope, image)
+	if err != nil {
+		return err
+	}
+
+	var windows_system, linux_root *diskPartition
+	has_ntfs, has_ext4 := false, false
+	for _, partition := range partitions {
+		switch partition.filesystem {
+		case "ntfs":
+			has_ntfs = true
+			if windows_system == nil &&
+				checkForWindowsDirectory(scope, image, partition.start) {
+				windows_system = partition
+			}
+
+		case "ext4":
+			has_ext4 = true
+			if linux_root == nil &&
+				checkForExt4Root(scope, image, partition.start) {
+				linux_root = partition
+			}
+		}
+	}
+
+	if !has_ntfs && !has_ext4 {
+		return fmt.Errorf("No NTFS or ext2/3/4 partitions found in %v", image)
+	}
+
+	os_type := "linux"
+	if windows_system != nil || (linux_root == nil && has_ntfs) {
+		os_type = "windows"
+	}
+
+	// Without a system partition nothing sets the impersonated OS.
+	if windows_system == nil && linux_root == nil {
+		impersonationClause(config_obj, os_type, *deaddisk_command_hostname)
+	}
+
+	next_drive := 'D'
+	for _, partition := range partitions {
+		if partition.filesystem != "ntfs" && partition.filesystem != "ext4" {
+			continue
+		}
+
+		if os_type == "windows" {
+			drive := "C:"
+			if partition != windows_system {
+				if next_drive > 'Z' {
+					scope.Log("No drive letter left for partition %v",
+						partition.number)
+					continue
+				}
+				drive = fmt.Sprintf("%c:", next_drive)
+				next_drive++
+			}
+
+			if partition.filesystem == "ntfs" {
+				addWindowsPartition(
+					config_obj, scope, image, partition.start, drive)
+				continue
+			}
+
+			addCommonPermissions(config_obj)
+			scope.Log("Adding linux partition at offset %v on %v",
+				partition.start, drive)
+			addFileMounts(config_obj, &config_proto.MountPoint{
+				Accessor: "ext4",
+				Prefix:   ext4PartitionPathspec(image, partition.start, "/"),
+			}, fmt.Sprintf("Mount the partition %v (offset %v) on the %v drive",
+				image, partition.start, drive), drive, "windows")
+			continue
+		}
+
+		mount_prefix := "/"
+		if partition != linux_root {
+			mount_prefix = fmt.Sprintf("/mnt/partition%d", partition.number)
+		}
+
+		if partition.filesystem == "ext4" {
+			addLinuxPartition(
+				config_obj, scope, image, partition.start, mount_prefix)
+			continue
+		}
+
+		addCommonPermissions(config_obj)
+		scope.Log("Adding windows partition at offset %v on %v",
+			partition.start, mount_prefix)
+		addFileMounts(config_obj, ntfsMountPoint(image, partition.start),
+			fmt.Sprintf("Mount the partition %v (offset %v) on %v",
+				image, partition.start, mount_prefix),
+			mount_prefix, "linux")
+	}
+
+	addCommonShadowAccessors(config_obj)
+
+	return nil
+}
@@ -49,6 +49,26 @@ var (
 	grant_command_policy_merge = grant_command.Flag(
 		"merge", "If specified we merge this policy with the old policy.").
 		Bool()
+
+	grant_command_scope_permissions = grant_command.Flag(
+		"scope_permission", "Add a scoped grant of these comma separated "+
+			"permissions (COLLECT_CLIENT, COLLECT_SERVER or READ_RESULTS)").
+		String()
+
+	grant_command_scope_artifacts = grant_command.Flag(
+		"scope_artifacts", "A comma separated list of artifact globs "+
+			"(e.g. Windows.Triage.*) the scoped grant is restricted to").
+		String()
+
+	grant_command_scope_labels = grant_command.Flag(
+		"scope_labels", "A comma separated list of client labels "+
+			"the scoped grant is restricted to").
+		String()
+
+	grant_command_scope_own = grant_command.Flag(
+		"scope_own", "Restrict the scoped grant to hunts and flows "+
+			"created by the principal").
+		Bool()
 )
 
 func doGrant() error {
@@ -136,9 +156,37 @@ func doGrant() error {
 		}
 	}
 
+	if *grant_command_scope_permissions != "" {
+		new_policy.ScopedGrants = append(new_policy.ScopedGrants,
+			&acl_proto.ScopedGrant{
+				Permissions: splitList(*grant_command_scope_permissions),
+				Artifacts:   splitList(*grant_command_scope_artifacts),
+				Labels:      splitList(*grant_command_scope_labels),
+				OwnOnly:     *grant_command_scope_own,
+			})
+	}
+
+	for _, grant := range new_policy.ScopedGrants {
+		err := acls.ValidateScopedGrant(grant)
+		if err != nil {
+			return err
+		}
+	}
+
 	return services.SetPolicy(org_config_obj, principal, new_policy)
 }
 
+func splitList(in string) []string {
+	var result []string
+	for _, item := range strings.Split(in, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
 func doShow() error {
 	config_obj, err := makeDefaultConfigLoader().WithRequiredFrontend().LoadAndValidate()
 	if err != nil {
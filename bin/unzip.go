@@ -17,7 +17,7 @@ import (
 )
 
 var (
-	unzip_cmd        = app.Command("unzip", "Unzip a container file")
+	unzip_cmd        = app.Command("unzip", "Unzip a container file (containers encrypted with a public key need the server config)")
 	unzip_cmd_filter = unzip_cmd.Flag("where", "A WHERE condition for the query").String()
 
 	unzip_path = unzip_cmd.Flag("dump_dir", "Directory to dump output files.").
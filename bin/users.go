@@ -23,9 +23,11 @@ import (
 	"os"
 	"strings"
 
+	"github.com/sirupsen/logrus"
 	"golang.org/x/crypto/ssh/terminal"
 	"www.velocidex.com/golang/velociraptor/api/authenticators"
 	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/logging"
 	"www.velocidex.com/golang/velociraptor/services"
 	"www.velocidex.com/golang/velociraptor/services/users"
 	"www.velocidex.com/golang/velociraptor/startup"
@@ -52,6 +54,17 @@ var (
 		"lock", "Lock a user immediately by locking their account.")
 	user_lock_name = user_lock.Arg(
 		"username", "Username to lock").Required().String()
+
+	user_mfa = user_command.Command(
+		"mfa", "Manage a user's TOTP second factor (basic authenticator).")
+	user_mfa_enroll = user_mfa.Command(
+		"enroll", "Start a new enrollment for the user, replacing any existing second factor.")
+	user_mfa_enroll_name = user_mfa_enroll.Arg(
+		"username", "Username to enroll").Required().String()
+	user_mfa_reset = user_mfa.Command(
+		"reset", "Remove the user's second factor so they can enroll again.")
+	user_mfa_reset_name = user_mfa_reset.Arg(
+		"username", "Username to reset").Required().String()
 )
 
 func doAddUser() error {
@@ -205,6 +218,75 @@ func doLockUser() error {
 	return nil
 }
 
+func doMFAUser(username string, enroll bool) error {
+	config_obj, err := makeDefaultConfigLoader().
+		WithRequiredFrontend().LoadAndValidate()
+	if err != nil {
+		return fmt.Errorf("Unable to load config file: %w", err)
+	}
+
+	config_obj.Services = services.GenericToolServices()
+
+	ctx, cancel := install_sig_handler()
+	defer cancel()
+
+	sm, err := startup.StartToolServices(ctx, config_obj)
+	if err != nil {
+		return fmt.Errorf("Starting services: %w", err)
+	}
+	defer sm.Close()
+
+	err = sm.Start(users.StartUserManager)
+	if err != nil {
+		return err
+	}
+
+	users_manager := services.GetUserManager()
+	_, err = users_manager.GetUser(ctx, username)
+	if err != nil {
+		return fmt.Errorf("Unable to find user %s", username)
+	}
+
+	principal := config_obj.Client.PinnedServerName
+	if !enroll {
+		err = users_manager.SetUserMFA(ctx, username, nil)
+		if err != nil {
+			return err
+		}
+
+		logging.LogAudit(config_obj, principal, "MFA reset",
+			logrus.Fields{"user": username})
+		fmt.Printf("Second factor for %v removed\n", username)
+		return nil
+	}
+
+	issuer := ""
+	if config_obj.GUI != nil && config_obj.GUI.Authenticator != nil {
+		issuer = config_obj.GUI.Authenticator.MfaIssuer
+	}
+
+	mfa, enrollment, err := users.NewMFAEnrollment(issuer, username)
+	if err != nil {
+		return err
+	}
+
+	err = users_manager.SetUserMFA(ctx, username, mfa)
+	if err != nil {
+		return err
+	}
+
+	logging.LogAudit(config_obj, principal, "MFA enrollment started",
+		logrus.Fields{"user": username})
+
+	fmt.Printf("Load the following URI into an authenticator app (e.g. as a QR code):\n%v\n\n",
+		enrollment.ProvisioningURI)
+	fmt.Printf("Recovery codes (each may be used once):\n%v\n\n",
+		strings.Join(enrollment.RecoveryCodes, "\n"))
+	fmt.Printf("The enrollment is confirmed when %v first logs in with "+
+		"their password followed by the current code.\n", username)
+	return nil
+}
+
 func init() {
 	command_handlers = append(command_handlers, func(command string) bool {
 		switch command {
@@ -217,6 +299,16 @@ func init() {
 		case user_show.FullCommand():
 			FatalIfError(user_show, doShowUser)
 
+		case user_mfa_enroll.FullCommand():
+			FatalIfError(user_mfa_enroll, func() error {
+				return doMFAUser(*user_mfa_enroll_name, true)
+			})
+
+		case user_mfa_reset.FullCommand():
+			FatalIfError(user_mfa_reset, func() error {
+				return doMFAUser(*user_mfa_reset_name, false)
+			})
+
 		default:
 			return false
 		}
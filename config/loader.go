@@ -146,7 +146,8 @@ func (self *Loader) WithRequiredUser() *Loader {
 		name: "WithRequiredUser",
 		validator: func(self *Loader, config_obj *config_proto.Config) error {
 			if config_obj.Datastore == nil ||
-				config_obj.Datastore.Implementation != "FileBaseDataStore" {
+				(config_obj.Datastore.Implementation != "FileBaseDataStore" &&
+					config_obj.Datastore.Implementation != "SqliteDataStore") {
 				return nil
 			}
 
@@ -78,9 +78,20 @@ const (
 	NTFS_MAX_LINKS           = "NTFS_MAX_LINKS"
 	NTFS_INCLUDE_SHORT_NAMES = "NTFS_INCLUDE_SHORT_NAMES"
 
+	// Number of pages of the ext4 device to cache in memory.
+	EXT4_CACHE_SIZE = "EXT4_CACHE_SIZE"
+
+	// List deleted directory entries recovered by the ext4 accessor.
+	EXT4_INCLUDE_DELETED = "EXT4_INCLUDE_DELETED"
+
+	// Number of pages of virtual disk image files (VMDK, VHDX,
+	// QCOW2) to cache in memory.
+	VDISK_CACHE_SIZE = "VDISK_CACHE_SIZE"
+
 	RAW_REG_CACHE_SIZE  = "RAW_REG_CACHE_SIZE"
 	BINARY_CACHE_SIZE   = "BINARY_CACHE_SIZE"
 	EVTX_FREQUENCY      = "EVTX_FREQUENCY"
+	JOURNALD_FREQUENCY  = "JOURNALD_FREQUENCY"
 	USN_FREQUENCY       = "USN_FREQUENCY"
 	ZIP_FILE_CACHE_SIZE = "ZIP_FILE_CACHE_SIZE"
 
@@ -103,6 +114,14 @@ const (
 
 	PinnedServerName = "VelociraptorServer"
 
+	// Clients report events dropped from their ring buffer to this
+	// artifact.
+	CLIENT_EVENT_BUFFER_STATS_ARTIFACT = "System.Client.EventBufferStats"
+
+	// Clients report rows suppressed by the suppression rules of
+	// event artifacts to this artifact.
+	CLIENT_EVENT_SUPPRESSION_STATS_ARTIFACT = "System.Client.EventSuppressionStats"
+
 	CLIENT_API_VERSION = uint32(4)
 
 	// The newer client communications from version 0.6.8:
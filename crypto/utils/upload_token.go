@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/go-errors/errors"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	"www.velocidex.com/golang/velociraptor/utils"
+)
+
+const (
+	UPLOAD_TOKEN_HEADER = "X-Velociraptor-Upload-Token"
+
+	// The total size of all uploads made with a token unless
+	// specified.
+	DEFAULT_UPLOAD_TOKEN_MAX_SIZE = 10 * 1024 * 1024 * 1024
+)
+
+var (
+	invalidUploadTokenError = errors.New("Invalid upload token")
+)
+
+// An upload token authorizes an offline collector to upload its
+// collection to the frontend. The token is embedded in the collector
+// so it is signed by the server and only valid for a limited time.
+// The server imports the uploads with the permissions of the
+// principal and accepts at most MaxSize bytes in total.
+type UploadToken struct {
+	OrgId     string `json:"org_id,omitempty"`
+	Principal string `json:"principal,omitempty"`
+	Expires   int64  `json:"expires,omitempty"`
+	MaxSize   int64  `json:"max_size,omitempty"`
+}
+
+// The signing key is derived from the frontend private key so it is
+// never used directly.
+func uploadTokenKey(config_obj *config_proto.Config) ([]byte, error) {
+	if config_obj.Frontend == nil || config_obj.Frontend.PrivateKey == "" {
+		return nil, errors.New("Frontend private key is not configured")
+	}
+
+	hash := sha256.Sum256([]byte("upload token:" + config_obj.Frontend.PrivateKey))
+	return hash[:], nil
+}
+
+func signUploadToken(key []byte, payload string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func NewUploadToken(config_obj *config_proto.Config,
+	org_id, principal string, expires time.Time,
+	max_size int64) (string, error) {
+	key, err := uploadTokenKey(config_obj)
+	if err != nil {
+		return "", err
+	}
+
+	serialized, err := json.Marshal(&UploadToken{
+		OrgId:     org_id,
+		Principal: principal,
+		Expires:   expires.Unix(),
+		MaxSize:   max_size,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	payload := base64.RawURLEncoding.EncodeToString(serialized)
+	return payload + "." + signUploadToken(key, payload), nil
+}
+
+func VerifyUploadToken(config_obj *config_proto.Config,
+	token string) (*UploadToken, error) {
+	key, err := uploadTokenKey(config_obj)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := strings.SplitN(token, ".", 2)
+	if len(parts) != 2 {
+		return nil, invalidUploadTokenError
+	}
+
+	if !hmac.Equal([]byte(signUploadToken(key, parts[0])), []byte(parts[1])) {
+		return nil, invalidUploadTokenError
+	}
+
+	serialized, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, invalidUploadTokenError
+	}
+
+	result := &UploadToken{}
+	err = json.Unmarshal(serialized, result)
+	if err != nil {
+		return nil, invalidUploadTokenError
+	}
+
+	if result.Expires < utils.GetTime().Now().Unix() {
+		return nil, errors.New("Upload token expired")
+	}
+
+	if result.MaxSize <= 0 {
+		result.MaxSize = DEFAULT_UPLOAD_TOKEN_MAX_SIZE
+	}
+
+	return result, nil
+}
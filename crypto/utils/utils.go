@@ -45,6 +45,10 @@ func GetPrivateKeyFromScope(scope vfilter.Scope) (*rsa.PrivateKey, error) {
 		return nil, errors.New("Must be running on server!")
 	}
 
+	if config_obj.Frontend == nil || config_obj.Frontend.PrivateKey == "" {
+		return nil, errors.New("Server private key is not available")
+	}
+
 	private_key := config_obj.Frontend.PrivateKey
 
 	key, err := ParseRsaPrivateKeyFromPemStr([]byte(private_key))
@@ -86,6 +90,45 @@ func EncryptRSAOAEP(msg []byte, pub *rsa.PublicKey) ([]byte, error) {
 	return rsa.EncryptOAEP(hash, rand.Reader, pub, msg, nil)
 }
 
+// Encrypt data using RSA-OAEP with a PEM encoded X509 certificate or
+// RSA public key.
+func EncryptWithPemPublicKey(msg []byte, pem_str []byte) ([]byte, error) {
+	for {
+		block, rest := pem.Decode(pem_str)
+		if block == nil {
+			return nil, errors.New("Unable to parse PEM: No certificate or public key found")
+		}
+
+		switch block.Type {
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, errors.Wrap(err, 0)
+			}
+			return EncryptWithX509PubKey(msg, cert)
+
+		case "RSA PUBLIC KEY":
+			pub, err := x509.ParsePKCS1PublicKey(block.Bytes)
+			if err != nil {
+				return nil, errors.Wrap(err, 0)
+			}
+			return EncryptRSAOAEP(msg, pub)
+
+		case "PUBLIC KEY":
+			pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, errors.Wrap(err, 0)
+			}
+			rsa_pub, ok := pub.(*rsa.PublicKey)
+			if !ok {
+				return nil, errors.New("Unsupported Type of Public Key")
+			}
+			return EncryptRSAOAEP(msg, rsa_pub)
+		}
+		pem_str = rest
+	}
+}
+
 func ParseRsaPrivateKeyFromPemStr(pem_str []byte) (*rsa.PrivateKey, error) {
 	for {
 		block, rest := pem.Decode(pem_str)
@@ -142,6 +142,12 @@ func getImpl(config_obj *config_proto.Config, implementation string) (DataStore,
 	case "RemoteFileDataStore":
 		return remote_datastopre_imp, nil
 
+	case "SqliteDataStore":
+		if sqlite_imp == nil {
+			sqlite_imp = NewSqliteDataStore()
+		}
+		return sqlite_imp, nil
+
 	case "Memcache":
 		if memcache_imp == nil {
 			memcache_imp_ := NewMemcacheDataStore(config_obj)
@@ -0,0 +1,457 @@
+/*
+   Velociraptor - Dig Deeper
+   Copyright (C) 2019-2022 Rapid7 Inc.
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published
+   by the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+// A data store backed by an embedded SQLite database.
+
+// The FileBaseDataStore writes a small file for each subject which
+// can consume a lot of inodes on large deployments and makes backups
+// slow. This datastore keeps all subjects in a single SQLite database
+// file inside the datastore location (there is one database per org
+// since each org has its own datastore location).
+
+// Subjects are keyed by their parent directory and name so listing
+// a directory is a simple index lookup. Intermediate directories are
+// recorded in a separate table as subjects are written so they can
+// be listed just like the FileBaseDataStore lists directories.
+
+// Large result sets are still stored in the file store on disk - this
+// datastore only replaces the small subject files.
+package datastore
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/go-errors/errors"
+	_ "github.com/mattn/go-sqlite3"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	"www.velocidex.com/golang/velociraptor/file_store/api"
+	"www.velocidex.com/golang/velociraptor/logging"
+	"www.velocidex.com/golang/velociraptor/utils"
+)
+
+const (
+	SQLITE_DATASTORE_FILENAME = "datastore.sqlite"
+
+	// A marker type for directory rows in ListChildren queries.
+	sqliteDirectoryType = -1
+)
+
+var (
+	sqlite_imp *SqliteDataStore
+
+	sqliteSchema = []string{`
+CREATE TABLE IF NOT EXISTS subjects (
+   parent TEXT NOT NULL,
+   name TEXT NOT NULL,
+   type INTEGER NOT NULL,
+   data BLOB,
+   modified INTEGER NOT NULL,
+   PRIMARY KEY (parent, name, type)
+)`, `
+CREATE TABLE IF NOT EXISTS directories (
+   parent TEXT NOT NULL,
+   name TEXT NOT NULL,
+   modified INTEGER NOT NULL,
+   PRIMARY KEY (parent, name)
+)`, `
+CREATE INDEX IF NOT EXISTS subjects_modified ON subjects (parent, modified)
+`}
+)
+
+type SqliteDataStore struct {
+	mu sync.Mutex
+
+	// One database per datastore location (i.e. per org).
+	dbs map[string]*sql.DB
+
+	// Used to keep modification times strictly increasing so
+	// ListChildren returns children in the order they were written.
+	last_modified int64
+}
+
+// Directories are keyed by their escaped components. This is
+// unambiguous because utils.JoinComponents quotes components that
+// contain path separators.
+func sqliteDirKey(components []string) string {
+	return utils.JoinComponents(components, "/")
+}
+
+func sqliteSplitURN(urn api.DSPathSpec) (parent string, name string) {
+	components := urn.Components()
+	if len(components) == 0 {
+		return "", ""
+	}
+	return sqliteDirKey(components[:len(components)-1]),
+		components[len(components)-1]
+}
+
+func (self *SqliteDataStore) getDB(
+	config_obj *config_proto.Config) (*sql.DB, error) {
+	if config_obj.Datastore == nil || config_obj.Datastore.Location == "" {
+		return nil, datastoreNotConfiguredError
+	}
+
+	filename := filepath.Join(config_obj.Datastore.Location,
+		SQLITE_DATASTORE_FILENAME)
+
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	db, pres := self.dbs[filename]
+	if pres {
+		return db, nil
+	}
+
+	err := os.MkdirAll(config_obj.Datastore.Location, 0700)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	// WAL mode allows readers to proceed concurrently with a
+	// writer. The busy timeout makes concurrent writers wait for
+	// each other instead of failing.
+	db, err = sql.Open("sqlite3", fmt.Sprintf(
+		"file:%s?_journal_mode=WAL&_busy_timeout=10000&_synchronous=NORMAL",
+		filename))
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	for _, statement := range sqliteSchema {
+		_, err = db.Exec(statement)
+		if err != nil {
+			db.Close()
+			return nil, errors.Wrap(err, 0)
+		}
+	}
+
+	self.dbs[filename] = db
+	return db, nil
+}
+
+func (self *SqliteDataStore) nextModified() int64 {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	now := utils.GetTime().Now().UnixNano()
+	if now <= self.last_modified {
+		now = self.last_modified + 1
+	}
+	self.last_modified = now
+	return now
+}
+
+func (self *SqliteDataStore) getData(
+	config_obj *config_proto.Config, urn api.DSPathSpec) ([]byte, error) {
+	db, err := self.getDB(config_obj)
+	if err != nil {
+		return nil, err
+	}
+
+	parent, name := sqliteSplitURN(urn)
+
+	var data []byte
+	err = db.QueryRow(`
+SELECT data FROM subjects WHERE parent = ? AND name = ? AND type = ?`,
+		parent, name, int(urn.Type())).Scan(&data)
+
+	// Try to read older protobuf based subjects for backwards
+	// compatibility.
+	if err == sql.ErrNoRows && urn.Type() == api.PATH_TYPE_DATASTORE_JSON {
+		err = db.QueryRow(`
+SELECT data FROM subjects WHERE parent = ? AND name = ? AND type = ?`,
+			parent, name, int(api.PATH_TYPE_DATASTORE_PROTO)).Scan(&data)
+	}
+
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("While opening %v: %w", urn.AsClientPath(),
+			os.ErrNotExist)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+	return data, nil
+}
+
+// Writes the data and all intermediate directories in a single
+// transaction.
+func (self *SqliteDataStore) setData(
+	config_obj *config_proto.Config,
+	urn api.DSPathSpec, data []byte) error {
+	db, err := self.getDB(config_obj)
+	if err != nil {
+		return err
+	}
+
+	modified := self.nextModified()
+	components := urn.Components()
+
+	tx, err := db.Begin()
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+	defer tx.Rollback()
+
+	for i := 0; i < len(components)-1; i++ {
+		_, err = tx.Exec(`
+INSERT OR IGNORE INTO directories (parent, name, modified) VALUES (?, ?, ?)`,
+			sqliteDirKey(components[:i]), components[i], modified)
+		if err != nil {
+			return errors.Wrap(err, 0)
+		}
+	}
+
+	parent, name := sqliteSplitURN(urn)
+	_, err = tx.Exec(`
+INSERT INTO subjects (parent, name, type, data, modified) VALUES (?, ?, ?, ?, ?)
+ON CONFLICT (parent, name, type) DO UPDATE SET
+   data = excluded.data, modified = excluded.modified`,
+		parent, name, int(urn.Type()), data, modified)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return tx.Commit()
+}
+
+func (self *SqliteDataStore) GetSubject(
+	config_obj *config_proto.Config,
+	urn api.DSPathSpec,
+	message proto.Message) error {
+
+	defer InstrumentWithDelay("read", "SqliteDataStore", urn)()
+
+	data, err := self.getData(config_obj, urn)
+	if err != nil {
+		return err
+	}
+
+	return unmarshalData(data, urn, message)
+}
+
+func (self *SqliteDataStore) SetSubject(
+	config_obj *config_proto.Config,
+	urn api.DSPathSpec,
+	message proto.Message) error {
+
+	return self.SetSubjectWithCompletion(config_obj, urn, message, nil)
+}
+
+func (self *SqliteDataStore) SetSubjectWithCompletion(
+	config_obj *config_proto.Config,
+	urn api.DSPathSpec,
+	message proto.Message, completion func()) error {
+
+	defer InstrumentWithDelay("write", "SqliteDataStore", urn)()
+
+	// Make sure to call the completer on all exit points
+	// (SqliteDataStore is actually synchronous - the transaction is
+	// committed before we return).
+	defer func() {
+		if completion != nil &&
+			!utils.CompareFuncs(completion, utils.SyncCompleter) {
+			completion()
+		}
+	}()
+
+	var serialized_content []byte
+	var err error
+
+	if urn.Type() == api.PATH_TYPE_DATASTORE_JSON {
+		serialized_content, err = protojson.Marshal(message)
+	} else {
+		serialized_content, err = proto.Marshal(message)
+	}
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return self.setData(config_obj, urn, serialized_content)
+}
+
+func (self *SqliteDataStore) DeleteSubjectWithCompletion(
+	config_obj *config_proto.Config,
+	urn api.DSPathSpec, completion func()) error {
+
+	err := self.DeleteSubject(config_obj, urn)
+	if completion != nil &&
+		!utils.CompareFuncs(completion, utils.SyncCompleter) {
+		completion()
+	}
+
+	return err
+}
+
+func (self *SqliteDataStore) DeleteSubject(
+	config_obj *config_proto.Config,
+	urn api.DSPathSpec) error {
+
+	defer InstrumentWithDelay("delete", "SqliteDataStore", urn)()
+
+	db, err := self.getDB(config_obj)
+	if err != nil {
+		return err
+	}
+
+	// It is ok to remove a subject that does not exist.  Note: We do
+	// not currently remove empty intermediate directories, just like
+	// the FileBaseDataStore.
+	parent, name := sqliteSplitURN(urn)
+	_, err = db.Exec(`
+DELETE FROM subjects WHERE parent = ? AND name = ? AND type = ?`,
+		parent, name, int(urn.Type()))
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+	return nil
+}
+
+// Lists all the children of a URN. Children are returned in the
+// order they were last written, and directories are returned along
+// with the subjects.
+func (self *SqliteDataStore) ListChildren(
+	config_obj *config_proto.Config,
+	urn api.DSPathSpec) ([]api.DSPathSpec, error) {
+
+	defer InstrumentWithDelay("list", "SqliteDataStore", urn)()
+
+	db, err := self.getDB(config_obj)
+	if err != nil {
+		return nil, err
+	}
+
+	max_dir_size := int(config_obj.Datastore.MaxDirSize)
+	if max_dir_size == 0 {
+		max_dir_size = 50000
+	}
+
+	// Fetch one more than the max so we can tell if the directory
+	// was truncated.
+	rows, err := db.Query(`
+SELECT name, type, modified FROM subjects WHERE parent = ?
+UNION ALL
+SELECT name, ?, modified FROM directories WHERE parent = ?
+ORDER BY modified, name
+LIMIT ?`, sqliteDirKey(urn.Components()), sqliteDirectoryType,
+		sqliteDirKey(urn.Components()), max_dir_size+1)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+	defer rows.Close()
+
+	result := []api.DSPathSpec{}
+	for rows.Next() {
+		var name string
+		var spec_type int
+		var modified int64
+
+		err = rows.Scan(&name, &spec_type, &modified)
+		if err != nil {
+			return nil, errors.Wrap(err, 0)
+		}
+
+		if spec_type == sqliteDirectoryType {
+			result = append(result, urn.AddUnsafeChild(name).SetDir())
+			continue
+		}
+
+		result = append(result,
+			urn.AddUnsafeChild(name).SetType(api.PathType(spec_type)))
+	}
+
+	if len(result) > max_dir_size {
+		logger := logging.GetLogger(config_obj, &logging.FrontendComponent)
+		logger.Error(
+			"ListChildren: Encountered a large directory %v, "+
+				"truncating to %v", urn.AsClientPath(), max_dir_size)
+		return result[:max_dir_size], nil
+	}
+
+	return result, rows.Err()
+}
+
+func (self *SqliteDataStore) Debug(config_obj *config_proto.Config) {
+	db, err := self.getDB(config_obj)
+	if err != nil {
+		return
+	}
+
+	rows, err := db.Query(`
+SELECT parent, name, type, length(data), modified FROM subjects
+ORDER BY parent, name`)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var parent, name string
+		var spec_type, length int
+		var modified int64
+
+		err = rows.Scan(&parent, &name, &spec_type, &length, &modified)
+		if err != nil {
+			return
+		}
+		fmt.Printf("%v/%v (%v) -> %v %v\n", parent, name, spec_type, length,
+			time.Unix(0, modified).UTC())
+	}
+}
+
+// Called to close all db handles etc.
+func (self *SqliteDataStore) Close() {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	for _, db := range self.dbs {
+		db.Close()
+	}
+	self.dbs = make(map[string]*sql.DB)
+}
+
+// Support RawDataStore interface
+func (self *SqliteDataStore) GetBuffer(
+	config_obj *config_proto.Config,
+	urn api.DSPathSpec) ([]byte, error) {
+
+	return self.getData(config_obj, urn)
+}
+
+func (self *SqliteDataStore) SetBuffer(
+	config_obj *config_proto.Config,
+	urn api.DSPathSpec, data []byte, completion func()) error {
+
+	err := self.setData(config_obj, urn, data)
+	if completion != nil &&
+		!utils.CompareFuncs(completion, utils.SyncCompleter) {
+		completion()
+	}
+	return err
+}
+
+func NewSqliteDataStore() *SqliteDataStore {
+	return &SqliteDataStore{
+		dbs: make(map[string]*sql.DB),
+	}
+}
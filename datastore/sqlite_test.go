@@ -0,0 +1,69 @@
+package datastore
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"www.velocidex.com/golang/velociraptor/config"
+	"www.velocidex.com/golang/velociraptor/file_store/api"
+	"www.velocidex.com/golang/velociraptor/file_store/path_specs"
+)
+
+type SqliteTestSuite struct {
+	BaseTestSuite
+	dirname string
+}
+
+func (self *SqliteTestSuite) SetupTest() {
+	var err error
+	self.dirname, err = ioutil.TempDir("", "sqlite_datastore_test")
+	assert.NoError(self.T(), err)
+
+	self.config_obj = config.GetDefaultConfig()
+	self.config_obj.Datastore.Implementation = "SqliteDataStore"
+	self.config_obj.Datastore.FilestoreDirectory = self.dirname
+	self.config_obj.Datastore.Location = self.dirname
+	self.BaseTestSuite.config_obj = self.config_obj
+}
+
+func (self *SqliteTestSuite) TearDownTest() {
+	self.datastore.Close()
+	os.RemoveAll(self.dirname) // clean up
+}
+
+// Deleting a subject should not affect other subjects in the same
+// directory.
+func (self *SqliteTestSuite) TestDeleteSubject() {
+	dir := path_specs.NewSafeDatastorePath("a", "b")
+	first := dir.AddChild("first").SetType(api.PATH_TYPE_DATASTORE_JSON)
+	second := dir.AddChild("second").SetType(api.PATH_TYPE_DATASTORE_JSON)
+
+	raw := self.datastore.(RawDataStore)
+	assert.NoError(self.T(), raw.SetBuffer(
+		self.config_obj, first, []byte("{}"), nil))
+	assert.NoError(self.T(), raw.SetBuffer(
+		self.config_obj, second, []byte("{}"), nil))
+
+	assert.NoError(self.T(), self.datastore.DeleteSubject(
+		self.config_obj, first))
+
+	_, err := raw.GetBuffer(self.config_obj, first)
+	assert.True(self.T(), errors.Is(err, os.ErrNotExist))
+
+	children, err := self.datastore.ListChildren(self.config_obj, dir)
+	assert.NoError(self.T(), err)
+	assert.Equal(self.T(), 1, len(children))
+	assert.Equal(self.T(), "second", children[0].Base())
+}
+
+func TestSqliteDatabase(t *testing.T) {
+	suite.Run(t, &SqliteTestSuite{
+		BaseTestSuite: BaseTestSuite{
+			datastore: NewSqliteDataStore(),
+		},
+	})
+}
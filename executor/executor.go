@@ -195,7 +195,8 @@ func NewClientExecutor(
 	}
 
 	// Install and initialize the event manager
-	self.event_manager = actions.NewEventTable(ctx, wg, config_obj)
+	self.event_manager = actions.NewEventTable(
+		ctx, wg, config_obj, self.flow_manager)
 	self.event_manager.StartFromWriteback(ctx, wg, config_obj, self.Outbound)
 
 	// Drain messages from server and execute them, pushing
@@ -27,6 +27,8 @@ import (
 	"www.velocidex.com/golang/velociraptor/file_store/directory"
 	"www.velocidex.com/golang/velociraptor/file_store/memcache"
 	"www.velocidex.com/golang/velociraptor/file_store/memory"
+	"www.velocidex.com/golang/velociraptor/file_store/s3"
+	"www.velocidex.com/golang/velociraptor/logging"
 )
 
 var (
@@ -57,13 +59,33 @@ func GetFileStore(config_obj *config_proto.Config) api.FileStore {
 		panic(err)
 	}
 
-	res, _ := getImpl(implementation, config_obj)
+	res, err := getImpl(implementation, config_obj)
+	if err != nil {
+		// Do not cache the failure so the next call can try again
+		// (e.g. when S3 is temporarily unreachable).
+		logger := logging.GetLogger(config_obj, &logging.FrontendComponent)
+		logger.Error("GetFileStore: Unable to create filestore: %v", err)
+		return nil
+	}
+
 	g_impl[config_obj.OrgId] = res
 	return res
 }
 
 func getImpl(implementation string,
 	config_obj *config_proto.Config) (api.FileStore, error) {
+
+	// The S3 file store may be used with any persistent datastore.
+	if implementation != "Test" &&
+		config_obj.Datastore.S3Filestore != nil &&
+		config_obj.Datastore.S3Filestore.Bucket != "" {
+		res, err := s3.NewS3FileStore(config_obj)
+		if err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
 	switch implementation {
 	case "Test":
 		return memory.NewMemoryFileStore(config_obj), nil
@@ -71,7 +93,7 @@ func getImpl(implementation string,
 	case "MemcacheFileDataStore", "RemoteFileDataStore":
 		return memcache.NewMemcacheFileStore(config_obj), nil
 
-	case "FileBaseDataStore", "ReadOnlyDataStore":
+	case "FileBaseDataStore", "ReadOnlyDataStore", "SqliteDataStore":
 		return directory.NewDirectoryFileStore(config_obj), nil
 
 	default:
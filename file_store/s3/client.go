@@ -0,0 +1,420 @@
+package s3
+
+// A minimal client for the S3 REST API. We only need a small subset
+// of the API to implement the file store so we speak the protocol
+// directly rather than pulling in a full SDK. This works against AWS
+// and S3 compatible servers such as MinIO.
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-errors/errors"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	"www.velocidex.com/golang/velociraptor/utils"
+)
+
+const (
+	amzDateFormat = "20060102T150405Z"
+	amzDayFormat  = "20060102"
+)
+
+type objectInfo struct {
+	Key          string    `xml:"Key"`
+	Size         int64     `xml:"Size"`
+	LastModified time.Time `xml:"LastModified"`
+}
+
+type listBucketResult struct {
+	IsTruncated    bool         `xml:"IsTruncated"`
+	Contents       []objectInfo `xml:"Contents"`
+	CommonPrefixes []struct {
+		Prefix string `xml:"Prefix"`
+	} `xml:"CommonPrefixes"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+var (
+	// A conditional write lost the race with another writer.
+	preconditionFailedError = errors.New("S3 precondition failed")
+)
+
+type s3Error struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+type s3Client struct {
+	endpoint   *url.URL
+	bucket     string
+	region     string
+	key        string
+	secret     string
+	path_style bool
+
+	client *http.Client
+}
+
+func newS3Client(config_obj *config_proto.S3FilestoreConfig) (*s3Client, error) {
+	if config_obj.Bucket == "" {
+		return nil, errors.New("S3 filestore: bucket not configured")
+	}
+
+	result := &s3Client{
+		bucket: config_obj.Bucket,
+		region: config_obj.Region,
+		key:    config_obj.CredentialsKey,
+		secret: config_obj.CredentialsSecret,
+	}
+
+	if result.region == "" {
+		result.region = "us-east-1"
+	}
+
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+	}
+	if config_obj.NoVerifyCert {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	result.client = &http.Client{
+		Transport: transport,
+		Timeout:   5 * time.Minute,
+	}
+
+	endpoint := config_obj.Endpoint
+	if endpoint == "" {
+		// Use virtual host style addressing for AWS.
+		endpoint = fmt.Sprintf("https://%s.s3.%s.amazonaws.com",
+			result.bucket, result.region)
+	} else {
+		// S3 compatible servers generally only support path style
+		// addressing.
+		result.path_style = true
+	}
+
+	var err error
+	result.endpoint, err = url.Parse(endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return result, nil
+}
+
+// Encode according to the rules of the AWS signature: Only
+// unreserved characters are left alone.
+func awsURIEncode(in string, encode_slash bool) string {
+	result := &strings.Builder{}
+	for _, c := range []byte(in) {
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') ||
+			(c >= '0' && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' ||
+			(c == '/' && !encode_slash) {
+			result.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(result, "%%%02X", c)
+	}
+	return result.String()
+}
+
+func (self *s3Client) newRequest(
+	method, key string, query url.Values, body []byte) (*http.Request, error) {
+
+	u := *self.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	u.RawPath = strings.TrimSuffix(self.endpoint.EscapedPath(), "/")
+	if self.path_style {
+		u.Path += "/" + self.bucket
+		u.RawPath += "/" + awsURIEncode(self.bucket, true)
+	}
+
+	// Bucket level operations address the bucket itself.
+	if key != "" || !self.path_style {
+		u.Path += "/" + key
+		u.RawPath += "/" + awsURIEncode(key, false)
+	}
+	u.RawQuery = canonicalQuery(query)
+
+	req, err := http.NewRequest(method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	self.sign(req, body)
+
+	return req, nil
+}
+
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		for _, v := range query[k] {
+			parts = append(parts,
+				awsURIEncode(k, true)+"="+awsURIEncode(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// Sign the request using AWS Signature Version 4. Requests are sent
+// anonymously when no credentials are configured.
+func (self *s3Client) sign(req *http.Request, body []byte) {
+	payload_hash := sha256.Sum256(body)
+	payload_hex := hex.EncodeToString(payload_hash[:])
+
+	now := utils.GetTime().Now().UTC()
+	amz_date := now.Format(amzDateFormat)
+
+	req.Header.Set("x-amz-content-sha256", payload_hex)
+	req.Header.Set("x-amz-date", amz_date)
+
+	if self.key == "" || self.secret == "" {
+		return
+	}
+
+	signed_headers := "host;x-amz-content-sha256;x-amz-date"
+	canonical_request := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		"host:" + req.URL.Host,
+		"x-amz-content-sha256:" + payload_hex,
+		"x-amz-date:" + amz_date,
+		"",
+		signed_headers,
+		payload_hex,
+	}, "\n")
+
+	scope := strings.Join([]string{
+		now.Format(amzDayFormat), self.region, "s3", "aws4_request"}, "/")
+
+	canonical_hash := sha256.Sum256([]byte(canonical_request))
+	string_to_sign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amz_date,
+		scope,
+		hex.EncodeToString(canonical_hash[:]),
+	}, "\n")
+
+	signing_key := hmacSHA256([]byte("AWS4"+self.secret), now.Format(amzDayFormat))
+	signing_key = hmacSHA256(signing_key, self.region)
+	signing_key = hmacSHA256(signing_key, "s3")
+	signing_key = hmacSHA256(signing_key, "aws4_request")
+
+	signature := hex.EncodeToString(hmacSHA256(signing_key, string_to_sign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		self.key, scope, signed_headers, signature))
+}
+
+func (self *s3Client) do(req *http.Request, key string) (*http.Response, error) {
+	resp, err := self.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, fmt.Errorf("S3 object %v: %w", key, os.ErrNotExist)
+	}
+
+	// S3 returns 409 when a concurrent conditional write is in
+	// progress.
+	if resp.StatusCode == http.StatusPreconditionFailed ||
+		resp.StatusCode == http.StatusConflict {
+		resp.Body.Close()
+		return nil, fmt.Errorf("S3 object %v: %w", key, preconditionFailedError)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+
+		s3_err := &s3Error{}
+		data, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 10000))
+		_ = xml.Unmarshal(data, s3_err)
+		return nil, fmt.Errorf("S3 %v %v: %v %v: %v", req.Method, key,
+			resp.Status, s3_err.Code, s3_err.Message)
+	}
+
+	return resp, nil
+}
+
+func (self *s3Client) PutObject(key string, data []byte) error {
+	req, err := self.newRequest("PUT", key, nil, data)
+	if err != nil {
+		return err
+	}
+
+	resp, err := self.do(req, key)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// Only write the object if it still has the etag, or if etag is
+// empty, only if it does not exist yet. Fails with
+// preconditionFailedError otherwise.
+func (self *s3Client) PutObjectIfMatch(key string, data []byte, etag string) error {
+	req, err := self.newRequest("PUT", key, nil, data)
+	if err != nil {
+		return err
+	}
+
+	if etag == "" {
+		req.Header.Set("If-None-Match", "*")
+	} else {
+		req.Header.Set("If-Match", etag)
+	}
+
+	resp, err := self.do(req, key)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// Read the entire object.
+func (self *s3Client) GetObject(key string) ([]byte, error) {
+	return self.GetObjectRange(key, 0, -1)
+}
+
+// Read the entire object and its etag.
+func (self *s3Client) GetObjectWithETag(key string) ([]byte, string, error) {
+	req, err := self.newRequest("GET", key, nil, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	resp, err := self.do(req, key)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", errors.Wrap(err, 0)
+	}
+	return data, resp.Header.Get("ETag"), nil
+}
+
+// Read length bytes from the object starting at offset. If length
+// is negative we read to the end of the object.
+func (self *s3Client) GetObjectRange(
+	key string, offset, length int64) ([]byte, error) {
+	req, err := self.newRequest("GET", key, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if length >= 0 {
+		req.Header.Set("Range", fmt.Sprintf(
+			"bytes=%d-%d", offset, offset+length-1))
+	} else if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := self.do(req, key)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+	return data, nil
+}
+
+func (self *s3Client) DeleteObject(key string) error {
+	req, err := self.newRequest("DELETE", key, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := self.do(req, key)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// List all objects under the prefix. If delimiter is specified,
+// objects further down the hierarchy are grouped into common
+// prefixes.
+func (self *s3Client) ListObjects(prefix, delimiter string) (
+	objects []objectInfo, prefixes []string, err error) {
+
+	continuation_token := ""
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", prefix)
+		if delimiter != "" {
+			query.Set("delimiter", delimiter)
+		}
+		if continuation_token != "" {
+			query.Set("continuation-token", continuation_token)
+		}
+
+		req, err := self.newRequest("GET", "", query, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resp, err := self.do(req, prefix)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		result := &listBucketResult{}
+		err = xml.NewDecoder(resp.Body).Decode(result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, 0)
+		}
+
+		objects = append(objects, result.Contents...)
+		for _, p := range result.CommonPrefixes {
+			prefixes = append(prefixes, p.Prefix)
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return objects, prefixes, nil
+		}
+		continuation_token = result.NextContinuationToken
+	}
+}
@@ -0,0 +1,785 @@
+// This is an implementation of the file store based on an S3
+// compatible object store.
+
+// Using an object store allows the frontend to run with ephemeral
+// disks since all result sets and uploads are kept in the bucket.
+
+package s3
+
+/*
+  Objects in S3 are immutable so we can not append to them. Instead
+  each file in the file store is represented by a small manifest
+  object which lists a sequence of segment objects:
+
+  <prefix>files/<path>.<ext>    - The JSON manifest.
+  <prefix>segments/<id>/<name>  - The file's data segments.
+
+  Writers buffer data in memory and upload a new segment when the
+  buffer reaches the segment size or the writer is closed. Flushing
+  only uploads buffers larger than MIN_SEGMENT_SIZE, and a small
+  trailing segment is merged with the next write into a new segment
+  object, so files do not end up as many tiny objects.
+
+  Segment objects are never modified. The manifest is updated with a
+  conditional PUT on its etag so several frontends can safely write
+  to the same bucket - a writer which loses the race cleans up its
+  new segment and tries again.
+
+  The manifest refers to the segments by a unique id so moving a file
+  only requires moving the manifest and truncating a file simply
+  starts a new id.
+
+  Directories are implicit - they are the common prefixes of the
+  manifest keys.
+
+  Orgs other than the root org add orgs/<org id>/ to the prefix.
+*/
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"www.velocidex.com/golang/velociraptor/accessors/file_store_file_info"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	"www.velocidex.com/golang/velociraptor/file_store/api"
+	"www.velocidex.com/golang/velociraptor/utils"
+)
+
+const (
+	DEFAULT_SEGMENT_SIZE = 5 * 1024 * 1024
+
+	// Flushing a writer only uploads its buffer once it holds at
+	// least this much. Smaller trailing segments are merged with
+	// the next write so files written in small pieces do not end
+	// up as many tiny objects.
+	MIN_SEGMENT_SIZE = 64 * 1024
+
+	// How often to retry a manifest update which raced with
+	// another frontend.
+	MAX_MANIFEST_RETRIES = 10
+)
+
+type s3Segment struct {
+	Name string `json:"name"`
+	Size int64  `json:"size"`
+}
+
+type s3Manifest struct {
+	Id       string      `json:"id"`
+	Segments []s3Segment `json:"segments"`
+	Modified int64       `json:"modified"`
+
+	// The etag of the manifest object when it was read. Updates are
+	// conditional on it so concurrent writers on other frontends
+	// are detected.
+	etag string
+}
+
+func (self *s3Manifest) Size() (result int64) {
+	for _, s := range self.Segments {
+		result += s.Size
+	}
+	return result
+}
+
+// Serializes manifest updates to the same key within this process
+// so they do not need to retry against each other.
+type keyLock struct {
+	sync.Mutex
+	refs int
+}
+
+type keyLocks struct {
+	mu    sync.Mutex
+	locks map[string]*keyLock
+}
+
+// Lock the keys and return a function to unlock them. Keys are
+// locked in order so two callers can not deadlock.
+func (self *keyLocks) Lock(keys ...string) func() {
+	keys = append([]string{}, keys...)
+	sort.Strings(keys)
+
+	var held []*keyLock
+	var held_keys []string
+	for _, key := range keys {
+		if len(held_keys) > 0 && held_keys[len(held_keys)-1] == key {
+			continue
+		}
+
+		self.mu.Lock()
+		lock, pres := self.locks[key]
+		if !pres {
+			lock = &keyLock{}
+			self.locks[key] = lock
+		}
+		lock.refs++
+		self.mu.Unlock()
+
+		lock.Lock()
+		held = append(held, lock)
+		held_keys = append(held_keys, key)
+	}
+
+	return func() {
+		for i, lock := range held {
+			lock.Unlock()
+
+			self.mu.Lock()
+			lock.refs--
+			if lock.refs == 0 {
+				delete(self.locks, held_keys[i])
+			}
+			self.mu.Unlock()
+		}
+	}
+}
+
+type S3FileStore struct {
+	// Protects manifest read-modify-write cycles.
+	locks *keyLocks
+
+	config_obj       *config_proto.Config
+	client           *s3Client
+	prefix           string
+	segment_size     int
+	min_segment_size int
+}
+
+func NewS3FileStore(config_obj *config_proto.Config) (*S3FileStore, error) {
+	if config_obj.Datastore == nil || config_obj.Datastore.S3Filestore == nil {
+		return nil, errors.New("S3 filestore not configured")
+	}
+
+	s3_config := config_obj.Datastore.S3Filestore
+	client, err := newS3Client(s3_config)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := strings.Trim(s3_config.Prefix, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+
+	// Keep each org's files separate just like the directory file
+	// store does.
+	if !utils.IsRootOrg(config_obj.OrgId) {
+		prefix += "orgs/" + config_obj.OrgId + "/"
+	}
+
+	segment_size := int(s3_config.SegmentSize)
+	if segment_size == 0 {
+		segment_size = DEFAULT_SEGMENT_SIZE
+	}
+
+	min_segment_size := MIN_SEGMENT_SIZE
+	if min_segment_size > segment_size {
+		min_segment_size = segment_size
+	}
+
+	return &S3FileStore{
+		locks:            &keyLocks{locks: make(map[string]*keyLock)},
+		config_obj:       config_obj,
+		client:           client,
+		prefix:           prefix,
+		segment_size:     segment_size,
+		min_segment_size: min_segment_size,
+	}, nil
+}
+
+// The key of the directory holding manifests (without a trailing /)
+func (self *S3FileStore) dirKey(dirname api.FSPathSpec) string {
+	result := self.prefix + "files"
+	for _, c := range dirname.Components() {
+		if c != "" {
+			result += "/" + utils.SanitizeString(c)
+		}
+	}
+	return result
+}
+
+func (self *S3FileStore) manifestKey(filename api.FSPathSpec) string {
+	return self.dirKey(filename) + api.GetExtensionForFilestore(filename)
+}
+
+func (self *S3FileStore) segmentKey(id, name string) string {
+	return fmt.Sprintf("%ssegments/%s/%s", self.prefix, id, name)
+}
+
+func (self *S3FileStore) getManifest(key string) (*s3Manifest, error) {
+	data, etag, err := self.client.GetObjectWithETag(key)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &s3Manifest{}
+	err = json.Unmarshal(data, result)
+	if err != nil {
+		return nil, fmt.Errorf("S3 filestore: invalid manifest %v: %w", key, err)
+	}
+	result.etag = etag
+	return result, nil
+}
+
+// Write the manifest if nobody changed it since it was read. New
+// manifests (without an etag) are only written if the key does not
+// exist yet.
+func (self *S3FileStore) setManifest(key string, manifest *s3Manifest) error {
+	manifest.Modified = utils.GetTime().Now().UnixNano()
+	serialized, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	return self.client.PutObjectIfMatch(key, serialized, manifest.etag)
+}
+
+// Read the manifest or start a new one if the file does not exist.
+func (self *S3FileStore) getOrNewManifest(key string) (*s3Manifest, error) {
+	manifest, err := self.getManifest(key)
+	if errors.Is(err, os.ErrNotExist) {
+		return newManifest(), nil
+	}
+	return manifest, err
+}
+
+func newManifest() *s3Manifest {
+	return &s3Manifest{Id: uuid.New().String()}
+}
+
+// Upload the data as a new segment and add it to the manifest. A
+// small trailing segment is replaced by a new segment holding its
+// data followed by the new data. Segment objects are never
+// overwritten so a lost race only leaves our own object to clean up.
+func (self *S3FileStore) appendSegment(key string, data []byte) error {
+	defer self.locks.Lock(key)()
+
+	for i := 0; i < MAX_MANIFEST_RETRIES; i++ {
+		manifest, err := self.getOrNewManifest(key)
+		if err != nil {
+			return err
+		}
+
+		payload := data
+		var replaced *s3Segment
+		n := len(manifest.Segments)
+		if n > 0 && manifest.Segments[n-1].Size < int64(self.min_segment_size) {
+			last := manifest.Segments[n-1]
+			existing, err := self.client.GetObject(
+				self.segmentKey(manifest.Id, last.Name))
+			if errors.Is(err, os.ErrNotExist) {
+				// Replaced by another writer - read the manifest again.
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			payload = append(existing, data...)
+			replaced = &last
+			manifest.Segments = manifest.Segments[:n-1]
+		}
+
+		segment := s3Segment{Name: uuid.New().String(), Size: int64(len(payload))}
+		segment_key := self.segmentKey(manifest.Id, segment.Name)
+		err = self.client.PutObject(segment_key, payload)
+		if err != nil {
+			return err
+		}
+
+		manifest.Segments = append(manifest.Segments, segment)
+		err = self.setManifest(key, manifest)
+		if errors.Is(err, preconditionFailedError) {
+			_ = self.client.DeleteObject(segment_key)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if replaced != nil {
+			_ = self.client.DeleteObject(
+				self.segmentKey(manifest.Id, replaced.Name))
+		}
+		return nil
+	}
+
+	return fmt.Errorf("S3 filestore: %v: too many concurrent updates", key)
+}
+
+func (self *S3FileStore) deleteSegments(id string) error {
+	objects, _, err := self.client.ListObjects(
+		fmt.Sprintf("%ssegments/%s/", self.prefix, id), "")
+	if err != nil {
+		return err
+	}
+
+	for _, o := range objects {
+		err = self.client.DeleteObject(o.Key)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Replace the manifest at key with a new one, retrying if another
+// writer changes it. The segments of the replaced file are removed.
+func (self *S3FileStore) replaceManifest(key string, manifest *s3Manifest) error {
+	for i := 0; i < MAX_MANIFEST_RETRIES; i++ {
+		old_manifest, err := self.getManifest(key)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+
+		manifest.etag = ""
+		if old_manifest != nil {
+			manifest.etag = old_manifest.etag
+		}
+
+		err = self.setManifest(key, manifest)
+		if errors.Is(err, preconditionFailedError) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if old_manifest != nil && old_manifest.Id != manifest.Id {
+			return self.deleteSegments(old_manifest.Id)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("S3 filestore: %v: too many concurrent updates", key)
+}
+
+func (self *S3FileStore) Move(src, dest api.FSPathSpec) error {
+	defer api.InstrumentWithDelay("move", "S3FileStore", src)()
+
+	src_key := self.manifestKey(src)
+	dest_key := self.manifestKey(dest)
+
+	defer self.locks.Lock(src_key, dest_key)()
+
+	manifest, err := self.getManifest(src_key)
+	if err != nil {
+		return err
+	}
+
+	err = self.replaceManifest(dest_key, manifest)
+	if err != nil {
+		return err
+	}
+
+	return self.client.DeleteObject(src_key)
+}
+
+func (self *S3FileStore) Close() error {
+	return nil
+}
+
+func (self *S3FileStore) ListDirectory(dirname api.FSPathSpec) (
+	[]api.FileInfo, error) {
+
+	defer api.InstrumentWithDelay("list", "S3FileStore", dirname)()
+
+	dir_key := self.dirKey(dirname) + "/"
+	objects, prefixes, err := self.client.ListObjects(dir_key, "/")
+	if err != nil {
+		return nil, err
+	}
+
+	var result []api.FileInfo
+	for _, prefix := range prefixes {
+		name := strings.TrimSuffix(strings.TrimPrefix(prefix, dir_key), "/")
+		name_type, name := api.GetFileStorePathTypeFromExtension(name)
+		child := dirname.AddUnsafeChild(
+			utils.UnsanitizeComponent(name)).SetType(name_type)
+
+		result = append(result, file_store_file_info.NewFileStoreFileInfo(
+			self.config_obj, child, &s3FileInfo{
+				name:   path.Base(prefix),
+				is_dir: true,
+			}))
+	}
+
+	for _, object := range objects {
+		name := strings.TrimPrefix(object.Key, dir_key)
+		if name == "" || strings.Contains(name, "/") {
+			continue
+		}
+
+		name_type, name := api.GetFileStorePathTypeFromExtension(name)
+		child := dirname.AddUnsafeChild(
+			utils.UnsanitizeComponent(name)).SetType(name_type)
+
+		// The listed object is the manifest so its size is not the
+		// file size. Most callers only need the names, so the
+		// manifest is only fetched if the size is requested.
+		info := &s3FileInfo{
+			name:        path.Base(object.Key),
+			mod_time:    object.LastModified,
+			size_loader: self.manifestSizeLoader(object.Key),
+		}
+
+		result = append(result, file_store_file_info.NewFileStoreFileInfo(
+			self.config_obj, child, info))
+	}
+
+	return result, nil
+}
+
+func (self *S3FileStore) manifestSizeLoader(key string) func() int64 {
+	return func() int64 {
+		manifest, err := self.getManifest(key)
+		if err != nil {
+			return 0
+		}
+		return manifest.Size()
+	}
+}
+
+func (self *S3FileStore) ReadFile(
+	filename api.FSPathSpec) (api.FileReader, error) {
+
+	defer api.InstrumentWithDelay("open_read", "S3FileStore", filename)()
+
+	key := self.manifestKey(filename)
+	manifest, err := self.getManifest(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &S3FileReader{
+		store:    self,
+		filename: filename,
+		key:      key,
+		manifest: manifest,
+	}, nil
+}
+
+func (self *S3FileStore) StatFile(
+	filename api.FSPathSpec) (api.FileInfo, error) {
+
+	defer api.Instrument("stat", "S3FileStore", filename)()
+
+	key := self.manifestKey(filename)
+	manifest, err := self.getManifest(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return file_store_file_info.NewFileStoreFileInfo(
+		self.config_obj, filename, &s3FileInfo{
+			name:     path.Base(key),
+			size:     manifest.Size(),
+			mod_time: time.Unix(0, manifest.Modified),
+		}), nil
+}
+
+func (self *S3FileStore) WriteFile(
+	filename api.FSPathSpec) (api.FileWriter, error) {
+	return self.WriteFileWithCompletion(filename, nil)
+}
+
+func (self *S3FileStore) WriteFileWithCompletion(
+	filename api.FSPathSpec, completion func()) (api.FileWriter, error) {
+
+	defer api.InstrumentWithDelay("open_write", "S3FileStore", filename)()
+
+	// Create the file if it does not already exist. The write is
+	// conditional on the key not existing so it can not clobber a
+	// file created concurrently.
+	key := self.manifestKey(filename)
+	_, err := self.getManifest(key)
+	if errors.Is(err, os.ErrNotExist) {
+		err = self.setManifest(key, newManifest())
+		if errors.Is(err, preconditionFailedError) {
+			err = nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &S3FileWriter{
+		store:      self,
+		key:        key,
+		completion: completion,
+	}, nil
+}
+
+func (self *S3FileStore) Delete(filename api.FSPathSpec) error {
+
+	defer api.InstrumentWithDelay("delete", "S3FileStore", filename)()
+
+	key := self.manifestKey(filename)
+	defer self.locks.Lock(key)()
+
+	manifest, err := self.getManifest(key)
+	if err != nil {
+		return err
+	}
+
+	err = self.client.DeleteObject(key)
+	if err != nil {
+		return err
+	}
+
+	return self.deleteSegments(manifest.Id)
+}
+
+type s3FileInfo struct {
+	name     string
+	size     int64
+	mod_time time.Time
+	is_dir   bool
+
+	// If set, the size is loaded on first use.
+	size_once   sync.Once
+	size_loader func() int64
+}
+
+func (self *s3FileInfo) Name() string {
+	return self.name
+}
+
+func (self *s3FileInfo) Size() int64 {
+	self.size_once.Do(func() {
+		if self.size_loader != nil {
+			self.size = self.size_loader()
+		}
+	})
+	return self.size
+}
+
+func (self *s3FileInfo) Mode() os.FileMode {
+	if self.is_dir {
+		return os.ModeDir | 0700
+	}
+	return 0600
+}
+
+func (self *s3FileInfo) ModTime() time.Time {
+	return self.mod_time
+}
+
+func (self *s3FileInfo) IsDir() bool {
+	return self.is_dir
+}
+
+func (self *s3FileInfo) Sys() interface{} {
+	return nil
+}
+
+type S3FileWriter struct {
+	store      *S3FileStore
+	key        string
+	buffer     []byte
+	completion func()
+}
+
+func (self *S3FileWriter) Size() (int64, error) {
+	manifest, err := self.store.getManifest(self.key)
+	if err != nil {
+		return 0, err
+	}
+	return manifest.Size() + int64(len(self.buffer)), nil
+}
+
+func (self *S3FileWriter) Write(data []byte) (int, error) {
+
+	defer api.InstrumentWithDelay("write", "S3FileWriter", nil)()
+
+	self.buffer = append(self.buffer, data...)
+	if len(self.buffer) >= self.store.segment_size {
+		err := self.Flush()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(data), nil
+}
+
+func (self *S3FileWriter) Truncate() error {
+	self.buffer = nil
+
+	defer self.store.locks.Lock(self.key)()
+
+	return self.store.replaceManifest(self.key, newManifest())
+}
+
+// Small buffers are kept until the writer is closed or they reach
+// the minimum segment size so frequent flushes do not create many
+// tiny objects. The data becomes visible to readers once uploaded.
+func (self *S3FileWriter) Flush() error {
+	if len(self.buffer) < self.store.min_segment_size {
+		return nil
+	}
+	return self.upload()
+}
+
+func (self *S3FileWriter) upload() error {
+	if len(self.buffer) == 0 {
+		return nil
+	}
+
+	err := self.store.appendSegment(self.key, self.buffer)
+	if err != nil {
+		return err
+	}
+	self.buffer = nil
+	return nil
+}
+
+func (self *S3FileWriter) Close() error {
+	err := self.upload()
+
+	// S3FileWriter is synchronous... complete on Close()
+	if self.completion != nil &&
+		!utils.CompareFuncs(self.completion, utils.SyncCompleter) {
+		self.completion()
+	}
+	return err
+}
+
+type S3FileReader struct {
+	store    *S3FileStore
+	filename api.FSPathSpec
+	key      string
+	offset   int64
+	manifest *s3Manifest
+
+	// The most recently read segment. Reads are usually sequential
+	// so this avoids a request per Read() call.
+	cached_name string
+	cached_data []byte
+}
+
+func (self *S3FileReader) refresh() error {
+	manifest, err := self.store.getManifest(self.key)
+	if err != nil {
+		return err
+	}
+	self.manifest = manifest
+	return nil
+}
+
+func (self *S3FileReader) getSegment(segment s3Segment) ([]byte, error) {
+	if self.cached_name == segment.Name {
+		return self.cached_data, nil
+	}
+
+	data, err := self.store.client.GetObject(
+		self.store.segmentKey(self.manifest.Id, segment.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	self.cached_name = segment.Name
+	self.cached_data = data
+	return data, nil
+}
+
+func (self *S3FileReader) Read(buff []byte) (int, error) {
+	defer api.InstrumentWithDelay("read", "S3FileReader", nil)()
+
+	// The file may have grown since we last looked.
+	if self.offset+int64(len(buff)) > self.manifest.Size() {
+		err := self.refresh()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	result, err := self.read(buff)
+
+	// A small trailing segment may have been merged into a new
+	// segment by a writer since we read the manifest.
+	if result == 0 && errors.Is(err, os.ErrNotExist) {
+		err = self.refresh()
+		if err != nil {
+			return 0, err
+		}
+		result, err = self.read(buff)
+	}
+	return result, err
+}
+
+func (self *S3FileReader) read(buff []byte) (int, error) {
+	if self.offset >= self.manifest.Size() {
+		return 0, io.EOF
+	}
+
+	result := 0
+	segment_start := int64(0)
+	for _, segment := range self.manifest.Segments {
+		if result >= len(buff) {
+			break
+		}
+
+		segment_end := segment_start + segment.Size
+		if self.offset >= segment_end {
+			segment_start = segment_end
+			continue
+		}
+
+		data, err := self.getSegment(segment)
+		if err != nil {
+			return result, err
+		}
+
+		offset_in_segment := self.offset - segment_start
+		if offset_in_segment >= int64(len(data)) {
+			break
+		}
+
+		n := copy(buff[result:], data[offset_in_segment:])
+		result += n
+		self.offset += int64(n)
+		segment_start = segment_end
+	}
+
+	return result, nil
+}
+
+func (self *S3FileReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+		self.offset = offset
+	case io.SeekCurrent:
+		self.offset += offset
+	case io.SeekEnd:
+		err := self.refresh()
+		if err != nil {
+			return 0, err
+		}
+		self.offset = self.manifest.Size() + offset
+	default:
+		return 0, errors.New("Invalid whence")
+	}
+
+	if self.offset < 0 {
+		self.offset = 0
+	}
+	return self.offset, nil
+}
+
+func (self *S3FileReader) Stat() (api.FileInfo, error) {
+	return self.store.StatFile(self.filename)
+}
+
+func (self *S3FileReader) Close() error {
+	return nil
+}
@@ -0,0 +1,419 @@
+package s3
+
+import (
+	"crypto/md5"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"www.velocidex.com/golang/velociraptor/config"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	"www.velocidex.com/golang/velociraptor/file_store/path_specs"
+	"www.velocidex.com/golang/velociraptor/file_store/tests"
+)
+
+// A minimal in memory S3 compatible server, standing in for MinIO.
+type fakeS3Server struct {
+	mu      sync.Mutex
+	bucket  string
+	objects map[string][]byte
+
+	// Number of object GET requests served.
+	gets int
+}
+
+func newFakeS3Server(bucket string) *fakeS3Server {
+	return &fakeS3Server{
+		bucket:  bucket,
+		objects: make(map[string][]byte),
+	}
+}
+
+func (self *fakeS3Server) Clear() {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	self.objects = make(map[string][]byte)
+}
+
+func (self *fakeS3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 ") {
+		http.Error(w, "AccessDenied", http.StatusForbidden)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/"+self.bucket)
+	if path == "" && r.Method == "GET" {
+		self.list(w, r)
+		return
+	}
+
+	key := strings.TrimPrefix(path, "/")
+	switch r.Method {
+	case "PUT":
+		data, _ := ioutil.ReadAll(r.Body)
+		existing, pres := self.objects[key]
+		if r.Header.Get("If-None-Match") == "*" && pres {
+			http.Error(w, "PreconditionFailed", http.StatusPreconditionFailed)
+			return
+		}
+
+		if_match := r.Header.Get("If-Match")
+		if if_match != "" && (!pres || etag(existing) != if_match) {
+			http.Error(w, "PreconditionFailed", http.StatusPreconditionFailed)
+			return
+		}
+
+		self.objects[key] = data
+		w.Header().Set("ETag", etag(data))
+
+	case "DELETE":
+		delete(self.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+
+	case "GET":
+		self.gets++
+		data, pres := self.objects[key]
+		if !pres {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+
+		var start, end int
+		n, _ := fmt.Sscanf(r.Header.Get("Range"), "bytes=%d-%d", &start, &end)
+		if n == 2 {
+			if end >= len(data) {
+				end = len(data) - 1
+			}
+			w.Header().Set("ETag", etag(data))
+			data = data[start : end+1]
+			w.WriteHeader(http.StatusPartialContent)
+		} else {
+			w.Header().Set("ETag", etag(data))
+		}
+		_, _ = w.Write(data)
+
+	default:
+		http.Error(w, "NotImplemented", http.StatusNotImplemented)
+	}
+}
+
+func etag(data []byte) string {
+	return fmt.Sprintf("\"%x\"", md5.Sum(data))
+}
+
+// Count the stored objects with the prefix.
+func (self *fakeS3Server) count(prefix string) int {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	result := 0
+	for k := range self.objects {
+		if strings.HasPrefix(k, prefix) {
+			result++
+		}
+	}
+	return result
+}
+
+func (self *fakeS3Server) list(w http.ResponseWriter, r *http.Request) {
+	prefix := r.URL.Query().Get("prefix")
+	delimiter := r.URL.Query().Get("delimiter")
+
+	result := &listBucketResult{}
+	seen := make(map[string]bool)
+
+	keys := []string{}
+	for k := range self.objects {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+
+		rest := strings.TrimPrefix(k, prefix)
+		if delimiter != "" {
+			idx := strings.Index(rest, delimiter)
+			if idx >= 0 {
+				common := prefix + rest[:idx+1]
+				if !seen[common] {
+					seen[common] = true
+					result.CommonPrefixes = append(result.CommonPrefixes,
+						struct {
+							Prefix string `xml:"Prefix"`
+						}{Prefix: common})
+				}
+				continue
+			}
+		}
+
+		result.Contents = append(result.Contents, objectInfo{
+			Key:          k,
+			Size:         int64(len(self.objects[k])),
+			LastModified: time.Unix(1600000000, 0).UTC(),
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(result)
+}
+
+type S3TestSuite struct {
+	*tests.FileStoreTestSuite
+
+	server *fakeS3Server
+}
+
+func (self *S3TestSuite) SetupTest() {
+	self.server.Clear()
+}
+
+func TestS3FileStore(t *testing.T) {
+	server := newFakeS3Server("velociraptor")
+	http_server := httptest.NewServer(server)
+	defer http_server.Close()
+
+	config_obj := config.GetDefaultConfig()
+	config_obj.Datastore.S3Filestore = &config_proto.S3FilestoreConfig{
+		Bucket:            "velociraptor",
+		Prefix:            "test",
+		Endpoint:          http_server.URL,
+		CredentialsKey:    "key",
+		CredentialsSecret: "secret",
+
+		// Use a small segment size to exercise reads across
+		// segments.
+		SegmentSize: 8,
+	}
+
+	file_store, err := NewS3FileStore(config_obj)
+	assert.NoError(t, err)
+
+	suite.Run(t, &S3TestSuite{
+		FileStoreTestSuite: tests.NewFileStoreTestSuite(config_obj, file_store),
+		server:             server,
+	})
+}
+
+func TestS3Move(t *testing.T) {
+	server := newFakeS3Server("velociraptor")
+	http_server := httptest.NewServer(server)
+	defer http_server.Close()
+
+	config_obj := config.GetDefaultConfig()
+	config_obj.Datastore.S3Filestore = &config_proto.S3FilestoreConfig{
+		Bucket:            "velociraptor",
+		Endpoint:          http_server.URL,
+		CredentialsKey:    "key",
+		CredentialsSecret: "secret",
+	}
+
+	file_store, err := NewS3FileStore(config_obj)
+	assert.NoError(t, err)
+
+	src := path_specs.NewSafeFilestorePath("a", "src")
+	dest := path_specs.NewSafeFilestorePath("b", "dest")
+
+	fd, err := file_store.WriteFile(src)
+	assert.NoError(t, err)
+	_, err = fd.Write([]byte("hello"))
+	assert.NoError(t, err)
+	assert.NoError(t, fd.Close())
+
+	assert.NoError(t, file_store.Move(src, dest))
+
+	_, err = file_store.StatFile(src)
+	assert.Error(t, err)
+
+	reader, err := file_store.ReadFile(dest)
+	assert.NoError(t, err)
+	data, err := ioutil.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", string(data))
+
+	// Deleting the file removes the manifest and all its segments.
+	assert.NoError(t, file_store.Delete(dest))
+	assert.Equal(t, 0, len(server.objects))
+}
+
+// Listing a directory only lists the bucket. Manifests are fetched
+// when the file size is needed.
+func TestS3ListDirectory(t *testing.T) {
+	server := newFakeS3Server("velociraptor")
+	http_server := httptest.NewServer(server)
+	defer http_server.Close()
+
+	config_obj := config.GetDefaultConfig()
+	config_obj.Datastore.S3Filestore = &config_proto.S3FilestoreConfig{
+		Bucket:            "velociraptor",
+		Endpoint:          http_server.URL,
+		CredentialsKey:    "key",
+		CredentialsSecret: "secret",
+	}
+
+	file_store, err := NewS3FileStore(config_obj)
+	assert.NoError(t, err)
+
+	dir := path_specs.NewSafeFilestorePath("dir")
+	for i := 0; i < 10; i++ {
+		fd, err := file_store.WriteFile(dir.AddChild(fmt.Sprintf("file%d", i)))
+		assert.NoError(t, err)
+		_, err = fd.Write([]byte(strings.Repeat("x", i)))
+		assert.NoError(t, err)
+		assert.NoError(t, fd.Close())
+	}
+
+	server.mu.Lock()
+	server.gets = 0
+	server.mu.Unlock()
+
+	children, err := file_store.ListDirectory(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, len(children))
+
+	server.mu.Lock()
+	assert.Equal(t, 0, server.gets)
+	server.mu.Unlock()
+
+	assert.Equal(t, int64(3), children[3].Size())
+
+	server.mu.Lock()
+	assert.Equal(t, 1, server.gets)
+	server.mu.Unlock()
+}
+
+func newTestStore(t *testing.T, url string) *S3FileStore {
+	config_obj := config.GetDefaultConfig()
+	config_obj.Datastore.S3Filestore = &config_proto.S3FilestoreConfig{
+		Bucket:            "velociraptor",
+		Endpoint:          url,
+		CredentialsKey:    "key",
+		CredentialsSecret: "secret",
+	}
+
+	file_store, err := NewS3FileStore(config_obj)
+	assert.NoError(t, err)
+	return file_store
+}
+
+// Frequent small flushes do not create an object per flush.
+func TestS3SmallFlushes(t *testing.T) {
+	server := newFakeS3Server("velociraptor")
+	http_server := httptest.NewServer(server)
+	defer http_server.Close()
+
+	file_store := newTestStore(t, http_server.URL)
+	filename := path_specs.NewSafeFilestorePath("small")
+
+	expected := ""
+	for i := 0; i < 20; i++ {
+		fd, err := file_store.WriteFile(filename)
+		assert.NoError(t, err)
+
+		for j := 0; j < 10; j++ {
+			line := fmt.Sprintf("line %d %d\n", i, j)
+			expected += line
+
+			_, err = fd.Write([]byte(line))
+			assert.NoError(t, err)
+			assert.NoError(t, fd.Flush())
+		}
+		assert.NoError(t, fd.Close())
+	}
+
+	// The small segments are merged on each append.
+	assert.Equal(t, 1, server.count("segments/"))
+
+	reader, err := file_store.ReadFile(filename)
+	assert.NoError(t, err)
+	data, err := ioutil.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, string(data))
+}
+
+// Two frontends with their own stores append to the same file at
+// the same time without losing data.
+func TestS3ConcurrentAppend(t *testing.T) {
+	server := newFakeS3Server("velociraptor")
+	http_server := httptest.NewServer(server)
+	defer http_server.Close()
+
+	filename := path_specs.NewSafeFilestorePath("shared")
+	stores := []*S3FileStore{
+		newTestStore(t, http_server.URL),
+		newTestStore(t, http_server.URL),
+	}
+
+	wg := &sync.WaitGroup{}
+	for _, file_store := range stores {
+		wg.Add(1)
+		go func(file_store *S3FileStore) {
+			defer wg.Done()
+
+			for i := 0; i < 20; i++ {
+				fd, err := file_store.WriteFile(filename)
+				assert.NoError(t, err)
+				_, err = fd.Write([]byte("0123456789"))
+				assert.NoError(t, err)
+				assert.NoError(t, fd.Close())
+			}
+		}(file_store)
+	}
+	wg.Wait()
+
+	stat, err := stores[0].StatFile(filename)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(400), stat.Size())
+
+	// Segments of lost races were removed.
+	assert.Equal(t, 1, server.count("segments/"))
+}
+
+// Sequential reads are served from the cached segment.
+func TestS3ReaderCache(t *testing.T) {
+	server := newFakeS3Server("velociraptor")
+	http_server := httptest.NewServer(server)
+	defer http_server.Close()
+
+	file_store := newTestStore(t, http_server.URL)
+	filename := path_specs.NewSafeFilestorePath("large")
+
+	fd, err := file_store.WriteFile(filename)
+	assert.NoError(t, err)
+	_, err = fd.Write([]byte(strings.Repeat("x", 100000)))
+	assert.NoError(t, err)
+	assert.NoError(t, fd.Close())
+
+	reader, err := file_store.ReadFile(filename)
+	assert.NoError(t, err)
+
+	server.mu.Lock()
+	server.gets = 0
+	server.mu.Unlock()
+
+	buff := make([]byte, 1000)
+	for i := 0; i < 100; i++ {
+		n, err := reader.Read(buff)
+		assert.NoError(t, err)
+		assert.Equal(t, 1000, n)
+	}
+
+	server.mu.Lock()
+	assert.Equal(t, 1, server.gets)
+	server.mu.Unlock()
+}
@@ -153,6 +153,14 @@ func (self *ClientFlowRunner) MonitoringVQLResponse(
 		return nil
 	}
 
+	// The client only knows the obfuscated names of the artifacts
+	// it reports on.
+	if query_name == constants.CLIENT_EVENT_BUFFER_STATS_ARTIFACT ||
+		query_name == constants.CLIENT_EVENT_SUPPRESSION_STATS_ARTIFACT {
+		response.JSONLResponse = artifacts.DeobfuscateString(
+			self.config_obj, response.JSONLResponse)
+	}
+
 	journal, err := services.GetJournal(self.config_obj)
 	if err != nil {
 		return err
@@ -110,6 +110,12 @@ func CheckClientStatus(
 			return nil
 		}
 
+		// Scheduled hunt templates never run on clients
+		// directly - only their spawned runs do.
+		if hunt.Schedule != nil {
+			return nil
+		}
+
 		// This hunt is not relevant to this client.
 		if hunt.StartTime <= stats.LastHuntTimestamp {
 			return nil
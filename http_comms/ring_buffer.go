@@ -24,6 +24,10 @@ import (
 const (
 	FileMagic         = "VRB\x5e"
 	FirstRecordOffset = 50
+
+	// Normal and low priority events may only fill this percentage
+	// of the buffer. The rest is kept for high priority events.
+	NormalPriorityLimitPercent = 80
 )
 
 type IRingBuffer interface {
@@ -113,6 +117,19 @@ type FileBasedRingBuffer struct {
 	log_ctx *logging.LogContext
 
 	flow_manager *responder.FlowManager
+
+	// Bytes in the buffer for each event query with a buffer
+	// policy. The queued items are remembered so they can be
+	// released when they are committed.
+	event_bytes  map[string]uint64
+	event_items  []bufferedEvent
+	sample_count map[string]uint64
+}
+
+type bufferedEvent struct {
+	offset int64
+	name   string
+	size   uint64
 }
 
 func (self *FileBasedRingBuffer) Enqueue(item []byte) {
@@ -123,6 +140,20 @@ func (self *FileBasedRingBuffer) Enqueue(item []byte) {
 		return
 	}
 
+	name, admit := self.admitEvent(item)
+	if !admit {
+		return
+	}
+
+	if name != "" {
+		self.event_bytes[name] += uint64(len(item))
+		self.event_items = append(self.event_items, bufferedEvent{
+			offset: self.header.WritePointer,
+			name:   name,
+			size:   uint64(len(item)),
+		})
+	}
+
 	binary.LittleEndian.PutUint64(self.write_buf, uint64(len(item)))
 	_, err := self.fd.WriteAt(self.write_buf, int64(self.header.WritePointer))
 	if err != nil {
@@ -162,6 +193,125 @@ func (self *FileBasedRingBuffer) Enqueue(item []byte) {
 	}
 }
 
+// Decide if an event response may be queued according to its buffer
+// policy. Returns the name of the event query if it is tracked by a
+// policy. Flow responses and events without a policy are always
+// queued (and block the query when the buffer is full). Lower
+// priority events are dropped before the buffer is full so there is
+// always room for high priority events.
+func (self *FileBasedRingBuffer) admitEvent(item []byte) (string, bool) {
+	if self.flow_manager == nil || !self.flow_manager.HasEventBufferPolicies() {
+		return "", true
+	}
+
+	name, rows := getEventResponse(item)
+	if name == "" {
+		return "", true
+	}
+
+	policy, pres := self.flow_manager.GetEventBufferPolicy(name)
+	if !pres {
+		return "", true
+	}
+
+	size := uint64(len(item))
+	capacity := self.header.MaxSize - FirstRecordOffset
+	used := self.header.WritePointer - FirstRecordOffset
+	full := used+8+int64(size) > capacity*NormalPriorityLimitPercent/100
+
+	admit := true
+	switch {
+	case policy.MaxBytes > 0 && self.event_bytes[name]+size > policy.MaxBytes:
+		admit = false
+
+	case policy.Priority == responder.BUFFER_PRIORITY_HIGH:
+
+	case full:
+		admit = false
+
+	case policy.Priority == responder.BUFFER_PRIORITY_LOW && used > capacity/2:
+		self.sample_count[name]++
+		admit = policy.SampleRate > 0 &&
+			self.sample_count[name]%policy.SampleRate == 0
+	}
+
+	if !admit {
+		self.flow_manager.RecordDroppedEvents(name, policy.Priority, rows, size)
+		return "", false
+	}
+
+	return name, true
+}
+
+// Rebuild the bytes held by each event query from the events left in
+// the buffer file by a previous run. The policies are not known yet
+// so all event responses are counted.
+func (self *FileBasedRingBuffer) loadEvents() {
+	offset := self.header.ReadPointer
+	for offset+8 <= self.header.WritePointer {
+		n, err := self.fd.ReadAt(self.read_buf, offset)
+		if err != nil || n != len(self.read_buf) {
+			return
+		}
+
+		length := int64(binary.LittleEndian.Uint64(self.read_buf))
+		if length > constants.MAX_MEMORY*2 || length <= 0 ||
+			offset+8+length > self.header.WritePointer {
+			return
+		}
+
+		item := make([]byte, length)
+		n, err = self.fd.ReadAt(item, offset+8)
+		if err != nil || int64(n) != length {
+			return
+		}
+
+		name, _ := getEventResponse(item)
+		if name != "" {
+			self.event_bytes[name] += uint64(length)
+			self.event_items = append(self.event_items, bufferedEvent{
+				offset: offset,
+				name:   name,
+				size:   uint64(length),
+			})
+		}
+
+		offset += 8 + length
+	}
+}
+
+// Release the quota held by events that were delivered to the
+// server.
+func (self *FileBasedRingBuffer) releaseEvents(offset int64) {
+	i := 0
+	for ; i < len(self.event_items) && self.event_items[i].offset < offset; i++ {
+		item := self.event_items[i]
+		self.event_bytes[item.name] -= item.size
+		if self.event_bytes[item.name] == 0 {
+			delete(self.event_bytes, item.name)
+		}
+	}
+	self.event_items = self.event_items[i:]
+}
+
+// Returns the query name and row count if the item is a client event
+// response.
+func getEventResponse(item []byte) (string, uint64) {
+	message_list := &crypto_proto.MessageList{}
+	err := proto.Unmarshal(item, message_list)
+	if err != nil {
+		return "", 0
+	}
+
+	for _, message := range message_list.Job {
+		if message.SessionId == constants.MONITORING_WELL_KNOWN_FLOW &&
+			message.VQLResponse != nil && message.VQLResponse.Query != nil {
+			return message.VQLResponse.Query.Name, message.VQLResponse.TotalRows
+		}
+	}
+	return "", 0
+}
+
 func (self *FileBasedRingBuffer) TotalSize() uint64 {
 	self.mu.Lock()
 	defer self.mu.Unlock()
@@ -276,6 +426,9 @@ func (self *FileBasedRingBuffer) _Truncate() {
 	serialized, _ := self.header.MarshalBinary()
 	_, _ = self.fd.WriteAt(serialized, 0)
 
+	self.event_bytes = make(map[string]uint64)
+	self.event_items = nil
+
 	// Unblock any blocked writers to let them know there is now room
 	// in the file.
 	self.c.Broadcast()
@@ -315,6 +468,7 @@ func (self *FileBasedRingBuffer) Commit() {
 
 	self.header.ReadPointer = self.leased_pointer
 	self.header.LeasedBytes = 0
+	self.releaseEvents(self.leased_pointer)
 
 	serialized, _ := self.header.MarshalBinary()
 	_, _ = self.fd.WriteAt(serialized, 0)
@@ -446,9 +600,12 @@ func newFileBasedRingBuffer(
 		leased_pointer: header.ReadPointer,
 		log_ctx:        log_ctx,
 		flow_manager:   flow_manager,
+		event_bytes:    make(map[string]uint64),
+		sample_count:   make(map[string]uint64),
 	}
 
 	result.c = sync.NewCond(&result.mu)
+	result.loadEvents()
 
 	log_ctx.WithFields(logrus.Fields{
 		"filename": fd.Name(),
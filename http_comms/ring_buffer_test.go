@@ -4,6 +4,7 @@ import (
 	"context"
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/sirupsen/logrus/hooks/test"
@@ -391,3 +392,140 @@ func TestRingBufferCancellation(t *testing.T) {
 	// Make sure all messages are delivered
 	assert.Equal(t, serialized_message_list, lease)
 }
+
+func makeEventResponse(t *testing.T, name string, rows uint64) []byte {
+	serialized, err := proto.Marshal(&crypto_proto.MessageList{
+		Job: []*crypto_proto.VeloMessage{{
+			SessionId: "F.Monitoring",
+			VQLResponse: &actions_proto.VQLResponse{
+				JSONLResponse: strings.Repeat("X", 100),
+				TotalRows:     rows,
+				Query:         &actions_proto.VQLRequest{Name: name},
+			},
+		}},
+	})
+	assert.NoError(t, err)
+	return serialized
+}
+
+func TestRingBufferEventPriority(t *testing.T) {
+	filename := getTempFile(t)
+	defer os.Remove(filename)
+
+	ring_buffer, flow_manager := createRB(t, filename)
+	defer ring_buffer.Close()
+
+	low := makeEventResponse(t, "Low", 2)
+	normal := makeEventResponse(t, "Mid", 3)
+	quota := makeEventResponse(t, "Max", 1)
+	high := makeEventResponse(t, "Top", 1)
+
+	// Room for 10 items.
+	item_size := int64(8 + len(low))
+	ring_buffer.header.MaxSize = FirstRecordOffset + 10*item_size
+
+	flow_manager.SetEventBufferPolicies(map[string]*responder.EventBufferPolicy{
+		"Low": {
+			Priority:   responder.BUFFER_PRIORITY_LOW,
+			SampleRate: 2,
+		},
+		"Mid": {Priority: responder.BUFFER_PRIORITY_NORMAL},
+		"Max": {MaxBytes: uint64(2 * len(quota))},
+		"Top": {Priority: responder.BUFFER_PRIORITY_HIGH},
+	})
+
+	// Only two items fit in the quota.
+	for i := 0; i < 3; i++ {
+		ring_buffer.Enqueue(quota)
+	}
+	assert.Equal(t, uint64(2*len(quota)), ring_buffer.event_bytes["Max"])
+
+	// Low priority events are queued until the buffer is half full
+	// and then sampled.
+	for i := 0; i < 6; i++ {
+		ring_buffer.Enqueue(low)
+	}
+	assert.Equal(t, 2*item_size+5*item_size, ring_buffer.header.WritePointer-
+		FirstRecordOffset)
+
+	// Normal priority events are queued until the buffer is 80%
+	// full.
+	for i := 0; i < 5; i++ {
+		ring_buffer.Enqueue(normal)
+	}
+	assert.Equal(t, 8*item_size, ring_buffer.header.WritePointer-
+		FirstRecordOffset)
+
+	// Low priority events are dropped too.
+	ring_buffer.Enqueue(low)
+
+	// The rest of the buffer is kept for high priority events.
+	for i := 0; i < 2; i++ {
+		ring_buffer.Enqueue(high)
+	}
+	assert.Equal(t, 10*item_size, ring_buffer.header.WritePointer-
+		FirstRecordOffset)
+
+	assert.Equal(t, []*responder.DroppedEventStats{{
+		Artifact:     "Low",
+		Priority:     "LOW",
+		DroppedRows:  4,
+		DroppedBytes: uint64(2 * len(low)),
+	}, {
+		Artifact:     "Max",
+		Priority:     "HIGH",
+		DroppedRows:  1,
+		DroppedBytes: uint64(len(quota)),
+	}, {
+		Artifact:     "Mid",
+		Priority:     "NORMAL",
+		DroppedRows:  12,
+		DroppedBytes: uint64(4 * len(normal)),
+	}}, flow_manager.GetDroppedEventStats())
+
+	// Delivering the events to the server releases the quota.
+	ring_buffer.Lease(1)
+	ring_buffer.Commit()
+	assert.Equal(t, uint64(len(quota)), ring_buffer.event_bytes["Max"])
+
+	ring_buffer.Lease(100 * uint64(item_size))
+	ring_buffer.Commit()
+	assert.Equal(t, 0, len(ring_buffer.event_bytes))
+
+	ring_buffer.Enqueue(quota)
+	assert.Equal(t, uint64(len(quota)), ring_buffer.event_bytes["Max"])
+	assert.Equal(t, 0, len(flow_manager.GetDroppedEventStats()))
+}
+
+// Events left in the buffer by a previous run still count towards
+// their quota.
+func TestRingBufferEventQuotaReopen(t *testing.T) {
+	filename := getTempFile(t)
+	defer os.Remove(filename)
+
+	ring_buffer, flow_manager := createRB(t, filename)
+	quota := makeEventResponse(t, "Max", 1)
+
+	flow_manager.SetEventBufferPolicies(map[string]*responder.EventBufferPolicy{
+		"Max": {MaxBytes: uint64(2 * len(quota))},
+	})
+
+	ring_buffer.Enqueue(quota)
+	ring_buffer.Enqueue([]byte("Hello"))
+
+	ring_buffer = openRB(t, filename, flow_manager)
+	defer ring_buffer.Close()
+
+	assert.Equal(t, uint64(len(quota)), ring_buffer.event_bytes["Max"])
+
+	// Only one more item fits in the quota.
+	for i := 0; i < 2; i++ {
+		ring_buffer.Enqueue(quota)
+	}
+	assert.Equal(t, uint64(2*len(quota)), ring_buffer.event_bytes["Max"])
+
+	// Delivering the events releases the quota.
+	ring_buffer.Lease(100 * uint64(len(quota)))
+	ring_buffer.Commit()
+	assert.Equal(t, 0, len(ring_buffer.event_bytes))
+}
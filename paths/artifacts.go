@@ -11,3 +11,9 @@ func GetArtifactDefintionPath(name string) api.FSPathSpec {
 	return ARTIFACT_DEFINITION_PREFIX.
 		AddUnsafeChild(strings.Split(name, ".")...)
 }
+
+// The revision history of an artifact is stored in a result set.
+func GetArtifactRevisionsPath(name string) api.FSPathSpec {
+	return ARTIFACT_REVISIONS_PREFIX.
+		AddUnsafeChild(strings.Split(name, ".")...)
+}
@@ -67,6 +67,10 @@ var (
 		"artifact_definitions").
 		SetType(api.PATH_TYPE_FILESTORE_YAML)
 
+	ARTIFACT_REVISIONS_PREFIX = path_specs.NewSafeFilestorePath(
+		"artifact_revisions").
+		SetType(api.PATH_TYPE_FILESTORE_JSON)
+
 	// These store configuration for the server and client
 	// monitoring artifacts.
 	ServerMonitoringFlowURN = path_specs.NewSafeDatastorePath("config",
@@ -77,4 +81,21 @@ var (
 
 	ThirdPartyInventory = path_specs.NewSafeDatastorePath(
 		"config", "inventory").SetType(api.PATH_TYPE_DATASTORE_JSON)
+
+	// Chunks of offline collections uploaded to the frontend.
+	COLLECTION_UPLOADS_ROOT = path_specs.NewSafeFilestorePath(
+		"collection_uploads").SetType(api.PATH_TYPE_FILESTORE_ANY)
+
+	// The number of bytes received with each collection upload
+	// token.
+	COLLECTION_UPLOAD_TOKENS_ROOT = path_specs.NewSafeFilestorePath(
+		"collection_upload_tokens").SetType(api.PATH_TYPE_FILESTORE_JSON)
+
+	// Rows waiting to be delivered by the webhook service.
+	WEBHOOK_QUEUE_ROOT = path_specs.NewSafeDatastorePath(
+		"webhooks", "queue").SetType(api.PATH_TYPE_DATASTORE_JSON)
+
+	// Sigma rules loaded by the sigma service.
+	SIGMA_RULES_ROOT = path_specs.NewSafeDatastorePath(
+		"config", "sigma").SetType(api.PATH_TYPE_DATASTORE_JSON)
 )
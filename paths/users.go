@@ -30,6 +30,12 @@ func (self UserPathManager) GUIOptions() api.DSPathSpec {
 		api.PATH_TYPE_DATASTORE_JSON)
 }
 
+// Where we store the user's second factor state
+func (self UserPathManager) MFA() api.DSPathSpec {
+	return USERS_ROOT.AddChild(self.Name, "mfa").
+		SetTag("UserMFA")
+}
+
 // Where we store the user's MRU clients
 func (self UserPathManager) MRUClient(client_id string) api.DSPathSpec {
 	return USERS_ROOT.AddChild(self.Name, "mru", client_id).SetType(
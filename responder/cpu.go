@@ -0,0 +1,36 @@
+package responder
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/process"
+)
+
+var (
+	proc_mu sync.Mutex
+	proc    *process.Process
+)
+
+// Returns the total CPU time used by the process so far. Go can not
+// account CPU time to a single goroutine so queries are charged with
+// all the CPU used by the process while they run.
+func getProcessCpuTime() time.Duration {
+	proc_mu.Lock()
+	defer proc_mu.Unlock()
+
+	if proc == nil {
+		p, err := process.NewProcess(int32(os.Getpid()))
+		if err != nil {
+			return 0
+		}
+		proc = p
+	}
+
+	cpu_time, err := proc.Times()
+	if err != nil {
+		return 0
+	}
+	return time.Duration(cpu_time.Total() * float64(time.Second))
+}
@@ -0,0 +1,127 @@
+package responder
+
+import (
+	"sort"
+	"strings"
+)
+
+// Client event queries may declare how their results are treated in
+// the local ring buffer when the server is unreachable (see the
+// buffer_priority artifact resource). The event table registers a
+// policy for each event query with the FlowManager and the ring
+// buffer consults it before queuing each response.
+type BufferPriority int
+
+const (
+	// High priority events block the query until there is room in
+	// the buffer. This is the default.
+	BUFFER_PRIORITY_HIGH BufferPriority = iota
+
+	// Normal priority events are dropped when the buffer is nearly
+	// full, leaving room for high priority events.
+	BUFFER_PRIORITY_NORMAL
+
+	// Low priority events are sampled when the buffer is half full
+	// and dropped with normal priority events.
+	BUFFER_PRIORITY_LOW
+)
+
+func ParseBufferPriority(priority string) BufferPriority {
+	switch strings.ToUpper(priority) {
+	case "NORMAL":
+		return BUFFER_PRIORITY_NORMAL
+	case "LOW":
+		return BUFFER_PRIORITY_LOW
+	default:
+		return BUFFER_PRIORITY_HIGH
+	}
+}
+
+func (self BufferPriority) String() string {
+	switch self {
+	case BUFFER_PRIORITY_NORMAL:
+		return "NORMAL"
+	case BUFFER_PRIORITY_LOW:
+		return "LOW"
+	default:
+		return "HIGH"
+	}
+}
+
+type EventBufferPolicy struct {
+	Priority BufferPriority
+
+	// Maximum bytes of this artifact's responses in the buffer (0
+	// means no limit).
+	MaxBytes uint64
+
+	// Keep one in this many LOW priority responses when sampling.
+	SampleRate uint64
+}
+
+// Events dropped from the ring buffer since the last report.
+type DroppedEventStats struct {
+	Artifact     string `json:"Artifact"`
+	Priority     string `json:"Priority"`
+	DroppedRows  uint64 `json:"DroppedRows"`
+	DroppedBytes uint64 `json:"DroppedBytes"`
+}
+
+// Replace the policies for all event queries. Policies are keyed by
+// the query name as it appears in the VQLResponse.
+func (self *FlowManager) SetEventBufferPolicies(
+	policies map[string]*EventBufferPolicy) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	self.event_policies = policies
+}
+
+func (self *FlowManager) HasEventBufferPolicies() bool {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	return len(self.event_policies) > 0
+}
+
+func (self *FlowManager) GetEventBufferPolicy(
+	name string) (*EventBufferPolicy, bool) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	policy, pres := self.event_policies[name]
+	return policy, pres
+}
+
+func (self *FlowManager) RecordDroppedEvents(
+	name string, priority BufferPriority, rows, size uint64) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	stats, pres := self.dropped_events[name]
+	if !pres {
+		stats = &DroppedEventStats{Artifact: name}
+		self.dropped_events[name] = stats
+	}
+	stats.Priority = priority.String()
+	stats.DroppedRows += rows
+	stats.DroppedBytes += size
+}
+
+// Returns the dropped event counts since the last call.
+func (self *FlowManager) GetDroppedEventStats() []*DroppedEventStats {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	result := make([]*DroppedEventStats, 0, len(self.dropped_events))
+	for _, v := range self.dropped_events {
+		result = append(result, v)
+	}
+	self.dropped_events = make(map[string]*DroppedEventStats)
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Artifact < result[j].Artifact
+	})
+
+	return result
+}
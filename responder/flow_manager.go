@@ -46,6 +46,11 @@ type FlowManager struct {
 	// Remember all the cancelled sessions so the ring buffer file can
 	// drop any messages for flows that were already cancelled.
 	cancelled map[string]bool
+
+	// Ring buffer policies for client event queries and the events
+	// dropped because of them.
+	event_policies map[string]*EventBufferPolicy
+	dropped_events map[string]*DroppedEventStats
 }
 
 func NewFlowManager(ctx context.Context,
@@ -57,6 +62,9 @@ func NewFlowManager(ctx context.Context,
 		config_obj: config_obj,
 		in_flight:  make(map[string]*FlowContext),
 		cancelled:  make(map[string]bool),
+
+		event_policies: make(map[string]*EventBufferPolicy),
+		dropped_events: make(map[string]*DroppedEventStats),
 	}
 	return result
 }
@@ -160,6 +160,9 @@ type MonitoringResponder struct {
 
 	monitoring_context *MonitoringContext
 	config_obj         *config_proto.Config
+
+	// If set, repeated rows are removed before they are queued.
+	suppressor *EventSuppressor
 }
 
 func NewMonitoringResponder(
@@ -167,7 +170,8 @@ func NewMonitoringResponder(
 	config_obj *config_proto.Config,
 	monitoring_manager *MonitoringManager,
 	output chan *crypto_proto.VeloMessage,
-	artifact string) *MonitoringResponder {
+	artifact string,
+	suppressor *EventSuppressor) *MonitoringResponder {
 
 	return &MonitoringResponder{
 		ctx:                ctx,
@@ -175,6 +179,7 @@ func NewMonitoringResponder(
 		artifact:           artifact,
 		monitoring_context: monitoring_manager.Context(output, artifact),
 		config_obj:         config_obj,
+		suppressor:         suppressor,
 	}
 }
 
@@ -187,6 +192,11 @@ func (self *MonitoringResponder) FlowContext() *FlowContext {
 func (self *MonitoringResponder) AddResponse(message *crypto_proto.VeloMessage) {
 	message.SessionId = "F.Monitoring"
 
+	if self.suppressor != nil && message.VQLResponse != nil &&
+		!self.suppressor.Filter(message.VQLResponse) {
+		return
+	}
+
 	select {
 	case <-self.ctx.Done():
 		break
@@ -21,6 +21,7 @@ import (
 	"context"
 	"runtime/debug"
 	"sync"
+	"time"
 
 	"google.golang.org/protobuf/proto"
 	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
@@ -47,6 +48,9 @@ type FlowResponder struct {
 	// query.
 	status crypto_proto.VeloStatus
 
+	// The process CPU time when the query started.
+	start_cpu_time time.Duration
+
 	// Our parent context that is shared between all queries from the
 	// same collection.
 	flow_context *FlowContext
@@ -74,6 +78,7 @@ func newFlowResponder(
 			Status:      crypto_proto.VeloStatus_PROGRESS,
 			FirstActive: uint64(utils.GetTime().Now().UnixNano() / 1000),
 		},
+		start_cpu_time: getProcessCpuTime(),
 	}
 	return result
 }
@@ -172,6 +177,7 @@ func (self *FlowResponder) RaiseError(ctx context.Context, message string) {
 		self.status.Status = crypto_proto.VeloStatus_GENERIC_ERROR
 		self.status.ErrorMessage = message
 		self.status.Backtrace = string(debug.Stack())
+		self.status.CpuTime = self.cpuTime()
 	}
 }
 
@@ -182,9 +188,16 @@ func (self *FlowResponder) Return(ctx context.Context) {
 
 	if self.status.Status == crypto_proto.VeloStatus_PROGRESS {
 		self.status.Status = crypto_proto.VeloStatus_OK
+		self.status.CpuTime = self.cpuTime()
 	}
 }
 
+// The CPU time is only sampled once when the query completes since
+// reading it is relatively expensive.
+func (self *FlowResponder) cpuTime() int64 {
+	return int64(getProcessCpuTime() - self.start_cpu_time)
+}
+
 // Send a log message to the server. We do not actually send the log
 // right away, but queue it locally and combine with other log
 // messages for self.flushLogMessages() to send.
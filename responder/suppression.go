@@ -0,0 +1,218 @@
+package responder
+
+import (
+	"bytes"
+	"container/list"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	actions_proto "www.velocidex.com/golang/velociraptor/actions/proto"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/utils"
+)
+
+const (
+	// The most keys a rule tracks. When a new key arrives and the
+	// rule is full the oldest window is evicted.
+	MAX_SUPPRESSION_KEYS = 10000
+)
+
+// Suppressed rows since the last report.
+type SuppressedEventStats struct {
+	Artifact       string `json:"Artifact"`
+	SuppressedRows uint64 `json:"SuppressedRows"`
+	ActiveKeys     uint64 `json:"ActiveKeys"`
+}
+
+type suppressionWindow struct {
+	key   string
+	start time.Time
+	count uint64
+}
+
+type suppressionRule struct {
+	key_columns []string
+	window      time.Duration
+	max_count   uint64
+
+	// Key is the serialized key columns. Values are elements of
+	// order.
+	windows map[string]*list.Element
+
+	// The windows ordered by start time, oldest first. All windows
+	// have the same length so this is also their expiry order.
+	order      *list.List
+	suppressed uint64
+}
+
+func newSuppressionRule(
+	key_columns []string, window time.Duration,
+	max_count uint64) *suppressionRule {
+	return &suppressionRule{
+		key_columns: key_columns,
+		window:      window,
+		max_count:   max_count,
+		windows:     make(map[string]*list.Element),
+		order:       list.New(),
+	}
+}
+
+func (self *suppressionRule) remove(element *list.Element) {
+	self.order.Remove(element)
+	delete(self.windows, element.Value.(*suppressionWindow).key)
+}
+
+// Remove windows which have expired.
+func (self *suppressionRule) purge(now time.Time) {
+	for {
+		oldest := self.order.Front()
+		if oldest == nil ||
+			now.Sub(oldest.Value.(*suppressionWindow).start) < self.window {
+			return
+		}
+		self.remove(oldest)
+	}
+}
+
+// Returns true if the row should be forwarded.
+func (self *suppressionRule) admit(line []byte, now time.Time) bool {
+	key := string(line)
+	if len(self.key_columns) > 0 {
+		row := make(map[string]interface{})
+		err := json.Unmarshal(line, &row)
+		if err != nil {
+			return true
+		}
+
+		values := make([]interface{}, 0, len(self.key_columns))
+		for _, column := range self.key_columns {
+			values = append(values, row[column])
+		}
+		key = json.MustMarshalString(values)
+	}
+
+	self.purge(now)
+
+	element, pres := self.windows[key]
+	if !pres {
+		if len(self.windows) >= MAX_SUPPRESSION_KEYS {
+			self.remove(self.order.Front())
+		}
+
+		self.windows[key] = self.order.PushBack(
+			&suppressionWindow{key: key, start: now, count: 1})
+		return true
+	}
+
+	window := element.Value.(*suppressionWindow)
+	if window.count < self.max_count {
+		window.count++
+		return true
+	}
+
+	self.suppressed++
+	return false
+}
+
+// Suppresses repeated rows from client event queries according to
+// the suppression spec of their artifact source. Rows are filtered
+// before they are queued in the ring buffer.
+type EventSuppressor struct {
+	mu sync.Mutex
+
+	// Key is the query name as it appears in the VQLResponse.
+	rules map[string]*suppressionRule
+}
+
+func NewEventSuppressor() *EventSuppressor {
+	return &EventSuppressor{
+		rules: make(map[string]*suppressionRule),
+	}
+}
+
+func (self *EventSuppressor) AddRule(
+	name string, spec *actions_proto.EventSuppression) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	max_count := spec.MaxCount
+	if max_count == 0 {
+		max_count = 1
+	}
+
+	self.rules[name] = newSuppressionRule(spec.KeyColumns,
+		time.Duration(spec.Window)*time.Second, max_count)
+}
+
+func (self *EventSuppressor) HasRules() bool {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	return len(self.rules) > 0
+}
+
+// Remove suppressed rows from the response. Returns false if no rows
+// are left.
+func (self *EventSuppressor) Filter(response *actions_proto.VQLResponse) bool {
+	if response.Query == nil {
+		return true
+	}
+
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	rule, pres := self.rules[response.Query.Name]
+	if !pres {
+		return true
+	}
+
+	now := utils.GetTime().Now()
+	result := &bytes.Buffer{}
+	var total_rows uint64
+
+	for _, line := range strings.SplitAfter(response.JSONLResponse, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if rule.admit([]byte(line), now) {
+			result.WriteString(line)
+			total_rows++
+		}
+	}
+
+	response.JSONLResponse = result.String()
+	response.TotalRows = total_rows
+
+	return total_rows > 0
+}
+
+// Returns the suppression counts since the last call.
+func (self *EventSuppressor) GetSuppressedEventStats() []*SuppressedEventStats {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	now := utils.GetTime().Now()
+	result := make([]*SuppressedEventStats, 0, len(self.rules))
+	for name, rule := range self.rules {
+		rule.purge(now)
+		if rule.suppressed == 0 {
+			continue
+		}
+
+		result = append(result, &SuppressedEventStats{
+			Artifact:       name,
+			SuppressedRows: rule.suppressed,
+			ActiveKeys:     uint64(len(rule.windows)),
+		})
+		rule.suppressed = 0
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Artifact < result[j].Artifact
+	})
+
+	return result
+}
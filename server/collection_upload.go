@@ -0,0 +1,589 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Velocidex/ordereddict"
+	"www.velocidex.com/golang/velociraptor/acls"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	crypto_utils "www.velocidex.com/golang/velociraptor/crypto/utils"
+	"www.velocidex.com/golang/velociraptor/file_store"
+	"www.velocidex.com/golang/velociraptor/file_store/api"
+	flows_proto "www.velocidex.com/golang/velociraptor/flows/proto"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/logging"
+	"www.velocidex.com/golang/velociraptor/paths"
+	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/utils"
+	"www.velocidex.com/golang/velociraptor/vql/acl_managers"
+	"www.velocidex.com/golang/velociraptor/vql/tools/collector"
+)
+
+const (
+	MAX_COLLECTION_CHUNK_SIZE = 32 * 1024 * 1024
+	MAX_COLLECTION_CHUNKS     = 100000
+
+	// Unfinished uploads are removed when no chunks arrive for this
+	// long.
+	COLLECTION_UPLOAD_EXPIRY = 7 * 24 * time.Hour
+
+	// How often the frontend looks for expired uploads.
+	COLLECTION_UPLOAD_GC_PERIOD = time.Hour
+
+	// The import carries on when the collector gives up waiting for
+	// it but can not take longer than this.
+	COLLECTION_IMPORT_TIMEOUT = time.Hour
+)
+
+var (
+	missingChunkError  = errors.New("Missing chunk")
+	quotaExceededError = errors.New("Upload token size limit exceeded")
+
+	// Chunk writes, imports and expiry of the same upload are
+	// serialized so a retried manifest waits for the import in
+	// progress instead of starting another one.
+	upload_locks = &collectionUploadLocks{
+		locks: make(map[string]*collectionUploadLock),
+	}
+
+	// Protects the usage records of the upload tokens.
+	token_usage_mu sync.Mutex
+)
+
+type collectionUploadLock struct {
+	sync.Mutex
+	refs int
+}
+
+type collectionUploadLocks struct {
+	mu    sync.Mutex
+	locks map[string]*collectionUploadLock
+}
+
+// Lock the upload and return a function to unlock it.
+func (self *collectionUploadLocks) Lock(key string) func() {
+	self.mu.Lock()
+	lock, pres := self.locks[key]
+	if !pres {
+		lock = &collectionUploadLock{}
+		self.locks[key] = lock
+	}
+	lock.refs++
+	self.mu.Unlock()
+
+	lock.Lock()
+
+	return func() {
+		lock.Unlock()
+
+		self.mu.Lock()
+		defer self.mu.Unlock()
+
+		lock.refs--
+		if lock.refs == 0 {
+			delete(self.locks, key)
+		}
+	}
+}
+
+// Stored with the chunks of each upload.
+type collectionUploadState struct {
+	// The last time a chunk or the manifest arrived.
+	Updated int64 `json:"updated"`
+
+	// Set once the upload is imported so a retried manifest gets
+	// the same response instead of importing the collection again.
+	Sha256   string `json:"sha256,omitempty"`
+	Response string `json:"response,omitempty"`
+}
+
+// The number of bytes received with each upload token.
+type collectionTokenUsage struct {
+	Size    int64 `json:"size"`
+	Expires int64 `json:"expires"`
+}
+
+// Sent by the offline collector after the last chunk. Must match
+// the manifest in vql/tools/collector/resumable.go
+type collectionManifest struct {
+	Name      string `json:"name"`
+	Size      int64  `json:"size"`
+	Sha256    string `json:"sha256"`
+	ChunkSize int64  `json:"chunk_size"`
+	Chunks    int64  `json:"chunks"`
+	Hostname  string `json:"hostname"`
+}
+
+// Offline collectors upload their container to this handler in
+// chunks (see the upload_resumable() plugin). When the manifest
+// arrives the chunks are reassembled and the collection is imported
+// against a client with a matching hostname (or a new client). The
+// import runs with the permissions of the user who created the
+// upload token.
+func collection_upload(config_obj *config_proto.Config) http.Handler {
+	logger := logging.GetLogger(config_obj, &logging.FrontendComponent)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			returnError(w, http.StatusMethodNotAllowed, "Method not allowed")
+			return
+		}
+
+		token_str := r.Header.Get(crypto_utils.UPLOAD_TOKEN_HEADER)
+		token, err := crypto_utils.VerifyUploadToken(config_obj, token_str)
+		if err != nil {
+			returnError(w, http.StatusForbidden, err.Error())
+			return
+		}
+
+		org_manager, err := services.GetOrgManager()
+		if err != nil {
+			returnError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		org_config_obj, err := org_manager.GetOrgConfig(token.OrgId)
+		if err != nil {
+			returnError(w, http.StatusForbidden, err.Error())
+			return
+		}
+
+		// The token is only good while its principal may still
+		// import collections. Importing from the filestore
+		// requires the fs accessor.
+		ok, err := services.CheckAccess(org_config_obj, token.Principal,
+			acls.COLLECT_SERVER, acls.SERVER_ADMIN)
+		if err != nil || !ok {
+			returnError(w, http.StatusForbidden, "Permission denied")
+			return
+		}
+
+		query := r.URL.Query()
+		name := query.Get("name")
+		if name == "" {
+			returnError(w, http.StatusBadRequest, "Upload name not specified")
+			return
+		}
+
+		// The name is chosen by the collector so it is hashed to
+		// make a safe path.
+		hash := sha256.Sum256([]byte(token.OrgId + "/" + name))
+		upload_path := paths.COLLECTION_UPLOADS_ROOT.AddChild(
+			hex.EncodeToString(hash[:16]))
+
+		unlock := upload_locks.Lock(org_config_obj.OrgId + "/" +
+			upload_path.AsClientPath())
+		defer unlock()
+
+		if query.Get("manifest") != "" {
+			manifest := &collectionManifest{}
+			data, err := ioutil.ReadAll(io.LimitReader(r.Body, 10000))
+			if err == nil {
+				err = json.Unmarshal(data, manifest)
+			}
+			if err != nil || manifest.ChunkSize <= 0 ||
+				manifest.ChunkSize > MAX_COLLECTION_CHUNK_SIZE ||
+				manifest.Chunks < 0 || manifest.Chunks > MAX_COLLECTION_CHUNKS {
+				returnError(w, http.StatusBadRequest, "Invalid manifest")
+				return
+			}
+
+			logger.Info("collection_upload: Importing %v uploaded by %v for %v",
+				name, token.Principal, manifest.Hostname)
+
+			response, err := importCollectionUpload(
+				org_config_obj, token.Principal, upload_path, manifest)
+			if err != nil {
+				logger.Error("collection_upload: %v: %v", name, err)
+
+				status := http.StatusInternalServerError
+				if errors.Is(err, missingChunkError) {
+					status = http.StatusConflict
+				}
+				returnError(w, status, err.Error())
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(response)
+			return
+		}
+
+		index, err := strconv.ParseInt(query.Get("index"), 10, 64)
+		if err != nil || index < 0 || index >= MAX_COLLECTION_CHUNKS {
+			returnError(w, http.StatusBadRequest, "Invalid chunk index")
+			return
+		}
+
+		err = writeCollectionChunk(r, org_config_obj, token, token_str,
+			upload_path, upload_path.AddChild(fmt.Sprintf("%06d", index)))
+		if err != nil {
+			status := http.StatusInternalServerError
+			if errors.Is(err, quotaExceededError) {
+				status = http.StatusRequestEntityTooLarge
+			}
+			returnError(w, status, err.Error())
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func writeCollectionChunk(r *http.Request,
+	config_obj *config_proto.Config,
+	token *crypto_utils.UploadToken, token_str string,
+	upload_path, path api.FSPathSpec) error {
+	data, err := ioutil.ReadAll(io.LimitReader(
+		r.Body, MAX_COLLECTION_CHUNK_SIZE+1))
+	if err != nil {
+		return err
+	}
+
+	if len(data) > MAX_COLLECTION_CHUNK_SIZE {
+		return packetTooLargeError
+	}
+
+	file_store_factory := file_store.GetFileStore(config_obj)
+
+	// A chunk sent again replaces the old one so only the
+	// difference counts towards the token's limit.
+	size := int64(len(data))
+	stat, err := file_store_factory.StatFile(path)
+	if err == nil {
+		size -= stat.Size()
+	}
+
+	err = addCollectionTokenUsage(config_obj, token, token_str, size)
+	if err != nil {
+		return err
+	}
+
+	err = writeCollectionJSON(file_store_factory, collectionStatePath(upload_path),
+		&collectionUploadState{Updated: utils.GetTime().Now().Unix()})
+	if err != nil {
+		return err
+	}
+
+	fd, err := file_store_factory.WriteFileWithCompletion(
+		path, utils.SyncCompleter)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	err = fd.Truncate()
+	if err != nil {
+		return err
+	}
+
+	_, err = fd.Write(data)
+	return err
+}
+
+// Reassemble the container from the chunks, verify it against the
+// manifest then import it. If the chunks do not match the manifest
+// they are removed and the collector needs to upload them again.
+func importCollectionUpload(
+	config_obj *config_proto.Config, principal string,
+	upload_path api.FSPathSpec, manifest *collectionManifest) ([]byte, error) {
+
+	file_store_factory := file_store.GetFileStore(config_obj)
+	state_path := collectionStatePath(upload_path)
+
+	// The collector retries the manifest when it times out waiting
+	// for an earlier import.
+	state := &collectionUploadState{}
+	err := readCollectionJSON(file_store_factory, state_path, state)
+	if err == nil && state.Response != "" && state.Sha256 == manifest.Sha256 {
+		return []byte(state.Response), nil
+	}
+
+	// Not bound to the request so the import completes even if the
+	// collector disconnects.
+	ctx, cancel := context.WithTimeout(
+		context.Background(), COLLECTION_IMPORT_TIMEOUT)
+	defer cancel()
+
+	container_path := upload_path.AddChild("container").
+		SetType(api.PATH_TYPE_FILESTORE_DOWNLOAD_ZIP)
+
+	defer file_store_factory.Delete(container_path)
+
+	// Chunks are kept if the import fails for other reasons so the
+	// collector can try again.
+	remove_chunks := func() {
+		for i := int64(0); i < manifest.Chunks; i++ {
+			_ = file_store_factory.Delete(
+				upload_path.AddChild(fmt.Sprintf("%06d", i)))
+		}
+	}
+
+	err = assembleCollection(ctx, file_store_factory,
+		upload_path, container_path, manifest)
+	if err != nil {
+		if errors.Is(err, missingChunkError) {
+			remove_chunks()
+		}
+		return nil, err
+	}
+
+	manager, err := services.GetRepositoryManager(config_obj)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := manager.BuildScope(services.ScopeBuilder{
+		Config:     config_obj,
+		ACLManager: acl_managers.NewServerACLManager(config_obj, principal),
+		Logger: logging.NewPlainLogger(
+			config_obj, &logging.FrontendComponent),
+		Env: ordereddict.NewDict(),
+	})
+	defer scope.Close()
+
+	result := collector.ImportCollectionFunction{}.Call(ctx, scope,
+		ordereddict.NewDict().
+			Set("client_id", "auto").
+			Set("hostname", manifest.Hostname).
+			Set("filename", container_path.AsClientPath()).
+			Set("accessor", "fs"))
+
+	collection_context, ok := result.(*flows_proto.ArtifactCollectorContext)
+	if !ok {
+		return nil, errors.New("Unable to import collection")
+	}
+
+	response, err := json.Marshal(ordereddict.NewDict().
+		Set("ClientId", collection_context.ClientId).
+		Set("FlowId", collection_context.SessionId))
+	if err != nil {
+		return nil, err
+	}
+
+	err = writeCollectionJSON(file_store_factory, state_path,
+		&collectionUploadState{
+			Updated:  utils.GetTime().Now().Unix(),
+			Sha256:   manifest.Sha256,
+			Response: string(response),
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	remove_chunks()
+
+	return response, nil
+}
+
+func assembleCollection(ctx context.Context, file_store_factory api.FileStore,
+	upload_path, container_path api.FSPathSpec,
+	manifest *collectionManifest) error {
+	out_fd, err := file_store_factory.WriteFileWithCompletion(
+		container_path, utils.SyncCompleter)
+	if err != nil {
+		return err
+	}
+	defer out_fd.Close()
+
+	err = out_fd.Truncate()
+	if err != nil {
+		return err
+	}
+
+	hash := sha256.New()
+	var size int64
+
+	for i := int64(0); i < manifest.Chunks; i++ {
+		fd, err := file_store_factory.ReadFile(
+			upload_path.AddChild(fmt.Sprintf("%06d", i)))
+		if err != nil {
+			return fmt.Errorf("%w %v", missingChunkError, i)
+		}
+
+		n, err := utils.Copy(ctx, io.MultiWriter(out_fd, hash), fd)
+		fd.Close()
+		if err != nil {
+			return err
+		}
+		size += int64(n)
+	}
+
+	if size != manifest.Size ||
+		hex.EncodeToString(hash.Sum(nil)) != manifest.Sha256 {
+		return fmt.Errorf("%w: Container does not match manifest",
+			missingChunkError)
+	}
+
+	return nil
+}
+
+func collectionStatePath(upload_path api.FSPathSpec) api.FSPathSpec {
+	return upload_path.AddChild("state").SetType(api.PATH_TYPE_FILESTORE_JSON)
+}
+
+func readCollectionJSON(file_store_factory api.FileStore,
+	path api.FSPathSpec, target interface{}) error {
+	fd, err := file_store_factory.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(fd, 100000))
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, target)
+}
+
+func writeCollectionJSON(file_store_factory api.FileStore,
+	path api.FSPathSpec, item interface{}) error {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+
+	fd, err := file_store_factory.WriteFileWithCompletion(
+		path, utils.SyncCompleter)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	err = fd.Truncate()
+	if err != nil {
+		return err
+	}
+
+	_, err = fd.Write(data)
+	return err
+}
+
+// Add size bytes to the total received with the token. The token
+// string is hashed to key the record since the same token may be
+// embedded in many collectors.
+func addCollectionTokenUsage(config_obj *config_proto.Config,
+	token *crypto_utils.UploadToken, token_str string, size int64) error {
+	token_usage_mu.Lock()
+	defer token_usage_mu.Unlock()
+
+	file_store_factory := file_store.GetFileStore(config_obj)
+	hash := sha256.Sum256([]byte(token_str))
+	path := paths.COLLECTION_UPLOAD_TOKENS_ROOT.AddChild(
+		hex.EncodeToString(hash[:16]))
+
+	usage := &collectionTokenUsage{}
+	_ = readCollectionJSON(file_store_factory, path, usage)
+
+	if size > 0 && usage.Size+size > token.MaxSize {
+		return quotaExceededError
+	}
+
+	usage.Size += size
+	if usage.Size < 0 {
+		usage.Size = 0
+	}
+	usage.Expires = token.Expires
+
+	return writeCollectionJSON(file_store_factory, path, usage)
+}
+
+// Remove uploads which received nothing for COLLECTION_UPLOAD_EXPIRY
+// and the usage records of expired tokens.
+func expireCollectionUploads(config_obj *config_proto.Config) {
+	logger := logging.GetLogger(config_obj, &logging.FrontendComponent)
+	file_store_factory := file_store.GetFileStore(config_obj)
+	now := utils.GetTime().Now()
+
+	children, _ := file_store_factory.ListDirectory(paths.COLLECTION_UPLOADS_ROOT)
+	for _, child := range children {
+		if !child.IsDir() {
+			continue
+		}
+
+		upload_path := child.PathSpec()
+		if expireCollectionUpload(config_obj, file_store_factory,
+			upload_path, now) {
+			logger.Info("collection_upload: Removed expired upload %v",
+				upload_path.AsClientPath())
+		}
+	}
+
+	token_usage_mu.Lock()
+	defer token_usage_mu.Unlock()
+
+	children, _ = file_store_factory.ListDirectory(
+		paths.COLLECTION_UPLOAD_TOKENS_ROOT)
+	for _, child := range children {
+		usage := &collectionTokenUsage{}
+		err := readCollectionJSON(file_store_factory, child.PathSpec(), usage)
+		if err == nil && usage.Expires >= now.Unix() {
+			continue
+		}
+		_ = file_store_factory.Delete(child.PathSpec())
+	}
+}
+
+func expireCollectionUpload(config_obj *config_proto.Config,
+	file_store_factory api.FileStore,
+	upload_path api.FSPathSpec, now time.Time) bool {
+	defer upload_locks.Lock(config_obj.OrgId + "/" +
+		upload_path.AsClientPath())()
+
+	state := &collectionUploadState{}
+	err := readCollectionJSON(file_store_factory,
+		collectionStatePath(upload_path), state)
+	if err == nil &&
+		now.Sub(time.Unix(state.Updated, 0)) < COLLECTION_UPLOAD_EXPIRY {
+		return false
+	}
+
+	_ = api.Walk(file_store_factory, upload_path,
+		func(path api.FSPathSpec, info os.FileInfo) error {
+			return file_store_factory.Delete(path)
+		})
+
+	return true
+}
+
+// Periodically expire collection uploads in all orgs.
+func startCollectionUploadExpiry(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case <-time.After(COLLECTION_UPLOAD_GC_PERIOD):
+			}
+
+			org_manager, err := services.GetOrgManager()
+			if err != nil {
+				continue
+			}
+
+			for _, org := range org_manager.ListOrgs() {
+				org_config_obj, err := org_manager.GetOrgConfig(org.Id)
+				if err == nil {
+					expireCollectionUploads(org_config_obj)
+				}
+			}
+		}
+	}()
+}
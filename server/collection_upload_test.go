@@ -0,0 +1,315 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Velocidex/ordereddict"
+	"github.com/alecthomas/assert"
+	"github.com/stretchr/testify/suite"
+	"www.velocidex.com/golang/velociraptor/accessors"
+	file_store_accessor "www.velocidex.com/golang/velociraptor/accessors/file_store"
+	api_proto "www.velocidex.com/golang/velociraptor/api/proto"
+	crypto_utils "www.velocidex.com/golang/velociraptor/crypto/utils"
+	"www.velocidex.com/golang/velociraptor/file_store"
+	"www.velocidex.com/golang/velociraptor/file_store/api"
+	"www.velocidex.com/golang/velociraptor/file_store/test_utils"
+	flows_proto "www.velocidex.com/golang/velociraptor/flows/proto"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/logging"
+	"www.velocidex.com/golang/velociraptor/paths"
+	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/utils"
+	"www.velocidex.com/golang/velociraptor/vql/acl_managers"
+	"www.velocidex.com/golang/velociraptor/vql/tools/collector"
+	"www.velocidex.com/golang/vfilter"
+
+	_ "www.velocidex.com/golang/velociraptor/result_sets/simple"
+	_ "www.velocidex.com/golang/velociraptor/result_sets/timed"
+	_ "www.velocidex.com/golang/velociraptor/vql/protocols"
+)
+
+type CollectionUploadTestSuite struct {
+	test_utils.TestSuite
+	server *httptest.Server
+}
+
+func (self *CollectionUploadTestSuite) SetupTest() {
+	self.ConfigObj = self.LoadConfig()
+	self.TestSuite.SetupTest()
+
+	// The binary registers the filestore accessor at startup.
+	accessors.Register("fs", file_store_accessor.NewFileStoreFileSystemAccessor(
+		self.ConfigObj), "")
+
+	// Uploads are imported with the permissions of the token's
+	// principal.
+	err := services.GrantRoles(self.ConfigObj, "admin", []string{"administrator"})
+	assert.NoError(self.T(), err)
+
+	self.server = httptest.NewServer(collection_upload(self.ConfigObj))
+}
+
+func (self *CollectionUploadTestSuite) TearDownTest() {
+	self.server.Close()
+	self.TestSuite.TearDownTest()
+}
+
+func (self *CollectionUploadTestSuite) upload(
+	token string, state_file string) *ordereddict.Dict {
+	manager, err := services.GetRepositoryManager(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	scope := manager.BuildScope(services.ScopeBuilder{
+		Config:     self.ConfigObj,
+		ACLManager: acl_managers.NullACLManager{},
+		Logger: logging.NewPlainLogger(
+			self.ConfigObj, &logging.FrontendComponent),
+		Env: ordereddict.NewDict(),
+	})
+	defer scope.Close()
+
+	import_file_path, err := filepath.Abs(
+		"../vql/tools/collector/fixtures/import.zip")
+	assert.NoError(self.T(), err)
+
+	rows := []vfilter.Row{}
+	for row := range (collector.UploadResumablePlugin{}).Call(
+		self.Ctx, scope, ordereddict.NewDict().
+			Set("file", import_file_path).
+			Set("accessor", "file").
+			Set("state_file", state_file).
+			Set("chunk_size", 1000).
+			Set("hostname", "UploadedHost").
+			Set("url", self.server.URL).
+			Set("token", token)) {
+		rows = append(rows, row)
+	}
+
+	assert.Equal(self.T(), 1, len(rows))
+	return vfilter.RowToDict(self.Ctx, scope, rows[0])
+}
+
+func (self *CollectionUploadTestSuite) TestUploadAndImport() {
+	tmpdir, err := ioutil.TempDir("", "tmp")
+	assert.NoError(self.T(), err)
+	defer os.RemoveAll(tmpdir)
+
+	token, err := crypto_utils.NewUploadToken(self.ConfigObj,
+		self.ConfigObj.OrgId, "admin", time.Now().Add(time.Hour), 0)
+	assert.NoError(self.T(), err)
+
+	row := self.upload(token, filepath.Join(tmpdir, "state.json"))
+	complete, _ := row.Get("Complete")
+	assert.Equal(self.T(), true, complete)
+
+	chunks, _ := row.Get("Chunks")
+	assert.True(self.T(), chunks.(int64) > 1)
+
+	// The collection is imported against a new client.
+	indexer, err := services.GetIndexer(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	search_resp, err := indexer.SearchClients(self.Ctx, self.ConfigObj,
+		&api_proto.SearchClientsRequest{Query: "host:UploadedHost"}, "")
+	assert.NoError(self.T(), err)
+	assert.Equal(self.T(), 1, len(search_resp.Items))
+
+	response, _ := row.Get("Response")
+	client_id, _ := response.(*ordereddict.Dict).GetString("ClientId")
+	assert.Equal(self.T(), search_resp.Items[0].ClientId, client_id)
+
+	flow_id, _ := response.(*ordereddict.Dict).GetString("FlowId")
+	launcher, err := services.GetLauncher(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	details, err := launcher.GetFlowDetails(self.ConfigObj, client_id, flow_id)
+	assert.NoError(self.T(), err)
+	assert.Equal(self.T(), []string{"Linux.Search.FileFinder"},
+		details.Context.ArtifactsWithResults)
+}
+
+func (self *CollectionUploadTestSuite) TestInvalidToken() {
+	tmpdir, err := ioutil.TempDir("", "tmp")
+	assert.NoError(self.T(), err)
+	defer os.RemoveAll(tmpdir)
+
+	token, err := crypto_utils.NewUploadToken(self.ConfigObj,
+		self.ConfigObj.OrgId, "admin", time.Now().Add(time.Hour), 0)
+	assert.NoError(self.T(), err)
+
+	// Tampering with the token invalidates it.
+	row := self.upload(token+"x", filepath.Join(tmpdir, "state.json"))
+	error_message, _ := row.GetString("Error")
+	assert.Contains(self.T(), error_message, "HTTP status 403")
+
+	// The state file is kept so the upload may be resumed.
+	_, err = os.Stat(filepath.Join(tmpdir, "state.json"))
+	assert.NoError(self.T(), err)
+
+	// The manifest is rejected when chunks are missing.
+	req, err := http.NewRequest("POST",
+		self.server.URL+"?name=missing&manifest=1", strings.NewReader(
+			`{"name":"missing","size":10,"chunk_size":10,"chunks":1}`))
+	assert.NoError(self.T(), err)
+	req.Header.Set(crypto_utils.UPLOAD_TOKEN_HEADER, token)
+
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(self.T(), err)
+	resp.Body.Close()
+	assert.Equal(self.T(), http.StatusConflict, resp.StatusCode)
+}
+
+func (self *CollectionUploadTestSuite) post(
+	token, query, body string) (int, string) {
+	req, err := http.NewRequest("POST", self.server.URL+"?"+query,
+		strings.NewReader(body))
+	assert.NoError(self.T(), err)
+	req.Header.Set(crypto_utils.UPLOAD_TOKEN_HEADER, token)
+
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(self.T(), err)
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(self.T(), err)
+
+	return resp.StatusCode, string(data)
+}
+
+// A manifest sent again after the import gets the same response
+// without importing the collection again.
+func (self *CollectionUploadTestSuite) TestRepeatedManifest() {
+	tmpdir, err := ioutil.TempDir("", "tmp")
+	assert.NoError(self.T(), err)
+	defer os.RemoveAll(tmpdir)
+
+	token, err := crypto_utils.NewUploadToken(self.ConfigObj,
+		self.ConfigObj.OrgId, "admin", time.Now().Add(time.Hour), 0)
+	assert.NoError(self.T(), err)
+
+	row := self.upload(token, filepath.Join(tmpdir, "state.json"))
+	response, _ := row.Get("Response")
+	client_id, _ := response.(*ordereddict.Dict).GetString("ClientId")
+	flow_id, _ := response.(*ordereddict.Dict).GetString("FlowId")
+
+	data, err := ioutil.ReadFile("../vql/tools/collector/fixtures/import.zip")
+	assert.NoError(self.T(), err)
+
+	hash := sha256.Sum256(data)
+	manifest := json.MustMarshalString(ordereddict.NewDict().
+		Set("name", "import.zip").
+		Set("size", len(data)).
+		Set("sha256", hex.EncodeToString(hash[:])).
+		Set("chunk_size", 1000).
+		Set("chunks", (len(data)+999)/1000).
+		Set("hostname", "UploadedHost"))
+
+	status, body := self.post(token, "name=import.zip&manifest=1", manifest)
+	assert.Equal(self.T(), http.StatusOK, status)
+	assert.Contains(self.T(), body, flow_id)
+
+	launcher, err := services.GetLauncher(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	flows, err := launcher.GetFlows(self.ConfigObj, client_id, true,
+		func(flow *flows_proto.ArtifactCollectorContext) bool {
+			return true
+		}, 0, 100)
+	assert.NoError(self.T(), err)
+	assert.Equal(self.T(), 1, len(flows.Items))
+}
+
+// Uploads stop once the token's size limit is reached.
+func (self *CollectionUploadTestSuite) TestTokenSizeLimit() {
+	tmpdir, err := ioutil.TempDir("", "tmp")
+	assert.NoError(self.T(), err)
+	defer os.RemoveAll(tmpdir)
+
+	token, err := crypto_utils.NewUploadToken(self.ConfigObj,
+		self.ConfigObj.OrgId, "admin", time.Now().Add(time.Hour), 2500)
+	assert.NoError(self.T(), err)
+
+	row := self.upload(token, filepath.Join(tmpdir, "state.json"))
+	error_message, _ := row.GetString("Error")
+	assert.Contains(self.T(), error_message, "HTTP status 413")
+
+	// Sending the same chunk again does not count twice.
+	status, _ := self.post(token, "name=import.zip&index=0",
+		strings.Repeat("x", 1000))
+	assert.Equal(self.T(), http.StatusOK, status)
+
+	status, _ = self.post(token, "name=other&index=0",
+		strings.Repeat("x", 501))
+	assert.Equal(self.T(), http.StatusRequestEntityTooLarge, status)
+}
+
+// Tokens are only accepted while their principal may import
+// collections.
+func (self *CollectionUploadTestSuite) TestPermissionDenied() {
+	token, err := crypto_utils.NewUploadToken(self.ConfigObj,
+		self.ConfigObj.OrgId, "reader", time.Now().Add(time.Hour), 0)
+	assert.NoError(self.T(), err)
+
+	err = services.GrantRoles(self.ConfigObj, "reader", []string{"reader"})
+	assert.NoError(self.T(), err)
+
+	status, _ := self.post(token, "name=test&index=0", "hello")
+	assert.Equal(self.T(), http.StatusForbidden, status)
+}
+
+// Unfinished uploads are removed once they expire.
+func (self *CollectionUploadTestSuite) TestExpireUploads() {
+	clock := &utils.MockClock{MockNow: time.Now()}
+	defer utils.MockTime(clock)()
+
+	token, err := crypto_utils.NewUploadToken(self.ConfigObj,
+		self.ConfigObj.OrgId, "admin", clock.MockNow.Add(time.Hour), 0)
+	assert.NoError(self.T(), err)
+
+	for i := 0; i < 2; i++ {
+		status, _ := self.post(token, fmt.Sprintf("name=test&index=%d", i),
+			"hello")
+		assert.Equal(self.T(), http.StatusOK, status)
+	}
+
+	file_store_factory := file_store.GetFileStore(self.ConfigObj)
+	list_files := func(root api.FSPathSpec) []string {
+		result := []string{}
+		_ = api.Walk(file_store_factory, root,
+			func(path api.FSPathSpec, info os.FileInfo) error {
+				result = append(result, path.Base())
+				return nil
+			})
+		sort.Strings(result)
+		return result
+	}
+
+	// Recent uploads are kept.
+	expireCollectionUploads(self.ConfigObj)
+	assert.Equal(self.T(), []string{"000000", "000001", "state"},
+		list_files(paths.COLLECTION_UPLOADS_ROOT))
+	assert.Equal(self.T(), 1,
+		len(list_files(paths.COLLECTION_UPLOAD_TOKENS_ROOT)))
+
+	clock.MockNow = clock.MockNow.Add(COLLECTION_UPLOAD_EXPIRY + time.Hour)
+	expireCollectionUploads(self.ConfigObj)
+	assert.Equal(self.T(), []string{},
+		list_files(paths.COLLECTION_UPLOADS_ROOT))
+	assert.Equal(self.T(), []string{},
+		list_files(paths.COLLECTION_UPLOAD_TOKENS_ROOT))
+}
+
+func TestCollectionUpload(t *testing.T) {
+	suite.Run(t, &CollectionUploadTestSuite{})
+}
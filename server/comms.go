@@ -149,6 +149,11 @@ func PrepareFrontendMux(
 	router.Handle(base+"/receive_messages",
 		RecordHTTPStats(send_client_messages(server_obj)))
 
+	// Offline collectors upload their collections here.
+	router.Handle(base+"/upload_collection",
+		GetLoggingHandler(config_obj, "/upload_collection")(
+			collection_upload(config_obj)))
+
 	// Publicly accessible part of the filestore. NOTE: this
 	// does not have to be a physical directory - it is served
 	// from the filestore.
@@ -119,6 +119,8 @@ func NewServer(ctx context.Context,
 	result.reader_concurrency = utils.NewConcurrencyControl(
 		int(100), result.concurrency_timeout)
 
+	startCollectionUploadExpiry(ctx, wg)
+
 	if config_obj.Frontend.Resources.ConnectionsPerSecond > 0 {
 		result.logger.Info("Throttling connections to %v QPS",
 			config_obj.Frontend.Resources.ConnectionsPerSecond)
@@ -1,6 +1,9 @@
 package services
 
 import (
+	"context"
+	"fmt"
+
 	"www.velocidex.com/golang/velociraptor/acls"
 	acl_proto "www.velocidex.com/golang/velociraptor/acls/proto"
 	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
@@ -24,6 +27,15 @@ type ACLManager interface {
 		principal string,
 		permissions ...acls.ACL_PERMISSION) (bool, error)
 
+	// Like CheckAccess() but permissions missing from the policy
+	// may also be granted by scoped grants matching the resource.
+	CheckAccessWithResource(
+		ctx context.Context,
+		config_obj *config_proto.Config,
+		principal string,
+		resource *acls.Resource,
+		permissions ...acls.ACL_PERMISSION) (bool, error)
+
 	GrantRoles(
 		config_obj *config_proto.Config,
 		principal string,
@@ -88,6 +100,60 @@ func CheckAccess(
 	return acl_manager.CheckAccess(config_obj, principal, permissions...)
 }
 
+func CheckAccessWithResource(
+	ctx context.Context,
+	config_obj *config_proto.Config,
+	principal string,
+	resource *acls.Resource,
+	permissions ...acls.ACL_PERMISSION) (bool, error) {
+	acl_manager, err := GetACLManager(config_obj)
+	if err != nil {
+		return false, err
+	}
+
+	return acl_manager.CheckAccessWithResource(
+		ctx, config_obj, principal, resource, permissions...)
+}
+
+// Principals whose permission only comes from scoped grants must
+// have a grant matching the resource. Other principals are left to
+// the caller's checks.
+func CheckScopedAccess(
+	ctx context.Context,
+	config_obj *config_proto.Config,
+	principal string,
+	resource *acls.Resource,
+	permission acls.ACL_PERMISSION) error {
+	if principal == "" {
+		return nil
+	}
+
+	policy, err := GetEffectivePolicy(config_obj, principal)
+	if err != nil {
+		return err
+	}
+
+	ok, err := CheckAccessWithToken(policy, permission)
+	if err != nil {
+		return err
+	}
+
+	if ok || !acls.HasScopedGrant(policy, permission) {
+		return nil
+	}
+
+	ok, err = CheckAccessWithResource(
+		ctx, config_obj, principal, resource, permission)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return fmt.Errorf("Permission denied: %v on %v", permission, resource)
+	}
+	return nil
+}
+
 func CheckAccessWithToken(
 	token *acl_proto.ApiClientACL,
 	permission acls.ACL_PERMISSION, args ...string) (bool, error) {
@@ -157,6 +157,83 @@ func (self ACLManager) CheckAccess(
 	return true, nil
 }
 
+func (self ACLManager) CheckAccessWithResource(
+	ctx context.Context,
+	config_obj *config_proto.Config,
+	principal string,
+	resource *acls.Resource,
+	permissions ...acls.ACL_PERMISSION) (bool, error) {
+
+	// Internal calls from the server are allowed to do anything.
+	if config_obj.Client != nil && principal == config_obj.Client.PinnedServerName {
+		return true, nil
+	}
+
+	if principal == "" {
+		return false, nil
+	}
+
+	acl_obj, err := self.GetEffectivePolicy(config_obj, principal)
+	if err != nil {
+		// A missing ACL means no privs
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	for _, permission := range permissions {
+		ok, err := services.CheckAccessWithToken(acl_obj, permission)
+		if err != nil {
+			return false, err
+		}
+
+		if !ok {
+			ok, err = self.checkScopedGrants(ctx, config_obj,
+				acl_obj, principal, resource, permission)
+			if !ok || err != nil {
+				return ok, err
+			}
+		}
+	}
+
+	return true, nil
+}
+
+func (self ACLManager) checkScopedGrants(
+	ctx context.Context,
+	config_obj *config_proto.Config,
+	acl_obj *acl_proto.ApiClientACL,
+	principal string,
+	resource *acls.Resource,
+	permission acls.ACL_PERMISSION) (bool, error) {
+	if resource == nil {
+		return false, nil
+	}
+
+	for _, grant := range acl_obj.ScopedGrants {
+		// Only look up the client's labels if a grant needs them.
+		if len(grant.Labels) > 0 && resource.ClientId != "" &&
+			resource.ClientLabels == nil {
+			labeler := services.GetLabeler(config_obj)
+			if labeler == nil {
+				return false, errors.New("Labeler service not available")
+			}
+			resource.ClientLabels = labeler.GetClientLabels(
+				ctx, config_obj, resource.ClientId)
+			if resource.ClientLabels == nil {
+				resource.ClientLabels = []string{}
+			}
+		}
+
+		if acls.ScopedGrantMatches(grant, permission, principal, resource) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (self ACLManager) GrantRoles(
 	config_obj *config_proto.Config,
 	principal string,
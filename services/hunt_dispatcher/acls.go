@@ -0,0 +1,52 @@
+package hunt_dispatcher
+
+import (
+	"context"
+
+	"www.velocidex.com/golang/velociraptor/acls"
+	api_proto "www.velocidex.com/golang/velociraptor/api/proto"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	"www.velocidex.com/golang/velociraptor/services"
+	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
+)
+
+// Describe the hunt for checking scoped grants. Only hunts targeting
+// labels can match grants restricted to labels.
+func GetHuntResource(hunt *api_proto.Hunt) *acls.Resource {
+	result := &acls.Resource{
+		Artifacts: hunt.Artifacts,
+		Creator:   hunt.Creator,
+	}
+
+	// The start request is what is actually collected.
+	if hunt.StartRequest != nil && len(hunt.StartRequest.Artifacts) > 0 {
+		result.Artifacts = hunt.StartRequest.Artifacts
+	}
+
+	if hunt.Condition != nil {
+		labels := hunt.Condition.GetLabels()
+		if labels != nil {
+			result.HuntLabels = labels.Label
+		}
+	}
+
+	return result
+}
+
+// Principals only holding scoped grants must be allowed to collect
+// the hunt's artifacts from all the clients it targets.
+func checkCreateHuntAccess(
+	ctx context.Context,
+	config_obj *config_proto.Config,
+	acl_manager vql_subsystem.ACLManager,
+	hunt *api_proto.Hunt) error {
+
+	principal_manager, ok := acl_manager.(vql_subsystem.PrincipalACLManager)
+	if !ok {
+		return nil
+	}
+
+	return services.CheckScopedAccess(ctx, config_obj,
+		principal_manager.GetPrincipal(), GetHuntResource(hunt),
+		acls.COLLECT_CLIENT)
+}
@@ -66,6 +66,7 @@ import (
 	"www.velocidex.com/golang/velociraptor/logging"
 	"www.velocidex.com/golang/velociraptor/paths"
 	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/services/hunt_manager"
 	"www.velocidex.com/golang/velociraptor/services/journal"
 	"www.velocidex.com/golang/velociraptor/utils"
 	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
@@ -116,10 +117,17 @@ func (self *HuntDispatcher) GetLastTimestamp() uint64 {
 
 // When a new hunt is started, we need to inform the hunt manager
 // about all clients currently directly connected to us. The hunt
-// manager may decide to schedule the hunt on these clients.
+// manager may decide to schedule the hunt on these clients. Clients
+// which clearly do not match the hunt condition are skipped to avoid
+// flooding the hunt manager.
 func (self *HuntDispatcher) participateAllConnectedClients(
 	ctx context.Context,
-	config_obj *config_proto.Config, hunt_id string) error {
+	config_obj *config_proto.Config, hunt_obj *api_proto.Hunt) error {
+
+	// Scheduled hunt templates never run on clients directly.
+	if hunt_obj.Schedule != nil {
+		return nil
+	}
 
 	notifier, err := services.GetNotifier(config_obj)
 	if err != nil {
@@ -135,10 +143,18 @@ func (self *HuntDispatcher) participateAllConnectedClients(
 			continue
 		}
 
+		// If we can not evaluate the condition here let the hunt
+		// manager decide.
+		matched, err := hunt_manager.ClientMatchesHuntCondition(
+			ctx, config_obj, hunt_obj, c)
+		if err == nil && !matched {
+			continue
+		}
+
 		// Notify the hunt manager about the new client
 		journal.PushRowsToArtifactAsync(ctx, config_obj,
 			ordereddict.NewDict().
-				Set("HuntId", hunt_id).
+				Set("HuntId", hunt_obj.HuntId).
 				Set("ClientId", c),
 			"System.Hunt.Participation")
 	}
@@ -187,7 +203,7 @@ func (self *HuntDispatcher) ProcessUpdate(
 	// our currently connected clients.
 	_, pres = row.Get("TriggerParticipation")
 	if pres {
-		self.participateAllConnectedClients(ctx, config_obj, hunt_obj.HuntId)
+		self.participateAllConnectedClients(ctx, config_obj, hunt_obj)
 	}
 
 	// On the master we also write it to storage.
@@ -493,18 +509,39 @@ func (self *HuntDispatcher) CreateHunt(
 		return "", errors.New("No artifacts to collect.")
 	}
 
-	hunt.CreateTime = uint64(time.Now().UTC().UnixNano() / 1000)
-	if hunt.Expires == 0 {
-		default_expiry := config_obj.Defaults.HuntExpiryHours
-		if default_expiry == 0 {
-			default_expiry = 7 * 24
+	err = checkCreateHuntAccess(ctx, config_obj, acl_manager, hunt)
+	if err != nil {
+		return "", err
+	}
+
+	err = hunt_manager.ValidateHuntCondition(hunt.Condition)
+	if err != nil {
+		return "", err
+	}
+
+	err = hunt_manager.ValidateHuntRollout(hunt)
+	if err != nil {
+		return "", err
+	}
+	hunt_manager.ResetHuntRollout(hunt.Rollout)
+
+	if hunt.Schedule != nil {
+		err = validateHuntSchedule(hunt.Schedule, utils.GetTime().Now())
+		if err != nil {
+			return "", err
 		}
+	}
+
+	hunt.CreateTime = uint64(time.Now().UTC().UnixNano() / 1000)
+
+	// Scheduled hunt templates do not expire by default - each
+	// spawned run gets its own expiry instead.
+	if hunt.Expires == 0 && hunt.Schedule == nil {
 		hunt.Expires = uint64(time.Now().Add(
-			time.Duration(default_expiry)*time.Hour).
-			UTC().UnixNano() / 1000)
+			getDefaultHuntExpiry(config_obj)).UTC().UnixNano() / 1000)
 	}
 
-	if hunt.Expires < hunt.CreateTime {
+	if hunt.Expires != 0 && hunt.Expires < hunt.CreateTime {
 		return "", errors.New("Hunt expiry is in the past!")
 	}
 
@@ -633,6 +670,15 @@ func NewHuntDispatcher(
 				if err != nil {
 					logger.Error("Unable to sync hunts: %v", err)
 				}
+
+				// Only the master spawns scheduled hunts.
+				if service.I_am_master {
+					err = service.ProcessScheduledHunts(
+						ctx, config_obj, utils.GetTime().Now())
+					if err != nil {
+						logger.Error("Unable to run scheduled hunts: %v", err)
+					}
+				}
 			}
 		}
 	}()
@@ -2,6 +2,7 @@ package hunt_dispatcher_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -11,7 +12,9 @@ import (
 	flows_proto "www.velocidex.com/golang/velociraptor/flows/proto"
 	"www.velocidex.com/golang/velociraptor/paths"
 	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/services/hunt_dispatcher"
 	"www.velocidex.com/golang/velociraptor/vql/acl_managers"
+	"www.velocidex.com/golang/velociraptor/vtesting"
 
 	_ "www.velocidex.com/golang/velociraptor/result_sets/timed"
 )
@@ -23,6 +26,7 @@ type HuntTestSuite struct {
 func (self *HuntTestSuite) SetupTest() {
 	self.ConfigObj = self.TestSuite.LoadConfig()
 	self.ConfigObj.Services.HuntDispatcher = true
+	self.ConfigObj.Services.HuntManager = true
 	self.TestSuite.SetupTest()
 }
 
@@ -105,6 +109,206 @@ sources:
 		[]string{"TestArtifact_Arg1", "AnotherTestArtifact_Arg1"})
 }
 
+func (self *HuntTestSuite) TestCronSchedule() {
+	cron, err := hunt_dispatcher.ParseCronSchedule("30 2 * * *")
+	assert.NoError(self.T(), err)
+
+	start := time.Date(2023, 1, 1, 3, 0, 0, 0, time.UTC)
+	assert.Equal(self.T(), time.Date(2023, 1, 2, 2, 30, 0, 0, time.UTC),
+		cron.Next(start))
+
+	// Every 15 minutes on weekdays.
+	cron, err = hunt_dispatcher.ParseCronSchedule("*/15 * * * 1-5")
+	assert.NoError(self.T(), err)
+
+	// 2023-01-06 is a Friday.
+	start = time.Date(2023, 1, 6, 23, 50, 0, 0, time.UTC)
+	assert.Equal(self.T(), time.Date(2023, 1, 9, 0, 0, 0, 0, time.UTC),
+		cron.Next(start))
+
+	// Invalid specifications
+	for _, spec := range []string{
+		"* * * *", "60 * * * *", "*/0 * * * *", "a * * * *", "5-2 * * * *"} {
+		_, err = hunt_dispatcher.ParseCronSchedule(spec)
+		assert.Error(self.T(), err, spec)
+	}
+}
+
+func (self *HuntTestSuite) TestScheduledHunts() {
+	manager, err := services.GetRepositoryManager(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	repository, err := manager.GetGlobalRepository(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	repository.LoadYaml(`
+name: TestArtifact
+sources:
+- query:
+    SELECT * FROM info()
+`, true, true)
+
+	repository.LoadYaml(`
+name: System.Hunt.Creation
+type: SERVER_EVENT`, true, true)
+
+	repository.LoadYaml(`
+name: System.Hunt.Archive
+type: SERVER_EVENT`, true, true)
+
+	journal, err := services.GetJournal(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	archived, cancel := journal.Watch(self.Ctx, "System.Hunt.Archive", "test")
+	defer cancel()
+
+	dispatcher, err := services.GetHuntDispatcher(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	// An invalid schedule is rejected.
+	_, err = dispatcher.CreateHunt(self.Ctx, self.ConfigObj,
+		acl_managers.NullACLManager{}, &api_proto.Hunt{
+			StartRequest: &flows_proto.ArtifactCollectorArgs{
+				Artifacts: []string{"TestArtifact"},
+			},
+			Schedule: &api_proto.HuntSchedule{IntervalSec: 10},
+		})
+	assert.Error(self.T(), err)
+
+	template_id, err := dispatcher.CreateHunt(self.Ctx, self.ConfigObj,
+		acl_managers.NullACLManager{}, &api_proto.Hunt{
+			HuntDescription: "Scheduled hunt",
+			State:           api_proto.Hunt_RUNNING,
+			StartRequest: &flows_proto.ArtifactCollectorArgs{
+				Artifacts: []string{"TestArtifact"},
+			},
+			Schedule: &api_proto.HuntSchedule{
+				IntervalSec: 3600,
+				RetainRuns:  2,
+			},
+		})
+	assert.NoError(self.T(), err)
+
+	template, pres := dispatcher.GetHunt(template_id)
+	assert.True(self.T(), pres)
+	assert.True(self.T(), template.Schedule.NextRunTime > 0)
+
+	master := dispatcher.(*hunt_dispatcher.HuntDispatcher)
+	now := time.Now()
+
+	// Nothing is due yet.
+	assert.NoError(self.T(),
+		master.ProcessScheduledHunts(self.Ctx, self.ConfigObj, now))
+	template, _ = dispatcher.GetHunt(template_id)
+	assert.Equal(self.T(), 0, len(template.ChildHuntIds))
+
+	// Spawn three runs - only the last two are retained.
+	var children []string
+	for i := 1; i <= 3; i++ {
+		now = now.Add(2 * time.Hour)
+		assert.NoError(self.T(),
+			master.ProcessScheduledHunts(self.Ctx, self.ConfigObj, now))
+
+		template, _ = dispatcher.GetHunt(template_id)
+		children = append(children,
+			template.ChildHuntIds[len(template.ChildHuntIds)-1])
+	}
+
+	assert.Equal(self.T(), children[1:], template.ChildHuntIds)
+	assert.Equal(self.T(), uint64(now.UnixNano()/1000),
+		template.Schedule.LastRunTime)
+
+	// The oldest run is archived on behalf of the schedule's
+	// creator.
+	select {
+	case row := <-archived:
+		hunt_id, _ := row.GetString("HuntId")
+		assert.Equal(self.T(), children[0], hunt_id)
+
+	case <-time.After(10 * time.Second):
+		self.T().Fatalf("No System.Hunt.Archive event")
+	}
+
+	vtesting.WaitUntil(2*time.Second, self.T(), func() bool {
+		child, _ := dispatcher.GetHunt(children[0])
+		return child.State == api_proto.Hunt_ARCHIVED
+	})
+
+	child, pres := dispatcher.GetHunt(children[2])
+
+	assert.True(self.T(), pres)
+	assert.Equal(self.T(), api_proto.Hunt_RUNNING, child.State)
+	assert.Equal(self.T(), template_id, child.ParentHuntId)
+	assert.Nil(self.T(), child.Schedule)
+	assert.Equal(self.T(), 1, len(child.StartRequest.CompiledCollectorArgs))
+
+	// List the run history.
+	runs, err := dispatcher.ListHunts(self.Ctx, self.ConfigObj,
+		&api_proto.ListHuntsRequest{
+			Count:        10,
+			ParentHuntId: template_id,
+		})
+	assert.NoError(self.T(), err)
+	assert.Equal(self.T(), 2, len(runs.Items))
+}
+
+// A weekly schedule keeps running past the default hunt expiry. Each
+// run gets its own expiry.
+func (self *HuntTestSuite) TestScheduledHuntsExpiry() {
+	manager, err := services.GetRepositoryManager(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	repository, err := manager.GetGlobalRepository(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	repository.LoadYaml(`
+name: TestArtifact
+sources:
+- query:
+    SELECT * FROM info()
+`, true, true)
+
+	dispatcher, err := services.GetHuntDispatcher(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	week := 7 * 24 * time.Hour
+	template_id, err := dispatcher.CreateHunt(self.Ctx, self.ConfigObj,
+		acl_managers.NullACLManager{}, &api_proto.Hunt{
+			HuntDescription: "Weekly hunt",
+			State:           api_proto.Hunt_RUNNING,
+			StartRequest: &flows_proto.ArtifactCollectorArgs{
+				Artifacts: []string{"TestArtifact"},
+			},
+			Schedule: &api_proto.HuntSchedule{
+				IntervalSec: uint64(week / time.Second),
+			},
+		})
+	assert.NoError(self.T(), err)
+
+	template, pres := dispatcher.GetHunt(template_id)
+	assert.True(self.T(), pres)
+	assert.Equal(self.T(), uint64(0), template.Expires)
+
+	master := dispatcher.(*hunt_dispatcher.HuntDispatcher)
+	now := time.Now()
+
+	// Run for three weeks - well past the default 7 day expiry.
+	for i := 1; i <= 3; i++ {
+		now = now.Add(week + time.Hour)
+		assert.NoError(self.T(),
+			master.ProcessScheduledHunts(self.Ctx, self.ConfigObj, now))
+
+		template, _ = dispatcher.GetHunt(template_id)
+		assert.Equal(self.T(), i, len(template.ChildHuntIds))
+
+		child, pres := dispatcher.GetHunt(
+			template.ChildHuntIds[len(template.ChildHuntIds)-1])
+		assert.True(self.T(), pres)
+		assert.Equal(self.T(), uint64(now.Add(week).UnixNano()/1000),
+			child.Expires)
+	}
+}
+
 func TestHunts(t *testing.T) {
 	suite.Run(t, &HuntTestSuite{})
 }
@@ -61,6 +61,12 @@ func (self *HuntDispatcher) ListHunts(
 	items := make([]*api_proto.Hunt, 0, end)
 	err = dispatcher.ApplyFuncOnHunts(
 		func(hunt *api_proto.Hunt) error {
+			// Only show the runs of a scheduled hunt.
+			if in.ParentHuntId != "" &&
+				hunt.ParentHuntId != in.ParentHuntId {
+				return nil
+			}
+
 			// Only show non-archived hunts.
 			if in.IncludeArchived ||
 				hunt.State != api_proto.Hunt_ARCHIVED {
@@ -0,0 +1,373 @@
+package hunt_dispatcher
+
+// Scheduled hunts: A hunt with a schedule is a template which is
+// never scheduled on clients directly. Instead, the master's hunt
+// dispatcher periodically spawns a new child hunt from the template
+// according to the schedule. Children are normal hunts which refer
+// back to their parent via the ParentHuntId field, while the parent
+// keeps a list of its most recent runs. Older runs are archived.
+
+// The template's state controls the schedule: A RUNNING template
+// spawns new runs, while a PAUSED or STOPPED template does
+// not. Templates do not expire unless an explicit expiry is given,
+// after which no further runs are spawned. Each run expires after the
+// schedule's run duration (or the default hunt expiry).
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	api_proto "www.velocidex.com/golang/velociraptor/api/proto"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	"www.velocidex.com/golang/velociraptor/logging"
+	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/vql/acl_managers"
+)
+
+const (
+	// Keep this many runs by default.
+	DEFAULT_RETAIN_RUNS = 10
+
+	// Do not allow hunts to be spawned too frequently.
+	MIN_SCHEDULE_INTERVAL_SEC = 60
+)
+
+// A parsed cron schedule. Each field is a set of allowed values.
+type CronSchedule struct {
+	minute, hour, dom, month, dow map[int]bool
+
+	// If either day of month or day of week is restricted, a day
+	// matches if any of them matches (like in the traditional
+	// cron).
+	dom_star, dow_star bool
+}
+
+// Parse a standard 5 field cron specification: "minute hour
+// day-of-month month day-of-week". Each field may be "*", a number,
+// a range "a-b", a step "*/n" or "a-b/n" or a comma separated list
+// of these. Times are evaluated in UTC.
+func ParseCronSchedule(spec string) (*CronSchedule, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf(
+			"Cron schedule %q: expected 5 fields but got %v", spec, len(fields))
+	}
+
+	result := &CronSchedule{
+		dom_star: fields[2] == "*",
+		dow_star: fields[4] == "*",
+	}
+
+	var err error
+	result.minute, err = parseCronField(fields[0], 0, 59)
+	if err != nil {
+		return nil, fmt.Errorf("Cron schedule %q: minute: %w", spec, err)
+	}
+
+	result.hour, err = parseCronField(fields[1], 0, 23)
+	if err != nil {
+		return nil, fmt.Errorf("Cron schedule %q: hour: %w", spec, err)
+	}
+
+	result.dom, err = parseCronField(fields[2], 1, 31)
+	if err != nil {
+		return nil, fmt.Errorf("Cron schedule %q: day of month: %w", spec, err)
+	}
+
+	result.month, err = parseCronField(fields[3], 1, 12)
+	if err != nil {
+		return nil, fmt.Errorf("Cron schedule %q: month: %w", spec, err)
+	}
+
+	// Day of week allows 7 to mean Sunday as well.
+	result.dow, err = parseCronField(fields[4], 0, 7)
+	if err != nil {
+		return nil, fmt.Errorf("Cron schedule %q: day of week: %w", spec, err)
+	}
+	if result.dow[7] {
+		result.dow[0] = true
+	}
+
+	return result, nil
+}
+
+func parseCronField(field string, min, max int) (map[int]bool, error) {
+	result := make(map[int]bool)
+
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		idx := strings.Index(part, "/")
+		if idx >= 0 {
+			var err error
+			step, err = strconv.Atoi(part[idx+1:])
+			if err != nil || step <= 0 {
+				return nil, fmt.Errorf("invalid step in %q", part)
+			}
+			part = part[:idx]
+		}
+
+		start, end := min, max
+		if part != "*" {
+			bounds := strings.SplitN(part, "-", 2)
+
+			var err error
+			start, err = strconv.Atoi(bounds[0])
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q", part)
+			}
+			end = start
+
+			if len(bounds) == 2 {
+				end, err = strconv.Atoi(bounds[1])
+				if err != nil {
+					return nil, fmt.Errorf("invalid range %q", part)
+				}
+
+				// A single value with a step means from the
+				// value to the end of the range.
+			} else if idx >= 0 {
+				end = max
+			}
+		}
+
+		if start < min || end > max || start > end {
+			return nil, fmt.Errorf("%q is out of range %v-%v", part, min, max)
+		}
+
+		for i := start; i <= end; i += step {
+			result[i] = true
+		}
+	}
+
+	return result, nil
+}
+
+func (self *CronSchedule) matchDay(t time.Time) bool {
+	dom_match := self.dom[t.Day()]
+	dow_match := self.dow[int(t.Weekday())]
+
+	if self.dom_star || self.dow_star {
+		return dom_match && dow_match
+	}
+	return dom_match || dow_match
+}
+
+// Returns the first time strictly after t which matches the
+// schedule. Returns the zero time if nothing matches in the next few
+// years (e.g. 30th of February).
+func (self *CronSchedule) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if !self.month[int(t.Month())] {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if !self.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if !self.hour[t.Hour()] {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+
+		if !self.minute[t.Minute()] {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+// Calculate the next time the schedule should run after now. Times
+// are in microseconds like all other hunt timestamps.
+func nextRunTime(schedule *api_proto.HuntSchedule, now time.Time) (uint64, error) {
+	if schedule.Cron != "" {
+		cron, err := ParseCronSchedule(schedule.Cron)
+		if err != nil {
+			return 0, err
+		}
+
+		next := cron.Next(now)
+		if next.IsZero() {
+			return 0, fmt.Errorf("Cron schedule %q never runs", schedule.Cron)
+		}
+		return uint64(next.UnixNano() / 1000), nil
+	}
+
+	return uint64(now.Add(time.Duration(schedule.IntervalSec)*
+		time.Second).UnixNano() / 1000), nil
+}
+
+// The expiry of hunts which do not specify one.
+func getDefaultHuntExpiry(config_obj *config_proto.Config) time.Duration {
+	default_expiry := config_obj.Defaults.HuntExpiryHours
+	if default_expiry == 0 {
+		default_expiry = 7 * 24
+	}
+	return time.Duration(default_expiry) * time.Hour
+}
+
+// Check the schedule is valid and calculate the first run.
+func validateHuntSchedule(schedule *api_proto.HuntSchedule, now time.Time) error {
+	if schedule.Cron != "" && schedule.IntervalSec > 0 {
+		return errors.New("Hunt schedule: only one of cron or interval may be specified")
+	}
+
+	if schedule.Cron == "" && schedule.IntervalSec == 0 {
+		return errors.New("Hunt schedule: one of cron or interval must be specified")
+	}
+
+	if schedule.Cron == "" && schedule.IntervalSec < MIN_SCHEDULE_INTERVAL_SEC {
+		return fmt.Errorf("Hunt schedule: interval must be at least %v seconds",
+			MIN_SCHEDULE_INTERVAL_SEC)
+	}
+
+	if schedule.RetainRuns == 0 {
+		schedule.RetainRuns = DEFAULT_RETAIN_RUNS
+	}
+
+	next_run, err := nextRunTime(schedule, now)
+	if err != nil {
+		return err
+	}
+	schedule.NextRunTime = next_run
+
+	return nil
+}
+
+// Spawn new runs from all scheduled hunts which are due. This is
+// only called on the master.
+func (self *HuntDispatcher) ProcessScheduledHunts(
+	ctx context.Context, config_obj *config_proto.Config, now time.Time) error {
+
+	now_us := uint64(now.UnixNano() / 1000)
+
+	var due []*api_proto.Hunt
+	err := self.ApplyFuncOnHunts(func(hunt *api_proto.Hunt) error {
+		if hunt.Schedule != nil &&
+			hunt.State == api_proto.Hunt_RUNNING &&
+			hunt.Schedule.NextRunTime <= now_us &&
+			(hunt.Expires == 0 || hunt.Expires > now_us) {
+			due = append(due, hunt)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger := logging.GetLogger(config_obj, &logging.FrontendComponent)
+	for _, template := range due {
+		err := self.spawnScheduledRun(ctx, config_obj, template, now)
+		if err != nil {
+			logger.Error("Scheduled hunt %v: %v", template.HuntId, err)
+		}
+	}
+
+	return nil
+}
+
+func (self *HuntDispatcher) spawnScheduledRun(
+	ctx context.Context, config_obj *config_proto.Config,
+	template *api_proto.Hunt, now time.Time) error {
+
+	// Always advance the schedule, even if we fail to spawn the
+	// run so we do not retry continuously.
+	next_run, sched_err := nextRunTime(template.Schedule, now)
+
+	child := proto.Clone(template).(*api_proto.Hunt)
+	child.HuntId = ""
+	child.ParentHuntId = template.HuntId
+	child.Schedule = nil
+	child.ChildHuntIds = nil
+	child.Stats = nil
+	child.Version = 0
+	child.StartTime = 0
+	child.State = api_proto.Hunt_RUNNING
+
+	// Each run expires relative to its own start.
+	run_duration := getDefaultHuntExpiry(config_obj)
+	if template.Schedule.RunDurationSec > 0 {
+		run_duration = time.Duration(
+			template.Schedule.RunDurationSec) * time.Second
+	}
+	child.Expires = uint64(now.Add(run_duration).UnixNano() / 1000)
+
+	// Recompile the artifacts for each run so runs pick up any
+	// changes to the artifact definitions.
+	if child.StartRequest != nil {
+		child.StartRequest.CompiledCollectorArgs = nil
+	}
+
+	acl_manager := acl_managers.NewServerACLManager(config_obj, template.Creator)
+	child_id, err := self.CreateHunt(ctx, config_obj, acl_manager, child)
+	if err != nil {
+		child_id = ""
+	}
+
+	var expired []string
+	self.ModifyHuntObject(ctx, template.HuntId,
+		func(hunt *api_proto.Hunt) services.HuntModificationAction {
+			if hunt.Schedule == nil {
+				return services.HuntUnmodified
+			}
+
+			hunt.Schedule.LastRunTime = uint64(now.UnixNano() / 1000)
+			hunt.Schedule.NextRunTime = next_run
+
+			// The schedule can not run any more so stop it.
+			if sched_err != nil {
+				hunt.State = api_proto.Hunt_STOPPED
+			}
+
+			if child_id != "" {
+				hunt.ChildHuntIds = append(hunt.ChildHuntIds, child_id)
+			}
+
+			retain := int(hunt.Schedule.RetainRuns)
+			if retain == 0 {
+				retain = DEFAULT_RETAIN_RUNS
+			}
+
+			if len(hunt.ChildHuntIds) > retain {
+				offset := len(hunt.ChildHuntIds) - retain
+				expired = append(expired, hunt.ChildHuntIds[:offset]...)
+				hunt.ChildHuntIds = append([]string{},
+					hunt.ChildHuntIds[offset:]...)
+			}
+
+			return services.HuntPropagateChanges
+		})
+
+	// Archive old runs the same way as a user would so the archive
+	// event is emitted on behalf of the schedule's creator.
+	logger := logging.GetLogger(config_obj, &logging.FrontendComponent)
+	for _, hunt_id := range expired {
+		archive_err := self.ModifyHunt(ctx, config_obj, &api_proto.Hunt{
+			HuntId: hunt_id,
+			State:  api_proto.Hunt_ARCHIVED,
+		}, template.Creator)
+		if archive_err != nil {
+			logger.Error("Scheduled hunt %v: archiving run %v: %v",
+				template.HuntId, hunt_id, archive_err)
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+	return sched_err
+}
@@ -0,0 +1,421 @@
+package hunt_manager
+
+// Evaluate hunt conditions against clients.
+
+// The HuntCondition contains the legacy label/OS conditions as well
+// as an optional condition tree (HuntConditionExpression). A client
+// matches the hunt condition only if it matches both.
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/Velocidex/ordereddict"
+	api_proto "www.velocidex.com/golang/velociraptor/api/proto"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	"www.velocidex.com/golang/velociraptor/logging"
+	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/utils"
+	"www.velocidex.com/golang/velociraptor/vql/acl_managers"
+	"www.velocidex.com/golang/vfilter"
+)
+
+var (
+	// Cache compiled regexes and VQL lambdas since the same hunt
+	// conditions are evaluated against many clients.
+	condition_mu     sync.Mutex
+	condition_regex  = make(map[string]*regexp.Regexp)
+	condition_lambda = make(map[string]*vfilter.Lambda)
+)
+
+// Information about a client that conditions are evaluated on. This
+// is lazily populated so cheap conditions do not need to fetch
+// everything.
+type clientContext struct {
+	ctx        context.Context
+	config_obj *config_proto.Config
+	client_id  string
+
+	// The principal whose permissions are used to evaluate VQL
+	// conditions (usually the hunt creator).
+	principal string
+
+	client_info *services.ClientInfo
+	metadata    *ordereddict.Dict
+}
+
+func (self *clientContext) getClientInfo() (*services.ClientInfo, error) {
+	if self.client_info != nil {
+		return self.client_info, nil
+	}
+
+	client_info_manager, err := services.GetClientInfoManager(self.config_obj)
+	if err != nil {
+		return nil, err
+	}
+
+	self.client_info, err = client_info_manager.Get(self.ctx, self.client_id)
+	return self.client_info, err
+}
+
+func (self *clientContext) getMetadata() (*ordereddict.Dict, error) {
+	if self.metadata != nil {
+		return self.metadata, nil
+	}
+
+	client_info_manager, err := services.GetClientInfoManager(self.config_obj)
+	if err != nil {
+		return nil, err
+	}
+
+	self.metadata, err = client_info_manager.GetMetadata(self.ctx, self.client_id)
+	return self.metadata, err
+}
+
+// Last seen time of the client in microseconds.
+func (self *clientContext) getLastSeen() uint64 {
+	client_info_manager, err := services.GetClientInfoManager(self.config_obj)
+	if err != nil {
+		return 0
+	}
+
+	stats, err := client_info_manager.GetStats(self.ctx, self.client_id)
+	if err != nil {
+		return 0
+	}
+	return stats.Ping
+}
+
+// Checks if the client matches the hunt's condition.
+func ClientMatchesHuntCondition(
+	ctx context.Context,
+	config_obj *config_proto.Config,
+	hunt_obj *api_proto.Hunt, client_id string) (bool, error) {
+	return ClientMatchesCondition(ctx, config_obj,
+		hunt_obj.Condition, hunt_obj.Creator, client_id)
+}
+
+// Checks if the client matches the condition. VQL conditions are
+// evaluated with the permissions of the principal.
+func ClientMatchesCondition(
+	ctx context.Context,
+	config_obj *config_proto.Config,
+	condition *api_proto.HuntCondition,
+	principal, client_id string) (bool, error) {
+
+	if condition == nil {
+		return true, nil
+	}
+
+	client_ctx := &clientContext{
+		ctx:        ctx,
+		config_obj: config_obj,
+		client_id:  client_id,
+		principal:  principal,
+	}
+
+	// Legacy conditions first.
+	labels := condition.GetLabels()
+	if labels != nil && len(labels.Label) > 0 &&
+		!hasAnyLabel(client_ctx, labels.Label) {
+		return false, nil
+	}
+
+	if condition.ExcludedLabels != nil &&
+		hasAnyLabel(client_ctx, condition.ExcludedLabels.Label) {
+		return false, nil
+	}
+
+	os_condition := condition.GetOs()
+	if os_condition != nil {
+		matched, err := matchesOS(client_ctx, os_condition)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+
+	return matchesExpression(client_ctx, condition.Expression)
+}
+
+// Checks only the condition tree, ignoring the legacy label and OS
+// conditions.
+func ClientMatchesExpression(
+	ctx context.Context,
+	config_obj *config_proto.Config,
+	expression *api_proto.HuntConditionExpression,
+	principal, client_id string) (bool, error) {
+
+	return matchesExpression(&clientContext{
+		ctx:        ctx,
+		config_obj: config_obj,
+		client_id:  client_id,
+		principal:  principal,
+	}, expression)
+}
+
+// Check that the condition tree is well formed so we can reject bad
+// hunts early rather than failing on every client.
+func ValidateHuntCondition(condition *api_proto.HuntCondition) error {
+	if condition == nil {
+		return nil
+	}
+	return validateExpression(condition.Expression)
+}
+
+func validateExpression(expression *api_proto.HuntConditionExpression) error {
+	if expression == nil {
+		return nil
+	}
+
+	for _, sub := range expression.And {
+		err := validateExpression(sub)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, sub := range expression.Or {
+		err := validateExpression(sub)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := validateExpression(expression.Not)
+	if err != nil {
+		return err
+	}
+
+	if expression.HostnameRegex != "" {
+		_, err := getRegex(expression.HostnameRegex)
+		if err != nil {
+			return err
+		}
+	}
+
+	if expression.Vql != "" {
+		_, err := getLambda(expression.Vql)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func hasAnyLabel(client_ctx *clientContext, labels []string) bool {
+	labeler := services.GetLabeler(client_ctx.config_obj)
+	for _, label := range labels {
+		if labeler.IsLabelSet(client_ctx.ctx, client_ctx.config_obj,
+			client_ctx.client_id, label) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesOS(client_ctx *clientContext,
+	os_condition *api_proto.HuntOsCondition) (bool, error) {
+	var expected services.ClientOS
+
+	switch os_condition.Os {
+	case api_proto.HuntOsCondition_WINDOWS:
+		expected = services.Windows
+	case api_proto.HuntOsCondition_LINUX:
+		expected = services.Linux
+	case api_proto.HuntOsCondition_OSX:
+		expected = services.MacOS
+	default:
+		return true, nil
+	}
+
+	client_info, err := client_ctx.getClientInfo()
+	if err != nil {
+		return false, err
+	}
+
+	return client_info.OS() == expected, nil
+}
+
+func matchesExpression(
+	client_ctx *clientContext,
+	expression *api_proto.HuntConditionExpression) (bool, error) {
+	if expression == nil {
+		return true, nil
+	}
+
+	for _, sub := range expression.And {
+		matched, err := matchesExpression(client_ctx, sub)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+
+	if len(expression.Or) > 0 {
+		any_matched := false
+		for _, sub := range expression.Or {
+			matched, err := matchesExpression(client_ctx, sub)
+			if err != nil {
+				return false, err
+			}
+			if matched {
+				any_matched = true
+				break
+			}
+		}
+
+		if !any_matched {
+			return false, nil
+		}
+	}
+
+	if expression.Not != nil {
+		matched, err := matchesExpression(client_ctx, expression.Not)
+		if err != nil || matched {
+			return false, err
+		}
+	}
+
+	if expression.Labels != nil && len(expression.Labels.Label) > 0 &&
+		!hasAnyLabel(client_ctx, expression.Labels.Label) {
+		return false, nil
+	}
+
+	if expression.Os != nil {
+		matched, err := matchesOS(client_ctx, expression.Os)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+
+	if expression.Metadata != nil && expression.Metadata.Key != "" {
+		metadata, err := client_ctx.getMetadata()
+		if err != nil {
+			return false, err
+		}
+
+		value, pres := metadata.Get(expression.Metadata.Key)
+		if !pres {
+			return false, nil
+		}
+
+		if expression.Metadata.Value != "" &&
+			utils.ToString(value) != expression.Metadata.Value {
+			return false, nil
+		}
+	}
+
+	if expression.HostnameRegex != "" {
+		re, err := getRegex(expression.HostnameRegex)
+		if err != nil {
+			return false, err
+		}
+
+		client_info, err := client_ctx.getClientInfo()
+		if err != nil {
+			return false, err
+		}
+
+		if !re.MatchString(client_info.Hostname) &&
+			!re.MatchString(client_info.Fqdn) {
+			return false, nil
+		}
+	}
+
+	if expression.LastSeenSec > 0 {
+		now := uint64(utils.GetTime().Now().UnixNano() / 1000)
+		if client_ctx.getLastSeen()+expression.LastSeenSec*1000000 < now {
+			return false, nil
+		}
+	}
+
+	if expression.Vql != "" {
+		return matchesVQL(client_ctx, expression.Vql)
+	}
+
+	return true, nil
+}
+
+func getRegex(expression string) (*regexp.Regexp, error) {
+	condition_mu.Lock()
+	defer condition_mu.Unlock()
+
+	re, pres := condition_regex[expression]
+	if pres {
+		return re, nil
+	}
+
+	re, err := regexp.Compile("(?i)" + expression)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid hostname regex %v: %w", expression, err)
+	}
+	condition_regex[expression] = re
+	return re, nil
+}
+
+func getLambda(expression string) (*vfilter.Lambda, error) {
+	condition_mu.Lock()
+	defer condition_mu.Unlock()
+
+	lambda, pres := condition_lambda[expression]
+	if pres {
+		return lambda, nil
+	}
+
+	lambda, err := vfilter.ParseLambda("ClientInfo=>" + expression)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid VQL condition %v: %w", expression, err)
+	}
+	condition_lambda[expression] = lambda
+	return lambda, nil
+}
+
+func matchesVQL(client_ctx *clientContext, expression string) (bool, error) {
+	lambda, err := getLambda(expression)
+	if err != nil {
+		return false, err
+	}
+
+	client_info, err := client_ctx.getClientInfo()
+	if err != nil {
+		return false, err
+	}
+
+	metadata, err := client_ctx.getMetadata()
+	if err != nil {
+		metadata = ordereddict.NewDict()
+	}
+
+	labeler := services.GetLabeler(client_ctx.config_obj)
+	labels := labeler.GetClientLabels(client_ctx.ctx,
+		client_ctx.config_obj, client_ctx.client_id)
+
+	manager, err := services.GetRepositoryManager(client_ctx.config_obj)
+	if err != nil {
+		return false, err
+	}
+
+	config_obj := client_ctx.config_obj
+	scope := manager.BuildScope(services.ScopeBuilder{
+		Config:     config_obj,
+		ACLManager: acl_managers.NewServerACLManager(config_obj, client_ctx.principal),
+		Logger: logging.NewPlainLogger(config_obj,
+			&logging.FrontendComponent),
+		Env: ordereddict.NewDict().
+			Set("ClientId", client_ctx.client_id).
+			Set("Hostname", client_info.Hostname).
+			Set("Fqdn", client_info.Fqdn).
+			Set("OS", client_info.System).
+			Set("Labels", labels).
+			Set("Metadata", metadata).
+			Set("LastSeen", time.Unix(0, int64(client_ctx.getLastSeen())*1000)),
+	})
+	defer scope.Close()
+
+	value := lambda.Reduce(client_ctx.ctx, scope,
+		[]vfilter.Any{&client_info.ClientInfo})
+	return scope.Bool(value), nil
+}
@@ -212,6 +212,19 @@ func (self *HuntManager) processMutation(
 				modification = services.HuntFlushToDatastoreAsync
 			}
 
+			if mutation.Stats.TotalExecutionDuration > 0 {
+				hunt_obj.Stats.TotalExecutionDuration +=
+					mutation.Stats.TotalExecutionDuration
+
+				modification = services.HuntFlushToDatastoreAsync
+			}
+
+			if mutation.Stats.TotalCpuTime > 0 {
+				hunt_obj.Stats.TotalCpuTime += mutation.Stats.TotalCpuTime
+
+				modification = services.HuntFlushToDatastoreAsync
+			}
+
 			// These modifications affect the state of the hunt and so
 			// need to propagate to all minions
 			// immediately. Eventually they will also hit the
@@ -228,6 +241,9 @@ func (self *HuntManager) processMutation(
 				hunt_obj.Stats.Stopped = false
 				hunt_obj.State = api_proto.Hunt_RUNNING
 
+				// Starting a paused rollout approves the next stage.
+				approveRolloutStage(hunt_obj)
+
 				// This hunt is now started, let all dispatchers know
 				// to participate connected clients.
 				modification = services.HuntTriggerParticipation
@@ -323,10 +339,12 @@ func (self *HuntManager) ProcessInterrogation(
 
 	return self.participateInAllHunts(ctx, config_obj, client_id,
 		// When a new client is interrogated, it can only really
-		// affect hunts with OS conditions.
+		// affect hunts with OS conditions or condition trees (which
+		// may depend on the hostname, OS etc).
 		func(hunt *api_proto.Hunt) bool {
 			return hunt.Condition != nil &&
-				hunt.Condition.GetOs() != nil
+				(hunt.Condition.GetOs() != nil ||
+					hunt.Condition.Expression != nil)
 		})
 }
 
@@ -389,6 +407,14 @@ func (self *HuntManager) ProcessFlowCompletion(
 		mutation.Stats.TotalClientsWithErrors = 1
 	}
 
+	if flow.ExecutionDuration > 0 {
+		mutation.Stats.TotalExecutionDuration = uint64(flow.ExecutionDuration)
+	}
+
+	if flow.TotalCpuTime > 0 {
+		mutation.Stats.TotalCpuTime = uint64(flow.TotalCpuTime)
+	}
+
 	// The minion hunt dispatcher does not actually care about flow
 	// status, so we dont bother broadcasting a mutation for them. We
 	// only need to update the local hunt dispatcher on the master
@@ -398,6 +424,12 @@ func (self *HuntManager) ProcessFlowCompletion(
 		return err
 	}
 
+	// The new stats may affect the hunt's rollout.
+	err = self.processRollout(ctx, config_obj, hunt_id)
+	if err != nil {
+		return err
+	}
+
 	journal, err := services.GetJournal(config_obj)
 	if err != nil {
 		return err
@@ -434,10 +466,11 @@ func (self *HuntManager) ProcessLabelChange(
 
 	return self.participateInAllHunts(ctx, config_obj, client_id,
 		// When a label changes it can only really affect hunts with
-		// include label conditions.
+		// include label conditions or condition trees.
 		func(hunt *api_proto.Hunt) bool {
 			return hunt.Condition != nil &&
-				hunt.Condition.GetLabels() != nil
+				(hunt.Condition.GetLabels() != nil ||
+					hunt.Condition.Expression != nil)
 		})
 }
 
@@ -522,6 +555,12 @@ func (self *HuntManager) ProcessParticipation(
 		return fmt.Errorf("Hunt %v not known", participation_row.HuntId)
 	}
 
+	// Scheduled hunt templates are never scheduled on clients.
+	if hunt_obj.Schedule != nil {
+		return fmt.Errorf("Hunt %v is a scheduled hunt template",
+			participation_row.HuntId)
+	}
+
 	// The event may override the regular hunt logic.
 	if participation_row.Override {
 		return scheduleHuntOnClient(ctx, config_obj,
@@ -546,6 +585,21 @@ func (self *HuntManager) ProcessParticipation(
 		participation_row.ClientId) {
 		return fmt.Errorf("Hunt %v: hunt label does not match with %v",
 			participation_row.HuntId, participation_row.ClientId)
+
+	} else if hunt_obj.Condition != nil &&
+		hunt_obj.Condition.Expression != nil {
+		matched, err := ClientMatchesExpression(
+			ctx, config_obj, hunt_obj.Condition.Expression,
+			hunt_obj.Creator, participation_row.ClientId)
+		if err != nil {
+			return fmt.Errorf("Hunt %v: evaluating condition on %v: %w",
+				participation_row.HuntId, participation_row.ClientId, err)
+		}
+
+		if !matched {
+			return fmt.Errorf("Hunt %v: %v does not match hunt condition",
+				participation_row.HuntId, participation_row.ClientId)
+		}
 	}
 
 	// Hunt limit exceeded or it expired - we stop it.
@@ -561,6 +615,16 @@ func (self *HuntManager) ProcessParticipation(
 				Stats:  &api_proto.HuntStats{Stopped: true}})
 	}
 
+	// During a staged rollout only schedule up to the stage's
+	// limit. Remaining clients will be offered the hunt again when
+	// the next stage starts.
+	rollout_limit := RolloutClientLimit(hunt_obj)
+	if rollout_limit > 0 &&
+		hunt_obj.Stats.TotalClientsScheduled >= rollout_limit {
+		return fmt.Errorf("Hunt %v: rollout stage %v is full",
+			participation_row.HuntId, hunt_obj.Rollout.CurrentStage)
+	}
+
 	// Control rate of hunt recruitment to balance server load.
 	self.limiter.Wait(ctx)
 
@@ -763,6 +763,328 @@ func (self *HuntTestSuite) TestHuntManagerErrors() {
 	})
 }
 
+// Hunts with a condition tree only schedule on matching clients.
+func (self *HuntTestSuite) TestHuntClientConditionExpression() {
+	t := self.T()
+
+	db, err := datastore.GetDB(self.ConfigObj)
+	assert.NoError(t, err)
+
+	client_info_manager, err := services.GetClientInfoManager(self.ConfigObj)
+	assert.NoError(t, err)
+
+	// A windows finance host and a windows engineering host.
+	client_id_1 := "C.12331"
+	client_id_2 := "C.12332"
+
+	for client_id, department := range map[string]string{
+		client_id_1: "finance",
+		client_id_2: "engineering",
+	} {
+		client_path_manager := paths.NewClientPathManager(client_id)
+		err = db.SetSubject(self.ConfigObj,
+			client_path_manager.Path(), &actions_proto.ClientInfo{
+				ClientId: client_id,
+				System:   "windows",
+				Hostname: "fin-" + client_id,
+			})
+		assert.NoError(t, err)
+
+		err = client_info_manager.SetMetadata(self.Ctx, client_id,
+			ordereddict.NewDict().Set("department", department))
+		assert.NoError(t, err)
+	}
+
+	hunt_obj := &api_proto.Hunt{
+		HuntId:       self.hunt_id,
+		StartRequest: self.expected,
+		State:        api_proto.Hunt_RUNNING,
+		Stats:        &api_proto.HuntStats{},
+		Expires:      uint64(time.Now().Add(7*24*time.Hour).UTC().UnixNano() / 1000),
+		Condition: &api_proto.HuntCondition{
+			Expression: &api_proto.HuntConditionExpression{
+				And: []*api_proto.HuntConditionExpression{{
+					Os: &api_proto.HuntOsCondition{
+						Os: api_proto.HuntOsCondition_WINDOWS,
+					},
+					HostnameRegex: "^fin-",
+				}, {
+					Or: []*api_proto.HuntConditionExpression{{
+						Metadata: &api_proto.HuntMetadataCondition{
+							Key:   "department",
+							Value: "finance",
+						},
+					}, {
+						Vql: "Metadata.department = 'sales'",
+					}},
+				}},
+				Not: &api_proto.HuntConditionExpression{
+					Vql: "OS != 'windows'",
+				},
+			},
+		},
+	}
+
+	acl_manager := acl_managers.NullACLManager{}
+	hunt_dispatcher, err := services.GetHuntDispatcher(self.ConfigObj)
+	assert.NoError(t, err)
+
+	self.hunt_id, err = hunt_dispatcher.CreateHunt(
+		self.Ctx, self.ConfigObj, acl_manager, hunt_obj)
+	assert.NoError(t, err)
+
+	matched, err := hunt_manager.ClientMatchesHuntCondition(
+		self.Ctx, self.ConfigObj, hunt_obj, client_id_1)
+	assert.NoError(t, err)
+	assert.True(t, matched)
+
+	matched, err = hunt_manager.ClientMatchesHuntCondition(
+		self.Ctx, self.ConfigObj, hunt_obj, client_id_2)
+	assert.NoError(t, err)
+	assert.False(t, matched)
+
+	// VQL conditions can see the client's metadata and info.
+	matched, err = hunt_manager.ClientMatchesExpression(
+		self.Ctx, self.ConfigObj, &api_proto.HuntConditionExpression{
+			Vql: "Metadata.department = 'engineering' AND ClientInfo.Hostname =~ '^fin-'",
+		}, "admin", client_id_2)
+	assert.NoError(t, err)
+	assert.True(t, matched)
+
+	// The engineering host is rejected by the hunt manager.
+	err = hunt_manager.HuntManagerForTests.ProcessParticipation(
+		self.Ctx, self.ConfigObj,
+		ordereddict.NewDict().
+			Set("HuntId", self.hunt_id).
+			Set("ClientId", client_id_2))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "does not match hunt condition")
+
+	// The finance host is scheduled.
+	err = hunt_manager.HuntManagerForTests.ProcessParticipation(
+		self.Ctx, self.ConfigObj,
+		ordereddict.NewDict().
+			Set("HuntId", self.hunt_id).
+			Set("ClientId", client_id_1))
+	assert.NoError(t, err)
+
+	_, err = LoadCollectionContext(self.ConfigObj, client_id_1, self.flow_id)
+	assert.NoError(t, err)
+
+	// Invalid conditions are rejected when the hunt is created.
+	hunt_obj.HuntId = ""
+	hunt_obj.Condition.Expression.HostnameRegex = "[invalid"
+	_, err = hunt_dispatcher.CreateHunt(
+		self.Ctx, self.ConfigObj, acl_manager, hunt_obj)
+	assert.Error(t, err)
+}
+
+// Staged rollouts only schedule clients up to the current stage and
+// pause the hunt when the thresholds are exceeded.
+func (self *HuntTestSuite) TestHuntRollout() {
+	t := self.T()
+
+	db, err := datastore.GetDB(self.ConfigObj)
+	assert.NoError(t, err)
+
+	client_id_2 := "C.235"
+	client_path_manager := paths.NewClientPathManager(client_id_2)
+	err = db.SetSubject(self.ConfigObj, client_path_manager.Path(),
+		&actions_proto.ClientInfo{ClientId: client_id_2})
+	assert.NoError(t, err)
+
+	hunt_obj := &api_proto.Hunt{
+		HuntId:       self.hunt_id,
+		StartRequest: self.expected,
+		State:        api_proto.Hunt_RUNNING,
+		Stats:        &api_proto.HuntStats{},
+		Expires:      uint64(time.Now().Add(7*24*time.Hour).UTC().UnixNano() / 1000),
+		Rollout: &api_proto.HuntRollout{
+			Stages: []*api_proto.HuntRolloutStage{
+				{ClientCount: 1, RequireApproval: true},
+				{ClientCount: 2},
+			},
+			MaxErrorRatio: 0.3,
+			MaxAvgCpuSec:  4,
+		},
+	}
+
+	assert.NoError(t, hunt_manager.ValidateHuntRollout(hunt_obj))
+
+	hunt_path_manager := paths.NewHuntPathManager(hunt_obj.HuntId)
+	err = db.SetSubject(self.ConfigObj, hunt_path_manager.Path(), hunt_obj)
+	assert.NoError(t, err)
+
+	dispatcher, err := services.GetHuntDispatcher(self.ConfigObj)
+	assert.NoError(t, err)
+	dispatcher.Refresh(self.ConfigObj)
+
+	journal, err := services.GetJournal(self.ConfigObj)
+	assert.NoError(t, err)
+
+	// The first client is the canary.
+	err = hunt_manager.HuntManagerForTests.ProcessParticipation(
+		self.Ctx, self.ConfigObj,
+		ordereddict.NewDict().
+			Set("HuntId", self.hunt_id).
+			Set("ClientId", self.client_id))
+	assert.NoError(t, err)
+
+	vtesting.WaitUntil(time.Second, t, func() bool {
+		h, _ := dispatcher.GetHunt(hunt_obj.HuntId)
+		return h.Stats.TotalClientsScheduled == 1
+	})
+
+	// The second client is turned away while the first stage runs.
+	err = hunt_manager.HuntManagerForTests.ProcessParticipation(
+		self.Ctx, self.ConfigObj,
+		ordereddict.NewDict().
+			Set("HuntId", self.hunt_id).
+			Set("ClientId", client_id_2))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "rollout stage 0 is full")
+
+	// The canary completes so the hunt waits for approval.
+	err = hunt_manager.HuntManagerForTests.ProcessFlowCompletion(
+		self.Ctx, self.ConfigObj, ordereddict.NewDict().
+			Set("Flow", &flows_proto.ArtifactCollectorContext{
+				ClientId:          self.client_id,
+				State:             flows_proto.ArtifactCollectorContext_FINISHED,
+				ExecutionDuration: int64(time.Second),
+			}).
+			Set("FlowId", self.flow_id).
+			Set("ClientId", self.client_id))
+	assert.NoError(t, err)
+
+	vtesting.WaitUntil(time.Second, t, func() bool {
+		h, _ := dispatcher.GetHunt(hunt_obj.HuntId)
+		return h.State == api_proto.Hunt_STOPPED
+	})
+
+	h, _ := dispatcher.GetHunt(hunt_obj.HuntId)
+	assert.True(t, h.Rollout.AwaitingApproval)
+	assert.Equal(t, uint64(0), h.Rollout.CurrentStage)
+	assert.Equal(t, uint64(time.Second), h.Stats.TotalExecutionDuration)
+
+	// Starting the hunt again approves the next stage.
+	assert.NoError(t, journal.PushRowsToArtifact(self.Ctx, self.ConfigObj,
+		[]*ordereddict.Dict{ordereddict.NewDict().
+			Set("HuntId", hunt_obj.HuntId).
+			Set("mutation", &api_proto.HuntMutation{
+				HuntId: hunt_obj.HuntId,
+				State:  api_proto.Hunt_RUNNING,
+			}),
+		}, "Server.Internal.HuntModification", "", ""))
+
+	vtesting.WaitUntil(time.Second, t, func() bool {
+		h, _ := dispatcher.GetHunt(hunt_obj.HuntId)
+		return h.State == api_proto.Hunt_RUNNING
+	})
+
+	h, _ = dispatcher.GetHunt(hunt_obj.HuntId)
+	assert.False(t, h.Rollout.AwaitingApproval)
+	assert.Equal(t, uint64(1), h.Rollout.CurrentStage)
+
+	err = hunt_manager.HuntManagerForTests.ProcessParticipation(
+		self.Ctx, self.ConfigObj,
+		ordereddict.NewDict().
+			Set("HuntId", self.hunt_id).
+			Set("ClientId", client_id_2))
+	assert.NoError(t, err)
+
+	// The second client fails which exceeds the error ratio so the
+	// hunt is paused. Only flows completed in this stage count.
+	err = hunt_manager.HuntManagerForTests.ProcessFlowCompletion(
+		self.Ctx, self.ConfigObj, ordereddict.NewDict().
+			Set("Flow", &flows_proto.ArtifactCollectorContext{
+				ClientId: client_id_2,
+				State:    flows_proto.ArtifactCollectorContext_ERROR,
+			}).
+			Set("FlowId", "F.5678."+self.hunt_id).
+			Set("ClientId", client_id_2))
+	assert.NoError(t, err)
+
+	vtesting.WaitUntil(time.Second, t, func() bool {
+		h, _ := dispatcher.GetHunt(hunt_obj.HuntId)
+		return h.State == api_proto.Hunt_STOPPED
+	})
+
+	h, _ = dispatcher.GetHunt(hunt_obj.HuntId)
+	assert.Contains(t, h.Rollout.AbortReason,
+		"Error ratio 1.00 exceeds 0.30 (1 of 1 clients)")
+
+	// Resuming the hunt overrides the abort.
+	assert.NoError(t, journal.PushRowsToArtifact(self.Ctx, self.ConfigObj,
+		[]*ordereddict.Dict{ordereddict.NewDict().
+			Set("HuntId", hunt_obj.HuntId).
+			Set("mutation", &api_proto.HuntMutation{
+				HuntId: hunt_obj.HuntId,
+				State:  api_proto.Hunt_RUNNING,
+			}),
+		}, "Server.Internal.HuntModification", "", ""))
+
+	vtesting.WaitUntil(time.Second, t, func() bool {
+		h, _ := dispatcher.GetHunt(hunt_obj.HuntId)
+		return h.State == api_proto.Hunt_RUNNING
+	})
+
+	h, _ = dispatcher.GetHunt(hunt_obj.HuntId)
+	assert.Equal(t, "", h.Rollout.AbortReason)
+
+	// The overall error ratio (1 of 3) still exceeds the threshold
+	// but the earlier error does not abort the hunt again.
+	err = hunt_manager.HuntManagerForTests.ProcessFlowCompletion(
+		self.Ctx, self.ConfigObj, ordereddict.NewDict().
+			Set("Flow", &flows_proto.ArtifactCollectorContext{
+				ClientId:     client_id_2,
+				State:        flows_proto.ArtifactCollectorContext_FINISHED,
+				TotalCpuTime: int64(time.Second),
+			}).
+			Set("FlowId", "F.5679."+self.hunt_id).
+			Set("ClientId", client_id_2))
+	assert.NoError(t, err)
+
+	vtesting.WaitUntil(time.Second, t, func() bool {
+		h, _ := dispatcher.GetHunt(hunt_obj.HuntId)
+		return h.Stats.TotalClientsWithResults == 3
+	})
+
+	h, _ = dispatcher.GetHunt(hunt_obj.HuntId)
+	assert.Equal(t, api_proto.Hunt_RUNNING, h.State)
+	assert.Equal(t, uint64(time.Second), h.Stats.TotalCpuTime)
+
+	// A client using too much CPU pauses the hunt.
+	err = hunt_manager.HuntManagerForTests.ProcessFlowCompletion(
+		self.Ctx, self.ConfigObj, ordereddict.NewDict().
+			Set("Flow", &flows_proto.ArtifactCollectorContext{
+				ClientId:     client_id_2,
+				State:        flows_proto.ArtifactCollectorContext_FINISHED,
+				TotalCpuTime: int64(10 * time.Second),
+			}).
+			Set("FlowId", "F.5680."+self.hunt_id).
+			Set("ClientId", client_id_2))
+	assert.NoError(t, err)
+
+	vtesting.WaitUntil(time.Second, t, func() bool {
+		h, _ := dispatcher.GetHunt(hunt_obj.HuntId)
+		return h.State == api_proto.Hunt_STOPPED
+	})
+
+	h, _ = dispatcher.GetHunt(hunt_obj.HuntId)
+	assert.Contains(t, h.Rollout.AbortReason,
+		"Average CPU time 10s exceeds 4s")
+
+	// Invalid rollouts are rejected.
+	hunt_obj.Rollout.Stages[1].ClientCount = 1
+	assert.Error(t, hunt_manager.ValidateHuntRollout(hunt_obj))
+
+	hunt_obj.Rollout.Stages[1] = &api_proto.HuntRolloutStage{Percentage: 50}
+	assert.Error(t, hunt_manager.ValidateHuntRollout(hunt_obj))
+
+	hunt_obj.ClientLimit = 100
+	assert.NoError(t, hunt_manager.ValidateHuntRollout(hunt_obj))
+}
+
 func TestHuntTestSuite(t *testing.T) {
 	suite.Run(t, &HuntTestSuite{
 		client_id: "C.234",
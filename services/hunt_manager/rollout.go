@@ -0,0 +1,299 @@
+package hunt_manager
+
+// Staged hunt rollouts.
+
+// A hunt with a rollout is first scheduled on a limited number of
+// clients (the first stage). Once enough of these clients complete,
+// the hunt progresses to the next stage - either automatically or,
+// if the stage requires approval, by pausing the hunt until the
+// user starts it again. After the last stage the hunt is scheduled
+// normally.
+
+// As flows complete, the error rate, average execution time and
+// average CPU time are checked against the rollout thresholds. If
+// these are exceeded the hunt is paused automatically and the reason
+// recorded. Thresholds only consider the flows completed in the
+// current stage - when a stage starts, or the hunt is resumed after
+// an abort, the stats are snapshotted so earlier flows do not count
+// again.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	api_proto "www.velocidex.com/golang/velociraptor/api/proto"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	"www.velocidex.com/golang/velociraptor/logging"
+	"www.velocidex.com/golang/velociraptor/services"
+)
+
+const (
+	DEFAULT_ROLLOUT_MIN_COMPLETED_RATIO = 0.9
+)
+
+func ValidateHuntRollout(hunt_obj *api_proto.Hunt) error {
+	rollout := hunt_obj.Rollout
+	if rollout == nil {
+		return nil
+	}
+
+	if len(rollout.Stages) == 0 {
+		return errors.New("Hunt rollout: no stages specified")
+	}
+
+	if rollout.MinCompletedRatio < 0 || rollout.MinCompletedRatio > 1 {
+		return errors.New("Hunt rollout: min_completed_ratio should be between 0 and 1")
+	}
+
+	if rollout.MaxErrorRatio < 0 || rollout.MaxErrorRatio > 1 {
+		return errors.New("Hunt rollout: max_error_ratio should be between 0 and 1")
+	}
+
+	if rollout.MaxAvgCpuSec < 0 {
+		return errors.New("Hunt rollout: max_avg_cpu_sec should be positive")
+	}
+
+	last_target := uint64(0)
+	for idx, stage := range rollout.Stages {
+		if stage.ClientCount > 0 && stage.Percentage > 0 {
+			return fmt.Errorf(
+				"Hunt rollout: stage %v: only one of client_count or percentage may be set", idx)
+		}
+
+		if stage.Percentage < 0 || stage.Percentage > 100 {
+			return fmt.Errorf(
+				"Hunt rollout: stage %v: percentage should be between 0 and 100", idx)
+		}
+
+		if stage.Percentage > 0 && expectedClients(hunt_obj) == 0 {
+			return fmt.Errorf(
+				"Hunt rollout: stage %v: percentage requires expected_clients or a client limit", idx)
+		}
+
+		target := rolloutStageTarget(hunt_obj, uint64(idx))
+		if target == 0 {
+			return fmt.Errorf(
+				"Hunt rollout: stage %v: no clients in stage", idx)
+		}
+
+		if target <= last_target {
+			return fmt.Errorf(
+				"Hunt rollout: stage %v: stages must schedule increasing number of clients", idx)
+		}
+		last_target = target
+	}
+
+	return nil
+}
+
+// Clear the state maintained by the hunt manager (e.g. when a new
+// hunt is created from an old one).
+func ResetHuntRollout(rollout *api_proto.HuntRollout) {
+	if rollout != nil {
+		rollout.CurrentStage = 0
+		rollout.AwaitingApproval = false
+		rollout.AbortReason = ""
+		rollout.StageStartStats = nil
+	}
+}
+
+// Remember the current stats so the thresholds are only evaluated
+// over flows completed from now on.
+func snapshotRolloutStats(hunt_obj *api_proto.Hunt) {
+	stats := hunt_obj.Stats
+	if stats == nil {
+		hunt_obj.Rollout.StageStartStats = nil
+		return
+	}
+
+	hunt_obj.Rollout.StageStartStats = &api_proto.HuntStats{
+		TotalClientsWithResults: stats.TotalClientsWithResults,
+		TotalClientsWithErrors:  stats.TotalClientsWithErrors,
+		TotalExecutionDuration:  stats.TotalExecutionDuration,
+		TotalCpuTime:            stats.TotalCpuTime,
+	}
+}
+
+func expectedClients(hunt_obj *api_proto.Hunt) uint64 {
+	if hunt_obj.Rollout.ExpectedClients > 0 {
+		return hunt_obj.Rollout.ExpectedClients
+	}
+	return hunt_obj.ClientLimit
+}
+
+// The total number of clients scheduled by the end of the stage.
+func rolloutStageTarget(hunt_obj *api_proto.Hunt, stage_idx uint64) uint64 {
+	stage := hunt_obj.Rollout.Stages[stage_idx]
+	if stage.ClientCount > 0 {
+		return stage.ClientCount
+	}
+
+	return uint64(math.Ceil(float64(stage.Percentage) / 100 *
+		float64(expectedClients(hunt_obj))))
+}
+
+// Returns the number of clients the hunt may be scheduled on in its
+// current stage, or 0 if the rollout is complete and the hunt is
+// unrestricted.
+func RolloutClientLimit(hunt_obj *api_proto.Hunt) uint64 {
+	rollout := hunt_obj.Rollout
+	if rollout == nil || rollout.CurrentStage >= uint64(len(rollout.Stages)) {
+		return 0
+	}
+	return rolloutStageTarget(hunt_obj, rollout.CurrentStage)
+}
+
+// Check the rollout thresholds against the flows completed in the
+// current stage. Returns a reason if the hunt should be aborted.
+func checkRolloutThresholds(hunt_obj *api_proto.Hunt) string {
+	rollout := hunt_obj.Rollout
+	stats := hunt_obj.Stats
+	start := rollout.StageStartStats
+	if start == nil {
+		start = &api_proto.HuntStats{}
+	}
+
+	completed := stats.TotalClientsWithResults - start.TotalClientsWithResults
+	errored := stats.TotalClientsWithErrors - start.TotalClientsWithErrors
+	duration := stats.TotalExecutionDuration - start.TotalExecutionDuration
+	cpu_time := stats.TotalCpuTime - start.TotalCpuTime
+	min_completed := rollout.MinCompletedClients
+	if min_completed == 0 {
+		min_completed = 1
+	}
+
+	if completed == 0 || completed < min_completed {
+		return ""
+	}
+
+	if rollout.MaxErrorRatio > 0 {
+		error_ratio := float64(errored) / float64(completed)
+		if error_ratio > float64(rollout.MaxErrorRatio) {
+			return fmt.Sprintf("Error ratio %.2f exceeds %.2f (%v of %v clients)",
+				error_ratio, rollout.MaxErrorRatio, errored, completed)
+		}
+	}
+
+	if rollout.MaxAvgDurationSec > 0 {
+		avg_duration := time.Duration(duration / completed)
+		if avg_duration > time.Duration(rollout.MaxAvgDurationSec)*time.Second {
+			return fmt.Sprintf("Average execution time %v exceeds %vs",
+				avg_duration.Round(time.Second), rollout.MaxAvgDurationSec)
+		}
+	}
+
+	if rollout.MaxAvgCpuSec > 0 {
+		avg_cpu := time.Duration(cpu_time / completed)
+		if avg_cpu.Seconds() > float64(rollout.MaxAvgCpuSec) {
+			return fmt.Sprintf("Average CPU time %v exceeds %vs",
+				avg_cpu.Round(time.Millisecond), rollout.MaxAvgCpuSec)
+		}
+	}
+
+	return ""
+}
+
+// Is the current stage complete?
+func rolloutStageComplete(hunt_obj *api_proto.Hunt) bool {
+	target := RolloutClientLimit(hunt_obj)
+	if target == 0 {
+		return false
+	}
+
+	ratio := float64(hunt_obj.Rollout.MinCompletedRatio)
+	if ratio == 0 {
+		ratio = DEFAULT_ROLLOUT_MIN_COMPLETED_RATIO
+	}
+
+	return hunt_obj.Stats.TotalClientsScheduled >= target &&
+		float64(hunt_obj.Stats.TotalClientsWithResults) >=
+			math.Ceil(ratio*float64(target))
+}
+
+// Called after flow completion to evaluate the rollout of the hunt.
+func (self *HuntManager) processRollout(
+	ctx context.Context, config_obj *config_proto.Config,
+	hunt_id string) error {
+
+	dispatcher, err := services.GetHuntDispatcher(config_obj)
+	if err != nil {
+		return err
+	}
+
+	var abort_reason string
+	var pause bool
+
+	dispatcher.ModifyHuntObject(ctx, hunt_id,
+		func(hunt_obj *api_proto.Hunt) services.HuntModificationAction {
+			rollout := hunt_obj.Rollout
+			if rollout == nil || hunt_obj.Stats == nil ||
+				hunt_obj.State != api_proto.Hunt_RUNNING ||
+				rollout.AwaitingApproval {
+				return services.HuntUnmodified
+			}
+
+			abort_reason = checkRolloutThresholds(hunt_obj)
+			if abort_reason != "" {
+				rollout.AbortReason = abort_reason
+				pause = true
+				return services.HuntPropagateChanges
+			}
+
+			if !rolloutStageComplete(hunt_obj) {
+				return services.HuntUnmodified
+			}
+
+			stage := rollout.Stages[rollout.CurrentStage]
+			if stage.RequireApproval {
+				rollout.AwaitingApproval = true
+				pause = true
+				return services.HuntPropagateChanges
+			}
+
+			// Move to the next stage. Clients which were turned
+			// away in the previous stage need to be offered the
+			// hunt again so bump the start time.
+			rollout.CurrentStage++
+			snapshotRolloutStats(hunt_obj)
+			hunt_obj.StartTime = uint64(time.Now().UnixNano() / 1000)
+			return services.HuntTriggerParticipation
+		})
+
+	if !pause {
+		return nil
+	}
+
+	logger := logging.GetLogger(config_obj, &logging.FrontendComponent)
+	if abort_reason != "" {
+		logger.Info("Hunt %v: rollout aborted: %v", hunt_id, abort_reason)
+	} else {
+		logger.Info("Hunt %v: rollout stage complete, waiting for approval",
+			hunt_id)
+	}
+
+	return dispatcher.MutateHunt(ctx, config_obj,
+		&api_proto.HuntMutation{
+			HuntId: hunt_id,
+			State:  api_proto.Hunt_PAUSED,
+		})
+}
+
+// When a paused hunt is started again, this approves the next stage
+// of the rollout. Starting a hunt after an abort overrides it, so the
+// thresholds are evaluated afresh from this point.
+func approveRolloutStage(hunt_obj *api_proto.Hunt) {
+	rollout := hunt_obj.Rollout
+	if rollout == nil {
+		return
+	}
+
+	if rollout.AwaitingApproval {
+		rollout.AwaitingApproval = false
+		rollout.CurrentStage++
+	}
+	rollout.AbortReason = ""
+	snapshotRolloutStats(hunt_obj)
+}
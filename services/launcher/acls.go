@@ -1,12 +1,15 @@
 package launcher
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-errors/errors"
 	"www.velocidex.com/golang/velociraptor/acls"
 	artifacts_proto "www.velocidex.com/golang/velociraptor/artifacts/proto"
 	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	flows_proto "www.velocidex.com/golang/velociraptor/flows/proto"
+	"www.velocidex.com/golang/velociraptor/services"
 	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
 )
 
@@ -32,3 +35,29 @@ func CheckAccess(
 
 	return nil
 }
+
+// Principals only holding scoped grants must have a grant matching
+// the collection, no matter how the collection was requested.
+func CheckCollectAccess(
+	ctx context.Context,
+	config_obj *config_proto.Config,
+	acl_manager vql_subsystem.ACLManager,
+	collector_request *flows_proto.ArtifactCollectorArgs) error {
+
+	principal_manager, ok := acl_manager.(vql_subsystem.PrincipalACLManager)
+	if !ok {
+		return nil
+	}
+
+	permission := acls.COLLECT_CLIENT
+	if collector_request.ClientId == "server" {
+		permission = acls.COLLECT_SERVER
+	}
+
+	return services.CheckScopedAccess(ctx, config_obj,
+		principal_manager.GetPrincipal(), &acls.Resource{
+			Artifacts: collector_request.Artifacts,
+			ClientId:  collector_request.ClientId,
+			Creator:   collector_request.Creator,
+		}, permission)
+}
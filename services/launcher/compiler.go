@@ -17,6 +17,12 @@ import (
 	"www.velocidex.com/golang/vfilter"
 )
 
+const (
+	// The suppression window in seconds when a source does not set
+	// one.
+	DEFAULT_SUPPRESSION_WINDOW = 60
+)
+
 var (
 	artifact_in_query_regex = regexp.MustCompile(`Artifact\.([^\s\(]+)\(`)
 	escape_regex            = regexp.MustCompile("(^[0-9]|[\"' .-])")
@@ -173,6 +179,9 @@ LET %v <= if(
 		result.OpsPerSecond = artifact.Resources.OpsPerSecond
 		result.CpuLimit = artifact.Resources.CpuLimit
 		result.IopsLimit = artifact.Resources.IopsLimit
+		result.BufferPriority = artifact.Resources.BufferPriority
+		result.MaxBufferBytes = artifact.Resources.MaxBufferBytes
+		result.BufferSampleRate = artifact.Resources.BufferSampleRate
 	}
 
 	err := resolveImports(ctx, config_obj, artifact, result)
@@ -327,15 +336,17 @@ func mergeSources(
 		// TODO: Backwards compatibility for older clients.
 		if precondition != "" {
 			result.Query = append(result.Query, &actions_proto.VQLRequest{
-				Name: name,
+				Name:        name,
+				Suppression: getSuppression(source),
 				VQL: fmt.Sprintf(
 					"SELECT * FROM if(then=%s, condition=%s, else={SELECT * FROM scope() WHERE log(message='Query skipped due to precondition') AND FALSE})",
 					source_result, precondition_var),
 			})
 		} else {
 			result.Query = append(result.Query, &actions_proto.VQLRequest{
-				Name: name,
-				VQL:  "SELECT * FROM " + source_result,
+				Name:        name,
+				Suppression: getSuppression(source),
+				VQL:         "SELECT * FROM " + source_result,
 			})
 		}
 	}
@@ -343,6 +354,27 @@ func mergeSources(
 	return nil
 }
 
+// The client enforces the suppression rules of each event source
+// before queuing the rows. A zero window would never suppress
+// anything so it gets a default.
+func getSuppression(
+	source *artifacts_proto.ArtifactSource) *actions_proto.EventSuppression {
+	if source.Suppression == nil {
+		return nil
+	}
+
+	window := source.Suppression.Window
+	if window == 0 {
+		window = DEFAULT_SUPPRESSION_WINDOW
+	}
+
+	return &actions_proto.EventSuppression{
+		KeyColumns: source.Suppression.KeyColumns,
+		Window:     window,
+		MaxCount:   source.Suppression.MaxCount,
+	}
+}
+
 // Parse the query and determine if it requires any artifacts. If any
 // artifacts are found, then recursivly determine their dependencies
 // etc.
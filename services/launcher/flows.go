@@ -360,6 +360,7 @@ func UpdateFlowStats(collection_context *flows_proto.ArtifactCollectorContext) {
 	// Total execution duration is the sum of all the query durations
 	// (this can be faster than wall time if queries run in parallel)
 	collection_context.ExecutionDuration = 0
+	collection_context.TotalCpuTime = 0
 	collection_context.TotalUploadedBytes = 0
 	collection_context.TotalExpectedUploadedBytes = 0
 	collection_context.TotalUploadedFiles = 0
@@ -376,6 +377,13 @@ func UpdateFlowStats(collection_context *flows_proto.ArtifactCollectorContext) {
 		if s.Duration > collection_context.ExecutionDuration {
 			collection_context.ExecutionDuration = s.Duration
 		}
+
+		// Each query is charged with all the CPU used by the client
+		// process while it ran, so queries running in parallel would
+		// be counted several times. The largest is the best estimate.
+		if s.CpuTime > collection_context.TotalCpuTime {
+			collection_context.TotalCpuTime = s.CpuTime
+		}
 		collection_context.TotalUploadedBytes += uint64(s.UploadedBytes)
 		collection_context.TotalExpectedUploadedBytes += uint64(s.ExpectedUploadedBytes)
 		collection_context.TotalUploadedFiles += uint64(s.UploadedFiles)
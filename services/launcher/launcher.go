@@ -121,6 +121,7 @@ import (
 	"encoding/base32"
 	"encoding/binary"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -198,6 +199,9 @@ func (self *Launcher) CompileCollectorArgs(
 	var max_rows, max_upload_bytes, timeout uint64
 	var ops_per_sec, cpu_limit, iops_limit float32
 
+	// The names of all the artifacts actually collected.
+	var names []string
+
 	for _, spec := range getCollectorSpecs(collector_request) {
 		var artifact *artifacts_proto.Artifact = nil
 
@@ -224,6 +228,7 @@ func (self *Launcher) CompileCollectorArgs(
 		if err != nil {
 			return nil, err
 		}
+		names = append(names, artifact.Name)
 
 		// Adjust collection wide resources to be the maximum
 		// number of all default
@@ -304,6 +309,15 @@ func (self *Launcher) CompileCollectorArgs(
 		collector_request.IopsLimit = iops_limit
 	}
 
+	// Record the exact revisions of the artifacts so the results
+	// can be explained even if the artifacts are modified later.
+	revisions, err := self.getArtifactRevisions(
+		ctx, config_obj, repository, names)
+	if err != nil {
+		return nil, err
+	}
+	collector_request.ArtifactRevisions = revisions
+
 	// Update the total count of requests
 	for idx, item := range result {
 		item.QueryId = int64(idx + 1)
@@ -313,6 +327,34 @@ func (self *Launcher) CompileCollectorArgs(
 	return result, nil
 }
 
+// Get the revisions of the artifacts and all their dependencies.
+func (self *Launcher) getArtifactRevisions(
+	ctx context.Context, config_obj *config_proto.Config,
+	repository services.Repository, names []string) (
+	[]*flows_proto.ArtifactRevisionSpec, error) {
+
+	deps, err := self.GetDependentArtifacts(ctx, config_obj, repository, names)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(deps)
+
+	var result []*flows_proto.ArtifactRevisionSpec
+	for _, name := range deps {
+		artifact, pres := repository.Get(ctx, config_obj, name)
+		if !pres || artifact.Revision == "" {
+			continue
+		}
+
+		result = append(result, &flows_proto.ArtifactRevisionSpec{
+			Artifact: name,
+			Revision: artifact.Revision,
+		})
+	}
+
+	return result, nil
+}
+
 // expandArtifacts converts a user artifact with multiple sources into
 // equivalent single source artifacts. Conversion occurs according to
 // the rules at the top of this file. Each single source artifact will
@@ -509,6 +551,11 @@ func (self *Launcher) ScheduleArtifactCollection(
 	collector_request *flows_proto.ArtifactCollectorArgs,
 	completion func()) (string, error) {
 
+	err := CheckCollectAccess(ctx, config_obj, acl_manager, collector_request)
+	if err != nil {
+		return "", err
+	}
+
 	args := collector_request.CompiledCollectorArgs
 	if args == nil {
 		// Compile and cache the compilation for next time
@@ -15,6 +15,7 @@ import (
 	"github.com/sebdah/goldie"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"www.velocidex.com/golang/velociraptor/acls"
 	acl_proto "www.velocidex.com/golang/velociraptor/acls/proto"
 	"www.velocidex.com/golang/velociraptor/actions"
 	actions_proto "www.velocidex.com/golang/velociraptor/actions/proto"
@@ -27,6 +28,7 @@ import (
 	"www.velocidex.com/golang/velociraptor/paths"
 	"www.velocidex.com/golang/velociraptor/responder"
 	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/services/launcher"
 	"www.velocidex.com/golang/velociraptor/vtesting"
 	"www.velocidex.com/golang/vfilter"
 
@@ -221,6 +223,25 @@ func (self *LauncherTestSuite) TestGetDependentArtifacts() {
 	sort.Strings(res)
 	assert.Equal(self.T(), []string{"Test.Artifact",
 		"Test.Artifact.Deps", "Test.Artifact.Deps2"}, res)
+
+	// Compiling records the revisions of all the dependencies.
+	request := &flows_proto.ArtifactCollectorArgs{
+		ClientId:  "C.1234",
+		Artifacts: []string{"Test.Artifact.Deps2"},
+	}
+	_, err = launcher.CompileCollectorArgs(self.Ctx, self.ConfigObj,
+		acl_managers.NullACLManager{}, repository,
+		services.CompilerOptions{}, request)
+	assert.NoError(self.T(), err)
+
+	var names []string
+	for _, item := range request.ArtifactRevisions {
+		artifact, pres := repository.Get(self.Ctx, self.ConfigObj, item.Artifact)
+		assert.True(self.T(), pres)
+		assert.Equal(self.T(), artifact.Revision, item.Revision)
+		names = append(names, item.Artifact)
+	}
+	assert.Equal(self.T(), res, names)
 }
 
 // https://github.com/Velocidex/velociraptor/issues/1287
@@ -615,6 +636,74 @@ sources:
 	assert.Equal(self.T(), len(compiled[0].Query), 2)
 }
 
+func (self *LauncherTestSuite) TestScopedGrants() {
+	repository := self.LoadArtifacts([]string{`
+name: Test.Triage.Files
+sources:
+- query: SELECT * FROM info()
+`, `
+name: Test.Other
+sources:
+- query: SELECT * FROM info()
+`})
+
+	ctx := context.Background()
+	launcher, err := services.GetLauncher(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	// The helpdesk may only triage clients labelled Helpdesk.
+	err = services.SetPolicy(self.ConfigObj, "UserY",
+		&acl_proto.ApiClientACL{
+			ScopedGrants: []*acl_proto.ScopedGrant{{
+				Permissions: []string{"COLLECT_CLIENT"},
+				Artifacts:   []string{"Test.Triage.*"},
+				Labels:      []string{"Helpdesk"},
+			}},
+		})
+	assert.NoError(self.T(), err)
+
+	schedule := func(artifacts ...string) error {
+		acl_manager := acl_managers.NewServerACLManager(self.ConfigObj, "UserY")
+		_, err := launcher.ScheduleArtifactCollection(
+			ctx, self.ConfigObj, acl_manager, repository,
+			&flows_proto.ArtifactCollectorArgs{
+				Creator:   "UserY",
+				ClientId:  "C.1234",
+				Artifacts: artifacts,
+			}, nil)
+		return err
+	}
+
+	// Not labelled yet.
+	err = schedule("Test.Triage.Files")
+	assert.Error(self.T(), err)
+	assert.Contains(self.T(), err.Error(), "Permission denied")
+
+	labeler := services.GetLabeler(self.ConfigObj)
+	err = labeler.SetClientLabel(ctx, self.ConfigObj, "C.1234", "Helpdesk")
+	assert.NoError(self.T(), err)
+
+	assert.NoError(self.T(), schedule("Test.Triage.Files"))
+
+	// Other artifacts are still denied.
+	assert.Error(self.T(), schedule("Test.Triage.Files", "Test.Other"))
+
+	// The plain permission does not require a matching scope.
+	ok, err := services.CheckAccess(self.ConfigObj, "UserY", acls.COLLECT_CLIENT)
+	assert.NoError(self.T(), err)
+	assert.False(self.T(), ok)
+
+	err = services.SetPolicy(self.ConfigObj, "UserY",
+		&acl_proto.ApiClientACL{CollectClient: true})
+	assert.NoError(self.T(), err)
+	assert.NoError(self.T(), schedule("Test.Other"))
+
+	// Principals without a policy are denied rather than allowed.
+	err = services.CheckScopedAccess(ctx, self.ConfigObj, "UnknownUser",
+		&acls.Resource{ClientId: "C.1234"}, acls.COLLECT_CLIENT)
+	assert.Error(self.T(), err)
+}
+
 func (self *LauncherTestSuite) TestParameterTypes() {
 	repository := self.LoadArtifacts(testArtifactWithTypes)
 
@@ -1151,6 +1240,85 @@ sources:
 	assert.Equal(self.T(), request.MaxRows, uint64(100))
 }
 
+func (self *LauncherTestSuite) TestArtifactSuppression() {
+	manager, _ := services.GetRepositoryManager(self.ConfigObj)
+	repository := manager.NewRepository()
+
+	_, err := repository.LoadYaml(`
+name: Test.Artifact.Suppression
+type: CLIENT_EVENT
+sources:
+- name: Suppressed
+  query: SELECT * FROM scope()
+  suppression:
+    key_columns:
+    - Name
+    - CommandLine
+    window: 60
+    max_count: 2
+- name: DefaultWindow
+  query: SELECT * FROM scope()
+  suppression:
+    key_columns:
+    - Name
+- name: NotSuppressed
+  query: SELECT * FROM scope()
+`, services.ValidateArtifact, services.ArtifactIsBuiltIn)
+	assert.NoError(self.T(), err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	launcher, err := services.GetLauncher(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	compiled, err := launcher.CompileCollectorArgs(
+		ctx, self.ConfigObj, acl_managers.NullACLManager{}, repository,
+		services.CompilerOptions{}, &flows_proto.ArtifactCollectorArgs{
+			Artifacts: []string{"Test.Artifact.Suppression"},
+		})
+	assert.NoError(self.T(), err)
+
+	// Each source query carries its own suppression spec.
+	suppression := make(map[string]*actions_proto.EventSuppression)
+	for _, request := range compiled {
+		for _, query := range request.Query {
+			if query.Name != "" {
+				suppression[query.Name] = query.Suppression
+			}
+		}
+	}
+
+	assert.Equal(self.T(), 3, len(suppression))
+	assert.Nil(self.T(),
+		suppression["Test.Artifact.Suppression/NotSuppressed"])
+
+	spec := suppression["Test.Artifact.Suppression/Suppressed"]
+	assert.NotNil(self.T(), spec)
+	assert.Equal(self.T(), []string{"Name", "CommandLine"}, spec.KeyColumns)
+	assert.Equal(self.T(), uint64(60), spec.Window)
+	assert.Equal(self.T(), uint64(2), spec.MaxCount)
+
+	// A zero window would never suppress anything.
+	spec = suppression["Test.Artifact.Suppression/DefaultWindow"]
+	assert.Equal(self.T(), uint64(60), spec.Window)
+}
+
+// Queries running in parallel are each charged with the process CPU
+// time so the collection uses the largest rather than the sum.
+func TestUpdateFlowStatsCpuTime(t *testing.T) {
+	collection_context := &flows_proto.ArtifactCollectorContext{
+		QueryStats: []*crypto_proto.VeloStatus{
+			{Status: crypto_proto.VeloStatus_OK, CpuTime: 3},
+			{Status: crypto_proto.VeloStatus_OK, CpuTime: 5},
+			{Status: crypto_proto.VeloStatus_OK, CpuTime: 4},
+		},
+	}
+
+	launcher.UpdateFlowStats(collection_context)
+	assert.Equal(t, int64(5), collection_context.TotalCpuTime)
+}
+
 func getReqName(in *actions_proto.VQLCollectorArgs) string {
 	for _, query := range in.Query {
 		if query.Name != "" {
@@ -59,6 +59,7 @@ type ServiceContainer interface {
 	ServerEventManager() (ServerEventManager, error)
 	Notifier() (Notifier, error)
 	ACLManager() (ACLManager, error)
+	SigmaService() (SigmaService, error)
 }
 
 // The org manager manages multi-tenancies.
@@ -31,8 +31,10 @@ import (
 	"www.velocidex.com/golang/velociraptor/services/sanity"
 	"www.velocidex.com/golang/velociraptor/services/server_artifacts"
 	"www.velocidex.com/golang/velociraptor/services/server_monitoring"
+	"www.velocidex.com/golang/velociraptor/services/sigma"
 	"www.velocidex.com/golang/velociraptor/services/users"
 	"www.velocidex.com/golang/velociraptor/services/vfs_service"
+	"www.velocidex.com/golang/velociraptor/services/webhooks"
 	"www.velocidex.com/golang/velociraptor/utils"
 )
 
@@ -55,6 +57,7 @@ type ServiceContainer struct {
 	server_event_manager services.ServerEventManager
 	notifier             services.Notifier
 	acl_manager          services.ACLManager
+	sigma                services.SigmaService
 }
 
 func (self *ServiceContainer) MockFrontendManager(svc services.FrontendManager) {
@@ -224,6 +227,17 @@ func (self *ServiceContainer) BroadcastService() (services.BroadcastService, err
 	return self.broadcast, nil
 }
 
+func (self *ServiceContainer) SigmaService() (services.SigmaService, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	if self.sigma == nil {
+		return nil, errors.New("Sigma service not initialized")
+	}
+
+	return self.sigma, nil
+}
+
 func (self *ServiceContainer) ACLManager() (services.ACLManager, error) {
 	self.mu.Lock()
 	defer self.mu.Unlock()
@@ -588,6 +602,24 @@ func (self *OrgManager) startOrgFromContext(org_ctx *OrgContext) (err error) {
 		service_container.mu.Unlock()
 	}
 
+	if spec.WebhookService {
+		err = webhooks.NewWebhookService(ctx, wg, org_config)
+		if err != nil {
+			return err
+		}
+	}
+
+	if spec.SigmaService {
+		sigma_service, err := sigma.NewSigmaService(ctx, wg, org_config)
+		if err != nil {
+			return err
+		}
+
+		service_container.mu.Lock()
+		service_container.sigma = sigma_service
+		service_container.mu.Unlock()
+	}
+
 	// Must be run after all the other services are up
 	if spec.SanityChecker {
 		err = sanity.NewSanityCheckService(ctx, wg, org_config)
@@ -142,6 +142,22 @@ type RepositoryManager interface {
 	// Delete the file from the global repository and the data store.
 	DeleteArtifactFile(ctx context.Context,
 		config_obj *config_proto.Config, principal, name string) error
+
+	// List the revision history of the artifact, most recent first.
+	ListArtifactRevisions(ctx context.Context,
+		config_obj *config_proto.Config,
+		name string) ([]*artifacts_proto.ArtifactRevision, error)
+
+	// Get a revision of the artifact (possibly by a prefix of the
+	// revision hash).
+	GetArtifactRevision(ctx context.Context,
+		config_obj *config_proto.Config,
+		name, revision string) (*artifacts_proto.ArtifactRevision, error)
+
+	// Make an old revision of the artifact current again.
+	RestoreArtifactRevision(ctx context.Context,
+		config_obj *config_proto.Config,
+		principal, name, revision string) (*artifacts_proto.Artifact, error)
 }
 
 type MockablePlugin interface {
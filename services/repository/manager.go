@@ -137,6 +137,14 @@ func (self *RepositoryManager) SetArtifactFile(
 	ctx context.Context, config_obj *config_proto.Config,
 	principal, definition, required_prefix string) (
 	*artifacts_proto.Artifact, error) {
+	return self.setArtifactFile(ctx, config_obj, principal,
+		definition, required_prefix, "set")
+}
+
+func (self *RepositoryManager) setArtifactFile(
+	ctx context.Context, config_obj *config_proto.Config,
+	principal, definition, required_prefix, op string) (
+	*artifacts_proto.Artifact, error) {
 
 	// Use regexes to force the artifact into the correct prefix.
 	if required_prefix != "" {
@@ -165,6 +173,9 @@ func (self *RepositoryManager) SetArtifactFile(
 		return nil, err
 	}
 
+	// The definition we are replacing (if any).
+	parent, _ := global_repository.Get(ctx, config_obj, artifact_definition.Name)
+
 	// Load the artifact into the currently running repository.
 	artifact, err := global_repository.LoadYaml(
 		definition, services.ValidateArtifact, !services.ArtifactIsBuiltIn)
@@ -196,6 +207,13 @@ func (self *RepositoryManager) SetArtifactFile(
 		}
 	}
 
+	// Keep the previous revisions in the history.
+	err = self.recordArtifactChange(config_obj, principal, op,
+		artifact.Name, definition, parent)
+	if err != nil {
+		return nil, err
+	}
+
 	// Tell interested parties that we modified this artifact.
 	journal, err := services.GetJournal(config_obj)
 	if err != nil {
@@ -209,6 +227,7 @@ func (self *RepositoryManager) SetArtifactFile(
 				Set("artifact", artifact.Name).
 				Set("op", "set").
 				Set("definition", definition).
+				Set("revision", artifact.Revision).
 				Set("id", self.id),
 		}, "Server.Internal.ArtifactModification", "server", "")
 
@@ -229,11 +248,17 @@ func (self *RepositoryManager) DeleteArtifactFile(
 	}
 
 	// If not there nothing to do...
-	_, pres := global_repository.Get(ctx, config_obj, name)
+	parent, pres := global_repository.Get(ctx, config_obj, name)
 	if !pres {
 		return nil
 	}
 
+	err = self.recordArtifactChange(config_obj, principal, "delete",
+		name, "", parent)
+	if err != nil {
+		return err
+	}
+
 	// Remove the artifact from the repository.
 	global_repository.Del(name)
 
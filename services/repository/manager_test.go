@@ -226,6 +226,79 @@ built_in: true
 	assert.NoError(self.T(), err)
 }
 
+func (self *ManagerTestSuite) TestArtifactRevisions() {
+	manager, err := services.GetRepositoryManager(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	first, err := manager.SetArtifactFile(self.Ctx, self.ConfigObj, "User1", `
+name: Custom.Revisions
+sources:
+- query: SELECT * FROM info()
+`, "")
+	assert.NoError(self.T(), err)
+	assert.NotEmpty(self.T(), first.Revision)
+
+	second, err := manager.SetArtifactFile(self.Ctx, self.ConfigObj, "User2", `
+name: Custom.Revisions
+sources:
+- query: SELECT * FROM pslist()
+`, "")
+	assert.NoError(self.T(), err)
+	assert.NotEqual(self.T(), first.Revision, second.Revision)
+
+	revisions, err := manager.ListArtifactRevisions(
+		self.Ctx, self.ConfigObj, "Custom.Revisions")
+	assert.NoError(self.T(), err)
+	assert.Equal(self.T(), 2, len(revisions))
+
+	// Most recent first.
+	assert.Equal(self.T(), second.Revision, revisions[0].Revision)
+	assert.Equal(self.T(), first.Revision, revisions[0].ParentRevision)
+	assert.Equal(self.T(), "User2", revisions[0].Author)
+	assert.Equal(self.T(), "set", revisions[0].Op)
+	assert.Equal(self.T(), `@@ -1,4 +1,4 @@
+ 
+ name: Custom.Revisions
+ sources:
+-- query: SELECT * FROM info()
++- query: SELECT * FROM pslist()
+`, revisions[0].Diff)
+
+	assert.Equal(self.T(), first.Revision, revisions[1].Revision)
+	assert.Equal(self.T(), "", revisions[1].ParentRevision)
+
+	// Restore the first revision by a prefix of its hash.
+	restored, err := manager.RestoreArtifactRevision(self.Ctx, self.ConfigObj,
+		"User3", "Custom.Revisions", first.Revision[:8])
+	assert.NoError(self.T(), err)
+	assert.Equal(self.T(), first.Revision, restored.Revision)
+
+	repository, err := manager.GetGlobalRepository(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	artifact, pres := repository.Get(self.Ctx, self.ConfigObj, "Custom.Revisions")
+	assert.True(self.T(), pres)
+	assert.Contains(self.T(), artifact.Sources[0].Query, "info()")
+
+	// Deleting is also recorded.
+	err = manager.DeleteArtifactFile(self.Ctx, self.ConfigObj,
+		"User4", "Custom.Revisions")
+	assert.NoError(self.T(), err)
+
+	revisions, err = manager.ListArtifactRevisions(
+		self.Ctx, self.ConfigObj, "Custom.Revisions")
+	assert.NoError(self.T(), err)
+	assert.Equal(self.T(), 4, len(revisions))
+	assert.Equal(self.T(), "delete", revisions[0].Op)
+	assert.Equal(self.T(), first.Revision, revisions[0].ParentRevision)
+	assert.Equal(self.T(), "restore", revisions[1].Op)
+	assert.Equal(self.T(), "User3", revisions[1].Author)
+
+	_, err = manager.GetArtifactRevision(
+		self.Ctx, self.ConfigObj, "Custom.Revisions", "nosuchrevision")
+	assert.Error(self.T(), err)
+}
+
 func TestManager(t *testing.T) {
 	suite.Run(t, &ManagerTestSuite{})
 }
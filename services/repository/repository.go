@@ -185,6 +185,10 @@ func (self *Repository) LoadProto(artifact *artifacts_proto.Artifact, validate b
 	// Make a copy of the artifact to store in the repository.
 	artifact = proto.Clone(artifact).(*artifacts_proto.Artifact)
 
+	if artifact.Raw != "" {
+		artifact.Revision = getArtifactRevision(artifact.Raw)
+	}
+
 	if !artifactNameRegex.MatchString(artifact.Name) {
 		return nil, errors.New(
 			"Invalid artifact name. Can only contain characted in this set 'a-zA-Z0-9_.'")
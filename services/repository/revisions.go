@@ -0,0 +1,281 @@
+package repository
+
+// Artifact revision history.
+
+// Every time a custom artifact is set, restored or deleted an
+// immutable revision is appended to the artifact's history. The
+// history is stored in a result set in the filestore. Each revision
+// is identified by the hash of its definition (which is also stored
+// in the Artifact.Revision field when the artifact is loaded) so
+// collections can record exactly which definition they were compiled
+// from.
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+	artifacts_proto "www.velocidex.com/golang/velociraptor/artifacts/proto"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	"www.velocidex.com/golang/velociraptor/file_store"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/paths"
+	"www.velocidex.com/golang/velociraptor/result_sets"
+	"www.velocidex.com/golang/velociraptor/utils"
+)
+
+const (
+	// Number of context lines around each change in the diff.
+	DIFF_CONTEXT_LINES = 3
+)
+
+// The revision of an artifact is the hash of its definition.
+func getArtifactRevision(definition string) string {
+	hash := sha256.Sum256([]byte(definition))
+	return hex.EncodeToString(hash[:])
+}
+
+// Append a revision to the artifact's history.
+func (self *RepositoryManager) addArtifactRevision(
+	config_obj *config_proto.Config,
+	revision *artifacts_proto.ArtifactRevision) error {
+	file_store_factory := file_store.GetFileStore(config_obj)
+	if file_store_factory == nil {
+		return nil
+	}
+
+	writer, err := result_sets.NewResultSetWriter(file_store_factory,
+		paths.GetArtifactRevisionsPath(revision.Name),
+		json.DefaultEncOpts(), utils.SyncCompleter, result_sets.AppendMode)
+	if err != nil {
+		return err
+	}
+	defer writer.Close()
+
+	writer.Write(json.ConvertProtoToOrderedDict(revision))
+	return nil
+}
+
+// Record a change to the artifact. The parent is the definition
+// which was replaced (or deleted) or nil for a new artifact.
+func (self *RepositoryManager) recordArtifactChange(
+	config_obj *config_proto.Config,
+	principal, op, name, definition string,
+	parent *artifacts_proto.Artifact) error {
+
+	revision := &artifacts_proto.ArtifactRevision{
+		Name:       name,
+		Author:     principal,
+		Timestamp:  uint64(utils.GetTime().Now().UnixNano() / 1000),
+		Op:         op,
+		Definition: definition,
+	}
+
+	if definition != "" {
+		revision.Revision = getArtifactRevision(definition)
+	}
+
+	parent_definition := ""
+	if parent != nil {
+		revision.ParentRevision = parent.Revision
+		parent_definition = parent.Raw
+	}
+	revision.Diff = diffDefinitions(parent_definition, definition)
+
+	return self.addArtifactRevision(config_obj, revision)
+}
+
+// List all the revisions of the artifact, most recent first.
+func (self *RepositoryManager) ListArtifactRevisions(
+	ctx context.Context, config_obj *config_proto.Config,
+	name string) ([]*artifacts_proto.ArtifactRevision, error) {
+	result := []*artifacts_proto.ArtifactRevision{}
+
+	file_store_factory := file_store.GetFileStore(config_obj)
+	if file_store_factory == nil {
+		return result, nil
+	}
+
+	reader, err := result_sets.NewResultSetReader(file_store_factory,
+		paths.GetArtifactRevisionsPath(name))
+	if err != nil {
+		// No history recorded yet.
+		return result, nil
+	}
+	defer reader.Close()
+
+	for row := range reader.Rows(ctx) {
+		serialized, err := json.Marshal(row)
+		if err != nil {
+			return nil, err
+		}
+
+		revision := &artifacts_proto.ArtifactRevision{}
+		err = json.Unmarshal(serialized, revision)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, revision)
+	}
+
+	// Reverse the order so the most recent revision is first.
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+
+	return result, nil
+}
+
+// Get a specific revision of the artifact. The revision may be
+// specified by a unique prefix of its hash.
+func (self *RepositoryManager) GetArtifactRevision(
+	ctx context.Context, config_obj *config_proto.Config,
+	name, revision string) (*artifacts_proto.ArtifactRevision, error) {
+	if revision == "" {
+		return nil, fmt.Errorf("Artifact %v: no revision specified", name)
+	}
+
+	revisions, err := self.ListArtifactRevisions(ctx, config_obj, name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range revisions {
+		if item.Revision != "" && strings.HasPrefix(item.Revision, revision) {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Artifact %v: revision %v not found",
+		name, revision)
+}
+
+// Restore an old revision of the artifact. This records a new
+// revision in the history with the old definition.
+func (self *RepositoryManager) RestoreArtifactRevision(
+	ctx context.Context, config_obj *config_proto.Config,
+	principal, name, revision string) (*artifacts_proto.Artifact, error) {
+
+	old_revision, err := self.GetArtifactRevision(
+		ctx, config_obj, name, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	return self.setArtifactFile(ctx, config_obj, principal,
+		old_revision.Definition, "", "restore")
+}
+
+// Produce a unified style line diff between the two definitions.
+func diffDefinitions(old, new string) string {
+	if old == new {
+		return ""
+	}
+
+	// Map each unique line to a rune so we can diff lines rather
+	// than characters.
+	line_runes := make(map[string]rune)
+	var lines []string
+	to_runes := func(text string) []rune {
+		var result []rune
+		for _, line := range strings.Split(strings.TrimSuffix(text, "\n"), "\n") {
+			r, pres := line_runes[line]
+			if !pres {
+				r = rune(0x10000 + len(lines))
+				line_runes[line] = r
+				lines = append(lines, line)
+			}
+			result = append(result, r)
+		}
+		return result
+	}
+
+	old_runes := to_runes(old)
+	new_runes := to_runes(new)
+	if old == "" {
+		old_runes = nil
+	}
+	if new == "" {
+		new_runes = nil
+	}
+
+	// Flatten the diff into individual lines.
+	type diffLine struct {
+		op   diffmatchpatch.Operation
+		text string
+	}
+
+	var all_lines []diffLine
+	dmp := diffmatchpatch.New()
+	for _, diff := range dmp.DiffMainRunes(old_runes, new_runes, false) {
+		for _, r := range diff.Text {
+			all_lines = append(all_lines, diffLine{
+				op: diff.Type, text: lines[r-0x10000]})
+		}
+	}
+
+	// Group the changes into hunks with some context around them.
+	result := &strings.Builder{}
+	old_line, new_line := 1, 1
+	for idx := 0; idx < len(all_lines); {
+		if all_lines[idx].op == diffmatchpatch.DiffEqual {
+			old_line++
+			new_line++
+			idx++
+			continue
+		}
+
+		// Found a change - back up to include the context.
+		start := idx - DIFF_CONTEXT_LINES
+		if start < 0 {
+			start = 0
+		}
+		hunk_old, hunk_new := old_line-(idx-start), new_line-(idx-start)
+
+		// Extend the hunk until we see enough unchanged lines.
+		end, equal := idx, 0
+		for end < len(all_lines) && equal <= 2*DIFF_CONTEXT_LINES {
+			if all_lines[end].op == diffmatchpatch.DiffEqual {
+				equal++
+			} else {
+				equal = 0
+			}
+			end++
+		}
+		if equal > DIFF_CONTEXT_LINES {
+			end -= equal - DIFF_CONTEXT_LINES
+		}
+
+		old_count, new_count := 0, 0
+		hunk := &strings.Builder{}
+		for _, line := range all_lines[start:end] {
+			switch line.op {
+			case diffmatchpatch.DiffEqual:
+				hunk.WriteString(" ")
+				old_count++
+				new_count++
+			case diffmatchpatch.DiffDelete:
+				hunk.WriteString("-")
+				old_count++
+			case diffmatchpatch.DiffInsert:
+				hunk.WriteString("+")
+				new_count++
+			}
+			hunk.WriteString(line.text + "\n")
+		}
+
+		fmt.Fprintf(result, "@@ -%d,%d +%d,%d @@\n",
+			hunk_old, old_count, hunk_new, new_count)
+		result.WriteString(hunk.String())
+
+		// Line numbers after the hunk.
+		old_line = hunk_old + old_count
+		new_line = hunk_new + new_count
+		idx = end
+	}
+
+	return result.String()
+}
@@ -0,0 +1,673 @@
+package repository
+
+// Static verification of artifacts.
+
+// Artifacts are only compiled when they are collected so many
+// problems are only discovered on the endpoint. The verifier inspects
+// artifact definitions offline and reports:
+
+// 1. References to artifacts which do not exist (either via imports
+//    or Artifact.Name() calls).
+// 2. Cycles in the artifact dependency graph.
+// 3. Calls to unknown VQL plugins or functions and unknown accessors.
+// 4. Tools used via ToolName= which are not declared by the artifact.
+// 5. Parameters with unknown types, defaults which do not match the
+//    declared type and parameters which are never used.
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Velocidex/yaml/v2"
+	"www.velocidex.com/golang/velociraptor/accessors"
+	api_proto "www.velocidex.com/golang/velociraptor/api/proto"
+	"www.velocidex.com/golang/velociraptor/artifacts/assets"
+	artifacts_proto "www.velocidex.com/golang/velociraptor/artifacts/proto"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/services/launcher"
+	"www.velocidex.com/golang/velociraptor/utils"
+	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
+	"www.velocidex.com/golang/vfilter"
+	"www.velocidex.com/golang/vfilter/types"
+)
+
+const (
+	SEVERITY_ERROR   = "error"
+	SEVERITY_WARNING = "warning"
+)
+
+var (
+	scope_call_regex = regexp.MustCompile(`\bscope\(\)|\bget\(\s*member\s*=`)
+
+	// These match the reformatted query (see vqlReferences).
+	string_regex      = regexp.MustCompile(`(?s)'''.*?'''|'(?:[^'\\]|\\.)*'|"(?:[^"\\]|\\.)*"`)
+	literal_arg_regex = regexp.MustCompile(`\b([A-Za-z_]\w*)\s*=\s*(?:'([^']*)'|"([^"]*)")\s*[,)]`)
+	let_regex         = regexp.MustCompile(`(?i)\bLET\s+([A-Za-z_]\w*)\s*(?:\(([^)]*)\))?`)
+	call_regex        = regexp.MustCompile(`(?i)(?:\b(FROM|LET)\s+)?\b([A-Za-z_][\w.]*)\s*\(`)
+
+	// Keywords which may be followed by a parenthesis.
+	vql_keywords = []string{
+		"SELECT", "FROM", "WHERE", "AND", "OR", "NOT", "IN", "AS",
+		"LET", "BY", "LIMIT", "EXPLAIN",
+	}
+
+	// Accessors that are only registered on some platforms or only
+	// on the server so may not be present in this binary.
+	platform_accessors = []string{
+		"reg", "registry", "ntfs", "lazy_ntfs", "file_links", "process",
+		"fs",
+	}
+
+	known_parameter_types = []string{
+		"", "string", "str", "regex", "yara", "upload", "preview_upload",
+		"int", "int64", "integer", "float", "timestamp", "starlark",
+		"csv", "artifactset", "json", "json_array", "regex_array",
+		"xml", "yaml", "bool", "hidden", "choices", "multichoice",
+		"server_metadata", "redacted", "client_id", "hash", "glob",
+		"hex", "base", "tree",
+	}
+)
+
+// An issue found while verifying an artifact.
+type ArtifactIssue struct {
+	Artifact string `json:"Artifact"`
+	File     string `json:"File,omitempty"`
+	Severity string `json:"Severity"`
+	Type     string `json:"Type"`
+	Message  string `json:"Message"`
+}
+
+type ArtifactVerifier struct {
+	config_obj *config_proto.Config
+	repository services.Repository
+
+	known_plugins   map[string]bool
+	known_functions map[string]bool
+	known_accessors map[string]bool
+
+	// Map artifact name to the file it was loaded from.
+	files map[string]string
+
+	issues []*ArtifactIssue
+}
+
+// Create a new verifier. Artifacts loaded into the verifier are
+// added to a copy of the repository so the repository itself is
+// not modified.
+func NewArtifactVerifier(
+	config_obj *config_proto.Config,
+	repository services.Repository) *ArtifactVerifier {
+	result := &ArtifactVerifier{
+		config_obj:      config_obj,
+		repository:      repository.Copy(),
+		known_plugins:   make(map[string]bool),
+		known_functions: make(map[string]bool),
+		known_accessors: make(map[string]bool),
+		files:           make(map[string]string),
+	}
+
+	result.loadVQLDescriptions()
+
+	for _, name := range accessors.DescribeAccessors().Keys() {
+		result.known_accessors[name] = true
+	}
+	for _, name := range platform_accessors {
+		result.known_accessors[name] = true
+	}
+
+	return result
+}
+
+// Plugins and functions may be compiled into the binary only for
+// some platforms. The reference documentation contains all of them
+// so we use it in addition to the ones actually registered.
+func (self *ArtifactVerifier) loadVQLDescriptions() {
+	assets.Init()
+
+	data, err := assets.ReadFile("docs/references/vql.yaml")
+	if err == nil {
+		descriptions := []*api_proto.Completion{}
+		err = yaml.Unmarshal(data, &descriptions)
+		if err == nil {
+			for _, item := range descriptions {
+				switch item.Type {
+				case "Plugin":
+					self.known_plugins[item.Name] = true
+				case "Function":
+					self.known_functions[item.Name] = true
+				}
+			}
+		}
+	}
+
+	scope := vql_subsystem.MakeScope()
+	defer scope.Close()
+
+	info := scope.Describe(types.NewTypeMap())
+	for _, item := range info.Plugins {
+		self.known_plugins[item.Name] = true
+	}
+	for _, item := range info.Functions {
+		self.known_functions[item.Name] = true
+	}
+}
+
+func (self *ArtifactVerifier) addIssue(
+	artifact, severity, issue_type, format string, args ...interface{}) {
+	self.issues = append(self.issues, &ArtifactIssue{
+		Artifact: artifact,
+		File:     self.files[artifact],
+		Severity: severity,
+		Type:     issue_type,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// Load an artifact definition. Returns the name of the loaded
+// artifact.
+func (self *ArtifactVerifier) LoadYaml(definition, filename string) (string, error) {
+	artifact, err := self.repository.LoadYaml(definition,
+		services.ValidateArtifact, !services.ArtifactIsBuiltIn)
+	if err != nil {
+		self.issues = append(self.issues, &ArtifactIssue{
+			File:     filename,
+			Severity: SEVERITY_ERROR,
+			Type:     "load_error",
+			Message:  err.Error(),
+		})
+		return "", err
+	}
+
+	if filename != "" {
+		self.files[artifact.Name] = filename
+	}
+	return artifact.Name, nil
+}
+
+// Load all the YAML files in the path (file or directory). Returns
+// the names of the loaded artifacts.
+func (self *ArtifactVerifier) LoadPath(path string) ([]string, error) {
+	var result []string
+
+	err := filepath.Walk(path,
+		func(file_path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if info.IsDir() || !(strings.HasSuffix(info.Name(), ".yaml") ||
+				strings.HasSuffix(info.Name(), ".yml")) {
+				return nil
+			}
+
+			data, err := ioutil.ReadFile(file_path)
+			if err != nil {
+				return err
+			}
+
+			name, err := self.LoadYaml(string(data), file_path)
+			if err == nil {
+				result = append(result, name)
+			}
+			return nil
+		})
+
+	return result, err
+}
+
+// Verify the named artifacts. If names is nil, all artifacts in the
+// repository are verified. Returns all issues found,
+// including any issues found while loading artifacts.
+func (self *ArtifactVerifier) Verify(
+	ctx context.Context, names []string) ([]*ArtifactIssue, error) {
+
+	if names == nil {
+		all, err := self.repository.List(ctx, self.config_obj)
+		if err != nil {
+			return nil, err
+		}
+		names = all
+	}
+
+	all_tools, err := self.getAllTools(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range names {
+		artifact, pres := self.repository.Get(ctx, self.config_obj, name)
+		if !pres {
+			self.addIssue(name, SEVERITY_ERROR, "missing_artifact",
+				"Artifact %v not found", name)
+			continue
+		}
+
+		self.verifyArtifact(ctx, artifact, all_tools)
+	}
+
+	self.findCycles(ctx, names)
+
+	return self.issues, nil
+}
+
+// All the tools declared by any artifact in the repository.
+func (self *ArtifactVerifier) getAllTools(
+	ctx context.Context) (map[string]bool, error) {
+	result := make(map[string]bool)
+
+	names, err := self.repository.List(ctx, self.config_obj)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range names {
+		artifact, pres := self.repository.Get(ctx, self.config_obj, name)
+		if !pres {
+			continue
+		}
+		for _, tool := range artifact.Tools {
+			result[tool.Name] = true
+		}
+	}
+
+	return result, nil
+}
+
+func (self *ArtifactVerifier) verifyArtifact(
+	ctx context.Context, artifact *artifacts_proto.Artifact,
+	all_tools map[string]bool) {
+
+	name := artifact.Name
+	refs := newVQLReferences()
+	queries := getArtifactQueries(artifact)
+
+	for _, query := range queries {
+		err := refs.parse(query)
+		if err != nil {
+			self.addIssue(name, SEVERITY_ERROR, "parse_error",
+				"While parsing VQL: %v", err)
+			continue
+		}
+
+		// Make sure all the artifacts the query calls can be
+		// resolved just like the launcher would when collecting it.
+		err = launcher.GetQueryDependencies(ctx, self.config_obj,
+			self.repository, query, 0, make(map[string]int))
+		if err != nil {
+			self.addIssue(name, SEVERITY_ERROR, "missing_dependency",
+				"%v", err)
+		}
+	}
+
+	// Imported exports run in the same scope so they can define
+	// functions and stored queries used by the artifact.
+	defined := newVQLReferences()
+	for _, imported := range artifact.Imports {
+		dep, pres := self.repository.Get(ctx, self.config_obj, imported)
+		if !pres {
+			self.addIssue(name, SEVERITY_ERROR, "missing_dependency",
+				"Imported artifact %v not found", imported)
+			continue
+		}
+		_ = defined.parse(dep.Export)
+	}
+
+	for _, parameter := range artifact.Parameters {
+		defined.lets[parameter.Name] = true
+	}
+	for k := range refs.lets {
+		defined.lets[k] = true
+	}
+
+	for _, plugin := range refs.sortedPlugins() {
+		if strings.Contains(plugin, ".") ||
+			self.known_plugins[plugin] || defined.lets[plugin] {
+			continue
+		}
+
+		self.addIssue(name, SEVERITY_ERROR, "unknown_plugin",
+			"Unknown VQL plugin %v", plugin)
+	}
+
+	for _, function := range sortedKeys(refs.functions) {
+		if strings.Contains(function, ".") ||
+			self.known_functions[function] || defined.lets[function] {
+			continue
+		}
+
+		self.addIssue(name, SEVERITY_ERROR, "unknown_function",
+			"Unknown VQL function %v", function)
+	}
+
+	for _, accessor := range sortedKeys(refs.literalArgs("accessor")) {
+		if !self.known_accessors[accessor] {
+			self.addIssue(name, SEVERITY_ERROR, "unknown_accessor",
+				"Unknown accessor %v", accessor)
+		}
+	}
+
+	declared_tools := make(map[string]bool)
+	for _, tool := range artifact.Tools {
+		declared_tools[tool.Name] = true
+	}
+
+	for _, tool := range sortedKeys(refs.literalArgs("ToolName")) {
+		if declared_tools[tool] {
+			continue
+		}
+
+		if all_tools[tool] {
+			self.addIssue(name, SEVERITY_WARNING, "undeclared_tool",
+				"Tool %v is not declared by this artifact", tool)
+		} else {
+			self.addIssue(name, SEVERITY_ERROR, "undeclared_tool",
+				"Tool %v is not declared by any artifact", tool)
+		}
+	}
+
+	// Queries calling scope() (possibly from within a template
+	// string) or get() without an item may access parameters
+	// dynamically so we can not tell if parameters are used.
+	dynamic := scope_call_regex.MatchString(strings.Join(queries, "\n"))
+	self.verifyParameters(artifact, queries, dynamic)
+}
+
+func (self *ArtifactVerifier) verifyParameters(
+	artifact *artifacts_proto.Artifact, queries []string, dynamic bool) {
+	name := artifact.Name
+	all_queries := strings.Join(queries, "\n")
+
+	seen := make(map[string]bool)
+	for _, parameter := range artifact.Parameters {
+		if seen[parameter.Name] {
+			self.addIssue(name, SEVERITY_ERROR, "duplicate_parameter",
+				"Parameter %v is declared more than once", parameter.Name)
+		}
+		seen[parameter.Name] = true
+
+		if !utils.InString(known_parameter_types, parameter.Type) {
+			self.addIssue(name, SEVERITY_WARNING, "unknown_parameter_type",
+				"Parameter %v has unknown type %v",
+				parameter.Name, parameter.Type)
+		}
+
+		err := checkParameterDefault(parameter)
+		if err != nil {
+			self.addIssue(name, SEVERITY_ERROR, "parameter_type_mismatch",
+				"Parameter %v: default does not match type %v: %v",
+				parameter.Name, parameter.Type, err)
+		}
+
+		// Upper case parameters usually configure plugins via
+		// scope variables (e.g. NTFS_CACHE_TIME).
+		if dynamic || parameter.Name == strings.ToUpper(parameter.Name) {
+			continue
+		}
+
+		used, _ := regexp.MatchString(
+			`\b`+regexp.QuoteMeta(parameter.Name)+`\b`, all_queries)
+		if !used {
+			self.addIssue(name, SEVERITY_WARNING, "unused_parameter",
+				"Parameter %v is not used", parameter.Name)
+		}
+	}
+}
+
+// Check the parameter's default value can be converted to its type.
+func checkParameterDefault(parameter *artifacts_proto.ArtifactParameter) error {
+	value := parameter.Default
+	if value == "" {
+		return nil
+	}
+
+	switch parameter.Type {
+	case "int", "int64", "integer":
+		_, err := strconv.ParseInt(strings.TrimSpace(value), 0, 64)
+		return err
+
+	case "float":
+		_, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		return err
+
+	case "bool":
+		switch strings.ToUpper(value) {
+		case "Y", "N", "YES", "NO", "TRUE", "FALSE", "OK":
+			return nil
+		}
+		return fmt.Errorf("%q is not a boolean", value)
+
+	case "choices":
+		if len(parameter.Choices) > 0 &&
+			!utils.InString(parameter.Choices, value) {
+			return fmt.Errorf("%q is not one of the choices", value)
+		}
+
+	case "regex":
+		_, err := regexp.Compile(value)
+		return err
+
+	case "csv", "artifactset":
+		_, err := csv.NewReader(strings.NewReader(value)).ReadAll()
+		return err
+
+	case "json":
+		var result interface{}
+		return json.Unmarshal([]byte(value), &result)
+
+	case "json_array", "regex_array":
+		var result []interface{}
+		return json.Unmarshal([]byte(value), &result)
+	}
+
+	return nil
+}
+
+// Report cycles in the dependency graph reachable from the named
+// artifacts.
+func (self *ArtifactVerifier) findCycles(ctx context.Context, names []string) {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+
+	state := make(map[string]int)
+	reported := make(map[string]bool)
+	var stack []string
+
+	var visit func(name string)
+	visit = func(name string) {
+		switch state[name] {
+		case done:
+			return
+
+		case visiting:
+			// Found a cycle - report it from where it starts.
+			for idx, item := range stack {
+				if item == name {
+					cycle := append(append([]string{}, stack[idx:]...), name)
+					key := strings.Join(cycle, " -> ")
+					if !reported[key] {
+						reported[key] = true
+						self.addIssue(name, SEVERITY_ERROR, "dependency_cycle",
+							"Dependency cycle: %v", key)
+					}
+				}
+			}
+			return
+		}
+
+		state[name] = visiting
+		stack = append(stack, name)
+
+		for _, dep := range self.getDependencies(ctx, name) {
+			visit(dep)
+		}
+
+		stack = stack[:len(stack)-1]
+		state[name] = done
+	}
+
+	for _, name := range names {
+		visit(name)
+	}
+}
+
+// All the queries that run in the artifact's scope.
+func getArtifactQueries(artifact *artifacts_proto.Artifact) []string {
+	result := []string{artifact.Precondition, artifact.Export}
+	for _, source := range artifact.Sources {
+		result = append(result, source.Precondition, source.Query)
+	}
+	return result
+}
+
+// The direct dependencies of the artifact.
+func (self *ArtifactVerifier) getDependencies(
+	ctx context.Context, name string) []string {
+	artifact, pres := self.repository.Get(ctx, self.config_obj, name)
+	if !pres {
+		return nil
+	}
+
+	deps := make(map[string]bool)
+	for _, imported := range artifact.Imports {
+		deps[imported] = true
+	}
+
+	// Artifacts called directly by the queries are found at depth
+	// 0. Missing artifacts are reported by verifyArtifact().
+	dependency := make(map[string]int)
+	for _, query := range getArtifactQueries(artifact) {
+		_ = launcher.GetQueryDependencies(ctx, self.config_obj,
+			self.repository, query, 0, dependency)
+	}
+
+	for dep, depth := range dependency {
+		if depth == 0 {
+			deps[dep] = true
+		}
+	}
+
+	// Sources may call other sources of the same artifact which is
+	// not a cycle.
+	delete(deps, name)
+
+	return sortedKeys(deps)
+}
+
+// Collect the references made in a VQL query. vfilter does not
+// export its AST so, like the launcher's dependency search, we
+// search the query for things which look like references. The query
+// is reformatted first to remove comments and normalize its layout.
+type vqlReferences struct {
+	plugins   map[string]bool
+	functions map[string]bool
+	lets      map[string]bool
+
+	// Args passed to plugins or functions as literal strings, keyed
+	// by arg name.
+	args map[string]map[string]bool
+}
+
+func newVQLReferences() *vqlReferences {
+	return &vqlReferences{
+		plugins:   make(map[string]bool),
+		functions: make(map[string]bool),
+		lets:      make(map[string]bool),
+		args:      make(map[string]map[string]bool),
+	}
+}
+
+func (self *vqlReferences) parse(query string) error {
+	if query == "" {
+		return nil
+	}
+
+	vqls, err := vfilter.MultiParse(query)
+	if err != nil {
+		return err
+	}
+
+	scope := vql_subsystem.MakeScope()
+	defer scope.Close()
+
+	for _, vql := range vqls {
+		self.search(vfilter.FormatToString(scope, vql))
+	}
+	return nil
+}
+
+func (self *vqlReferences) search(query string) {
+	for _, hit := range literal_arg_regex.FindAllStringSubmatch(query, -1) {
+		values, pres := self.args[hit[1]]
+		if !pres {
+			values = make(map[string]bool)
+			self.args[hit[1]] = values
+		}
+		values[hit[2]+hit[3]] = true
+	}
+
+	// Strings may contain anything which looks like a call.
+	query = string_regex.ReplaceAllString(query, "''")
+
+	for _, hit := range let_regex.FindAllStringSubmatch(query, -1) {
+		self.lets[hit[1]] = true
+
+		// Parameters of LET functions are also defined within the
+		// function body.
+		for _, parameter := range strings.Split(hit[2], ",") {
+			parameter = strings.TrimSpace(parameter)
+			if parameter != "" {
+				self.lets[parameter] = true
+			}
+		}
+	}
+
+	for _, hit := range call_regex.FindAllStringSubmatch(query, -1) {
+		name := hit[2]
+		switch strings.ToUpper(hit[1]) {
+		case "FROM":
+			self.plugins[name] = true
+
+		// Definitions of LET functions.
+		case "LET":
+
+		default:
+			if !utils.InString(vql_keywords, strings.ToUpper(name)) {
+				self.functions[name] = true
+			}
+		}
+	}
+}
+
+func (self *vqlReferences) literalArgs(name string) map[string]bool {
+	result, pres := self.args[name]
+	if !pres {
+		return map[string]bool{}
+	}
+	return result
+}
+
+func (self *vqlReferences) sortedPlugins() []string {
+	return sortedKeys(self.plugins)
+}
+
+func sortedKeys(in map[string]bool) []string {
+	result := make([]string, 0, len(in))
+	for k := range in {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVQLReferences(t *testing.T) {
+	refs := newVQLReferences()
+	assert.NoError(t, refs.parse(`
+// Comments and strings are not references: fake_plugin()
+LET Filter(Y) = SELECT * FROM Y
+SELECT upcase(string=Name), format(format="not_a_call(%v)", args=1)
+FROM Filter(Y={
+   SELECT * FROM glob(globs="/*", accessor="file")
+})
+WHERE Name IN ("a", "b") AND NOT Name =~ "x"`))
+
+	assert.Equal(t, []string{"Filter", "glob"}, refs.sortedPlugins())
+	assert.Equal(t, []string{"format", "upcase"}, sortedKeys(refs.functions))
+	assert.Equal(t, []string{"Filter", "Y"}, sortedKeys(refs.lets))
+	assert.Equal(t, map[string]bool{"file": true}, refs.literalArgs("accessor"))
+
+	// Only complete literals are recorded.
+	refs = newVQLReferences()
+	assert.NoError(t, refs.parse(
+		`SELECT * FROM Artifact.Foo(ToolName="Tool_" + Arch)`))
+	assert.Equal(t, []string{"Artifact.Foo"}, refs.sortedPlugins())
+	assert.Equal(t, map[string]bool{}, refs.literalArgs("ToolName"))
+}
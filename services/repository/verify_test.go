@@ -0,0 +1,172 @@
+package repository_test
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"www.velocidex.com/golang/velociraptor/file_store/test_utils"
+	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/services/repository"
+
+	_ "www.velocidex.com/golang/velociraptor/vql_plugins"
+)
+
+var (
+	verify_artifact_definitions = []string{`
+name: Verify.Good
+tools:
+- name: MyTool
+parameters:
+- name: Count
+  type: int
+  default: "10"
+sources:
+- query: |
+    LET Filter(X) = SELECT * FROM X WHERE TRUE
+    SELECT * FROM Filter(X={
+       SELECT * FROM glob(globs="/*", accessor="file")
+    })
+    WHERE Count > 0
+- name: Tool
+  query: |
+    SELECT * FROM Artifact.Generic.Utils.FetchBinary(ToolName="MyTool")
+`, `
+name: Verify.Bad
+imports:
+- Verify.DoesNotExist
+parameters:
+- name: Count
+  type: int
+  default: "ten"
+- name: Unused
+- name: Duplicate
+- name: Duplicate
+- name: Weird
+  type: weird
+sources:
+- query: |
+    SELECT no_such_function(), Duplicate, Weird, Count
+    FROM no_such_plugin()
+- name: Missing
+  query: |
+    SELECT * FROM Artifact.Verify.Missing()
+- name: Accessor
+  query: |
+    SELECT * FROM glob(globs="/*", accessor="no_such_accessor")
+- name: Tools
+  query: |
+    SELECT * FROM chain(
+      a={SELECT * FROM Artifact.Generic.Utils.FetchBinary(ToolName="MyTool")},
+      b={SELECT * FROM Artifact.Generic.Utils.FetchBinary(ToolName="NoSuchTool")})
+`, `
+name: Verify.Cycle1
+sources:
+- query: SELECT * FROM Artifact.Verify.Cycle2()
+`, `
+name: Verify.Cycle2
+sources:
+- query: SELECT * FROM Artifact.Verify.Cycle1()
+`, `
+name: Verify.Self
+sources:
+- name: First
+  query: SELECT * FROM info()
+- name: Second
+  query: SELECT * FROM Artifact.Verify.Self(source="First")
+`}
+)
+
+type VerifyTestSuite struct {
+	test_utils.TestSuite
+}
+
+func (self *VerifyTestSuite) TestVerify() {
+	manager, err := services.GetRepositoryManager(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	base := manager.NewRepository()
+	_, err = base.LoadYaml(`
+name: Generic.Utils.FetchBinary
+parameters:
+- name: ToolName
+sources:
+- query: SELECT ToolName FROM scope()
+`, services.ValidateArtifact, !services.ArtifactIsBuiltIn)
+	assert.NoError(self.T(), err)
+
+	verifier := repository.NewArtifactVerifier(self.ConfigObj, base)
+
+	var names []string
+	for _, definition := range verify_artifact_definitions {
+		name, err := verifier.LoadYaml(definition, name_to_file(definition))
+		assert.NoError(self.T(), err)
+		names = append(names, name)
+	}
+
+	// Loading errors are reported as issues.
+	_, err = verifier.LoadYaml("name: [", "broken.yaml")
+	assert.Error(self.T(), err)
+
+	issues, err := verifier.Verify(self.Ctx, names)
+	assert.NoError(self.T(), err)
+
+	var result []string
+	for _, issue := range issues {
+		result = append(result, fmt.Sprintf("%v %v %v %v",
+			issue.Severity, issue.Type, issue.Artifact, issue.File))
+	}
+	sort.Strings(result)
+
+	assert.Equal(self.T(), []string{
+		"error dependency_cycle Verify.Cycle1 Verify.Cycle1.yaml",
+		"error duplicate_parameter Verify.Bad Verify.Bad.yaml",
+		"error load_error  broken.yaml",
+		"error missing_dependency Verify.Bad Verify.Bad.yaml",
+		"error missing_dependency Verify.Bad Verify.Bad.yaml",
+		"error parameter_type_mismatch Verify.Bad Verify.Bad.yaml",
+		"error undeclared_tool Verify.Bad Verify.Bad.yaml",
+		"error unknown_accessor Verify.Bad Verify.Bad.yaml",
+		"error unknown_function Verify.Bad Verify.Bad.yaml",
+		"error unknown_plugin Verify.Bad Verify.Bad.yaml",
+		"warning undeclared_tool Verify.Bad Verify.Bad.yaml",
+		"warning unknown_parameter_type Verify.Bad Verify.Bad.yaml",
+		"warning unused_parameter Verify.Bad Verify.Bad.yaml",
+	}, result)
+}
+
+// Built in artifacts should verify without errors.
+func (self *VerifyTestSuite) TestVerifyBuiltIn() {
+	manager, err := services.GetRepositoryManager(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	err = repository.LoadBuiltInArtifacts(
+		self.Ctx, self.ConfigObj, manager.(*repository.RepositoryManager),
+		services.ValidateArtifact)
+	assert.NoError(self.T(), err)
+
+	global_repository, err := manager.GetGlobalRepository(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	verifier := repository.NewArtifactVerifier(self.ConfigObj, global_repository)
+	issues, err := verifier.Verify(self.Ctx, nil)
+	assert.NoError(self.T(), err)
+
+	for _, issue := range issues {
+		if issue.Severity == repository.SEVERITY_ERROR {
+			self.T().Errorf("%v: %v: %v", issue.Artifact, issue.Type, issue.Message)
+		}
+	}
+}
+
+func name_to_file(definition string) string {
+	var name string
+	fmt.Sscanf(definition, "\nname: %s", &name)
+	return name + ".yaml"
+}
+
+func TestVerify(t *testing.T) {
+	suite.Run(t, &VerifyTestSuite{})
+}
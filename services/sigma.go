@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+
+	api_proto "www.velocidex.com/golang/velociraptor/api/proto"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+)
+
+func GetSigmaService(config_obj *config_proto.Config) (SigmaService, error) {
+	org_manager, err := GetOrgManager()
+	if err != nil {
+		return nil, err
+	}
+
+	return org_manager.Services(config_obj.OrgId).SigmaService()
+}
+
+// Manages the Sigma rules stored on the server. The rules are
+// matched against the event artifacts configured in the
+// sigma_log_sources section of the config.
+type SigmaService interface {
+	// Store a rule in YAML format, replacing any rule with the same
+	// id.
+	SetRule(ctx context.Context, principal, rule string) (
+		*api_proto.SigmaRule, error)
+
+	DeleteRule(ctx context.Context, id string) error
+
+	// All stored rules sorted by id.
+	ListRules(ctx context.Context) []*api_proto.SigmaRule
+}
@@ -0,0 +1,248 @@
+package sigma
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+var (
+	condition_token_regex = regexp.MustCompile(`\(|\)|\||[^\s()|]+`)
+)
+
+type conditionNode interface {
+	eval(ctx *matchContext) bool
+}
+
+type andNode []conditionNode
+
+func (self andNode) eval(ctx *matchContext) bool {
+	for _, node := range self {
+		if !node.eval(ctx) {
+			return false
+		}
+	}
+	return true
+}
+
+type orNode []conditionNode
+
+func (self orNode) eval(ctx *matchContext) bool {
+	for _, node := range self {
+		if node.eval(ctx) {
+			return true
+		}
+	}
+	return false
+}
+
+type notNode struct {
+	node conditionNode
+}
+
+func (self notNode) eval(ctx *matchContext) bool {
+	return !self.node.eval(ctx)
+}
+
+type selectionNode string
+
+func (self selectionNode) eval(ctx *matchContext) bool {
+	sel, pres := ctx.selections[string(self)]
+	return pres && sel.match(ctx)
+}
+
+// Parses the condition of a rule:
+//
+//	condition := and_expr ("or" and_expr)*
+//	and_expr  := not_expr ("and" not_expr)*
+//	not_expr  := "not" not_expr | "(" condition ")" | quantifier | name
+//	quantifier := ("1" | "any" | "all") "of" (pattern | "them")
+//
+// Aggregations (e.g. "| count() > 5") are not supported.
+type conditionParser struct {
+	tokens     []string
+	pos        int
+	selections []string
+}
+
+func parseCondition(condition string, selections []string) (conditionNode, error) {
+	parser := &conditionParser{
+		tokens:     condition_token_regex.FindAllString(condition, -1),
+		selections: selections,
+	}
+
+	if len(parser.tokens) == 0 {
+		return nil, errors.New("Empty condition")
+	}
+
+	node, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	switch parser.peek() {
+	case "":
+	case "|":
+		return nil, errors.New("Aggregations are not supported")
+	default:
+		return nil, fmt.Errorf("Unexpected %v", parser.tokens[parser.pos])
+	}
+
+	return node, nil
+}
+
+func (self *conditionParser) peek() string {
+	if self.pos >= len(self.tokens) {
+		return ""
+	}
+	return strings.ToLower(self.tokens[self.pos])
+}
+
+func (self *conditionParser) next() (string, error) {
+	if self.pos >= len(self.tokens) {
+		return "", errors.New("Unexpected end of condition")
+	}
+	self.pos++
+	return self.tokens[self.pos-1], nil
+}
+
+func (self *conditionParser) parseOr() (conditionNode, error) {
+	node, err := self.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	result := orNode{node}
+	for self.peek() == "or" {
+		self.pos++
+		node, err := self.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, node)
+	}
+
+	if len(result) == 1 {
+		return result[0], nil
+	}
+	return result, nil
+}
+
+func (self *conditionParser) parseAnd() (conditionNode, error) {
+	node, err := self.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	result := andNode{node}
+	for self.peek() == "and" {
+		self.pos++
+		node, err := self.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, node)
+	}
+
+	if len(result) == 1 {
+		return result[0], nil
+	}
+	return result, nil
+}
+
+func (self *conditionParser) parseNot() (conditionNode, error) {
+	switch self.peek() {
+	case "not":
+		self.pos++
+		node, err := self.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{node: node}, nil
+
+	case "(":
+		self.pos++
+		node, err := self.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		token, err := self.next()
+		if err != nil {
+			return nil, err
+		}
+		if token != ")" {
+			return nil, fmt.Errorf("Expected ) but got %v", token)
+		}
+		return node, nil
+
+	case "|":
+		return nil, errors.New("Aggregations are not supported")
+
+	case "1", "any", "all":
+		if self.pos+1 < len(self.tokens) &&
+			strings.ToLower(self.tokens[self.pos+1]) == "of" {
+			return self.parseQuantifier()
+		}
+	}
+
+	token, err := self.next()
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(token) {
+	case "and", "or", ")":
+		return nil, fmt.Errorf("Unexpected %v", token)
+	}
+
+	for _, name := range self.selections {
+		if name == token {
+			return selectionNode(token), nil
+		}
+	}
+
+	return nil, fmt.Errorf("Unknown selection %v", token)
+}
+
+func (self *conditionParser) parseQuantifier() (conditionNode, error) {
+	quantifier, _ := self.next()
+
+	// Skip "of"
+	self.pos++
+
+	pattern, err := self.next()
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []conditionNode
+	for _, name := range self.selections {
+		if strings.ToLower(pattern) == "them" {
+			// Selections starting with _ are excluded from "them".
+			if !strings.HasPrefix(name, "_") {
+				nodes = append(nodes, selectionNode(name))
+			}
+			continue
+		}
+
+		matched, err := path.Match(pattern, name)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			nodes = append(nodes, selectionNode(name))
+		}
+	}
+
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("No selections match %v", pattern)
+	}
+
+	if strings.ToLower(quantifier) == "all" {
+		return andNode(nodes), nil
+	}
+	return orNode(nodes), nil
+}
@@ -0,0 +1,430 @@
+package sigma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Velocidex/ordereddict"
+	"github.com/Velocidex/yaml/v2"
+	"www.velocidex.com/golang/vfilter"
+)
+
+type matchContext struct {
+	ctx        context.Context
+	scope      vfilter.Scope
+	row        vfilter.Row
+	mapping    map[string]string
+	selections map[string]*selection
+
+	// All the values in the row for keyword searches, filled on
+	// demand.
+	values []string
+}
+
+// Get the row's value for the Sigma field. Returns nil if the field
+// is not present.
+func (self *matchContext) get(field string) interface{} {
+	column, pres := self.mapping[field]
+	if !pres {
+		column = field
+	}
+
+	value, pres := self.scope.Associative(self.row, column)
+	if pres {
+		return normalize(value)
+	}
+
+	// Try a dotted path into nested objects.
+	if !strings.Contains(column, ".") {
+		return nil
+	}
+
+	var result vfilter.Any = self.row
+	for _, part := range strings.Split(column, ".") {
+		result, pres = self.scope.Associative(result, part)
+		if !pres {
+			return nil
+		}
+	}
+
+	return normalize(result)
+}
+
+func (self *matchContext) getValues() []string {
+	if self.values == nil {
+		self.values = []string{}
+		self.collectValues(vfilter.RowToDict(self.ctx, self.scope, self.row))
+	}
+	return self.values
+}
+
+func (self *matchContext) collectValues(value interface{}) {
+	switch t := normalize(value).(type) {
+	case nil:
+	case *ordereddict.Dict:
+		for _, k := range t.Keys() {
+			v, _ := t.Get(k)
+			self.collectValues(v)
+		}
+	case map[string]interface{}:
+		for _, v := range t {
+			self.collectValues(v)
+		}
+	case []interface{}:
+		for _, v := range t {
+			self.collectValues(v)
+		}
+	default:
+		self.values = append(self.values, toString(t))
+	}
+}
+
+func normalize(value interface{}) interface{} {
+	switch t := value.(type) {
+	case vfilter.Null, *vfilter.Null:
+		return nil
+	case []byte:
+		return string(t)
+	default:
+		return value
+	}
+}
+
+func toString(value interface{}) string {
+	switch t := value.(type) {
+	case string:
+		return t
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case time.Time:
+		return t.UTC().Format(time.RFC3339)
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch t := value.(type) {
+	case int:
+		return float64(t), true
+	case int64:
+		return float64(t), true
+	case uint64:
+		return float64(t), true
+	case float64:
+		return t, true
+	case nil:
+		return 0, false
+	default:
+		result, err := strconv.ParseFloat(toString(t), 64)
+		return result, err == nil
+	}
+}
+
+// A selection matches if any of its field groups matches (all fields
+// in a group must match) or any of its keywords is found in the row.
+type selection struct {
+	groups   [][]*fieldMatcher
+	keywords []valueMatcher
+}
+
+func (self *selection) match(ctx *matchContext) bool {
+	for _, group := range self.groups {
+		matched := true
+		for _, field := range group {
+			if !field.match(ctx) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+
+	if len(self.keywords) > 0 {
+		for _, value := range ctx.getValues() {
+			for _, keyword := range self.keywords {
+				if keyword(value) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func compileSelection(value interface{}) (*selection, error) {
+	result := &selection{}
+
+	switch t := value.(type) {
+	case yaml.MapSlice:
+		group, err := compileFieldGroup(t)
+		if err != nil {
+			return nil, err
+		}
+		result.groups = append(result.groups, group)
+
+	case []interface{}:
+		for _, item := range t {
+			switch item_t := item.(type) {
+			case yaml.MapSlice:
+				group, err := compileFieldGroup(item_t)
+				if err != nil {
+					return nil, err
+				}
+				result.groups = append(result.groups, group)
+
+			// A list of values is a keyword search.
+			case nil:
+				return nil, errors.New("Invalid null keyword")
+
+			default:
+				matcher, err := compileStringMatcher(
+					"contains", toString(item_t), false, "")
+				if err != nil {
+					return nil, err
+				}
+				result.keywords = append(result.keywords, matcher)
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("Invalid selection %v", value)
+	}
+
+	return result, nil
+}
+
+func compileFieldGroup(fields yaml.MapSlice) ([]*fieldMatcher, error) {
+	result := []*fieldMatcher{}
+	for _, item := range fields {
+		key, ok := item.Key.(string)
+		if !ok {
+			return nil, fmt.Errorf("Invalid field %v", item.Key)
+		}
+
+		matcher, err := compileFieldMatcher(key, item.Value)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, matcher)
+	}
+	return result, nil
+}
+
+// Receives the row's value for the field, or nil if it is missing.
+type valueMatcher func(value interface{}) bool
+
+// Matches a field against a list of values. Any value may match
+// unless the "all" modifier is used.
+type fieldMatcher struct {
+	field  string
+	values []valueMatcher
+	all    bool
+}
+
+func (self *fieldMatcher) match(ctx *matchContext) bool {
+	value := ctx.get(self.field)
+	for _, matcher := range self.values {
+		matched := matchValue(matcher, value)
+		if self.all && !matched {
+			return false
+		}
+		if !self.all && matched {
+			return true
+		}
+	}
+	return self.all
+}
+
+// Lists in the row match if any item matches.
+func matchValue(matcher valueMatcher, value interface{}) bool {
+	items, ok := value.([]interface{})
+	if !ok {
+		return matcher(value)
+	}
+
+	for _, item := range items {
+		if matcher(normalize(item)) {
+			return true
+		}
+	}
+	return false
+}
+
+func compileFieldMatcher(key string, value interface{}) (*fieldMatcher, error) {
+	parts := strings.Split(key, "|")
+	result := &fieldMatcher{field: parts[0]}
+
+	mode := ""
+	cased := false
+	regex_flags := ""
+	for _, modifier := range parts[1:] {
+		switch strings.ToLower(modifier) {
+		case "all":
+			result.all = true
+		case "cased":
+			cased = true
+		case "i", "m", "s":
+			regex_flags += strings.ToLower(modifier)
+		case "contains", "startswith", "endswith", "re", "cidr",
+			"lt", "lte", "gt", "gte", "exists":
+			if mode != "" {
+				return nil, fmt.Errorf("%v: Only one of %v or %v may be used",
+					key, mode, modifier)
+			}
+			mode = strings.ToLower(modifier)
+		default:
+			return nil, fmt.Errorf("%v: Unsupported modifier %v", key, modifier)
+		}
+	}
+
+	values, ok := value.([]interface{})
+	if !ok {
+		values = []interface{}{value}
+	}
+
+	for _, v := range values {
+		matcher, err := compileValueMatcher(mode, v, cased, regex_flags)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", key, err)
+		}
+		result.values = append(result.values, matcher)
+	}
+
+	return result, nil
+}
+
+func compileValueMatcher(mode string, value interface{},
+	cased bool, regex_flags string) (valueMatcher, error) {
+
+	// A null value matches missing or empty fields.
+	if value == nil {
+		if mode != "" {
+			return nil, errors.New("Null values can not have modifiers")
+		}
+		return func(value interface{}) bool {
+			return value == nil || value == ""
+		}, nil
+	}
+
+	switch mode {
+	case "exists":
+		expected, ok := value.(bool)
+		if !ok {
+			return nil, errors.New("exists requires true or false")
+		}
+		return func(value interface{}) bool {
+			return (value != nil) == expected
+		}, nil
+
+	case "cidr":
+		_, network, err := net.ParseCIDR(toString(value))
+		if err != nil {
+			return nil, err
+		}
+		return func(value interface{}) bool {
+			ip := net.ParseIP(toString(value))
+			return value != nil && ip != nil && network.Contains(ip)
+		}, nil
+
+	case "lt", "lte", "gt", "gte":
+		expected, ok := toFloat(value)
+		if !ok {
+			return nil, fmt.Errorf("%v requires a number", mode)
+		}
+		return func(value interface{}) bool {
+			number, ok := toFloat(value)
+			if !ok {
+				return false
+			}
+			switch mode {
+			case "lt":
+				return number < expected
+			case "lte":
+				return number <= expected
+			case "gt":
+				return number > expected
+			default:
+				return number >= expected
+			}
+		}, nil
+	}
+
+	return compileStringMatcher(mode, toString(value), cased, regex_flags)
+}
+
+// String values may contain the wildcards * and ? (escaped with
+// \). Matching is case insensitive unless the cased modifier is
+// used. Regular expressions are case sensitive unless the i flag is
+// given.
+func compileStringMatcher(mode, value string,
+	cased bool, regex_flags string) (valueMatcher, error) {
+	var expression string
+
+	if mode == "re" {
+		expression = value
+		if regex_flags != "" {
+			expression = "(?" + regex_flags + ")" + expression
+		}
+
+	} else {
+		pattern := wildcardToRegex(value)
+		switch mode {
+		case "contains":
+		case "startswith":
+			pattern = "^" + pattern
+		case "endswith":
+			pattern = pattern + "$"
+		default:
+			pattern = "^" + pattern + "$"
+		}
+
+		expression = "(?s)" + pattern
+		if !cased {
+			expression = "(?is)" + pattern
+		}
+	}
+
+	re, err := regexp.Compile(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(value interface{}) bool {
+		return value != nil && re.MatchString(toString(value))
+	}, nil
+}
+
+func wildcardToRegex(value string) string {
+	runes := []rune(value)
+	result := &strings.Builder{}
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		switch c {
+		case '*':
+			result.WriteString(".*")
+		case '?':
+			result.WriteString(".")
+		case '\\':
+			// Only wildcards and backslashes are escaped. Other
+			// backslashes are literal.
+			if i+1 < len(runes) && strings.ContainsRune(`*?\`, runes[i+1]) {
+				i++
+				c = runes[i]
+			}
+			result.WriteString(regexp.QuoteMeta(string(c)))
+		default:
+			result.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return result.String()
+}
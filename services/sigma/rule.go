@@ -0,0 +1,216 @@
+package sigma
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/Velocidex/ordereddict"
+	"github.com/Velocidex/yaml/v2"
+	"www.velocidex.com/golang/vfilter"
+)
+
+type ruleLogSource struct {
+	Product  string `yaml:"product"`
+	Category string `yaml:"category"`
+	Service  string `yaml:"service"`
+}
+
+type ruleDefinition struct {
+	Title       string        `yaml:"title"`
+	Id          string        `yaml:"id"`
+	Status      string        `yaml:"status"`
+	Description string        `yaml:"description"`
+	Level       string        `yaml:"level"`
+	Tags        []string      `yaml:"tags"`
+	LogSource   ruleLogSource `yaml:"logsource"`
+	Detection   yaml.MapSlice `yaml:"detection"`
+}
+
+// A compiled Sigma rule.
+type Rule struct {
+	Id          string
+	Title       string
+	Description string
+	Level       string
+	Tags        []string
+
+	Product  string
+	Category string
+	Service  string
+
+	selections map[string]*selection
+	condition  conditionNode
+}
+
+// Does the rule apply to events from this log source? Every part of
+// the rule's logsource must match.
+func (self *Rule) MatchesLogSource(product, category, service string) bool {
+	return matchLogSourceField(self.Product, product) &&
+		matchLogSourceField(self.Category, category) &&
+		matchLogSourceField(self.Service, service)
+}
+
+func matchLogSourceField(rule_field, field string) bool {
+	return rule_field == "" || strings.EqualFold(rule_field, field)
+}
+
+// Match the row against the rule. The mapping translates Sigma field
+// names to columns in the row.
+func (self *Rule) Match(ctx context.Context, scope vfilter.Scope,
+	row vfilter.Row, mapping map[string]string) bool {
+	return self.condition.eval(&matchContext{
+		ctx:        ctx,
+		scope:      scope,
+		row:        row,
+		mapping:    mapping,
+		selections: self.selections,
+	})
+}
+
+// A detection row for a match of this rule.
+func (self *Rule) Detection() *ordereddict.Dict {
+	return ordereddict.NewDict().
+		Set("RuleId", self.Id).
+		Set("Title", self.Title).
+		Set("Level", self.Level).
+		Set("Tags", self.Tags)
+}
+
+// Compile all the rules in the YAML text. Multiple rules may be
+// separated by "---".
+func CompileRules(text string) ([]*Rule, error) {
+	result := []*Rule{}
+
+	decoder := yaml.NewDecoder(bytes.NewReader([]byte(text)))
+	for {
+		definition := &ruleDefinition{}
+		err := decoder.Decode(definition)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		rule, err := compileRule(definition)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, rule)
+	}
+
+	if len(result) == 0 {
+		return nil, errors.New("No Sigma rules found")
+	}
+
+	return result, nil
+}
+
+// Compile a single rule.
+func CompileRule(text string) (*Rule, error) {
+	rules, err := CompileRules(text)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rules) > 1 {
+		return nil, errors.New("Only one Sigma rule may be specified")
+	}
+
+	return rules[0], nil
+}
+
+func compileRule(definition *ruleDefinition) (*Rule, error) {
+	if definition.Title == "" {
+		return nil, errors.New("Sigma rule has no title")
+	}
+
+	result := &Rule{
+		Id:          definition.Id,
+		Title:       definition.Title,
+		Description: definition.Description,
+		Level:       definition.Level,
+		Tags:        definition.Tags,
+		Product:     definition.LogSource.Product,
+		Category:    definition.LogSource.Category,
+		Service:     definition.LogSource.Service,
+		selections:  make(map[string]*selection),
+	}
+
+	// Rules without an id are identified by their title.
+	if result.Id == "" {
+		result.Id = result.Title
+	}
+
+	if result.Tags == nil {
+		result.Tags = []string{}
+	}
+
+	var conditions []string
+	for _, item := range definition.Detection {
+		name, ok := item.Key.(string)
+		if !ok {
+			return nil, fmt.Errorf("%v: Invalid detection key %v",
+				result.Title, item.Key)
+		}
+
+		switch name {
+		case "condition":
+			switch t := item.Value.(type) {
+			case string:
+				conditions = append(conditions, t)
+			case []interface{}:
+				for _, c := range t {
+					conditions = append(conditions, fmt.Sprintf("%v", c))
+				}
+			default:
+				return nil, fmt.Errorf("%v: Invalid condition", result.Title)
+			}
+
+		// Only used by aggregations which are not supported.
+		case "timeframe":
+
+		default:
+			sel, err := compileSelection(item.Value)
+			if err != nil {
+				return nil, fmt.Errorf("%v: Selection %v: %w",
+					result.Title, name, err)
+			}
+			result.selections[name] = sel
+		}
+	}
+
+	if len(conditions) == 0 {
+		return nil, fmt.Errorf("%v: No condition specified", result.Title)
+	}
+
+	names := make([]string, 0, len(result.selections))
+	for k := range result.selections {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	// A list of conditions matches if any of them matches.
+	var nodes orNode
+	for _, condition := range conditions {
+		node, err := parseCondition(condition, names)
+		if err != nil {
+			return nil, fmt.Errorf("%v: Condition %v: %w",
+				result.Title, condition, err)
+		}
+		nodes = append(nodes, node)
+	}
+
+	if len(nodes) == 1 {
+		result.condition = nodes[0]
+	} else {
+		result.condition = nodes
+	}
+
+	return result, nil
+}
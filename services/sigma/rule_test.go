@@ -0,0 +1,193 @@
+package sigma
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Velocidex/ordereddict"
+	"github.com/alecthomas/assert"
+	"github.com/sebdah/goldie"
+	"www.velocidex.com/golang/velociraptor/json"
+	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
+)
+
+var test_rules = `
+title: Suspicious Cmd
+id: rule-1
+level: high
+tags:
+- attack.execution
+logsource:
+  product: windows
+  category: process_creation
+detection:
+  selection:
+    Image|endswith: '\cmd.exe'
+    CommandLine|contains|all:
+    - '/c'
+    - 'whoami'
+  filter:
+  - User: SYSTEM
+  - ParentImage: null
+  condition: selection and not filter
+---
+title: Encoded PowerShell
+id: rule-2
+level: medium
+logsource:
+  product: windows
+detection:
+  selection_image:
+    Image|endswith: '\powershell.exe'
+  selection_args:
+    CommandLine|contains:
+    - ' -enc '
+    - ' -EncodedCommand '
+  condition: all of selection_*
+---
+title: Keywords
+id: rule-3
+detection:
+  keywords:
+  - 'mimikatz'
+  - 'sekurlsa::*'
+  condition: keywords
+---
+title: Network
+id: rule-4
+logsource:
+  category: network_connection
+detection:
+  internal:
+    DestinationIp|cidr: 10.0.0.0/8
+  port:
+    DestinationPort|gte: 1024
+  known:
+    Image|re: '(?i)\\(chrome|firefox)\.exe$'
+  condition: (internal or 1 of port) and not known
+---
+title: Event Id
+id: rule-5
+detection:
+  selection:
+    EventData.EventID:
+    - 4624
+    - 4625
+    LogonType: 3
+  condition: 1 of them
+`
+
+func TestSigmaRules(t *testing.T) {
+	rules, err := CompileRules(test_rules)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, len(rules))
+
+	rows := []*ordereddict.Dict{
+		ordereddict.NewDict().
+			Set("Image", `C:\Windows\System32\CMD.EXE`).
+			Set("CommandLine", "cmd.exe /c whoami /all").
+			Set("User", "Admin").
+			Set("ParentImage", `C:\Windows\explorer.exe`),
+
+		// Filtered out because the user is SYSTEM
+		ordereddict.NewDict().
+			Set("Image", `C:\Windows\System32\cmd.exe`).
+			Set("CommandLine", "cmd.exe /c whoami").
+			Set("User", "SYSTEM").
+			Set("ParentImage", `C:\Windows\explorer.exe`),
+
+		ordereddict.NewDict().
+			Set("Image", `C:\Windows\powershell.exe`).
+			Set("CommandLine", "powershell.exe -enc SQBFAFgA").
+			Set("Details", []interface{}{"Invoke sekurlsa::logonpasswords"}),
+
+		ordereddict.NewDict().
+			Set("Image", `C:\Program Files\Google\chrome.exe`).
+			Set("DestinationIp", "10.1.2.3").
+			Set("DestinationPort", 443),
+
+		ordereddict.NewDict().
+			Set("Image", `C:\Temp\beacon.exe`).
+			Set("DestinationIp", "8.8.8.8").
+			Set("DestinationPort", 8443),
+
+		ordereddict.NewDict().
+			Set("EventData", ordereddict.NewDict().Set("EventID", 4625)).
+			Set("LogonType", 2),
+	}
+
+	ctx := context.Background()
+	scope := vql_subsystem.MakeScope()
+	defer scope.Close()
+
+	golden := ordereddict.NewDict()
+	for idx, row := range rows {
+		matches := []string{}
+		for _, rule := range rules {
+			if rule.Match(ctx, scope, row, nil) {
+				matches = append(matches, rule.Id)
+			}
+		}
+		golden.Set(json.MustMarshalString(idx), matches)
+	}
+
+	// Field mappings translate the Sigma fields to columns.
+	mapped := ordereddict.NewDict().
+		Set("Name", `C:\Windows\cmd.exe`).
+		Set("Args", "/c whoami").
+		Set("Parent", `C:\Windows\explorer.exe`)
+	golden.Set("Mapped", rules[0].Match(ctx, scope, mapped, map[string]string{
+		"Image":       "Name",
+		"CommandLine": "Args",
+		"ParentImage": "Parent",
+	}))
+
+	golden.Set("LogSources", []bool{
+		rules[0].MatchesLogSource("windows", "process_creation", ""),
+		rules[0].MatchesLogSource("windows", "file_event", ""),
+		rules[1].MatchesLogSource("Windows", "process_creation", "security"),
+		rules[2].MatchesLogSource("", "", ""),
+	})
+
+	goldie.Assert(t, "TestSigmaRules", json.MustMarshalIndent(golden))
+}
+
+func TestSigmaRuleErrors(t *testing.T) {
+	errors := []string{}
+	for _, rule := range []string{`
+title: No condition
+detection:
+  selection:
+    Image: foo
+`, `
+title: Unknown selection
+detection:
+  selection:
+    Image: foo
+  condition: selection and other
+`, `
+title: Aggregation
+detection:
+  selection:
+    Image: foo
+  condition: selection | count() > 5
+`, `
+title: Bad modifier
+detection:
+  selection:
+    Image|base64offset: foo
+  condition: selection
+`, `
+title: Unbalanced
+detection:
+  selection:
+    Image: foo
+  condition: (selection
+`} {
+		_, err := CompileRule(rule)
+		assert.Error(t, err)
+		errors = append(errors, err.Error())
+	}
+
+	goldie.Assert(t, "TestSigmaRuleErrors", json.MustMarshalIndent(errors))
+}
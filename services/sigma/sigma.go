@@ -0,0 +1,328 @@
+/*
+  Match Sigma rules against client and server event artifacts.
+
+  Rules are stored in the datastore (see the sigma_rule_set()
+  function) and compiled when the service starts or a rule
+  changes. The sigma_log_sources section of the config lists the
+  event artifacts to watch for each Sigma logsource, and how Sigma
+  field names map to the artifact's columns. Rows which match a rule
+  are written to the Server.Detections.Sigma artifact.
+*/
+
+package sigma
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Velocidex/ordereddict"
+	api_proto "www.velocidex.com/golang/velociraptor/api/proto"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	"www.velocidex.com/golang/velociraptor/datastore"
+	"www.velocidex.com/golang/velociraptor/logging"
+	"www.velocidex.com/golang/velociraptor/paths"
+	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/services/journal"
+	"www.velocidex.com/golang/velociraptor/utils"
+	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
+	"www.velocidex.com/golang/vfilter"
+)
+
+const (
+	DETECTIONS_ARTIFACT = "Server.Detections.Sigma"
+)
+
+type logSource struct {
+	config  *config_proto.SigmaLogSource
+	mapping map[string]string
+}
+
+func newLogSource(config *config_proto.SigmaLogSource) *logSource {
+	return &logSource{
+		config:  config,
+		mapping: GetFieldMapping(config),
+	}
+}
+
+func GetFieldMapping(config *config_proto.SigmaLogSource) map[string]string {
+	result := make(map[string]string)
+	for _, mapping := range config.FieldMappings {
+		result[mapping.Field] = mapping.Column
+	}
+	return result
+}
+
+// Find the configured log source for the logsource fields.
+func FindLogSource(config_obj *config_proto.Config,
+	product, category, service string) *config_proto.SigmaLogSource {
+	for _, log_source := range config_obj.SigmaLogSources {
+		if strings.EqualFold(log_source.Product, product) &&
+			strings.EqualFold(log_source.Category, category) &&
+			strings.EqualFold(log_source.Service, service) {
+			return log_source
+		}
+	}
+	return nil
+}
+
+type SigmaManager struct {
+	mu         sync.Mutex
+	config_obj *config_proto.Config
+
+	// Key is the rule id.
+	rules    map[string]*api_proto.SigmaRule
+	compiled map[string]*Rule
+
+	// The compiled rules sorted by id for ProcessRow. Replaced
+	// whenever the rules change and never modified in place.
+	sorted []*Rule
+
+	// Key is the artifact name.
+	log_sources map[string][]*logSource
+
+	scope vfilter.Scope
+}
+
+func NewSigmaManager(config_obj *config_proto.Config) *SigmaManager {
+	result := &SigmaManager{
+		config_obj:  config_obj,
+		rules:       make(map[string]*api_proto.SigmaRule),
+		compiled:    make(map[string]*Rule),
+		log_sources: make(map[string][]*logSource),
+		scope:       vql_subsystem.MakeScope(),
+	}
+
+	for _, config := range config_obj.SigmaLogSources {
+		log_source := newLogSource(config)
+		for _, artifact := range config.Artifacts {
+			result.log_sources[artifact] = append(
+				result.log_sources[artifact], log_source)
+		}
+	}
+
+	return result
+}
+
+func (self *SigmaManager) Start(
+	ctx context.Context, wg *sync.WaitGroup) error {
+	logger := logging.GetLogger(self.config_obj, &logging.FrontendComponent)
+
+	err := self.LoadRules(ctx)
+	if err != nil {
+		return err
+	}
+
+	for artifact := range self.log_sources {
+		err := journal.WatchQueueWithCB(ctx, self.config_obj, wg,
+			artifact, "SigmaService", self.processor(artifact))
+		if err != nil {
+			return err
+		}
+
+		logger.Info("<green>Starting</> Sigma detection for %v", artifact)
+	}
+
+	return nil
+}
+
+func (self *SigmaManager) processor(artifact string) func(
+	ctx context.Context, config_obj *config_proto.Config,
+	row *ordereddict.Dict) error {
+	return func(ctx context.Context, config_obj *config_proto.Config,
+		row *ordereddict.Dict) error {
+		return self.ProcessRow(ctx, artifact, row)
+	}
+}
+
+// Match the row from the artifact against all rules for the
+// artifact's log sources.
+func (self *SigmaManager) ProcessRow(
+	ctx context.Context, artifact string, row *ordereddict.Dict) error {
+	self.mu.Lock()
+	rules := self.sorted
+	log_sources := self.log_sources[artifact]
+	self.mu.Unlock()
+
+	client_id, _ := row.GetString("ClientId")
+
+	detections := []*ordereddict.Dict{}
+	for _, log_source := range log_sources {
+		for _, rule := range rules {
+			if !rule.MatchesLogSource(log_source.config.Product,
+				log_source.config.Category, log_source.config.Service) {
+				continue
+			}
+
+			if rule.Match(ctx, self.scope, row, log_source.mapping) {
+				detections = append(detections, rule.Detection().
+					Set("Timestamp", utils.GetTime().Now().UTC()).
+					Set("Artifact", artifact).
+					Set("ClientId", client_id).
+					Set("Event", row))
+			}
+		}
+	}
+
+	if len(detections) == 0 {
+		return nil
+	}
+
+	journal, err := services.GetJournal(self.config_obj)
+	if err != nil {
+		return err
+	}
+
+	return journal.PushRowsToArtifact(ctx, self.config_obj,
+		detections, DETECTIONS_ARTIFACT, "server", "")
+}
+
+// Sort the compiled rules by id. Must be called with the lock held
+// after the compiled rules change.
+func (self *SigmaManager) sortRules() {
+	result := make([]*Rule, 0, len(self.compiled))
+	for _, rule := range self.compiled {
+		result = append(result, rule)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Id < result[j].Id
+	})
+	self.sorted = result
+}
+
+func (self *SigmaManager) SetRule(
+	ctx context.Context, principal, text string) (*api_proto.SigmaRule, error) {
+	rule, err := CompileRule(text)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &api_proto.SigmaRule{
+		Id:           rule.Id,
+		Title:        rule.Title,
+		Level:        rule.Level,
+		Rule:         text,
+		ModifiedBy:   principal,
+		ModifiedTime: utils.GetTime().Now().Unix(),
+	}
+
+	db, err := datastore.GetDB(self.config_obj)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.SetSubject(self.config_obj,
+		paths.SIGMA_RULES_ROOT.AddChild(record.Id), record)
+	if err != nil {
+		return nil, err
+	}
+
+	self.mu.Lock()
+	self.rules[record.Id] = record
+	self.compiled[record.Id] = rule
+	self.sortRules()
+	self.mu.Unlock()
+
+	return record, nil
+}
+
+func (self *SigmaManager) DeleteRule(ctx context.Context, id string) error {
+	self.mu.Lock()
+	_, pres := self.rules[id]
+	delete(self.rules, id)
+	delete(self.compiled, id)
+	if pres {
+		self.sortRules()
+	}
+	self.mu.Unlock()
+
+	if !pres {
+		return errors.New("Sigma rule not found")
+	}
+
+	db, err := datastore.GetDB(self.config_obj)
+	if err != nil {
+		return err
+	}
+
+	return db.DeleteSubject(self.config_obj,
+		paths.SIGMA_RULES_ROOT.AddChild(id))
+}
+
+func (self *SigmaManager) ListRules(
+	ctx context.Context) []*api_proto.SigmaRule {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	result := make([]*api_proto.SigmaRule, 0, len(self.rules))
+	for _, rule := range self.rules {
+		result = append(result, rule)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Id < result[j].Id
+	})
+	return result
+}
+
+// Load and compile all the stored rules. Rules which fail to compile
+// are skipped.
+func (self *SigmaManager) LoadRules(ctx context.Context) error {
+	logger := logging.GetLogger(self.config_obj, &logging.FrontendComponent)
+
+	db, err := datastore.GetDB(self.config_obj)
+	if err != nil {
+		return err
+	}
+
+	children, err := db.ListChildren(self.config_obj, paths.SIGMA_RULES_ROOT)
+	if err != nil {
+		return err
+	}
+
+	rules := make(map[string]*api_proto.SigmaRule)
+	compiled := make(map[string]*Rule)
+	for _, child := range children {
+		if child.IsDir() {
+			continue
+		}
+
+		record := &api_proto.SigmaRule{}
+		err := db.GetSubject(self.config_obj, child, record)
+		if err != nil || record.Id == "" {
+			continue
+		}
+
+		rule, err := CompileRule(record.Rule)
+		if err != nil {
+			logger.Error("Sigma: Unable to compile rule %v: %v",
+				record.Id, err)
+			continue
+		}
+
+		rules[record.Id] = record
+		compiled[record.Id] = rule
+	}
+
+	self.mu.Lock()
+	self.rules = rules
+	self.compiled = compiled
+	self.sortRules()
+	self.mu.Unlock()
+
+	logger.Info("Sigma: Loaded %v rules", len(compiled))
+
+	return nil
+}
+
+func NewSigmaService(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	config_obj *config_proto.Config) (services.SigmaService, error) {
+
+	service := NewSigmaManager(config_obj)
+	return service, service.Start(ctx, wg)
+}
@@ -0,0 +1,183 @@
+package sigma_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Velocidex/ordereddict"
+	"github.com/alecthomas/assert"
+	"github.com/sebdah/goldie"
+	"github.com/stretchr/testify/suite"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	"www.velocidex.com/golang/velociraptor/file_store/test_utils"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/services/sigma"
+	"www.velocidex.com/golang/velociraptor/utils"
+)
+
+var process_rule = `
+title: Whoami Execution
+id: whoami
+level: high
+logsource:
+  product: windows
+  category: process_creation
+detection:
+  selection:
+    Image|endswith: '\whoami.exe'
+  condition: selection
+`
+
+var network_rule = `
+title: Network Connection
+id: network
+logsource:
+  product: windows
+  category: network_connection
+detection:
+  selection:
+    DestinationPort: 4444
+  condition: selection
+`
+
+type SigmaTestSuite struct {
+	test_utils.TestSuite
+}
+
+func (self *SigmaTestSuite) SetupTest() {
+	self.ConfigObj = self.LoadConfig()
+	self.LoadArtifacts([]string{`
+name: Server.Detections.Sigma
+type: SERVER_EVENT
+`, `
+name: Windows.Events.ProcessCreation
+type: CLIENT_EVENT
+`})
+
+	self.TestSuite.SetupTest()
+}
+
+func (self *SigmaTestSuite) TestRuleStorage() {
+	service := sigma.NewSigmaManager(self.ConfigObj)
+
+	_, err := service.SetRule(self.Ctx, "admin", network_rule)
+	assert.NoError(self.T(), err)
+
+	record, err := service.SetRule(self.Ctx, "admin", process_rule)
+	assert.NoError(self.T(), err)
+	assert.Equal(self.T(), "whoami", record.Id)
+	assert.Equal(self.T(), "Whoami Execution", record.Title)
+
+	// Invalid rules are rejected.
+	_, err = service.SetRule(self.Ctx, "admin", "title: Invalid")
+	assert.Error(self.T(), err)
+
+	// A restarted service loads the stored rules.
+	service = sigma.NewSigmaManager(self.ConfigObj)
+	assert.NoError(self.T(), service.LoadRules(self.Ctx))
+
+	rules := service.ListRules(self.Ctx)
+	assert.Equal(self.T(), 2, len(rules))
+	assert.Equal(self.T(), "network", rules[0].Id)
+	assert.Equal(self.T(), "admin", rules[0].ModifiedBy)
+	assert.Equal(self.T(), "whoami", rules[1].Id)
+
+	assert.NoError(self.T(), service.DeleteRule(self.Ctx, "network"))
+	assert.Error(self.T(), service.DeleteRule(self.Ctx, "network"))
+
+	service = sigma.NewSigmaManager(self.ConfigObj)
+	assert.NoError(self.T(), service.LoadRules(self.Ctx))
+
+	rules = service.ListRules(self.Ctx)
+	assert.Equal(self.T(), 1, len(rules))
+	assert.Equal(self.T(), "whoami", rules[0].Id)
+}
+
+// Rows pushed to a watched artifact are matched against the rules
+// for the artifact's log source.
+func (self *SigmaTestSuite) TestDetections() {
+	closer := utils.MockTime(&utils.MockClock{MockNow: time.Unix(1672531200, 0)})
+	defer closer()
+
+	self.ConfigObj.SigmaLogSources = []*config_proto.SigmaLogSource{{
+		Product:   "windows",
+		Category:  "process_creation",
+		Artifacts: []string{"Windows.Events.ProcessCreation"},
+		FieldMappings: []*config_proto.SigmaFieldMapping{{
+			Field:  "Image",
+			Column: "EventData.Image",
+		}},
+	}}
+
+	journal, err := services.GetJournal(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	detections, cancel := journal.Watch(self.Ctx,
+		sigma.DETECTIONS_ARTIFACT, "test")
+	defer cancel()
+
+	service := sigma.NewSigmaManager(self.ConfigObj)
+	assert.NoError(self.T(), service.Start(self.Ctx, self.Wg))
+
+	for _, rule := range []string{process_rule, network_rule} {
+		_, err = service.SetRule(self.Ctx, "admin", rule)
+		assert.NoError(self.T(), err)
+	}
+
+	err = journal.PushRowsToArtifact(self.Ctx, self.ConfigObj,
+		[]*ordereddict.Dict{
+			ordereddict.NewDict().
+				Set("ClientId", "C.1234").
+				Set("EventData", ordereddict.NewDict().
+					Set("Image", `C:\Windows\notepad.exe`)),
+			ordereddict.NewDict().
+				Set("ClientId", "C.1234").
+				Set("EventData", ordereddict.NewDict().
+					Set("Image", `C:\Windows\System32\whoami.exe`).
+					Set("DestinationPort", 4444)),
+		}, "Windows.Events.ProcessCreation", "C.1234", "")
+	assert.NoError(self.T(), err)
+
+	golden := []*ordereddict.Dict{}
+	select {
+	case row := <-detections:
+		// The journal timestamps rows with the real time.
+		row.Delete("_ts")
+		event, _ := row.Get("Event")
+		event.(*ordereddict.Dict).Delete("_ts")
+		golden = append(golden, row)
+	case <-time.After(5 * time.Second):
+		self.T().Fatalf("Timed out waiting for detections")
+	}
+
+	goldie.Assert(self.T(), "TestDetections", json.MustMarshalIndent(golden))
+
+	// Changes to the rules apply to the next row.
+	assert.NoError(self.T(), service.DeleteRule(self.Ctx, "whoami"))
+	_, err = service.SetRule(self.Ctx, "admin",
+		strings.NewReplacer("whoami", "notepad", "Whoami", "Notepad").
+			Replace(process_rule))
+	assert.NoError(self.T(), err)
+
+	for _, image := range []string{
+		`C:\Windows\System32\whoami.exe`, `C:\Windows\notepad.exe`} {
+		err = service.ProcessRow(self.Ctx, "Windows.Events.ProcessCreation",
+			ordereddict.NewDict().Set("EventData",
+				ordereddict.NewDict().Set("Image", image)))
+		assert.NoError(self.T(), err)
+	}
+
+	select {
+	case row := <-detections:
+		rule_id, _ := row.GetString("RuleId")
+		assert.Equal(self.T(), "notepad", rule_id)
+	case <-time.After(5 * time.Second):
+		self.T().Fatalf("Timed out waiting for detections")
+	}
+}
+
+func TestSigmaService(t *testing.T) {
+	suite.Run(t, &SigmaTestSuite{})
+}
@@ -79,5 +79,7 @@ func AllServerServicesSpec() *config_proto.ServerServicesConfig {
 		Label:               true,
 		Launcher:            true,
 		NotebookService:     true,
+		WebhookService:      true,
+		SigmaService:        true,
 	}
 }
@@ -51,6 +51,10 @@ type UserManager interface {
 		principal, fav_type string) (*api_proto.Favorites, error)
 
 	DeleteUser(ctx context.Context, config_obj *config_proto.Config, username string) error
+
+	// TOTP second factor state used by the basic authenticator.
+	GetUserMFA(ctx context.Context, username string) (*api_proto.UserMFA, error)
+	SetUserMFA(ctx context.Context, username string, mfa *api_proto.UserMFA) error
 }
 
 func RegisterUserManager(dispatcher UserManager) {
@@ -41,6 +41,10 @@ func (self *UserManager) DeleteUser(
 		return err
 	}
 
+	// Remove any second factor so a new user with the same name
+	// starts afresh.
+	_ = db.DeleteSubject(root_config_obj, user_path_manager.MFA())
+
 	// Also remove the ACLs for the user from all orgs.
 	for _, org_record := range org_manager.ListOrgs() {
 		org_config_obj, err := org_manager.GetOrgConfig(org_record.Id)
@@ -0,0 +1,235 @@
+package users
+
+// TOTP second factor (RFC 6238) for the basic authenticator.
+
+// A user enrolls by generating a new secret which is loaded into an
+// authenticator app using the provisioning URI (usually rendered as
+// a QR code). The enrollment remains pending until the user logs in
+// with a valid code. Recovery codes may be used once each instead
+// of a TOTP code if the user loses their device.
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	api_proto "www.velocidex.com/golang/velociraptor/api/proto"
+	datastore "www.velocidex.com/golang/velociraptor/datastore"
+	"www.velocidex.com/golang/velociraptor/paths"
+)
+
+const (
+	TOTP_PERIOD_SEC  = 30
+	TOTP_DIGITS      = 6
+	TOTP_MODULUS     = 1000000
+	TOTP_SECRET_SIZE = 20
+
+	// Accept codes from one period either side to allow for clock
+	// skew.
+	TOTP_SKEW = 1
+
+	RECOVERY_CODE_COUNT = 10
+
+	// Recovery codes look like xxxxx-xxxxx
+	RECOVERY_CODE_LENGTH = 11
+
+	DEFAULT_MFA_ISSUER = "Velociraptor"
+)
+
+var (
+	totp_encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+)
+
+// The details the user needs to complete the enrollment. These are
+// only available at enrollment time.
+type MFAEnrollment struct {
+	Secret          string   `json:"secret"`
+	ProvisioningURI string   `json:"provisioning_uri"`
+	RecoveryCodes   []string `json:"recovery_codes"`
+}
+
+// Start a new enrollment for the user.
+func NewMFAEnrollment(issuer, username string) (
+	*api_proto.UserMFA, *MFAEnrollment, error) {
+	if issuer == "" {
+		issuer = DEFAULT_MFA_ISSUER
+	}
+
+	secret := make([]byte, TOTP_SECRET_SIZE)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mfa := &api_proto.UserMFA{
+		Secret: totp_encoding.EncodeToString(secret),
+	}
+
+	enrollment := &MFAEnrollment{
+		Secret:          mfa.Secret,
+		ProvisioningURI: GetTOTPProvisioningURI(issuer, username, mfa.Secret),
+	}
+
+	for i := 0; i < RECOVERY_CODE_COUNT; i++ {
+		code := make([]byte, 8)
+		_, err := rand.Read(code)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		encoded := strings.ToLower(totp_encoding.EncodeToString(code))
+		recovery_code := encoded[:5] + "-" + encoded[5:10]
+
+		enrollment.RecoveryCodes = append(enrollment.RecoveryCodes, recovery_code)
+		mfa.RecoveryCodes = append(mfa.RecoveryCodes, hashRecoveryCode(recovery_code))
+	}
+
+	return mfa, enrollment, nil
+}
+
+// The otpauth:// URI understood by authenticator apps.
+func GetTOTPProvisioningURI(issuer, username, secret string) string {
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprintf("%d", TOTP_DIGITS))
+	params.Set("period", fmt.Sprintf("%d", TOTP_PERIOD_SEC))
+
+	return "otpauth://totp/" + url.PathEscape(issuer+":"+username) +
+		"?" + params.Encode()
+}
+
+// Calculate the TOTP code for the time step.
+func GetTOTPCode(secret string, step uint64) (string, error) {
+	key, err := totp_encoding.DecodeString(
+		strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return "", err
+	}
+
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, step)
+
+	mac := hmac.New(sha1.New, key)
+	_, _ = mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation (RFC 4226 section 5.3)
+	offset := sum[len(sum)-1] & 0xf
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", TOTP_DIGITS, value%TOTP_MODULUS), nil
+}
+
+func GetTOTPStep(now time.Time) uint64 {
+	return uint64(now.Unix()) / TOTP_PERIOD_SEC
+}
+
+// Verify a TOTP code. Codes may only be used once so the time step
+// is recorded in the mfa record on success.
+func VerifyTOTP(mfa *api_proto.UserMFA, code string, now time.Time) bool {
+	if mfa == nil || mfa.Secret == "" || len(code) != TOTP_DIGITS {
+		return false
+	}
+
+	current := GetTOTPStep(now)
+	for step := current - TOTP_SKEW; step <= current+TOTP_SKEW; step++ {
+		if step <= mfa.LastStep {
+			continue
+		}
+
+		expected, err := GetTOTPCode(mfa.Secret, step)
+		if err != nil {
+			return false
+		}
+
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			mfa.LastStep = step
+			return true
+		}
+	}
+
+	return false
+}
+
+func hashRecoveryCode(code string) string {
+	hash := sha256.Sum256([]byte(strings.ToLower(code)))
+	return hex.EncodeToString(hash[:])
+}
+
+// Verify a recovery code. Each code may only be used once so it is
+// removed from the mfa record on success.
+func VerifyRecoveryCode(mfa *api_proto.UserMFA, code string) bool {
+	if mfa == nil || len(code) != RECOVERY_CODE_LENGTH {
+		return false
+	}
+
+	hash := hashRecoveryCode(code)
+	for idx, item := range mfa.RecoveryCodes {
+		if subtle.ConstantTimeCompare([]byte(item), []byte(hash)) == 1 {
+			mfa.RecoveryCodes = append(mfa.RecoveryCodes[:idx],
+				mfa.RecoveryCodes[idx+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// Get the user's second factor state. Returns an empty record if the
+// user never enrolled.
+func (self UserManager) GetUserMFA(ctx context.Context, username string) (
+	*api_proto.UserMFA, error) {
+	err := validateUsername(self.config_obj, username)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := datastore.GetDB(self.config_obj)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &api_proto.UserMFA{}
+	err = db.GetSubject(self.config_obj,
+		paths.UserPathManager{Name: username}.MFA(), result)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Store the user's second factor state. A nil record removes it.
+func (self UserManager) SetUserMFA(ctx context.Context, username string,
+	mfa *api_proto.UserMFA) error {
+	err := validateUsername(self.config_obj, username)
+	if err != nil {
+		return err
+	}
+
+	db, err := datastore.GetDB(self.config_obj)
+	if err != nil {
+		return err
+	}
+
+	path := paths.UserPathManager{Name: username}.MFA()
+	if mfa == nil {
+		return db.DeleteSubject(self.config_obj, path)
+	}
+
+	return db.SetSubject(self.config_obj, path, mfa)
+}
@@ -0,0 +1,79 @@
+package users
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	api_proto "www.velocidex.com/golang/velociraptor/api/proto"
+)
+
+// Test vectors from RFC 6238 Appendix B (SHA1) truncated to 6 digits.
+func TestTOTPCode(t *testing.T) {
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+	for _, test := range []struct {
+		time int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	} {
+		code, err := GetTOTPCode(secret, GetTOTPStep(time.Unix(test.time, 0)))
+		assert.NoError(t, err)
+		assert.Equal(t, test.code, code)
+	}
+}
+
+func TestVerifyTOTP(t *testing.T) {
+	mfa, enrollment, err := NewMFAEnrollment("", "bob")
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(enrollment.ProvisioningURI,
+		"otpauth://totp/Velociraptor:bob?"))
+	assert.Contains(t, enrollment.ProvisioningURI, "secret="+mfa.Secret)
+
+	now := time.Unix(1700000000, 0)
+	code, err := GetTOTPCode(mfa.Secret, GetTOTPStep(now))
+	assert.NoError(t, err)
+
+	assert.False(t, VerifyTOTP(mfa, "000000x", now))
+	assert.True(t, VerifyTOTP(mfa, code, now))
+
+	// Codes can not be replayed.
+	assert.False(t, VerifyTOTP(mfa, code, now))
+
+	// Allow for some clock skew.
+	next, err := GetTOTPCode(mfa.Secret, GetTOTPStep(now)+1)
+	assert.NoError(t, err)
+	assert.True(t, VerifyTOTP(mfa, next, now))
+
+	// But not too much.
+	later, err := GetTOTPCode(mfa.Secret, GetTOTPStep(now)+3)
+	assert.NoError(t, err)
+	assert.False(t, VerifyTOTP(mfa, later, now))
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	mfa, enrollment, err := NewMFAEnrollment("Issuer", "bob")
+	assert.NoError(t, err)
+	assert.Equal(t, RECOVERY_CODE_COUNT, len(enrollment.RecoveryCodes))
+
+	// Only hashes are stored.
+	for _, code := range enrollment.RecoveryCodes {
+		assert.Equal(t, RECOVERY_CODE_LENGTH, len(code))
+		assert.NotContains(t, mfa.RecoveryCodes, code)
+	}
+
+	code := enrollment.RecoveryCodes[3]
+	assert.True(t, VerifyRecoveryCode(mfa, strings.ToUpper(code)))
+	assert.Equal(t, RECOVERY_CODE_COUNT-1, len(mfa.RecoveryCodes))
+
+	// Each code may only be used once.
+	assert.False(t, VerifyRecoveryCode(mfa, code))
+	assert.False(t, VerifyRecoveryCode(&api_proto.UserMFA{}, code))
+}
@@ -0,0 +1,343 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Velocidex/ordereddict"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	"www.velocidex.com/golang/velociraptor/constants"
+	"www.velocidex.com/golang/velociraptor/crypto"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/utils"
+	"www.velocidex.com/golang/velociraptor/vql/networking"
+)
+
+const (
+	FORMAT_JSON  = "json"
+	FORMAT_SLACK = "slack"
+	FORMAT_TEAMS = "teams"
+
+	DEFAULT_JSON_TEMPLATE = `{"Webhook": {_Webhook}, "Artifact": {_Artifact}, "Row": {_Row}}`
+	DEFAULT_TEXT_TEMPLATE = "*{_Artifact}*\n```{_Row}```"
+
+	DEFAULT_HMAC_HEADER      = "X-Velociraptor-Signature"
+	HMAC_TIMESTAMP_HEADER    = "X-Velociraptor-Timestamp"
+	DEFAULT_MAX_RETRIES      = 10
+	DEFAULT_INITIAL_BACKOFF  = 10
+	DEFAULT_MAX_BACKOFF      = 3600
+	DEFAULT_REQUEST_TIMEOUT  = 30
+	DEFAULT_MAX_QUEUE        = 10000
+	MAX_RESPONSE_ERROR_BYTES = 256
+)
+
+var (
+	// Only identifiers are expanded so JSON braces in templates are
+	// left alone.
+	placeholder_re = regexp.MustCompile(`\{([a-zA-Z0-9_.]+)\}`)
+
+	errInvalidPayload = errors.New("Invalid payload")
+)
+
+// Returned when the receiver rejects the request.
+type statusError struct {
+	status  int
+	message string
+}
+
+func (self statusError) Error() string {
+	if self.message == "" {
+		return fmt.Sprintf("HTTP status %v", self.status)
+	}
+	return fmt.Sprintf("HTTP status %v: %v", self.status, self.message)
+}
+
+// A delivery is not retried if the receiver tells us the request
+// itself is bad. Timeouts and rate limiting are transient.
+func isRetriable(err error) bool {
+	status_err, ok := err.(statusError)
+	if !ok {
+		return !errors.Is(err, errInvalidPayload)
+	}
+
+	switch status_err.status {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return true
+	}
+	return status_err.status < 400 || status_err.status >= 500
+}
+
+type webhook struct {
+	config  *config_proto.WebhookConfig
+	client  *http.Client
+	headers [][2]string
+
+	format      string
+	template    string
+	hmac_header string
+
+	max_retries     uint64
+	max_queue       int
+	initial_backoff time.Duration
+	max_backoff     time.Duration
+}
+
+func newWebhook(config_obj *config_proto.Config,
+	webhook_config *config_proto.WebhookConfig) (*webhook, error) {
+	if webhook_config.Name == "" {
+		return nil, errors.New("Webhook name must be set")
+	}
+
+	if webhook_config.Url == "" {
+		return nil, fmt.Errorf("Webhook %v: url must be set", webhook_config.Name)
+	}
+
+	result := &webhook{
+		config:          webhook_config,
+		format:          strings.ToLower(webhook_config.Format),
+		template:        webhook_config.Template,
+		hmac_header:     webhook_config.HmacHeader,
+		max_retries:     webhook_config.MaxRetries,
+		max_queue:       int(webhook_config.MaxQueue),
+		initial_backoff: time.Duration(webhook_config.InitialBackoffSec) * time.Second,
+		max_backoff:     time.Duration(webhook_config.MaxBackoffSec) * time.Second,
+	}
+
+	switch result.format {
+	case "":
+		result.format = FORMAT_JSON
+	case FORMAT_JSON, FORMAT_SLACK, FORMAT_TEAMS:
+	default:
+		return nil, fmt.Errorf(
+			"Webhook %v: unsupported format %v (should be json, slack or teams)",
+			webhook_config.Name, webhook_config.Format)
+	}
+
+	if result.template == "" {
+		result.template = DEFAULT_TEXT_TEMPLATE
+		if result.format == FORMAT_JSON {
+			result.template = DEFAULT_JSON_TEMPLATE
+		}
+	}
+
+	if result.hmac_header == "" {
+		result.hmac_header = DEFAULT_HMAC_HEADER
+	}
+
+	if result.max_retries == 0 {
+		result.max_retries = DEFAULT_MAX_RETRIES
+	}
+
+	if result.max_queue == 0 {
+		result.max_queue = DEFAULT_MAX_QUEUE
+	}
+
+	if result.initial_backoff == 0 {
+		result.initial_backoff = DEFAULT_INITIAL_BACKOFF * time.Second
+	}
+
+	if result.max_backoff == 0 {
+		result.max_backoff = DEFAULT_MAX_BACKOFF * time.Second
+	}
+
+	for _, header := range webhook_config.Headers {
+		parts := strings.SplitN(header, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("Webhook %v: invalid header %v (should be Name: Value)",
+				webhook_config.Name, header)
+		}
+		result.headers = append(result.headers, [2]string{
+			strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])})
+	}
+
+	CA_Pool := x509.NewCertPool()
+	crypto.AddPublicRoots(CA_Pool)
+	err := crypto.AddDefaultCerts(config_obj.Client, CA_Pool)
+	if err != nil {
+		return nil, err
+	}
+
+	if webhook_config.RootCa != "" &&
+		!CA_Pool.AppendCertsFromPEM([]byte(webhook_config.RootCa)) {
+		return nil, fmt.Errorf("Webhook %v: Unable to add root certs",
+			webhook_config.Name)
+	}
+
+	timeout := webhook_config.TimeoutSec
+	if timeout == 0 {
+		timeout = DEFAULT_REQUEST_TIMEOUT
+	}
+
+	result.client = &http.Client{
+		Timeout: time.Duration(timeout) * time.Second,
+		Transport: &http.Transport{
+			Proxy: networking.GetProxy(),
+			TLSClientConfig: &tls.Config{
+				RootCAs:            CA_Pool,
+				InsecureSkipVerify: webhook_config.SkipVerify,
+			},
+		},
+	}
+
+	return result, nil
+}
+
+// The delay before the next attempt doubles with each failure.
+func (self *webhook) backoff(attempts uint64) time.Duration {
+	result := self.initial_backoff
+	for i := uint64(1); i < attempts; i++ {
+		result *= 2
+		if result >= self.max_backoff {
+			return self.max_backoff
+		}
+	}
+
+	if result > self.max_backoff {
+		return self.max_backoff
+	}
+	return result
+}
+
+// Build the request body for the row.
+func (self *webhook) payload(artifact string, row *ordereddict.Dict) ([]byte, error) {
+	extra := ordereddict.NewDict().
+		Set("_Webhook", self.config.Name).
+		Set("_Artifact", artifact).
+		Set("_Row", row)
+
+	lookup := func(name string) (interface{}, bool) {
+		value, pres := row.Get(name)
+		if pres {
+			return value, true
+		}
+		return extra.Get(name)
+	}
+
+	switch self.format {
+	case FORMAT_SLACK:
+		return json.Marshal(ordereddict.NewDict().
+			Set("text", expandText(self.template, lookup)))
+
+	case FORMAT_TEAMS:
+		return json.Marshal(ordereddict.NewDict().
+			Set("@type", "MessageCard").
+			Set("@context", "https://schema.org/extensions").
+			Set("summary", artifact).
+			Set("title", artifact).
+			Set("text", expandText(self.template, lookup)))
+	}
+
+	// JSON templates receive JSON encoded values so the result is
+	// still a valid document.
+	result := placeholder_re.ReplaceAllStringFunc(self.template,
+		func(match string) string {
+			value, pres := lookup(match[1 : len(match)-1])
+			if !pres {
+				return "null"
+			}
+
+			data, err := json.Marshal(value)
+			if err != nil {
+				return "null"
+			}
+			return string(data)
+		})
+
+	var parsed interface{}
+	err := json.Unmarshal([]byte(result), &parsed)
+	if err != nil {
+		return nil, fmt.Errorf("%w: template does not produce valid JSON",
+			errInvalidPayload)
+	}
+
+	return []byte(result), nil
+}
+
+// Expand {Column} placeholders as text. Strings are used as is,
+// anything else is JSON encoded.
+func expandText(template string,
+	lookup func(name string) (interface{}, bool)) string {
+	return placeholder_re.ReplaceAllStringFunc(template,
+		func(match string) string {
+			value, _ := lookup(match[1 : len(match)-1])
+			if utils.IsNil(value) {
+				return ""
+			}
+
+			switch t := value.(type) {
+			case string:
+				return t
+			case []byte:
+				return string(t)
+			}
+
+			data, err := json.Marshal(value)
+			if err != nil {
+				return utils.ToString(value)
+			}
+			return string(data)
+		})
+}
+
+// The signature covers the timestamp so a captured request can not
+// be replayed later.
+func sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (self *webhook) send(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", self.config.Url,
+		bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", constants.USER_AGENT)
+	for _, header := range self.headers {
+		req.Header.Set(header[0], header[1])
+	}
+
+	if self.config.HmacSecret != "" {
+		timestamp := strconv.FormatInt(utils.GetTime().Now().Unix(), 10)
+		req.Header.Set(HMAC_TIMESTAMP_HEADER, timestamp)
+		req.Header.Set(self.hmac_header,
+			sign(self.config.HmacSecret, timestamp, body))
+	}
+
+	resp, err := self.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		return nil
+	}
+
+	message, _ := ioutil.ReadAll(io.LimitReader(
+		resp.Body, MAX_RESPONSE_ERROR_BYTES))
+	return statusError{
+		status:  resp.StatusCode,
+		message: strings.TrimSpace(string(message)),
+	}
+}
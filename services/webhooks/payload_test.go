@@ -0,0 +1,75 @@
+package webhooks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Velocidex/ordereddict"
+	"github.com/alecthomas/assert"
+	"github.com/sebdah/goldie"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	"www.velocidex.com/golang/velociraptor/json"
+)
+
+func TestPayloads(t *testing.T) {
+	row := ordereddict.NewDict().
+		Set("Fqdn", "host.example.com").
+		Set("Message", "Process \"evil.exe\" started").
+		Set("Count", 3)
+
+	golden := ordereddict.NewDict()
+	for _, webhook_config := range []*config_proto.WebhookConfig{
+		{Name: "json"},
+		{Name: "json_template",
+			Template: `{"host": {Fqdn}, "alert": {Message}, "missing": {Missing}}`},
+		{Name: "slack", Format: "slack"},
+		{Name: "teams", Format: "teams",
+			Template: "{Fqdn}: {Message} ({Count})"},
+	} {
+		webhook_config.Url = "http://localhost/"
+		hook, err := newWebhook(&config_proto.Config{}, webhook_config)
+		assert.NoError(t, err)
+
+		body, err := hook.payload("Server.Monitor.Alerts", row)
+		assert.NoError(t, err)
+
+		payload := ordereddict.NewDict()
+		assert.NoError(t, payload.UnmarshalJSON(body))
+		golden.Set(webhook_config.Name, payload)
+	}
+
+	// Templates which do not produce JSON are not retried.
+	hook, err := newWebhook(&config_proto.Config{}, &config_proto.WebhookConfig{
+		Name: "bad", Url: "http://localhost/", Template: `{"host": "{Fqdn}"}`})
+	assert.NoError(t, err)
+
+	_, err = hook.payload("Server.Monitor.Alerts", row)
+	assert.Error(t, err)
+	assert.False(t, isRetriable(err))
+
+	goldie.Assert(t, "TestPayloads", json.MustMarshalIndent(golden))
+}
+
+func TestWebhookConfig(t *testing.T) {
+	_, err := newWebhook(&config_proto.Config{}, &config_proto.WebhookConfig{
+		Name: "test", Url: "http://localhost/", Format: "xml"})
+	assert.Error(t, err)
+
+	_, err = newWebhook(&config_proto.Config{}, &config_proto.WebhookConfig{
+		Name: "test", Url: "http://localhost/", Headers: []string{"Invalid"}})
+	assert.Error(t, err)
+
+	hook, err := newWebhook(&config_proto.Config{}, &config_proto.WebhookConfig{
+		Name: "test", Url: "http://localhost/", MaxBackoffSec: 60})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 10*time.Second, hook.backoff(1))
+	assert.Equal(t, 20*time.Second, hook.backoff(2))
+	assert.Equal(t, 40*time.Second, hook.backoff(3))
+	assert.Equal(t, 60*time.Second, hook.backoff(4))
+	assert.Equal(t, 60*time.Second, hook.backoff(100))
+
+	assert.True(t, isRetriable(statusError{status: 503}))
+	assert.True(t, isRetriable(statusError{status: 429}))
+	assert.False(t, isRetriable(statusError{status: 404}))
+}
@@ -0,0 +1,421 @@
+/*
+  Deliver rows from server event artifacts to webhooks.
+
+  Each row received from a watched artifact is written to the
+  datastore before delivery is attempted, so pending deliveries
+  survive a server restart. Failed deliveries are retried with
+  exponential backoff. Rows which can not be delivered are moved to
+  the Server.Internal.WebhookDeadLetter artifact where they are
+  visible in the GUI. The queue for each webhook is bounded by its
+  max_queue setting - when the receiver is down for long, further
+  rows go straight to the dead letter queue.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Velocidex/ordereddict"
+	api_proto "www.velocidex.com/golang/velociraptor/api/proto"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	"www.velocidex.com/golang/velociraptor/datastore"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/logging"
+	"www.velocidex.com/golang/velociraptor/paths"
+	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/services/journal"
+	"www.velocidex.com/golang/velociraptor/utils"
+)
+
+const (
+	DEAD_LETTER_ARTIFACT = "Server.Internal.WebhookDeadLetter"
+)
+
+type WebhookManager struct {
+	mu         sync.Mutex
+	config_obj *config_proto.Config
+
+	webhooks map[string]*webhook
+
+	// Deliveries which are not yet complete, keyed by id.
+	pending map[string]*api_proto.WebhookDelivery
+
+	// Number of queued deliveries for each webhook.
+	queued map[string]int
+
+	// Wakes up the webhook's worker when a new row is queued.
+	wakeup map[string]chan bool
+
+	id_counter uint64
+}
+
+func NewWebhookManager(
+	config_obj *config_proto.Config) (*WebhookManager, error) {
+	result := &WebhookManager{
+		config_obj: config_obj,
+		webhooks:   make(map[string]*webhook),
+		pending:    make(map[string]*api_proto.WebhookDelivery),
+		queued:     make(map[string]int),
+		wakeup:     make(map[string]chan bool),
+	}
+
+	for _, webhook_config := range config_obj.Webhooks {
+		hook, err := newWebhook(config_obj, webhook_config)
+		if err != nil {
+			return nil, err
+		}
+
+		_, pres := result.webhooks[webhook_config.Name]
+		if pres {
+			return nil, fmt.Errorf("Webhook %v is defined more than once",
+				webhook_config.Name)
+		}
+
+		result.webhooks[webhook_config.Name] = hook
+		result.wakeup[webhook_config.Name] = make(chan bool, 1)
+	}
+
+	return result, nil
+}
+
+func (self *WebhookManager) Start(
+	ctx context.Context, wg *sync.WaitGroup) error {
+	logger := logging.GetLogger(self.config_obj, &logging.FrontendComponent)
+
+	err := self.LoadQueue(ctx)
+	if err != nil {
+		return err
+	}
+
+	for name, hook := range self.webhooks {
+		for _, artifact := range hook.config.Artifacts {
+			err := journal.WatchQueueWithCB(ctx, self.config_obj, wg,
+				artifact, "WebhookService."+name, self.processor(name, artifact))
+			if err != nil {
+				return err
+			}
+		}
+
+		logger.Info("<green>Starting</> webhook %v for %v", name,
+			hook.config.Artifacts)
+
+		wg.Add(1)
+		go self.worker(ctx, wg, name, self.wakeup[name])
+	}
+
+	return nil
+}
+
+func (self *WebhookManager) processor(name, artifact string) func(
+	ctx context.Context, config_obj *config_proto.Config,
+	row *ordereddict.Dict) error {
+	return func(ctx context.Context, config_obj *config_proto.Config,
+		row *ordereddict.Dict) error {
+		return self.Enqueue(ctx, name, artifact, row)
+	}
+}
+
+// Persist the row then wake up the worker to deliver it.
+func (self *WebhookManager) Enqueue(ctx context.Context,
+	name, artifact string, row *ordereddict.Dict) error {
+	hook, pres := self.webhooks[name]
+	if !pres {
+		return fmt.Errorf("Webhook %v is not configured", name)
+	}
+
+	serialized, err := json.Marshal(row)
+	if err != nil {
+		return err
+	}
+
+	now := utils.GetTime().Now()
+	delivery := &api_proto.WebhookDelivery{
+		Id: fmt.Sprintf("%d_%08d", now.UnixNano(),
+			atomic.AddUint64(&self.id_counter, 1)),
+		Webhook:     name,
+		Artifact:    artifact,
+		Row:         string(serialized),
+		CreateTime:  now.Unix(),
+		NextAttempt: now.Unix(),
+	}
+
+	// Reserve a place in the queue before storing the delivery.
+	self.mu.Lock()
+	if self.queued[name] >= hook.max_queue {
+		self.mu.Unlock()
+
+		delivery.LastError = "Webhook queue is full"
+		self.deadLetter(ctx, delivery)
+		return nil
+	}
+	self.queued[name]++
+	self.mu.Unlock()
+
+	err = self.store(delivery)
+	if err != nil {
+		self.mu.Lock()
+		self.queued[name]--
+		self.mu.Unlock()
+		return err
+	}
+
+	self.mu.Lock()
+	self.pending[delivery.Id] = delivery
+	wakeup := self.wakeup[name]
+	self.mu.Unlock()
+
+	select {
+	case wakeup <- true:
+	default:
+	}
+
+	return nil
+}
+
+func (self *WebhookManager) worker(ctx context.Context,
+	wg *sync.WaitGroup, name string, wakeup chan bool) {
+	defer wg.Done()
+
+	for {
+		self.DeliverDue(ctx, name)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-wakeup:
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// Attempt all deliveries for the webhook which are due, oldest
+// first. When the receiver is down we stop at the first transient
+// failure and postpone the rest until the failed delivery is retried.
+func (self *WebhookManager) DeliverDue(ctx context.Context, name string) {
+	hook, pres := self.webhooks[name]
+	if !pres {
+		return
+	}
+
+	now := utils.GetTime().Now()
+	logger := logging.GetLogger(self.config_obj, &logging.FrontendComponent)
+
+	due := self.getDue(name, now.Unix())
+	for idx, delivery := range due {
+		if ctx.Err() != nil {
+			return
+		}
+
+		err := self.deliver(ctx, hook, delivery)
+		if err == nil {
+			self.remove(delivery)
+			continue
+		}
+
+		delivery.Attempts++
+		delivery.LastError = err.Error()
+
+		if !isRetriable(err) || delivery.Attempts > hook.max_retries {
+			logger.Error("Webhook %v: Giving up on delivery after %v attempts: %v",
+				hook.config.Name, delivery.Attempts, err)
+			self.deadLetter(ctx, delivery)
+			continue
+		}
+
+		delivery.NextAttempt = now.Add(hook.backoff(delivery.Attempts)).Unix()
+		err = self.store(delivery)
+		if err != nil {
+			logger.Error("Webhook %v: %v", hook.config.Name, err)
+		}
+
+		self.mu.Lock()
+		for _, postponed := range due[idx+1:] {
+			postponed.NextAttempt = delivery.NextAttempt
+		}
+		self.mu.Unlock()
+		return
+	}
+}
+
+func (self *WebhookManager) deliver(ctx context.Context,
+	hook *webhook, delivery *api_proto.WebhookDelivery) error {
+	row := ordereddict.NewDict()
+	err := row.UnmarshalJSON([]byte(delivery.Row))
+	if err != nil {
+		return fmt.Errorf("%w: %v", errInvalidPayload, err)
+	}
+
+	body, err := hook.payload(delivery.Artifact, row)
+	if err != nil {
+		return err
+	}
+
+	return hook.send(ctx, body)
+}
+
+// Deliveries which are still pending, oldest first.
+func (self *WebhookManager) Pending(name string) []*api_proto.WebhookDelivery {
+	return self.getDue(name, math.MaxInt64)
+}
+
+func (self *WebhookManager) getDue(
+	name string, now int64) []*api_proto.WebhookDelivery {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	result := []*api_proto.WebhookDelivery{}
+	for _, delivery := range self.pending {
+		if delivery.Webhook == name && delivery.NextAttempt <= now {
+			result = append(result, delivery)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].CreateTime != result[j].CreateTime {
+			return result[i].CreateTime < result[j].CreateTime
+		}
+		return result[i].Id < result[j].Id
+	})
+
+	return result
+}
+
+// Move the delivery to the dead letter queue.
+func (self *WebhookManager) deadLetter(ctx context.Context,
+	delivery *api_proto.WebhookDelivery) {
+	var row interface{} = delivery.Row
+	row_dict := ordereddict.NewDict()
+	if row_dict.UnmarshalJSON([]byte(delivery.Row)) == nil {
+		row = row_dict
+	}
+
+	journal, err := services.GetJournal(self.config_obj)
+	if err == nil {
+		err = journal.PushRowsToArtifact(ctx, self.config_obj,
+			[]*ordereddict.Dict{ordereddict.NewDict().
+				Set("Webhook", delivery.Webhook).
+				Set("Artifact", delivery.Artifact).
+				Set("Attempts", delivery.Attempts).
+				Set("LastError", delivery.LastError).
+				Set("FirstAttempt", time.Unix(delivery.CreateTime, 0).UTC()).
+				Set("Row", row)},
+			DEAD_LETTER_ARTIFACT, "server", "")
+	}
+
+	if err != nil {
+		logger := logging.GetLogger(self.config_obj, &logging.FrontendComponent)
+		logger.Error("Webhook %v: Unable to write dead letter: %v",
+			delivery.Webhook, err)
+	}
+
+	self.remove(delivery)
+}
+
+func (self *WebhookManager) store(delivery *api_proto.WebhookDelivery) error {
+	db, err := datastore.GetDB(self.config_obj)
+	if err != nil {
+		return err
+	}
+
+	return db.SetSubject(self.config_obj,
+		paths.WEBHOOK_QUEUE_ROOT.AddChild(delivery.Id), delivery)
+}
+
+func (self *WebhookManager) remove(delivery *api_proto.WebhookDelivery) {
+	self.mu.Lock()
+	_, pres := self.pending[delivery.Id]
+	if pres {
+		delete(self.pending, delivery.Id)
+		self.queued[delivery.Webhook]--
+	}
+	self.mu.Unlock()
+
+	db, err := datastore.GetDB(self.config_obj)
+	if err != nil {
+		return
+	}
+
+	_ = db.DeleteSubject(self.config_obj,
+		paths.WEBHOOK_QUEUE_ROOT.AddChild(delivery.Id))
+}
+
+// Reload deliveries which were pending when the server last
+// stopped. Deliveries for webhooks which are no longer configured
+// can never be sent so they go straight to the dead letter queue.
+func (self *WebhookManager) LoadQueue(ctx context.Context) error {
+	db, err := datastore.GetDB(self.config_obj)
+	if err != nil {
+		return err
+	}
+
+	children, err := db.ListChildren(self.config_obj, paths.WEBHOOK_QUEUE_ROOT)
+	if err != nil {
+		return err
+	}
+
+	// Delivery ids start with the time so the oldest are loaded
+	// first.
+	sort.Slice(children, func(i, j int) bool {
+		return children[i].Base() < children[j].Base()
+	})
+
+	for _, child := range children {
+		if child.IsDir() {
+			continue
+		}
+
+		delivery := &api_proto.WebhookDelivery{}
+		err := db.GetSubject(self.config_obj, child, delivery)
+		if err != nil || delivery.Id == "" {
+			continue
+		}
+
+		hook, pres := self.webhooks[delivery.Webhook]
+		if !pres {
+			delivery.LastError = "Webhook is no longer configured"
+			self.deadLetter(ctx, delivery)
+			continue
+		}
+
+		// The limit may have been lowered since the rows were
+		// queued.
+		self.mu.Lock()
+		full := self.queued[delivery.Webhook] >= hook.max_queue
+		if !full {
+			self.pending[delivery.Id] = delivery
+			self.queued[delivery.Webhook]++
+		}
+		self.mu.Unlock()
+
+		if full {
+			delivery.LastError = "Webhook queue is full"
+			self.deadLetter(ctx, delivery)
+		}
+	}
+
+	return nil
+}
+
+func NewWebhookService(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	config_obj *config_proto.Config) error {
+
+	if len(config_obj.Webhooks) == 0 {
+		return nil
+	}
+
+	service, err := NewWebhookManager(config_obj)
+	if err != nil {
+		return err
+	}
+
+	return service.Start(ctx, wg)
+}
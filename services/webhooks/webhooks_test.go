@@ -0,0 +1,339 @@
+package webhooks_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/Velocidex/ordereddict"
+	"github.com/alecthomas/assert"
+	"github.com/sebdah/goldie"
+	"github.com/stretchr/testify/suite"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	"www.velocidex.com/golang/velociraptor/file_store/test_utils"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/services/webhooks"
+	"www.velocidex.com/golang/velociraptor/utils"
+	"www.velocidex.com/golang/velociraptor/vtesting"
+)
+
+type request struct {
+	headers http.Header
+	body    string
+}
+
+type WebhookTestSuite struct {
+	test_utils.TestSuite
+
+	server *httptest.Server
+
+	mu       sync.Mutex
+	requests []request
+
+	// Status codes to return for the next requests. After these are
+	// used up requests succeed.
+	statuses []int
+}
+
+func (self *WebhookTestSuite) SetupTest() {
+	self.ConfigObj = self.LoadConfig()
+	self.LoadArtifacts([]string{`
+name: Server.Internal.WebhookDeadLetter
+type: SERVER_EVENT
+`, `
+name: Server.Monitor.Alerts
+type: SERVER_EVENT
+`})
+
+	self.TestSuite.SetupTest()
+
+	self.requests = nil
+	self.statuses = nil
+	self.server = httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+
+			self.mu.Lock()
+			defer self.mu.Unlock()
+
+			self.requests = append(self.requests, request{
+				headers: r.Header, body: string(body)})
+
+			if len(self.statuses) > 0 {
+				status := self.statuses[0]
+				self.statuses = self.statuses[1:]
+				w.WriteHeader(status)
+				_, _ = w.Write([]byte("Try again later"))
+			}
+		}))
+}
+
+func (self *WebhookTestSuite) TearDownTest() {
+	self.server.Close()
+	self.TestSuite.TearDownTest()
+}
+
+func (self *WebhookTestSuite) getRequests() []request {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	return append([]request{}, self.requests...)
+}
+
+func (self *WebhookTestSuite) makeService(
+	webhook_configs ...*config_proto.WebhookConfig) *webhooks.WebhookManager {
+	self.ConfigObj.Webhooks = webhook_configs
+	service, err := webhooks.NewWebhookManager(self.ConfigObj)
+	assert.NoError(self.T(), err)
+	return service
+}
+
+func (self *WebhookTestSuite) TestSigning() {
+	closer := utils.MockTime(&utils.MockClock{MockNow: time.Unix(1672531200, 0)})
+	defer closer()
+
+	service := self.makeService(&config_proto.WebhookConfig{
+		Name:       "signed",
+		Url:        self.server.URL,
+		HmacSecret: "secret",
+		Headers:    []string{"Authorization: Bearer token"},
+	})
+	err := service.Enqueue(self.Ctx, "signed", "Server.Monitor.Alerts",
+		ordereddict.NewDict().Set("Message", "Hello"))
+	assert.NoError(self.T(), err)
+
+	service.DeliverDue(self.Ctx, "signed")
+
+	requests := self.getRequests()
+	assert.Equal(self.T(), 1, len(requests))
+	assert.Equal(self.T(), `{"Webhook": "signed", "Artifact": "Server.Monitor.Alerts", "Row": {"Message":"Hello"}}`,
+		requests[0].body)
+	assert.Equal(self.T(), "Bearer token",
+		requests[0].headers.Get("Authorization"))
+	assert.Equal(self.T(), "1672531200",
+		requests[0].headers.Get(webhooks.HMAC_TIMESTAMP_HEADER))
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("1672531200." + requests[0].body))
+	assert.Equal(self.T(), "sha256="+hex.EncodeToString(mac.Sum(nil)),
+		requests[0].headers.Get(webhooks.DEFAULT_HMAC_HEADER))
+
+	// The delivery is removed from the queue.
+	assert.Equal(self.T(), 0, len(service.Pending("signed")))
+}
+
+func (self *WebhookTestSuite) TestRetries() {
+	clock := &utils.MockClock{MockNow: time.Unix(1672531200, 0)}
+	closer := utils.MockTime(clock)
+	defer closer()
+
+	self.statuses = []int{503, 429}
+
+	service := self.makeService(&config_proto.WebhookConfig{
+		Name:              "retry",
+		Url:               self.server.URL,
+		InitialBackoffSec: 10,
+	})
+
+	for i := 0; i < 2; i++ {
+		err := service.Enqueue(self.Ctx, "retry", "Server.Monitor.Alerts",
+			ordereddict.NewDict().Set("Count", i))
+		assert.NoError(self.T(), err)
+	}
+
+	// The first attempt fails and the rest are left for later.
+	service.DeliverDue(self.Ctx, "retry")
+	assert.Equal(self.T(), 1, len(self.getRequests()))
+
+	// Nothing is due until the backoff expires.
+	clock.MockNow = clock.MockNow.Add(5 * time.Second)
+	service.DeliverDue(self.Ctx, "retry")
+	assert.Equal(self.T(), 1, len(self.getRequests()))
+
+	// The backoff doubles after the second failure.
+	clock.MockNow = clock.MockNow.Add(5 * time.Second)
+	service.DeliverDue(self.Ctx, "retry")
+	assert.Equal(self.T(), 2, len(self.getRequests()))
+	due := service.Pending("retry")
+	assert.Equal(self.T(), 2, len(due))
+	assert.Equal(self.T(), clock.MockNow.Add(20*time.Second).Unix(),
+		due[0].NextAttempt)
+	assert.Equal(self.T(), due[0].NextAttempt, due[1].NextAttempt)
+	assert.Equal(self.T(), uint64(2), due[0].Attempts)
+	assert.Equal(self.T(), "HTTP status 429: Try again later", due[0].LastError)
+	assert.Equal(self.T(), uint64(0), due[1].Attempts)
+
+	// A restarted service picks up the pending deliveries.
+	service = self.makeService(&config_proto.WebhookConfig{
+		Name: "retry",
+		Url:  self.server.URL,
+	})
+	assert.NoError(self.T(), service.LoadQueue(self.Ctx))
+	assert.Equal(self.T(), 2, len(service.Pending("retry")))
+
+	clock.MockNow = clock.MockNow.Add(20 * time.Second)
+	service.DeliverDue(self.Ctx, "retry")
+
+	requests := self.getRequests()
+	assert.Equal(self.T(), 4, len(requests))
+	assert.Contains(self.T(), requests[2].body, `"Row": {"Count":0}`)
+	assert.Contains(self.T(), requests[3].body, `"Row": {"Count":1}`)
+	assert.Equal(self.T(), 0, len(service.Pending("retry")))
+
+	// The queue is empty on disk as well.
+	service = self.makeService(&config_proto.WebhookConfig{
+		Name: "retry",
+		Url:  self.server.URL,
+	})
+	assert.NoError(self.T(), service.LoadQueue(self.Ctx))
+	assert.Equal(self.T(), 0, len(service.Pending("retry")))
+}
+
+func (self *WebhookTestSuite) TestDeadLetter() {
+	clock := &utils.MockClock{MockNow: time.Unix(1672531200, 0)}
+	closer := utils.MockTime(clock)
+	defer closer()
+
+	journal, err := services.GetJournal(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	dead_letters, cancel := journal.Watch(self.Ctx,
+		webhooks.DEAD_LETTER_ARTIFACT, "test")
+	defer cancel()
+
+	// The receiver rejects the request outright.
+	self.statuses = []int{400, 500, 500}
+
+	service := self.makeService(&config_proto.WebhookConfig{
+		Name:              "dead",
+		Url:               self.server.URL,
+		MaxRetries:        1,
+		InitialBackoffSec: 1,
+	})
+
+	err = service.Enqueue(self.Ctx, "dead", "Server.Monitor.Alerts",
+		ordereddict.NewDict().Set("Count", 1))
+	assert.NoError(self.T(), err)
+	service.DeliverDue(self.Ctx, "dead")
+
+	// Transient errors are retried max_retries times.
+	err = service.Enqueue(self.Ctx, "dead", "Server.Monitor.Alerts",
+		ordereddict.NewDict().Set("Count", 2))
+	assert.NoError(self.T(), err)
+	service.DeliverDue(self.Ctx, "dead")
+
+	clock.MockNow = clock.MockNow.Add(time.Second)
+	service.DeliverDue(self.Ctx, "dead")
+
+	assert.Equal(self.T(), 3, len(self.getRequests()))
+	assert.Equal(self.T(), 0, len(service.Pending("dead")))
+
+	golden := []*ordereddict.Dict{}
+	for len(golden) < 2 {
+		select {
+		case row := <-dead_letters:
+			// The journal timestamps rows with the real time.
+			row.Delete("_ts")
+			golden = append(golden, row)
+		case <-time.After(5 * time.Second):
+			self.T().Fatalf("Timed out waiting for dead letters")
+		}
+	}
+
+	goldie.Assert(self.T(), "TestDeadLetter", json.MustMarshalIndent(golden))
+}
+
+// Rows beyond max_queue go to the dead letter queue rather than
+// growing the queue without bound.
+func (self *WebhookTestSuite) TestQueueLimit() {
+	clock := &utils.MockClock{MockNow: time.Unix(1672531200, 0)}
+	closer := utils.MockTime(clock)
+	defer closer()
+
+	journal, err := services.GetJournal(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	dead_letters, cancel := journal.Watch(self.Ctx,
+		webhooks.DEAD_LETTER_ARTIFACT, "test")
+	defer cancel()
+
+	// The receiver is down.
+	self.statuses = []int{503}
+
+	service := self.makeService(&config_proto.WebhookConfig{
+		Name:              "limited",
+		Url:               self.server.URL,
+		MaxQueue:          2,
+		InitialBackoffSec: 1,
+	})
+
+	for i := 1; i <= 3; i++ {
+		err = service.Enqueue(self.Ctx, "limited", "Server.Monitor.Alerts",
+			ordereddict.NewDict().Set("Count", i))
+		assert.NoError(self.T(), err)
+	}
+	service.DeliverDue(self.Ctx, "limited")
+	assert.Equal(self.T(), 2, len(service.Pending("limited")))
+
+	select {
+	case row := <-dead_letters:
+		last_error, _ := row.GetString("LastError")
+		assert.Equal(self.T(), "Webhook queue is full", last_error)
+
+		dead_row, _ := row.Get("Row")
+		assert.Equal(self.T(), `{"Count":3}`, json.MustMarshalString(dead_row))
+
+	case <-time.After(5 * time.Second):
+		self.T().Fatalf("Timed out waiting for dead letters")
+	}
+
+	// Once the receiver recovers the queue drains and accepts new
+	// rows.
+	clock.MockNow = clock.MockNow.Add(time.Second)
+	service.DeliverDue(self.Ctx, "limited")
+	assert.Equal(self.T(), 0, len(service.Pending("limited")))
+
+	err = service.Enqueue(self.Ctx, "limited", "Server.Monitor.Alerts",
+		ordereddict.NewDict().Set("Count", 4))
+	assert.NoError(self.T(), err)
+	assert.Equal(self.T(), 1, len(service.Pending("limited")))
+}
+
+// Rows pushed to a watched artifact are delivered by the service.
+func (self *WebhookTestSuite) TestWatchArtifact() {
+	self.ConfigObj.Webhooks = []*config_proto.WebhookConfig{{
+		Name:      "alerts",
+		Url:       self.server.URL,
+		Format:    "slack",
+		Template:  "{Message}",
+		Artifacts: []string{"Server.Monitor.Alerts"},
+	}}
+
+	err := webhooks.NewWebhookService(self.Ctx, self.Wg, self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	journal, err := services.GetJournal(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	err = journal.PushRowsToArtifact(self.Ctx, self.ConfigObj,
+		[]*ordereddict.Dict{ordereddict.NewDict().Set("Message", "Alert!")},
+		"Server.Monitor.Alerts", "server", "")
+	assert.NoError(self.T(), err)
+
+	vtesting.WaitUntil(5*time.Second, self.T(), func() bool {
+		return len(self.getRequests()) == 1
+	})
+
+	assert.Equal(self.T(), `{"text":"Alert!"}`, self.getRequests()[0].body)
+}
+
+func TestWebhookService(t *testing.T) {
+	suite.Run(t, &WebhookTestSuite{})
+}
@@ -36,6 +36,13 @@ func AddUserToOrg(
 		return NameReservedError
 	}
 
+	for _, grant := range policy.ScopedGrants {
+		err := acls.ValidateScopedGrant(grant)
+		if err != nil {
+			return err
+		}
+	}
+
 	org_manager, err := services.GetOrgManager()
 	if err != nil {
 		return err
@@ -1,6 +1,7 @@
 package acl_managers
 
 import (
+	"context"
 	"sync"
 
 	"www.velocidex.com/golang/velociraptor/acls"
@@ -100,6 +101,13 @@ func (self *ServerACLManager) CheckAccessWithArgs(
 	return services.CheckAccessWithToken(policy, permission, args...)
 }
 
+func (self *ServerACLManager) CheckAccessWithResource(
+	ctx context.Context, resource *acls.Resource,
+	permissions ...acls.ACL_PERMISSION) (bool, error) {
+	return services.CheckAccessWithResource(ctx, self.config_obj,
+		self.principal, resource, permissions...)
+}
+
 func NewServerACLManager(
 	config_obj *config_proto.Config,
 	principal string) vql_subsystem.ACLManager {
@@ -1,6 +1,7 @@
 package vql
 
 import (
+	"context"
 	"fmt"
 
 	"www.velocidex.com/golang/velociraptor/acls"
@@ -27,6 +28,12 @@ type PrincipalACLManager interface {
 	GetPrincipal() string
 }
 
+// Implemented by ACL managers which can evaluate scoped grants.
+type ResourceACLManager interface {
+	CheckAccessWithResource(ctx context.Context, resource *acls.Resource,
+		permissions ...acls.ACL_PERMISSION) (bool, error)
+}
+
 // Check access through the ACL manager in the scope.  NOTE: This
 // assumes it is not possible for a user to mask the ACL manager in
 // the scope! There is currently no way to create an acl manager type
@@ -96,6 +103,32 @@ func CheckAccessWithArgs(scope vfilter.Scope, permissions acls.ACL_PERMISSION,
 	return nil
 }
 
+// Check access to a specific resource. ACL managers which do not
+// support scoped grants only consider the plain permissions.
+func CheckAccessWithResource(ctx context.Context, scope vfilter.Scope,
+	resource *acls.Resource, permissions ...acls.ACL_PERMISSION) error {
+	manager_any, pres := scope.Resolve(ACL_MANAGER_VAR)
+	if !pres {
+		return fmt.Errorf("Permission denied: %v", permissions)
+	}
+
+	var perm bool
+	var err error
+
+	switch manager := manager_any.(type) {
+	case ResourceACLManager:
+		perm, err = manager.CheckAccessWithResource(ctx, resource, permissions...)
+	case ACLManager:
+		perm, err = manager.CheckAccess(permissions...)
+	}
+
+	if !perm || err != nil {
+		return fmt.Errorf("Permission denied: %v on %v", permissions, resource)
+	}
+
+	return nil
+}
+
 func CheckFilesystemAccess(scope vfilter.Scope, accessor string) error {
 	switch accessor {
 
@@ -0,0 +1,78 @@
+package parsers
+
+import (
+	"context"
+
+	"github.com/Velocidex/ordereddict"
+	"www.velocidex.com/golang/velociraptor/accessors"
+	"www.velocidex.com/golang/velociraptor/accessors/ext4"
+	utils "www.velocidex.com/golang/velociraptor/utils"
+	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
+	vfilter "www.velocidex.com/golang/vfilter"
+	"www.velocidex.com/golang/vfilter/arg_parser"
+)
+
+type Ext4InodeFunctionArgs struct {
+	Filename *accessors.OSPath `vfilter:"required,field=filename,doc=The raw ext4 filesystem (e.g. a partition) to open."`
+	Accessor string            `vfilter:"optional,field=accessor,doc=The accessor to use."`
+	Inode    int64             `vfilter:"required,field=inode,doc=The inode number to parse."`
+}
+
+type Ext4InodeFunction struct{}
+
+func (self Ext4InodeFunction) Info(scope vfilter.Scope, type_map *vfilter.TypeMap) *vfilter.FunctionInfo {
+	return &vfilter.FunctionInfo{
+		Name:    "parse_ext4_inode",
+		Doc:     "Parse a specific inode from an ext4 filesystem image or raw device.",
+		ArgType: type_map.AddType(scope, &Ext4InodeFunctionArgs{}),
+	}
+}
+
+func (self Ext4InodeFunction) Call(
+	ctx context.Context, scope vfilter.Scope,
+	args *ordereddict.Dict) vfilter.Any {
+
+	defer utils.RecoverVQL(scope)
+
+	arg := &Ext4InodeFunctionArgs{}
+	err := arg_parser.ExtractArgsWithContext(ctx, scope, args, arg)
+	if err != nil {
+		scope.Log("parse_ext4_inode: %v", err)
+		return &vfilter.Null{}
+	}
+
+	err = vql_subsystem.CheckFilesystemAccess(scope, arg.Accessor)
+	if err != nil {
+		scope.Log("parse_ext4_inode: %v", err)
+		return &vfilter.Null{}
+	}
+
+	fs, err := ext4.GetExt4Filesystem(scope, arg.Filename, arg.Accessor)
+	if err != nil {
+		scope.Log("parse_ext4_inode: %v", err)
+		return &vfilter.Null{}
+	}
+
+	inode, err := fs.GetInode(uint64(arg.Inode))
+	if err != nil {
+		scope.Log("parse_ext4_inode: %v", err)
+		return &vfilter.Null{}
+	}
+
+	result := inode.Stat().
+		Set("allocated", inode.IsAllocated())
+
+	// Inline data and fast symlinks have no runs.
+	if !inode.HasFlag(ext4.EXT4_INLINE_DATA_FL) && !inode.IsFastSymlink() {
+		runs, err := inode.Runs()
+		if err == nil {
+			result.Set("runs", runs)
+		}
+	}
+
+	return result.Set("device", arg.Filename)
+}
+
+func init() {
+	vql_subsystem.RegisterFunction(&Ext4InodeFunction{})
+}
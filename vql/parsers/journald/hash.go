@@ -0,0 +1,128 @@
+package journald
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// Bob Jenkins' lookup3 hashlittle2() as used by journald for files
+// without keyed hashes. The two 32 bit results are combined into a
+// single 64 bit hash.
+func jenkinsHash64(data []byte) uint64 {
+	a := uint32(0xdeadbeef) + uint32(len(data))
+	b, c := a, a
+
+	mix := func() {
+		a -= c
+		a ^= bits.RotateLeft32(c, 4)
+		c += b
+		b -= a
+		b ^= bits.RotateLeft32(a, 6)
+		a += c
+		c -= b
+		c ^= bits.RotateLeft32(b, 8)
+		b += a
+		a -= c
+		a ^= bits.RotateLeft32(c, 16)
+		c += b
+		b -= a
+		b ^= bits.RotateLeft32(a, 19)
+		a += c
+		c -= b
+		c ^= bits.RotateLeft32(b, 4)
+		b += a
+	}
+
+	final := func() {
+		c ^= b
+		c -= bits.RotateLeft32(b, 14)
+		a ^= c
+		a -= bits.RotateLeft32(c, 11)
+		b ^= a
+		b -= bits.RotateLeft32(a, 25)
+		c ^= b
+		c -= bits.RotateLeft32(b, 16)
+		a ^= c
+		a -= bits.RotateLeft32(c, 4)
+		b ^= a
+		b -= bits.RotateLeft32(a, 14)
+		c ^= b
+		c -= bits.RotateLeft32(b, 24)
+	}
+
+	for len(data) > 12 {
+		a += binary.LittleEndian.Uint32(data[0:])
+		b += binary.LittleEndian.Uint32(data[4:])
+		c += binary.LittleEndian.Uint32(data[8:])
+		mix()
+		data = data[12:]
+	}
+
+	if len(data) > 0 {
+		// The tail is zero padded to 12 bytes.
+		tail := make([]byte, 12)
+		copy(tail, data)
+		a += binary.LittleEndian.Uint32(tail[0:])
+		b += binary.LittleEndian.Uint32(tail[4:])
+		c += binary.LittleEndian.Uint32(tail[8:])
+		final()
+	}
+
+	return uint64(c)<<32 | uint64(b)
+}
+
+// SipHash-2-4 keyed with the file id as used by journald for files
+// with keyed hashes.
+func siphash24(data []byte, key [16]byte) uint64 {
+	k0 := binary.LittleEndian.Uint64(key[0:])
+	k1 := binary.LittleEndian.Uint64(key[8:])
+
+	v0 := k0 ^ 0x736f6d6570736575
+	v1 := k1 ^ 0x646f72616e646f6d
+	v2 := k0 ^ 0x6c7967656e657261
+	v3 := k1 ^ 0x7465646279746573
+
+	round := func() {
+		v0 += v1
+		v1 = bits.RotateLeft64(v1, 13)
+		v1 ^= v0
+		v0 = bits.RotateLeft64(v0, 32)
+		v2 += v3
+		v3 = bits.RotateLeft64(v3, 16)
+		v3 ^= v2
+		v0 += v3
+		v3 = bits.RotateLeft64(v3, 21)
+		v3 ^= v0
+		v2 += v1
+		v1 = bits.RotateLeft64(v1, 17)
+		v1 ^= v2
+		v2 = bits.RotateLeft64(v2, 32)
+	}
+
+	length := len(data)
+	for len(data) >= 8 {
+		m := binary.LittleEndian.Uint64(data)
+		v3 ^= m
+		round()
+		round()
+		v0 ^= m
+		data = data[8:]
+	}
+
+	// The last block holds the remaining bytes and the length.
+	last := make([]byte, 8)
+	copy(last, data)
+	m := binary.LittleEndian.Uint64(last) | uint64(length)<<56
+	v3 ^= m
+	round()
+	round()
+	v0 ^= m
+
+	v2 ^= 0xff
+	round()
+	round()
+	round()
+	round()
+
+	return v0 ^ v1 ^ v2 ^ v3
+}
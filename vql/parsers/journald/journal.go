@@ -0,0 +1,526 @@
+// A parser for the systemd journal file format.
+
+// The format is documented at
+// https://systemd.io/JOURNAL_FILE_FORMAT/
+//
+// A journal file is an arena of objects. Entries are found through a
+// chain of entry arrays starting at the header. Each entry refers to
+// a list of data objects, each holding a single FIELD=value pair
+// which may be compressed. Data objects are also reachable through a
+// hash table so entries with a specific field value can be found
+// without reading the whole file.
+
+package journald
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Velocidex/ordereddict"
+	"github.com/klauspost/compress/zstd"
+	"github.com/ulikunitz/xz"
+)
+
+const (
+	JOURNAL_SIGNATURE = "LPKSHHRH"
+
+	// Header incompatible flags
+	HEADER_INCOMPATIBLE_COMPRESSED_XZ   = 1
+	HEADER_INCOMPATIBLE_COMPRESSED_LZ4  = 2
+	HEADER_INCOMPATIBLE_KEYED_HASH      = 4
+	HEADER_INCOMPATIBLE_COMPRESSED_ZSTD = 8
+	HEADER_INCOMPATIBLE_COMPACT         = 16
+
+	// Object types
+	OBJECT_DATA        = 1
+	OBJECT_FIELD       = 2
+	OBJECT_ENTRY       = 3
+	OBJECT_DATA_HASH   = 4
+	OBJECT_FIELD_HASH  = 5
+	OBJECT_ENTRY_ARRAY = 6
+	OBJECT_TAG         = 7
+
+	// Object flags
+	OBJECT_COMPRESSED_XZ   = 1
+	OBJECT_COMPRESSED_LZ4  = 2
+	OBJECT_COMPRESSED_ZSTD = 4
+
+	OBJECT_HEADER_SIZE = 16
+
+	// Limits to protect against corrupt files.
+	MAX_OBJECT_SIZE   = 64 * 1024 * 1024
+	MAX_CHAIN_LENGTH  = 1000000
+	MAX_PAYLOAD_SIZE  = 16 * 1024 * 1024
+	MIN_HEADER_LENGTH = 208
+)
+
+var (
+	NotJournalError = errors.New("Not a journal file")
+)
+
+type Header struct {
+	IncompatibleFlags uint32
+	State             uint8
+	FileID            [16]byte
+	MachineID         [16]byte
+	SeqnumID          [16]byte
+
+	HeaderSize          uint64
+	ArenaSize           uint64
+	DataHashTableOffset uint64
+	DataHashTableSize   uint64
+	TailObjectOffset    uint64
+	NEntries            uint64
+	TailEntrySeqnum     uint64
+	HeadEntrySeqnum     uint64
+	EntryArrayOffset    uint64
+	HeadEntryRealtime   uint64
+	TailEntryRealtime   uint64
+	TailEntryMonotonic  uint64
+}
+
+func (self *Header) Compact() bool {
+	return self.IncompatibleFlags&HEADER_INCOMPATIBLE_COMPACT != 0
+}
+
+func (self *Header) KeyedHash() bool {
+	return self.IncompatibleFlags&HEADER_INCOMPATIBLE_KEYED_HASH != 0
+}
+
+type Journal struct {
+	reader io.ReaderAt
+	Header Header
+}
+
+func OpenJournal(reader io.ReaderAt) (*Journal, error) {
+	buf := make([]byte, MIN_HEADER_LENGTH)
+	_, err := reader.ReadAt(buf, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if string(buf[:8]) != JOURNAL_SIGNATURE {
+		return nil, NotJournalError
+	}
+
+	result := &Journal{reader: reader}
+	header := &result.Header
+	header.IncompatibleFlags = binary.LittleEndian.Uint32(buf[12:])
+	header.State = buf[16]
+	copy(header.FileID[:], buf[24:40])
+	copy(header.MachineID[:], buf[40:56])
+	copy(header.SeqnumID[:], buf[72:88])
+
+	fields := []*uint64{
+		&header.HeaderSize, &header.ArenaSize,
+		&header.DataHashTableOffset, &header.DataHashTableSize,
+		nil, nil, // Field hash table
+		&header.TailObjectOffset, nil,
+		&header.NEntries, &header.TailEntrySeqnum,
+		&header.HeadEntrySeqnum, &header.EntryArrayOffset,
+		&header.HeadEntryRealtime, &header.TailEntryRealtime,
+		&header.TailEntryMonotonic,
+	}
+	for i, field := range fields {
+		if field != nil {
+			*field = binary.LittleEndian.Uint64(buf[88+i*8:])
+		}
+	}
+
+	unsupported := header.IncompatibleFlags &^ (HEADER_INCOMPATIBLE_COMPRESSED_XZ |
+		HEADER_INCOMPATIBLE_COMPRESSED_LZ4 | HEADER_INCOMPATIBLE_KEYED_HASH |
+		HEADER_INCOMPATIBLE_COMPRESSED_ZSTD | HEADER_INCOMPATIBLE_COMPACT)
+	if unsupported != 0 {
+		return nil, fmt.Errorf("Unsupported journal incompatible flags %#x",
+			unsupported)
+	}
+
+	return result, nil
+}
+
+type objectHeader struct {
+	Type  uint8
+	Flags uint8
+	Size  uint64
+}
+
+// Read the whole object at offset, checking its type.
+func (self *Journal) readObject(offset uint64, object_type uint8) (
+	*objectHeader, []byte, error) {
+	if offset == 0 || offset%8 != 0 {
+		return nil, nil, fmt.Errorf("Invalid object offset %#x", offset)
+	}
+
+	buf := make([]byte, OBJECT_HEADER_SIZE)
+	_, err := self.reader.ReadAt(buf, int64(offset))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header := &objectHeader{
+		Type:  buf[0],
+		Flags: buf[1],
+		Size:  binary.LittleEndian.Uint64(buf[8:]),
+	}
+
+	if header.Type != object_type {
+		return nil, nil, fmt.Errorf("Expected object type %v at %#x but got %v",
+			object_type, offset, header.Type)
+	}
+
+	if header.Size < OBJECT_HEADER_SIZE || header.Size > MAX_OBJECT_SIZE {
+		return nil, nil, fmt.Errorf("Invalid object size %v at %#x",
+			header.Size, offset)
+	}
+
+	data := make([]byte, header.Size)
+	n, err := self.reader.ReadAt(data, int64(offset))
+	if uint64(n) < header.Size {
+		if err == nil {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, nil, err
+	}
+
+	return header, data, nil
+}
+
+// Walk a chain of entry arrays starting at offset, calling cb with
+// each entry offset. There are at most n_entries entries if it is
+// not 0.
+func (self *Journal) walkEntryArray(offset uint64, n_entries uint64,
+	cb func(entry_offset uint64) error) error {
+	item_size := uint64(8)
+	if self.Header.Compact() {
+		item_size = 4
+	}
+
+	count := uint64(0)
+	for i := 0; offset != 0 && i < MAX_CHAIN_LENGTH; i++ {
+		_, data, err := self.readObject(offset, OBJECT_ENTRY_ARRAY)
+		if err != nil {
+			return err
+		}
+
+		for item := uint64(24); item+item_size <= uint64(len(data)); item += item_size {
+			var entry_offset uint64
+			if item_size == 4 {
+				entry_offset = uint64(binary.LittleEndian.Uint32(data[item:]))
+			} else {
+				entry_offset = binary.LittleEndian.Uint64(data[item:])
+			}
+
+			// Unused items are at the end of the last array.
+			if entry_offset == 0 {
+				return nil
+			}
+
+			err = cb(entry_offset)
+			if err != nil {
+				return err
+			}
+
+			count++
+			if n_entries > 0 && count >= n_entries {
+				return nil
+			}
+		}
+
+		offset = binary.LittleEndian.Uint64(data[16:])
+	}
+	return nil
+}
+
+// Call cb with the offset of every entry in the file in order.
+func (self *Journal) EntryOffsets(cb func(entry_offset uint64) error) error {
+	return self.walkEntryArray(self.Header.EntryArrayOffset,
+		self.Header.NEntries, cb)
+}
+
+type Entry struct {
+	Offset    uint64
+	Seqnum    uint64
+	Realtime  uint64
+	Monotonic uint64
+	BootID    [16]byte
+	XorHash   uint64
+
+	// The offsets of the data objects
+	Items []uint64
+}
+
+func (self *Entry) Timestamp() time.Time {
+	return time.UnixMicro(int64(self.Realtime)).UTC()
+}
+
+func (self *Journal) ReadEntry(offset uint64) (*Entry, error) {
+	_, data, err := self.readObject(offset, OBJECT_ENTRY)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < 64 {
+		return nil, fmt.Errorf("Entry object too small at %#x", offset)
+	}
+
+	result := &Entry{
+		Offset:    offset,
+		Seqnum:    binary.LittleEndian.Uint64(data[16:]),
+		Realtime:  binary.LittleEndian.Uint64(data[24:]),
+		Monotonic: binary.LittleEndian.Uint64(data[32:]),
+		XorHash:   binary.LittleEndian.Uint64(data[56:]),
+	}
+	copy(result.BootID[:], data[40:56])
+
+	// Regular items are an offset and a hash, compact items are
+	// just a 32 bit offset.
+	item_size := 16
+	if self.Header.Compact() {
+		item_size = 4
+	}
+
+	for item := 64; item+item_size <= len(data); item += item_size {
+		var data_offset uint64
+		if item_size == 4 {
+			data_offset = uint64(binary.LittleEndian.Uint32(data[item:]))
+		} else {
+			data_offset = binary.LittleEndian.Uint64(data[item:])
+		}
+		if data_offset != 0 {
+			result.Items = append(result.Items, data_offset)
+		}
+	}
+
+	return result, nil
+}
+
+type dataObject struct {
+	Hash             uint64
+	NextHashOffset   uint64
+	EntryOffset      uint64
+	EntryArrayOffset uint64
+	NEntries         uint64
+	Payload          []byte
+}
+
+func (self *Journal) readData(offset uint64) (*dataObject, error) {
+	header, data, err := self.readObject(offset, OBJECT_DATA)
+	if err != nil {
+		return nil, err
+	}
+
+	payload_offset := 64
+	if self.Header.Compact() {
+		payload_offset = 72
+	}
+
+	if len(data) < payload_offset {
+		return nil, fmt.Errorf("Data object too small at %#x", offset)
+	}
+
+	result := &dataObject{
+		Hash:             binary.LittleEndian.Uint64(data[16:]),
+		NextHashOffset:   binary.LittleEndian.Uint64(data[24:]),
+		EntryOffset:      binary.LittleEndian.Uint64(data[40:]),
+		EntryArrayOffset: binary.LittleEndian.Uint64(data[48:]),
+		NEntries:         binary.LittleEndian.Uint64(data[56:]),
+	}
+
+	result.Payload, err = decompress(header.Flags, data[payload_offset:])
+	if err != nil {
+		return nil, fmt.Errorf("Data object at %#x: %w", offset, err)
+	}
+
+	return result, nil
+}
+
+func decompress(flags uint8, payload []byte) ([]byte, error) {
+	switch {
+	case flags&OBJECT_COMPRESSED_ZSTD != 0:
+		decoder, err := zstd.NewReader(nil,
+			zstd.WithDecoderMaxMemory(MAX_PAYLOAD_SIZE))
+		if err != nil {
+			return nil, err
+		}
+		defer decoder.Close()
+		return decoder.DecodeAll(payload, nil)
+
+	case flags&OBJECT_COMPRESSED_XZ != 0:
+		reader, err := xz.NewReader(bytes.NewReader(payload))
+		if err != nil {
+			return nil, err
+		}
+		return ioutil.ReadAll(io.LimitReader(reader, MAX_PAYLOAD_SIZE))
+
+	case flags&OBJECT_COMPRESSED_LZ4 != 0:
+		// The uncompressed size is stored before the LZ4 block.
+		if len(payload) < 8 {
+			return nil, errors.New("LZ4 payload too short")
+		}
+		size := binary.LittleEndian.Uint64(payload)
+		if size > MAX_PAYLOAD_SIZE {
+			return nil, fmt.Errorf("LZ4 payload too large (%v)", size)
+		}
+		return lz4DecompressBlock(payload[8:], int(size))
+	}
+	return payload, nil
+}
+
+// Decode the entry's fields into a dict. Fields which appear more
+// than once in an entry are collected into a list.
+func (self *Journal) EntryFields(entry *Entry) (*ordereddict.Dict, error) {
+	result := ordereddict.NewDict()
+	for _, item := range entry.Items {
+		data, err := self.readData(item)
+		if err != nil {
+			return nil, err
+		}
+
+		idx := bytes.IndexByte(data.Payload, '=')
+		if idx <= 0 {
+			continue
+		}
+
+		name := string(data.Payload[:idx])
+		var value interface{} = data.Payload[idx+1:]
+		if utf8.Valid(data.Payload[idx+1:]) {
+			value = string(data.Payload[idx+1:])
+		}
+
+		existing, pres := result.Get(name)
+		if !pres {
+			result.Set(name, value)
+			continue
+		}
+
+		values, ok := existing.([]interface{})
+		if !ok {
+			values = []interface{}{existing}
+		}
+		result.Update(name, append(values, value))
+	}
+	return result, nil
+}
+
+// Hash the payload the same way journald does for the data hash
+// table.
+func (self *Journal) hash(payload []byte) uint64 {
+	if self.Header.KeyedHash() {
+		return siphash24(payload, self.Header.FileID)
+	}
+	return jenkinsHash64(payload)
+}
+
+// Find the data object with the exact FIELD=value payload using the
+// data hash table. Returns nil if there is no such object.
+func (self *Journal) FindData(payload []byte) (*dataObject, error) {
+	n_buckets := self.Header.DataHashTableSize / 16
+	if n_buckets == 0 {
+		return nil, nil
+	}
+
+	hash := self.hash(payload)
+	bucket := make([]byte, 16)
+	_, err := self.reader.ReadAt(bucket, int64(
+		self.Header.DataHashTableOffset+(hash%n_buckets)*16))
+	if err != nil {
+		return nil, err
+	}
+
+	offset := binary.LittleEndian.Uint64(bucket)
+	for i := 0; offset != 0 && i < MAX_CHAIN_LENGTH; i++ {
+		data, err := self.readData(offset)
+		if err != nil {
+			return nil, err
+		}
+
+		if data.Hash == hash && bytes.Equal(data.Payload, payload) {
+			return data, nil
+		}
+		offset = data.NextHashOffset
+	}
+	return nil, nil
+}
+
+// The offsets of all entries referring to the data object.
+func (self *Journal) dataEntryOffsets(data *dataObject) ([]uint64, error) {
+	if data.NEntries == 0 || data.EntryOffset == 0 {
+		return nil, nil
+	}
+
+	// The first entry is stored inline.
+	result := []uint64{data.EntryOffset}
+	if data.NEntries > 1 {
+		err := self.walkEntryArray(data.EntryArrayOffset, data.NEntries-1,
+			func(entry_offset uint64) error {
+				result = append(result, entry_offset)
+				return nil
+			})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// Find the offsets of entries matching all the FIELD=value
+// matches. Like journalctl, matches for the same field are
+// alternatives while matches for different fields must all match.
+func (self *Journal) MatchingEntryOffsets(matches []string) ([]uint64, error) {
+	var field_order []string
+	by_field := make(map[string][]string)
+	for _, match := range matches {
+		idx := bytes.IndexByte([]byte(match), '=')
+		if idx <= 0 {
+			return nil, fmt.Errorf("Invalid match %q: should be FIELD=value", match)
+		}
+		field := match[:idx]
+		if _, pres := by_field[field]; !pres {
+			field_order = append(field_order, field)
+		}
+		by_field[field] = append(by_field[field], match)
+	}
+
+	var result map[uint64]bool
+	for _, field := range field_order {
+		field_matches := make(map[uint64]bool)
+		for _, match := range by_field[field] {
+			data, err := self.FindData([]byte(match))
+			if err != nil {
+				return nil, err
+			}
+			if data == nil {
+				continue
+			}
+
+			offsets, err := self.dataEntryOffsets(data)
+			if err != nil {
+				return nil, err
+			}
+			for _, offset := range offsets {
+				if result == nil || result[offset] {
+					field_matches[offset] = true
+				}
+			}
+		}
+		result = field_matches
+	}
+
+	// Entries are appended to the file so sorting by offset keeps
+	// them in order.
+	offsets := make([]uint64, 0, len(result))
+	for offset := range result {
+		offsets = append(offsets, offset)
+	}
+	sort.Slice(offsets, func(i, j int) bool {
+		return offsets[i] < offsets[j]
+	})
+	return offsets, nil
+}
@@ -0,0 +1,223 @@
+package journald
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/Velocidex/ordereddict"
+	"www.velocidex.com/golang/velociraptor/accessors"
+	"www.velocidex.com/golang/velociraptor/utils"
+	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
+	vfilter "www.velocidex.com/golang/vfilter"
+	"www.velocidex.com/golang/vfilter/arg_parser"
+)
+
+type _ParseJournaldPluginArgs struct {
+	Filenames []*accessors.OSPath `vfilter:"required,field=filename,doc=A list of journal files to parse."`
+	Accessor  string              `vfilter:"optional,field=accessor,doc=The accessor to use."`
+	Matches   []string            `vfilter:"optional,field=matches,doc=Only emit entries with these FIELD=value pairs. Matches on the same field are alternatives, matches on different fields must all match."`
+}
+
+type _ParseJournaldPlugin struct{}
+
+func (self _ParseJournaldPlugin) Call(
+	ctx context.Context,
+	scope vfilter.Scope,
+	args *ordereddict.Dict) <-chan vfilter.Row {
+	output_chan := make(chan vfilter.Row)
+
+	go func() {
+		defer close(output_chan)
+
+		arg := &_ParseJournaldPluginArgs{}
+		err := arg_parser.ExtractArgsWithContext(ctx, scope, args, arg)
+		if err != nil {
+			scope.Log("parse_journald: %s", err.Error())
+			return
+		}
+
+		err = vql_subsystem.CheckFilesystemAccess(scope, arg.Accessor)
+		if err != nil {
+			scope.Log("parse_journald: %s", err)
+			return
+		}
+
+		accessor, err := accessors.GetAccessor(arg.Accessor, scope)
+		if err != nil {
+			scope.Log("parse_journald: %v", err)
+			return
+		}
+
+		for _, filename := range arg.Filenames {
+			func() {
+				defer utils.RecoverVQL(scope)
+
+				fd, err := accessor.OpenWithOSPath(filename)
+				if err != nil {
+					scope.Log("Unable to open file %s: %v",
+						filename, err)
+					return
+				}
+				defer fd.Close()
+
+				journal, err := OpenJournal(utils.MakeReaderAtter(fd))
+				if err != nil {
+					scope.Log("parse_journald: Unable to parse file %s: %v",
+						filename, err)
+					return
+				}
+
+				emit := func(offset uint64) error {
+					row, err := journal.entryRow(offset)
+					if err != nil {
+						return err
+					}
+
+					select {
+					case <-ctx.Done():
+						return ctx.Err()
+					case output_chan <- row:
+					}
+					return nil
+				}
+
+				if len(arg.Matches) == 0 {
+					err = journal.EntryOffsets(emit)
+
+				} else {
+					var offsets []uint64
+					offsets, err = journal.MatchingEntryOffsets(arg.Matches)
+					for _, offset := range offsets {
+						if err != nil {
+							break
+						}
+						err = emit(offset)
+					}
+				}
+
+				if err != nil && ctx.Err() == nil {
+					scope.Log("parse_journald: %s: %v", filename, err)
+				}
+			}()
+		}
+	}()
+
+	return output_chan
+}
+
+func (self _ParseJournaldPlugin) Info(scope vfilter.Scope, type_map *vfilter.TypeMap) *vfilter.PluginInfo {
+	return &vfilter.PluginInfo{
+		Name:    "parse_journald",
+		Doc:     "Parses entries from a systemd journal file.",
+		ArgType: type_map.AddType(scope, &_ParseJournaldPluginArgs{}),
+	}
+}
+
+// Build the row emitted for the entry at offset.
+func (self *Journal) entryRow(offset uint64) (*ordereddict.Dict, error) {
+	entry, err := self.ReadEntry(offset)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := self.EntryFields(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return ordereddict.NewDict().
+		Set("Timestamp", entry.Timestamp()).
+		Set("Seqnum", entry.Seqnum).
+		Set("Monotonic", entry.Monotonic).
+		Set("BootID", hex.EncodeToString(entry.BootID[:])).
+		Set("Fields", fields), nil
+}
+
+type _WatchJournaldPluginArgs struct {
+	Directories []*accessors.OSPath `vfilter:"optional,field=directories,doc=The journal directories to watch (default /var/log/journal and /run/log/journal)."`
+	Accessor    string              `vfilter:"optional,field=accessor,doc=The accessor to use."`
+}
+
+type _WatchJournaldPlugin struct{}
+
+func (self _WatchJournaldPlugin) Call(
+	ctx context.Context,
+	scope vfilter.Scope,
+	args *ordereddict.Dict) <-chan vfilter.Row {
+	output_chan := make(chan vfilter.Row)
+
+	go func() {
+		defer close(output_chan)
+
+		arg := &_WatchJournaldPluginArgs{}
+		err := arg_parser.ExtractArgsWithContext(ctx, scope, args, arg)
+		if err != nil {
+			scope.Log("watch_journald: %s", err.Error())
+			return
+		}
+
+		err = vql_subsystem.CheckFilesystemAccess(scope, arg.Accessor)
+		if err != nil {
+			scope.Log("watch_journald: %s", err)
+			return
+		}
+
+		if len(arg.Directories) == 0 {
+			accessor, err := accessors.GetAccessor(arg.Accessor, scope)
+			if err != nil {
+				scope.Log("watch_journald: %v", err)
+				return
+			}
+
+			for _, directory := range []string{
+				"/var/log/journal", "/run/log/journal"} {
+				path, err := accessor.ParsePath(directory)
+				if err != nil {
+					scope.Log("watch_journald: %v", err)
+					return
+				}
+				arg.Directories = append(arg.Directories, path)
+			}
+		}
+
+		// The event channel is never closed since the watcher
+		// service may still send on it. All senders give up once
+		// the context is done.
+		event_channel := make(chan vfilter.Row)
+
+		for _, directory := range arg.Directories {
+			cancel := GlobalJournaldService.Register(
+				directory, arg.Accessor, ctx, scope, event_channel)
+			defer cancel()
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case event := <-event_channel:
+				select {
+				case <-ctx.Done():
+					return
+				case output_chan <- event:
+				}
+			}
+		}
+	}()
+
+	return output_chan
+}
+
+func (self _WatchJournaldPlugin) Info(scope vfilter.Scope, type_map *vfilter.TypeMap) *vfilter.PluginInfo {
+	return &vfilter.PluginInfo{
+		Name:    "watch_journald",
+		Doc:     "Watch systemd journal directories and emit new entries.",
+		ArgType: type_map.AddType(scope, &_WatchJournaldPluginArgs{}),
+	}
+}
+
+func init() {
+	vql_subsystem.RegisterPlugin(&_ParseJournaldPlugin{})
+	vql_subsystem.RegisterPlugin(&_WatchJournaldPlugin{})
+}
@@ -0,0 +1,174 @@
+package journald
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Velocidex/ordereddict"
+	"github.com/alecthomas/assert"
+	"github.com/sebdah/goldie"
+	"github.com/stretchr/testify/suite"
+	"www.velocidex.com/golang/velociraptor/constants"
+	"www.velocidex.com/golang/velociraptor/file_store/test_utils"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/logging"
+	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/vql/acl_managers"
+	"www.velocidex.com/golang/vfilter"
+
+	_ "www.velocidex.com/golang/velociraptor/accessors/file"
+)
+
+type JournaldTestSuite struct {
+	test_utils.TestSuite
+}
+
+func (self *JournaldTestSuite) parse(
+	filename string, matches []string) []*ordereddict.Dict {
+	path, _ := filepath.Abs("../../../artifacts/testdata/files/journal/" + filename)
+	env := ordereddict.NewDict().Set("Filename", path)
+	query := `
+SELECT Seqnum, Timestamp, Fields.MESSAGE AS Message,
+       Fields.SYSLOG_IDENTIFIER AS Identifier, Fields.PRIORITY AS Priority
+FROM parse_journald(filename=Filename)`
+
+	if matches != nil {
+		env.Set("Matches", matches)
+		query = strings.Replace(query, "filename=Filename",
+			"filename=Filename, matches=Matches", 1)
+	}
+
+	rows, err := test_utils.RunQuery(self.ConfigObj, query, env)
+	assert.NoError(self.T(), err)
+	return rows
+}
+
+func (self *JournaldTestSuite) TestParseJournald() {
+	result := ordereddict.NewDict()
+
+	// Compact files use keyed hashes while regular files use the
+	// jenkins hash - matches exercise both.
+	for _, filename := range []string{"compact.journal", "regular.journal"} {
+		all := self.parse(filename, nil)
+		assert.Equal(self.T(), 9, len(all), filename)
+
+		// The large message is stored compressed.
+		found := false
+		for _, row := range all {
+			message, _ := row.GetString("Message")
+			if strings.HasPrefix(message, "A large message ") {
+				assert.Equal(self.T(), 16+2000, len(message))
+				found = true
+			}
+		}
+		assert.True(self.T(), found, filename)
+
+		velotest := self.parse(filename, []string{
+			"SYSLOG_IDENTIFIER=velotest"})
+		assert.Equal(self.T(), 3, len(velotest), filename)
+
+		// Different fields must all match.
+		warning := self.parse(filename, []string{
+			"SYSLOG_IDENTIFIER=velotest", "PRIORITY=4"})
+		assert.Equal(self.T(), 1, len(warning), filename)
+
+		// Same fields are alternatives.
+		either := self.parse(filename, []string{
+			"SYSLOG_IDENTIFIER=velotest", "SYSLOG_IDENTIFIER=sshd"})
+		assert.Equal(self.T(), 4, len(either), filename)
+
+		missing := self.parse(filename, []string{
+			"SYSLOG_IDENTIFIER=nosuchprogram"})
+		assert.Equal(self.T(), 0, len(missing), filename)
+
+		// Keep the fixture readable.
+		for _, row := range velotest {
+			message, _ := row.GetString("Message")
+			if len(message) > 40 {
+				row.Update("Message", message[:40]+"...")
+			}
+		}
+		result.Set(filename, velotest)
+	}
+
+	goldie.Assert(self.T(), "TestParseJournald",
+		json.MustMarshalIndent(result))
+}
+
+// New files appearing in the watched directory are emitted in full.
+func (self *JournaldTestSuite) TestWatchJournald() {
+	dir, err := ioutil.TempDir("", "journal")
+	assert.NoError(self.T(), err)
+	defer os.RemoveAll(dir)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	builder := services.ScopeBuilder{
+		Config:     self.ConfigObj,
+		ACLManager: acl_managers.NullACLManager{},
+		Logger:     logging.NewPlainLogger(self.ConfigObj, &logging.FrontendComponent),
+		Env: ordereddict.NewDict().
+			Set(constants.JOURNALD_FREQUENCY, 1).
+			Set("Directory", dir),
+	}
+
+	manager, err := services.GetRepositoryManager(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	scope := manager.BuildScope(builder)
+	defer scope.Close()
+
+	vql, err := vfilter.Parse(`
+SELECT Seqnum, OSPath.Basename AS Name
+FROM watch_journald(directories=Directory)
+LIMIT 9`)
+	assert.NoError(self.T(), err)
+
+	// Copy the file in once the watcher has started.
+	go func() {
+		time.Sleep(2 * time.Second)
+		data, err := ioutil.ReadFile(
+			"../../../artifacts/testdata/files/journal/regular.journal")
+		assert.NoError(self.T(), err)
+
+		err = ioutil.WriteFile(filepath.Join(dir, "system.journal"), data, 0600)
+		assert.NoError(self.T(), err)
+	}()
+
+	var rows []vfilter.Row
+	for row := range vql.Eval(ctx, scope) {
+		rows = append(rows, row)
+	}
+
+	assert.Equal(self.T(), 9, len(rows))
+	if len(rows) > 0 {
+		name, _ := scope.Associative(rows[0], "Name")
+		assert.Equal(self.T(), "system.journal", name)
+	}
+}
+
+func TestLZ4Block(t *testing.T) {
+	// Literals "abcd" followed by a 12 byte overlapping match at
+	// offset 4 and a final 2 byte literal.
+	block := []byte{0x48, 'a', 'b', 'c', 'd', 0x04, 0x00, 0x20, 'x', 'y'}
+	result, err := lz4DecompressBlock(block, 18)
+	assert.NoError(t, err)
+	assert.Equal(t, "abcdabcdabcdabcdxy", string(result))
+
+	// Wrong sizes and bad offsets are rejected.
+	_, err = lz4DecompressBlock(block, 17)
+	assert.Error(t, err)
+
+	_, err = lz4DecompressBlock([]byte{0x10, 'a', 0x05, 0x00}, 10)
+	assert.Error(t, err)
+}
+
+func TestJournald(t *testing.T) {
+	suite.Run(t, &JournaldTestSuite{})
+}
@@ -0,0 +1,89 @@
+package journald
+
+import (
+	"errors"
+)
+
+var (
+	lz4CorruptError = errors.New("Corrupt LZ4 block")
+)
+
+// Decompress a raw LZ4 block (without the frame format) of a known
+// uncompressed size.
+func lz4DecompressBlock(src []byte, size int) ([]byte, error) {
+	dst := make([]byte, 0, size)
+
+	readLength := func(length int, i *int) (int, error) {
+		for {
+			if *i >= len(src) {
+				return 0, lz4CorruptError
+			}
+			b := src[*i]
+			*i++
+			length += int(b)
+			if b != 255 {
+				return length, nil
+			}
+		}
+	}
+
+	i := 0
+	for i < len(src) {
+		token := src[i]
+		i++
+
+		literals := int(token >> 4)
+		if literals == 15 {
+			var err error
+			literals, err = readLength(literals, &i)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if i+literals > len(src) || len(dst)+literals > size {
+			return nil, lz4CorruptError
+		}
+		dst = append(dst, src[i:i+literals]...)
+		i += literals
+
+		// The last sequence has only literals.
+		if i >= len(src) {
+			break
+		}
+
+		if i+2 > len(src) {
+			return nil, lz4CorruptError
+		}
+		offset := int(src[i]) | int(src[i+1])<<8
+		i += 2
+		if offset == 0 || offset > len(dst) {
+			return nil, lz4CorruptError
+		}
+
+		match_length := int(token & 0x0f)
+		if match_length == 15 {
+			var err error
+			match_length, err = readLength(match_length, &i)
+			if err != nil {
+				return nil, err
+			}
+		}
+		match_length += 4
+
+		if len(dst)+match_length > size {
+			return nil, lz4CorruptError
+		}
+
+		// Matches may overlap the output so copy byte by byte.
+		start := len(dst) - offset
+		for j := 0; j < match_length; j++ {
+			dst = append(dst, dst[start+j])
+		}
+	}
+
+	if len(dst) != size {
+		return nil, lz4CorruptError
+	}
+	return dst, nil
+}
@@ -0,0 +1,307 @@
+package journald
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"www.velocidex.com/golang/velociraptor/accessors"
+	"www.velocidex.com/golang/velociraptor/constants"
+	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/services/repository"
+	"www.velocidex.com/golang/velociraptor/utils"
+	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
+	"www.velocidex.com/golang/vfilter"
+)
+
+var (
+	GlobalJournaldService = NewJournaldWatcherService()
+
+	// Returned from the callback to stop walking the entries.
+	stopError = errors.New("Stop")
+)
+
+// This service watches journal directories and multiplexes new
+// entries to multiple readers.
+type JournaldWatcherService struct {
+	mu sync.Mutex
+
+	registrations map[string][]*Handle
+}
+
+func NewJournaldWatcherService() *JournaldWatcherService {
+	return &JournaldWatcherService{
+		registrations: make(map[string][]*Handle),
+	}
+}
+
+func (self *JournaldWatcherService) Register(
+	directory *accessors.OSPath,
+	accessor string,
+	ctx context.Context,
+	scope vfilter.Scope,
+	output_chan chan vfilter.Row) func() {
+
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	subctx, cancel := context.WithCancel(ctx)
+
+	handle := &Handle{
+		ctx:         subctx,
+		output_chan: output_chan,
+		scope:       scope}
+
+	key := directory.String() + accessor
+	registration, pres := self.registrations[key]
+	if !pres {
+		registration = []*Handle{}
+		self.registrations[key] = registration
+
+		frequency := vql_subsystem.GetIntFromRow(
+			scope, scope, constants.JOURNALD_FREQUENCY)
+
+		// Create a scope with a completely different lifespan since
+		// it may outlive this query (if another query starts watching
+		// the same directory).
+		manager := &repository.RepositoryManager{}
+		builder := services.ScopeBuilderFromScope(scope)
+		subscope := manager.BuildScope(builder)
+
+		go self.StartMonitoring(
+			subscope, directory, accessor, frequency)
+	}
+
+	registration = append(registration, handle)
+	self.registrations[key] = registration
+
+	scope.Log("Registering journald watcher for %v", directory)
+
+	return cancel
+}
+
+// Monitor the directory for new entries and emit them to all
+// interested listeners. If no listeners exist we terminate.
+func (self *JournaldWatcherService) StartMonitoring(
+	scope vfilter.Scope,
+	directory *accessors.OSPath,
+	accessor_name string, frequency uint64) {
+	defer scope.Close()
+	defer utils.CheckForPanic("StartMonitoring")
+
+	// Journald syncs to disk every few minutes but the journal is
+	// mmapped so new entries are visible much sooner.
+	if frequency == 0 {
+		frequency = 5
+	}
+
+	accessor, err := accessors.GetAccessor(accessor_name, scope)
+	if err != nil {
+		scope.Log("Registering journald watcher error: %v", err)
+		return
+	}
+
+	// Only emit entries added after we started watching.
+	seen := make(map[string]uint64)
+	for _, filename := range findJournalFiles(accessor, directory) {
+		file_id, n_entries, err := countEntries(accessor, filename)
+		if err == nil {
+			seen[file_id] = n_entries
+		}
+	}
+
+	key := directory.String() + accessor_name
+	for {
+		self.mu.Lock()
+		registration, pres := self.registrations[key]
+		self.mu.Unlock()
+
+		// No more listeners left, we are done.
+		if !pres || len(registration) == 0 {
+			return
+		}
+
+		self.monitorOnce(directory, accessor_name, accessor, seen)
+
+		time.Sleep(time.Duration(frequency) * time.Second)
+	}
+}
+
+// Find the journal files in the directory and its immediate
+// subdirectories (journald keeps files in a directory named after
+// the machine id).
+func findJournalFiles(
+	accessor accessors.FileSystemAccessor,
+	directory *accessors.OSPath) []*accessors.OSPath {
+	var result []*accessors.OSPath
+
+	children, _ := accessor.ReadDirWithOSPath(directory)
+	for _, child := range children {
+		if child.IsDir() {
+			files, _ := accessor.ReadDirWithOSPath(child.OSPath())
+			for _, file := range files {
+				if !file.IsDir() && strings.HasSuffix(file.Name(), ".journal") {
+					result = append(result, file.OSPath())
+				}
+			}
+			continue
+		}
+
+		if strings.HasSuffix(child.Name(), ".journal") {
+			result = append(result, child.OSPath())
+		}
+	}
+	return result
+}
+
+func countEntries(accessor accessors.FileSystemAccessor,
+	filename *accessors.OSPath) (string, uint64, error) {
+	fd, err := accessor.OpenWithOSPath(filename)
+	if err != nil {
+		return "", 0, err
+	}
+	defer fd.Close()
+
+	journal, err := OpenJournal(utils.MakeReaderAtter(fd))
+	if err != nil {
+		return "", 0, err
+	}
+
+	count := uint64(0)
+	err = journal.EntryOffsets(func(offset uint64) error {
+		count++
+		return nil
+	})
+	return hex.EncodeToString(journal.Header.FileID[:]), count, err
+}
+
+func (self *JournaldWatcherService) getActiveHandles(key string) []*Handle {
+	handles, pres := self.registrations[key]
+	if !pres {
+		return nil
+	}
+
+	new_handles := make([]*Handle, 0, len(handles))
+	for _, h := range handles {
+		select {
+		case <-h.ctx.Done():
+			continue
+		default:
+			new_handles = append(new_handles, h)
+		}
+	}
+
+	if len(new_handles) == 0 {
+		delete(self.registrations, key)
+	}
+
+	return new_handles
+}
+
+func (self *JournaldWatcherService) monitorOnce(
+	directory *accessors.OSPath,
+	accessor_name string,
+	accessor accessors.FileSystemAccessor,
+	seen map[string]uint64) {
+
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	key := directory.String() + accessor_name
+	handles := self.getActiveHandles(key)
+	if len(handles) == 0 {
+		return
+	}
+
+	for _, filename := range findJournalFiles(accessor, directory) {
+		handles = self.monitorFile(key, filename, accessor, seen, handles)
+		if len(handles) == 0 {
+			return
+		}
+	}
+}
+
+// Emit the entries of a single journal file we have not seen
+// before. Files are tracked by their file id so rotated files are not
+// emitted again.
+func (self *JournaldWatcherService) monitorFile(
+	key string,
+	filename *accessors.OSPath,
+	accessor accessors.FileSystemAccessor,
+	seen map[string]uint64,
+	handles []*Handle) []*Handle {
+
+	fd, err := accessor.OpenWithOSPath(filename)
+	if err != nil {
+		return handles
+	}
+	defer fd.Close()
+
+	journal, err := OpenJournal(utils.MakeReaderAtter(fd))
+	if err != nil {
+		return handles
+	}
+
+	file_id := hex.EncodeToString(journal.Header.FileID[:])
+	last_seen := seen[file_id]
+	if journal.Header.NEntries <= last_seen {
+		return handles
+	}
+
+	count := uint64(0)
+	err = journal.EntryOffsets(func(offset uint64) error {
+		count++
+		if count <= last_seen {
+			return nil
+		}
+
+		row, err := journal.entryRow(offset)
+		if err != nil {
+			return err
+		}
+		row.Set("OSPath", filename)
+		seen[file_id] = count
+
+		new_handles := make([]*Handle, 0, len(handles))
+		for _, handle := range handles {
+			select {
+			case <-handle.ctx.Done():
+				// If context is done, drop the event.
+
+			case handle.output_chan <- row:
+				new_handles = append(new_handles, handle)
+			}
+		}
+
+		// Update the registrations - possibly omitting finished
+		// listeners.
+		handles = new_handles
+		if len(handles) == 0 {
+			delete(self.registrations, key)
+			return stopError
+		}
+		self.registrations[key] = handles
+		return nil
+	})
+
+	// The file may be written to as we read it - we just try again
+	// next time from the last entry we emitted.
+	if err != nil && err != stopError {
+		for _, handle := range handles {
+			handle.scope.Log("watch_journald: %v: %v", filename, err)
+		}
+	}
+
+	return handles
+}
+
+// A handle is given for each interested party. We write the event on
+// to the output_chan unless the context is done.
+type Handle struct {
+	ctx         context.Context
+	output_chan chan vfilter.Row
+	scope       vfilter.Scope
+}
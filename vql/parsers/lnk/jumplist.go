@@ -0,0 +1,228 @@
+// Parsers for Windows jump lists.
+
+// Automatic destinations (*.automaticDestinations-ms) are OLE
+// compound files with one LNK stream per entry and a DestList stream
+// describing them. Custom destinations (*.customDestinations-ms) are
+// a list of categories with LNK files stored one after the other.
+//
+// See https://github.com/libyal/dtformats/blob/main/documentation/Jump%20lists%20format.asciidoc
+
+package lnk
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Velocidex/ordereddict"
+	"www.velocidex.com/golang/oleparse"
+)
+
+const (
+	DESTLIST_HEADER_SIZE = 32
+
+	// Offsets into a DestList entry
+	DESTLIST_ENTRY_V1_PATH = 112
+	DESTLIST_ENTRY_V3_PATH = 128
+
+	// Stream directory entries
+	OLE_STREAM = 2
+)
+
+type JumpListEntry struct {
+	Type         string
+	EntryNumber  uint32
+	StreamName   string
+	Hostname     string
+	LastModified time.Time
+	Pinned       bool
+	AccessCount  uint32
+	Path         string
+	TargetPath   string
+	Tracker      *ordereddict.Dict
+	Link         *ordereddict.Dict
+}
+
+func (self *JumpListEntry) ToDict() *ordereddict.Dict {
+	return ordereddict.NewDict().
+		Set("Type", self.Type).
+		Set("EntryNumber", self.EntryNumber).
+		Set("StreamName", self.StreamName).
+		Set("Hostname", self.Hostname).
+		Set("LastModified", self.LastModified).
+		Set("Pinned", self.Pinned).
+		Set("AccessCount", self.AccessCount).
+		Set("Path", self.Path).
+		Set("TargetPath", self.TargetPath).
+		Set("Tracker", self.Tracker).
+		Set("Link", self.Link)
+}
+
+// Parse an automatic destinations jump list.
+func ParseAutomaticDestinations(data []byte) ([]*JumpListEntry, error) {
+	ole, err := oleparse.NewOLEFile(data)
+	if err != nil {
+		return nil, err
+	}
+
+	streams := make(map[string][]byte)
+	for _, dir := range ole.Directory {
+		if dir.Header.Mse == OLE_STREAM {
+			streams[strings.ToLower(dir.Name)] = ole.GetStream(dir.Index)
+		}
+	}
+
+	result := []*JumpListEntry{}
+	seen := make(map[string]bool)
+
+	destlist, pres := streams["destlist"]
+	if pres {
+		entries, err := parseDestList(destlist)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			seen[entry.StreamName] = true
+			stream, pres := streams[entry.StreamName]
+			if pres {
+				entry.Link, _ = ParseLnk(stream)
+			}
+			entry.TargetPath = targetPath(entry.Link)
+			result = append(result, entry)
+		}
+	}
+
+	// Also include LNK streams not referenced from the DestList.
+	for _, dir := range ole.Directory {
+		name := strings.ToLower(dir.Name)
+		stream, pres := streams[name]
+		if !pres || name == "destlist" || seen[name] {
+			continue
+		}
+
+		link, err := ParseLnk(stream)
+		if err != nil {
+			continue
+		}
+
+		var number uint64
+		fmt.Sscanf(name, "%x", &number)
+
+		result = append(result, &JumpListEntry{
+			Type:        "Automatic",
+			EntryNumber: uint32(number),
+			StreamName:  name,
+			TargetPath:  targetPath(link),
+			Link:        link,
+		})
+	}
+
+	return result, nil
+}
+
+func parseDestList(data []byte) ([]*JumpListEntry, error) {
+	r := &reader{data: data}
+	version := r.uint32(0)
+	count := int(r.uint32(4))
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	result := []*JumpListEntry{}
+	offset := DESTLIST_HEADER_SIZE
+	for i := 0; i < count && offset < len(data); i++ {
+		entry := &reader{data: data[offset:]}
+
+		path_offset := DESTLIST_ENTRY_V1_PATH
+		if version >= 3 {
+			path_offset = DESTLIST_ENTRY_V3_PATH
+		}
+
+		// The path size is in characters.
+		path_size := int(entry.uint16(path_offset))
+		path := decodeUTF16(entry.bytes(path_offset+2, path_size*2))
+		if entry.err != nil {
+			return result, entry.err
+		}
+
+		// The hostname is a NUL padded 16 byte field.
+		hostname := (&reader{data: entry.bytes(72, 16)}).cString(0)
+
+		file_droid := entry.bytes(24, 16)
+		item := &JumpListEntry{
+			Type:         "Automatic",
+			EntryNumber:  entry.uint32(88),
+			Hostname:     hostname,
+			LastModified: entry.filetime(100),
+			Pinned:       int32(entry.uint32(108)) != -1,
+			Path:         path,
+			Tracker: ordereddict.NewDict().
+				Set("MachineID", hostname).
+				Set("VolumeID", formatGUID(entry.bytes(8, 16))).
+				Set("ObjectID", formatGUID(file_droid)).
+				Set("BirthVolumeID", formatGUID(entry.bytes(40, 16))).
+				Set("BirthObjectID", formatGUID(entry.bytes(56, 16))).
+				Set("MACAddress", uuidNode(file_droid)).
+				Set("ObjectIDTimestamp", uuidTime(file_droid)),
+		}
+		item.StreamName = fmt.Sprintf("%x", item.EntryNumber)
+
+		offset += path_offset + 2 + path_size*2
+		if version >= 3 {
+			item.AccessCount = entry.uint32(116)
+
+			// Newer entries have 4 trailing bytes.
+			offset += 4
+		}
+
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+// Parse a custom destinations jump list. The category structure is
+// not needed to find the links so we just find each LNK in turn.
+func ParseCustomDestinations(data []byte) ([]*JumpListEntry, error) {
+	var offsets []int
+	for start := 0; start < len(data); {
+		idx := bytes.Index(data[start:], LNK_SIGNATURE)
+		if idx < 0 {
+			break
+		}
+		offsets = append(offsets, start+idx)
+		start += idx + len(LNK_SIGNATURE)
+	}
+
+	result := []*JumpListEntry{}
+	for i, offset := range offsets {
+		end := len(data)
+		if i+1 < len(offsets) {
+			end = offsets[i+1]
+		}
+
+		link, err := ParseLnk(data[offset:end])
+		if err != nil {
+			continue
+		}
+
+		result = append(result, &JumpListEntry{
+			Type:        "Custom",
+			EntryNumber: uint32(len(result) + 1),
+			TargetPath:  targetPath(link),
+			Link:        link,
+		})
+	}
+
+	return result, nil
+}
+
+func targetPath(link *ordereddict.Dict) string {
+	if link == nil {
+		return ""
+	}
+	target, _ := link.GetString("TargetPath")
+	return target
+}
@@ -0,0 +1,484 @@
+// A parser for Windows shell link (LNK) files.
+
+// The format is documented at
+// https://docs.microsoft.com/en-us/openspecs/windows_protocols/ms-shllink
+// https://github.com/libyal/liblnk/blob/main/documentation/Windows%20Shortcut%20File%20(LNK)%20format.asciidoc
+
+package lnk
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/Velocidex/ordereddict"
+)
+
+const (
+	LNK_HEADER_SIZE = 0x4c
+
+	// Extra data block signatures
+	ENVIRONMENT_VARIABLES_BLOCK = 0xa0000001
+	CONSOLE_BLOCK               = 0xa0000002
+	TRACKER_BLOCK               = 0xa0000003
+	CONSOLE_FE_BLOCK            = 0xa0000004
+	SPECIAL_FOLDER_BLOCK        = 0xa0000005
+	DARWIN_BLOCK                = 0xa0000006
+	ICON_ENVIRONMENT_BLOCK      = 0xa0000007
+	SHIM_BLOCK                  = 0xa0000008
+	PROPERTY_STORE_BLOCK        = 0xa0000009
+	KNOWN_FOLDER_BLOCK          = 0xa000000b
+	VISTA_IDLIST_BLOCK          = 0xa000000c
+
+	// Link info flags
+	VOLUME_ID_AND_LOCAL_BASE_PATH = 1
+	COMMON_NETWORK_RELATIVE_LINK  = 2
+)
+
+var (
+	// The shell link class id 00021401-0000-0000-C000-000000000046
+	LNK_CLSID = []byte{
+		0x01, 0x14, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0xc0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}
+
+	// The header size followed by the class id starts every link.
+	LNK_SIGNATURE = append([]byte{0x4c, 0, 0, 0}, LNK_CLSID...)
+
+	NotLnkError = errors.New("Not a LNK file")
+
+	linkFlagNames = []string{
+		"HasLinkTargetIDList", "HasLinkInfo", "HasName", "HasRelativePath",
+		"HasWorkingDir", "HasArguments", "HasIconLocation", "IsUnicode",
+		"ForceNoLinkInfo", "HasExpString", "RunInSeparateProcess", "",
+		"HasDarwinID", "RunAsUser", "HasExpIcon", "NoPidlAlias", "",
+		"RunWithShimLayer", "ForceNoLinkTrack", "EnableTargetMetadata",
+		"DisableLinkPathTracking", "DisableKnownFolderTracking",
+		"DisableKnownFolderAlias", "AllowLinkToLink", "UnaliasOnSave",
+		"PreferEnvironmentPath", "KeepLocalIDListForUNCTarget",
+	}
+
+	fileAttributeNames = []string{
+		"READONLY", "HIDDEN", "SYSTEM", "", "DIRECTORY", "ARCHIVE", "",
+		"NORMAL", "TEMPORARY", "SPARSE_FILE", "REPARSE_POINT",
+		"COMPRESSED", "OFFLINE", "NOT_CONTENT_INDEXED", "ENCRYPTED",
+	}
+
+	showCommands = map[uint32]string{
+		1: "SW_SHOWNORMAL",
+		3: "SW_SHOWMAXIMIZED",
+		7: "SW_SHOWMINNOACTIVE",
+	}
+
+	driveTypes = []string{
+		"DRIVE_UNKNOWN", "DRIVE_NO_ROOT_DIR", "DRIVE_REMOVABLE",
+		"DRIVE_FIXED", "DRIVE_REMOTE", "DRIVE_CDROM", "DRIVE_RAMDISK",
+	}
+)
+
+const (
+	HAS_LINK_TARGET_ID_LIST = 1 << iota
+	HAS_LINK_INFO
+	HAS_NAME
+	HAS_RELATIVE_PATH
+	HAS_WORKING_DIR
+	HAS_ARGUMENTS
+	HAS_ICON_LOCATION
+	IS_UNICODE
+)
+
+// A bounds checked view of the link data. Reads past the end return
+// zero values and set the error.
+type reader struct {
+	data []byte
+	err  error
+}
+
+func (self *reader) bytes(offset, length int) []byte {
+	if offset < 0 || length < 0 || offset+length > len(self.data) {
+		if self.err == nil {
+			self.err = fmt.Errorf("Read of %v bytes at %#x past end of data",
+				length, offset)
+		}
+		return make([]byte, length)
+	}
+	return self.data[offset : offset+length]
+}
+
+func (self *reader) uint16(offset int) uint16 {
+	return binary.LittleEndian.Uint16(self.bytes(offset, 2))
+}
+
+func (self *reader) uint32(offset int) uint32 {
+	return binary.LittleEndian.Uint32(self.bytes(offset, 4))
+}
+
+func (self *reader) uint64(offset int) uint64 {
+	return binary.LittleEndian.Uint64(self.bytes(offset, 8))
+}
+
+func (self *reader) filetime(offset int) time.Time {
+	return filetimeToTime(self.uint64(offset))
+}
+
+// A NUL terminated ANSI string starting at offset.
+func (self *reader) cString(offset int) string {
+	if offset < 0 || offset >= len(self.data) {
+		return ""
+	}
+	data := self.data[offset:]
+	idx := bytes.IndexByte(data, 0)
+	if idx >= 0 {
+		data = data[:idx]
+	}
+	return string(data)
+}
+
+// A NUL terminated UTF-16 string starting at offset.
+func (self *reader) utf16String(offset int) string {
+	if offset < 0 || offset >= len(self.data) {
+		return ""
+	}
+	return decodeUTF16(self.data[offset:])
+}
+
+func decodeUTF16(data []byte) string {
+	var chars []uint16
+	for i := 0; i+1 < len(data); i += 2 {
+		c := binary.LittleEndian.Uint16(data[i:])
+		if c == 0 {
+			break
+		}
+		chars = append(chars, c)
+	}
+	return string(utf16.Decode(chars))
+}
+
+func filetimeToTime(filetime uint64) time.Time {
+	if filetime == 0 {
+		return time.Time{}
+	}
+	// FILETIME is 100ns intervals since 1601-01-01.
+	return time.Unix(int64(filetime/10000000)-11644473600,
+		int64(filetime%10000000)*100).UTC()
+}
+
+func flagNames(value uint32, names []string) []string {
+	result := []string{}
+	for i, name := range names {
+		if name != "" && value&(1<<uint(i)) != 0 {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+func formatGUID(data []byte) string {
+	if len(data) < 16 {
+		return ""
+	}
+	return fmt.Sprintf("%08X-%04X-%04X-%X-%X",
+		binary.LittleEndian.Uint32(data),
+		binary.LittleEndian.Uint16(data[4:]),
+		binary.LittleEndian.Uint16(data[6:]),
+		data[8:10], data[10:16])
+}
+
+// Parse a LNK file held in data.
+func ParseLnk(data []byte) (*ordereddict.Dict, error) {
+	if len(data) < LNK_HEADER_SIZE || !bytes.HasPrefix(data, LNK_SIGNATURE) {
+		return nil, NotLnkError
+	}
+
+	r := &reader{data: data}
+	flags := r.uint32(0x14)
+	attributes := r.uint32(0x18)
+	show_command, pres := showCommands[r.uint32(0x3c)]
+	if !pres {
+		show_command = fmt.Sprintf("%d", r.uint32(0x3c))
+	}
+
+	header := ordereddict.NewDict().
+		Set("CreationTime", r.filetime(0x1c)).
+		Set("AccessTime", r.filetime(0x24)).
+		Set("WriteTime", r.filetime(0x2c)).
+		Set("FileSize", r.uint32(0x34)).
+		Set("FileAttributes", flagNames(attributes, fileAttributeNames)).
+		Set("LinkFlags", flagNames(flags, linkFlagNames)).
+		Set("ShowCommand", show_command).
+		Set("IconIndex", int32(r.uint32(0x38))).
+		Set("HotKey", r.uint16(0x40))
+
+	offset := LNK_HEADER_SIZE
+
+	var id_list []*ordereddict.Dict
+	id_list_path := ""
+	if flags&HAS_LINK_TARGET_ID_LIST != 0 {
+		size := int(r.uint16(offset))
+		id_list, id_list_path = parseIDList(r.bytes(offset+2, size))
+		offset += 2 + size
+	}
+
+	var link_info *ordereddict.Dict
+	link_info_path := ""
+	if flags&HAS_LINK_INFO != 0 {
+		size := int(r.uint32(offset))
+		link_info, link_info_path = parseLinkInfo(r.bytes(offset, size))
+		offset += size
+	}
+
+	string_data := ordereddict.NewDict()
+	for _, field := range []struct {
+		flag uint32
+		name string
+	}{
+		{HAS_NAME, "Name"},
+		{HAS_RELATIVE_PATH, "RelativePath"},
+		{HAS_WORKING_DIR, "WorkingDir"},
+		{HAS_ARGUMENTS, "Arguments"},
+		{HAS_ICON_LOCATION, "IconLocation"},
+	} {
+		if flags&field.flag == 0 {
+			continue
+		}
+
+		// The size is in characters.
+		count := int(r.uint16(offset))
+		offset += 2
+		if flags&IS_UNICODE != 0 {
+			string_data.Set(field.name, decodeUTF16(r.bytes(offset, count*2)))
+			offset += count * 2
+		} else {
+			string_data.Set(field.name, string(r.bytes(offset, count)))
+			offset += count
+		}
+	}
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	extra := parseExtraData(data[offset:])
+
+	// Work out the best target path we have.
+	target := link_info_path
+	if target == "" {
+		target = id_list_path
+	}
+	if target == "" {
+		if env, ok := extra.Get("EnvironmentVariables"); ok {
+			target, _ = env.(string)
+		}
+	}
+
+	return ordereddict.NewDict().
+		Set("TargetPath", target).
+		Set("Header", header).
+		Set("LinkTarget", ordereddict.NewDict().
+			Set("Path", id_list_path).
+			Set("ShellItems", id_list)).
+		Set("LinkInfo", link_info).
+		Set("StringData", string_data).
+		Set("ExtraData", extra), nil
+}
+
+func parseLinkInfo(data []byte) (*ordereddict.Dict, string) {
+	r := &reader{data: data}
+	result := ordereddict.NewDict()
+
+	header_size := int(r.uint32(4))
+	flags := r.uint32(8)
+	volume_id_offset := int(r.uint32(12))
+	local_base_path_offset := int(r.uint32(16))
+	network_offset := int(r.uint32(20))
+	suffix_offset := int(r.uint32(24))
+
+	local_base_path := ""
+	suffix := r.cString(suffix_offset)
+
+	// Newer links also have unicode versions of the paths.
+	if header_size >= 0x24 {
+		if unicode_offset := int(r.uint32(28)); unicode_offset > 0 &&
+			flags&VOLUME_ID_AND_LOCAL_BASE_PATH != 0 {
+			local_base_path = r.utf16String(unicode_offset)
+		}
+		if unicode_offset := int(r.uint32(32)); unicode_offset > 0 {
+			suffix = r.utf16String(unicode_offset)
+		}
+	}
+
+	path := ""
+	if flags&VOLUME_ID_AND_LOCAL_BASE_PATH != 0 {
+		v := &reader{data: data[min(volume_id_offset, len(data)):]}
+		drive_type := v.uint32(4)
+		drive_type_name := fmt.Sprintf("%d", drive_type)
+		if int(drive_type) < len(driveTypes) {
+			drive_type_name = driveTypes[drive_type]
+		}
+
+		label_offset := int(v.uint32(12))
+		label := v.cString(label_offset)
+		if label_offset == 0x14 {
+			label = v.utf16String(int(v.uint32(16)))
+		}
+
+		if local_base_path == "" {
+			local_base_path = r.cString(local_base_path_offset)
+		}
+
+		result.Set("DriveType", drive_type_name).
+			Set("DriveSerialNumber", fmt.Sprintf("%08X", v.uint32(8))).
+			Set("VolumeLabel", label).
+			Set("LocalBasePath", local_base_path)
+		path = joinPath(local_base_path, suffix)
+	}
+
+	if flags&COMMON_NETWORK_RELATIVE_LINK != 0 {
+		n := &reader{data: data[min(network_offset, len(data)):]}
+		net_name_offset := int(n.uint32(8))
+		device_name_offset := int(n.uint32(12))
+
+		net_name := n.cString(net_name_offset)
+		device_name := n.cString(device_name_offset)
+		if net_name_offset > 0x14 {
+			net_name = n.utf16String(int(n.uint32(20)))
+			device_name = n.utf16String(int(n.uint32(24)))
+		}
+
+		result.Set("NetName", net_name).
+			Set("DeviceName", device_name)
+		if path == "" {
+			path = joinPath(net_name, suffix)
+		}
+	}
+
+	result.Set("CommonPathSuffix", suffix)
+	return result, path
+}
+
+func joinPath(base, suffix string) string {
+	if suffix == "" {
+		return base
+	}
+	if base == "" || strings.HasSuffix(base, "\\") {
+		return base + suffix
+	}
+	return base + "\\" + suffix
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func parseExtraData(data []byte) *ordereddict.Dict {
+	result := ordereddict.NewDict()
+
+	for len(data) >= 8 {
+		r := &reader{data: data}
+		size := int(r.uint32(0))
+		if size < 8 || size > len(data) {
+			break
+		}
+		block := &reader{data: data[:size]}
+		data = data[size:]
+
+		switch r.uint32(4) {
+		case TRACKER_BLOCK:
+			result.Set("Tracker", parseTrackerBlock(block))
+
+		case ENVIRONMENT_VARIABLES_BLOCK:
+			target := block.utf16String(268)
+			if target == "" {
+				target = block.cString(8)
+			}
+			result.Set("EnvironmentVariables", target)
+
+		case ICON_ENVIRONMENT_BLOCK:
+			target := block.utf16String(268)
+			if target == "" {
+				target = block.cString(8)
+			}
+			result.Set("IconEnvironment", target)
+
+		case DARWIN_BLOCK:
+			result.Set("DarwinID", block.utf16String(268))
+
+		case KNOWN_FOLDER_BLOCK:
+			result.Set("KnownFolderID", formatGUID(block.bytes(8, 16)))
+
+		case SPECIAL_FOLDER_BLOCK:
+			result.Set("SpecialFolderID", block.uint32(8))
+
+		case SHIM_BLOCK:
+			result.Set("ShimLayer", block.utf16String(8))
+
+		case VISTA_IDLIST_BLOCK:
+			items, path := parseIDList(block.data[8:])
+			result.Set("VistaIDList", ordereddict.NewDict().
+				Set("Path", path).
+				Set("ShellItems", items))
+		}
+	}
+	return result
+}
+
+// The tracker block holds the distributed link tracker ids of the
+// target. The object ids are version 1 UUIDs which contain the
+// creation time and MAC address of the machine that created them.
+func parseTrackerBlock(r *reader) *ordereddict.Dict {
+	file_droid := r.bytes(48, 16)
+	birth_file_droid := r.bytes(80, 16)
+
+	return ordereddict.NewDict().
+		Set("MachineID", r.cString(16)).
+		Set("VolumeID", formatGUID(r.bytes(32, 16))).
+		Set("ObjectID", formatGUID(file_droid)).
+		Set("BirthVolumeID", formatGUID(r.bytes(64, 16))).
+		Set("BirthObjectID", formatGUID(birth_file_droid)).
+		Set("MACAddress", uuidNode(file_droid)).
+		Set("ObjectIDTimestamp", uuidTime(file_droid)).
+		Set("ObjectIDSequence", uuidSequence(file_droid)).
+		Set("BirthMACAddress", uuidNode(birth_file_droid)).
+		Set("BirthObjectIDTimestamp", uuidTime(birth_file_droid))
+}
+
+func uuidVersion(data []byte) int {
+	return int(binary.LittleEndian.Uint16(data[6:]) >> 12)
+}
+
+func uuidNode(data []byte) string {
+	if uuidVersion(data) != 1 {
+		return ""
+	}
+	node := data[10:16]
+	return fmt.Sprintf("%02x:%02x:%02x:%02x:%02x:%02x",
+		node[0], node[1], node[2], node[3], node[4], node[5])
+}
+
+func uuidSequence(data []byte) uint16 {
+	if uuidVersion(data) != 1 {
+		return 0
+	}
+	return binary.BigEndian.Uint16(data[8:]) & 0x3fff
+}
+
+func uuidTime(data []byte) time.Time {
+	if uuidVersion(data) != 1 {
+		return time.Time{}
+	}
+
+	// 100ns intervals since 1582-10-15
+	low := uint64(binary.LittleEndian.Uint32(data))
+	mid := uint64(binary.LittleEndian.Uint16(data[4:]))
+	high := uint64(binary.LittleEndian.Uint16(data[6:]) & 0x0fff)
+	timestamp := high<<48 | mid<<32 | low
+
+	return time.Unix(int64(timestamp/10000000)-12219292800,
+		int64(timestamp%10000000)*100).UTC()
+}
@@ -0,0 +1,69 @@
+package lnk
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/Velocidex/ordereddict"
+	"github.com/alecthomas/assert"
+	"github.com/sebdah/goldie"
+	"github.com/stretchr/testify/suite"
+	"www.velocidex.com/golang/velociraptor/file_store/test_utils"
+	"www.velocidex.com/golang/velociraptor/json"
+
+	_ "www.velocidex.com/golang/velociraptor/accessors/file"
+)
+
+type LnkTestSuite struct {
+	test_utils.TestSuite
+}
+
+func (self *LnkTestSuite) query(query string, filenames ...string) []*ordereddict.Dict {
+	var paths []string
+	for _, filename := range filenames {
+		path, _ := filepath.Abs("../../../artifacts/testdata/files/" + filename)
+		paths = append(paths, path)
+	}
+
+	rows, err := test_utils.RunQuery(self.ConfigObj, query,
+		ordereddict.NewDict().Set("Filenames", paths))
+	assert.NoError(self.T(), err)
+
+	// Remove the path since it depends on the checkout location.
+	for _, row := range rows {
+		row.Delete("OSPath")
+	}
+	return rows
+}
+
+func (self *LnkTestSuite) TestParseLnk() {
+	rows := self.query(`SELECT * FROM parse_lnk(filename=Filenames)`,
+		"password.txt.lnk", "1.lnk")
+	assert.Equal(self.T(), 2, len(rows))
+
+	target, _ := rows[0].GetString("TargetPath")
+	assert.Equal(self.T(), `C:\Windows\System32\cmd.exe`, target)
+
+	goldie.Assert(self.T(), "TestParseLnk", json.MustMarshalIndent(rows))
+}
+
+func (self *LnkTestSuite) TestParseJumpList() {
+	rows := self.query(`
+SELECT Type, EntryNumber, StreamName, Hostname, LastModified, Pinned,
+       AccessCount, Path, TargetPath, Tracker,
+       Link.LinkInfo AS LinkInfo
+FROM parse_jumplist(filename=Filenames)`,
+		"test.automaticDestinations-ms", "test.customDestinations-ms")
+	assert.Equal(self.T(), 4, len(rows))
+
+	goldie.Assert(self.T(), "TestParseJumpList", json.MustMarshalIndent(rows))
+}
+
+func TestNotLnk(t *testing.T) {
+	_, err := ParseLnk([]byte("not a lnk file"))
+	assert.Equal(t, NotLnkError, err)
+}
+
+func TestLnk(t *testing.T) {
+	suite.Run(t, &LnkTestSuite{})
+}
@@ -0,0 +1,186 @@
+package lnk
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+
+	"github.com/Velocidex/ordereddict"
+	"www.velocidex.com/golang/oleparse"
+	"www.velocidex.com/golang/velociraptor/accessors"
+	"www.velocidex.com/golang/velociraptor/constants"
+	"www.velocidex.com/golang/velociraptor/utils"
+	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
+	vfilter "www.velocidex.com/golang/vfilter"
+	"www.velocidex.com/golang/vfilter/arg_parser"
+)
+
+type _ParseLnkPluginArgs struct {
+	Filenames []*accessors.OSPath `vfilter:"required,field=filename,doc=A list of LNK files to parse."`
+	Accessor  string              `vfilter:"optional,field=accessor,doc=The accessor to use."`
+}
+
+type _ParseLnkPlugin struct{}
+
+func (self _ParseLnkPlugin) Call(
+	ctx context.Context,
+	scope vfilter.Scope,
+	args *ordereddict.Dict) <-chan vfilter.Row {
+	output_chan := make(chan vfilter.Row)
+
+	go func() {
+		defer close(output_chan)
+
+		arg := &_ParseLnkPluginArgs{}
+		err := arg_parser.ExtractArgsWithContext(ctx, scope, args, arg)
+		if err != nil {
+			scope.Log("parse_lnk: %s", err.Error())
+			return
+		}
+
+		err = vql_subsystem.CheckFilesystemAccess(scope, arg.Accessor)
+		if err != nil {
+			scope.Log("parse_lnk: %s", err)
+			return
+		}
+
+		accessor, err := accessors.GetAccessor(arg.Accessor, scope)
+		if err != nil {
+			scope.Log("parse_lnk: %v", err)
+			return
+		}
+
+		for _, filename := range arg.Filenames {
+			func() {
+				defer utils.RecoverVQL(scope)
+
+				data, err := readFile(accessor, filename)
+				if err != nil {
+					scope.Log("Unable to open file %s: %v", filename, err)
+					return
+				}
+
+				link, err := ParseLnk(data)
+				if err != nil {
+					scope.Log("parse_lnk: Unable to parse file %s: %v",
+						filename, err)
+					return
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case output_chan <- link.Set("OSPath", filename):
+				}
+			}()
+		}
+	}()
+
+	return output_chan
+}
+
+func (self _ParseLnkPlugin) Info(scope vfilter.Scope, type_map *vfilter.TypeMap) *vfilter.PluginInfo {
+	return &vfilter.PluginInfo{
+		Name:    "parse_lnk",
+		Doc:     "Parses a Windows shortcut (LNK) file.",
+		ArgType: type_map.AddType(scope, &_ParseLnkPluginArgs{}),
+	}
+}
+
+type _ParseJumpListPluginArgs struct {
+	Filenames []*accessors.OSPath `vfilter:"required,field=filename,doc=A list of jump list files to parse."`
+	Accessor  string              `vfilter:"optional,field=accessor,doc=The accessor to use."`
+}
+
+type _ParseJumpListPlugin struct{}
+
+func (self _ParseJumpListPlugin) Call(
+	ctx context.Context,
+	scope vfilter.Scope,
+	args *ordereddict.Dict) <-chan vfilter.Row {
+	output_chan := make(chan vfilter.Row)
+
+	go func() {
+		defer close(output_chan)
+
+		arg := &_ParseJumpListPluginArgs{}
+		err := arg_parser.ExtractArgsWithContext(ctx, scope, args, arg)
+		if err != nil {
+			scope.Log("parse_jumplist: %s", err.Error())
+			return
+		}
+
+		err = vql_subsystem.CheckFilesystemAccess(scope, arg.Accessor)
+		if err != nil {
+			scope.Log("parse_jumplist: %s", err)
+			return
+		}
+
+		accessor, err := accessors.GetAccessor(arg.Accessor, scope)
+		if err != nil {
+			scope.Log("parse_jumplist: %v", err)
+			return
+		}
+
+		for _, filename := range arg.Filenames {
+			func() {
+				defer utils.RecoverVQL(scope)
+
+				data, err := readFile(accessor, filename)
+				if err != nil {
+					scope.Log("Unable to open file %s: %v", filename, err)
+					return
+				}
+
+				// Automatic destinations are OLE files, anything
+				// else is treated as custom destinations.
+				var entries []*JumpListEntry
+				if len(data) > len(oleparse.OLE_SIGNATURE) &&
+					string(data[:len(oleparse.OLE_SIGNATURE)]) == oleparse.OLE_SIGNATURE {
+					entries, err = ParseAutomaticDestinations(data)
+				} else {
+					entries, err = ParseCustomDestinations(data)
+				}
+				if err != nil {
+					scope.Log("parse_jumplist: Unable to parse file %s: %v",
+						filename, err)
+					return
+				}
+
+				for _, entry := range entries {
+					select {
+					case <-ctx.Done():
+						return
+					case output_chan <- entry.ToDict().Set("OSPath", filename):
+					}
+				}
+			}()
+		}
+	}()
+
+	return output_chan
+}
+
+func (self _ParseJumpListPlugin) Info(scope vfilter.Scope, type_map *vfilter.TypeMap) *vfilter.PluginInfo {
+	return &vfilter.PluginInfo{
+		Name:    "parse_jumplist",
+		Doc:     "Parses Windows automatic and custom destinations jump lists.",
+		ArgType: type_map.AddType(scope, &_ParseJumpListPluginArgs{}),
+	}
+}
+
+func readFile(accessor accessors.FileSystemAccessor,
+	filename *accessors.OSPath) ([]byte, error) {
+	fd, err := accessor.OpenWithOSPath(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+
+	return ioutil.ReadAll(io.LimitReader(fd, constants.MAX_MEMORY))
+}
+
+func init() {
+	vql_subsystem.RegisterPlugin(&_ParseLnkPlugin{})
+	vql_subsystem.RegisterPlugin(&_ParseJumpListPlugin{})
+}
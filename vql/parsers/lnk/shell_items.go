@@ -0,0 +1,187 @@
+package lnk
+
+import (
+	"encoding/binary"
+	"time"
+	"unicode/utf16"
+
+	"github.com/Velocidex/ordereddict"
+)
+
+// Only the common shell items are decoded. See
+// https://github.com/libyal/libfwsi/blob/main/documentation/Windows%20Shell%20Item%20format.asciidoc
+
+const (
+	SHELL_ITEM_ROOT_FOLDER = 0x1f
+	SHELL_ITEM_VOLUME      = 0x20
+	SHELL_ITEM_FILE_ENTRY  = 0x30
+	SHELL_ITEM_NETWORK     = 0x40
+
+	FILE_ENTRY_DIRECTORY = 0x01
+	FILE_ENTRY_UNICODE   = 0x04
+
+	FILE_ENTRY_EXTENSION_SIGNATURE = 0xbeef0004
+)
+
+var (
+	knownFolders = map[string]string{
+		"20D04FE0-3AEA-1069-A2D8-08002B30309D": "My Computer",
+		"208D2C60-3AEA-1069-A2D7-08002B30309D": "My Network Places",
+		"59031A47-3F72-44A7-89C5-5595FE6B30EE": "Users Files",
+		"645FF040-5081-101B-9F08-00AA002F954E": "Recycle Bin",
+		"21EC2020-3AEA-1069-A2DD-08002B30309D": "Control Panel",
+		"031E4825-7B94-4DC3-B131-E946B44C8DD5": "Libraries",
+		"679F85CB-0220-4080-B29B-5540CC05AAB6": "Quick Access",
+		"B4BFCC3A-DB2C-424C-B029-7FE99A87C641": "Desktop",
+		"A8CDFF1C-4878-43BE-B5FD-F8091C1C60D0": "Documents",
+		"374DE290-123F-4565-9164-39C4925E467B": "Downloads",
+		"F02C1A0D-BE21-4350-88B0-7367FC96EF3C": "Network",
+	}
+)
+
+// Parse an IDList into its shell items and the path they describe.
+func parseIDList(data []byte) ([]*ordereddict.Dict, string) {
+	result := []*ordereddict.Dict{}
+	var components []string
+
+	for len(data) >= 2 {
+		size := int(binary.LittleEndian.Uint16(data))
+		if size == 0 {
+			break
+		}
+		if size < 3 || size > len(data) {
+			break
+		}
+
+		item, name := parseShellItem(&reader{data: data[:size]})
+		result = append(result, item)
+		if name != "" {
+			components = append(components, name)
+		}
+		data = data[size:]
+	}
+
+	path := ""
+	for _, component := range components {
+		path = joinPath(path, component)
+	}
+	return result, path
+}
+
+// Decode a shell item returning it and the path component it
+// contributes (if any).
+func parseShellItem(r *reader) (*ordereddict.Dict, string) {
+	item_type := r.bytes(2, 1)[0]
+	result := ordereddict.NewDict()
+
+	switch {
+	case item_type == SHELL_ITEM_ROOT_FOLDER:
+		guid := formatGUID(r.bytes(4, 16))
+		name, pres := knownFolders[guid]
+		if !pres {
+			name = "{" + guid + "}"
+		}
+		result.Set("Type", "RootFolder").
+			Set("Name", name).
+			Set("FolderID", guid)
+
+		// My Computer is implied by the drive letter that follows.
+		if guid == "20D04FE0-3AEA-1069-A2D8-08002B30309D" {
+			return result, ""
+		}
+		return result, name
+
+	case item_type&0x70 == SHELL_ITEM_VOLUME:
+		name := r.cString(3)
+		result.Set("Type", "Volume").
+			Set("Name", name)
+		return result, name
+
+	case item_type&0x70 == SHELL_ITEM_FILE_ENTRY:
+		return parseFileEntry(r, item_type)
+
+	case item_type&0x70 == SHELL_ITEM_NETWORK:
+		name := r.cString(5)
+		result.Set("Type", "Network").
+			Set("Name", name)
+		return result, name
+	}
+
+	result.Set("Type", "Unknown").
+		Set("ItemType", item_type).
+		Set("Size", len(r.data))
+	return result, ""
+}
+
+func parseFileEntry(r *reader, item_type uint8) (*ordereddict.Dict, string) {
+	item_kind := "File"
+	if item_type&FILE_ENTRY_DIRECTORY != 0 {
+		item_kind = "Directory"
+	}
+
+	// The short name is padded to an even length.
+	offset := 14
+	var short_name string
+	if item_type&FILE_ENTRY_UNICODE != 0 {
+		short_name = r.utf16String(offset)
+		offset += (len(utf16.Encode([]rune(short_name))) + 1) * 2
+	} else {
+		short_name = r.cString(offset)
+		offset += len(short_name) + 1
+		if offset%2 != 0 {
+			offset++
+		}
+	}
+
+	result := ordereddict.NewDict().
+		Set("Type", item_kind).
+		Set("Name", short_name).
+		Set("ShortName", short_name).
+		Set("Size", r.uint32(4)).
+		Set("ModificationTime", fatTime(r.bytes(8, 4)))
+
+	name := short_name
+	ext := &reader{data: r.data[min(offset, len(r.data)):]}
+	if len(ext.data) >= 8 && ext.uint32(4) == FILE_ENTRY_EXTENSION_SIGNATURE {
+		version := ext.uint16(2)
+		result.Set("CreationTime", fatTime(ext.bytes(8, 4))).
+			Set("AccessTime", fatTime(ext.bytes(12, 4)))
+
+		name_offset := 18
+		if version >= 7 {
+			reference := ext.uint64(20)
+			result.Set("MFTEntry", reference&0xffffffffffff).
+				Set("MFTSequence", reference>>48)
+			name_offset = 38
+		}
+		if version >= 8 {
+			name_offset += 4
+		}
+		if version >= 9 {
+			name_offset += 4
+		}
+
+		if version >= 3 {
+			long_name := ext.utf16String(name_offset)
+			if long_name != "" {
+				name = long_name
+				result.Update("Name", long_name)
+			}
+		}
+	}
+
+	return result, name
+}
+
+// FAT timestamps are a date followed by a time in local time.
+func fatTime(data []byte) time.Time {
+	date := binary.LittleEndian.Uint16(data)
+	tm := binary.LittleEndian.Uint16(data[2:])
+	if date == 0 {
+		return time.Time{}
+	}
+
+	return time.Date(
+		int(date>>9)+1980, time.Month((date>>5)&0x0f), int(date&0x1f),
+		int(tm>>11), int((tm>>5)&0x3f), int(tm&0x1f)*2, 0, time.UTC)
+}
@@ -0,0 +1,473 @@
+package pcap
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/Velocidex/ordereddict"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	LINKTYPE_NULL      = 0
+	LINKTYPE_ETHERNET  = 1
+	LINKTYPE_RAW       = 101
+	LINKTYPE_LOOP      = 108
+	LINKTYPE_LINUX_SLL = 113
+	LINKTYPE_IPV4      = 228
+	LINKTYPE_IPV6      = 229
+	LINKTYPE_SLL2      = 276
+
+	ETHERTYPE_IPV4  = 0x0800
+	ETHERTYPE_ARP   = 0x0806
+	ETHERTYPE_VLAN  = 0x8100
+	ETHERTYPE_QINQ  = 0x88a8
+	ETHERTYPE_IPV6  = 0x86dd
+	IPPROTO_ICMP    = 1
+	IPPROTO_TCP     = 6
+	IPPROTO_UDP     = 17
+	IPPROTO_ICMPV6  = 58
+	DNS_PORT        = 53
+	MDNS_PORT       = 5353
+	MAX_VLAN_LAYERS = 4
+
+	// Only this much of the HTTP request is examined.
+	MAX_HTTP_HEADER = 8192
+)
+
+var (
+	ipProtocolNames = map[uint8]string{
+		IPPROTO_ICMP:   "ICMP",
+		IPPROTO_TCP:    "TCP",
+		IPPROTO_UDP:    "UDP",
+		IPPROTO_ICMPV6: "ICMPv6",
+	}
+
+	httpMethods = []string{
+		"GET", "POST", "HEAD", "PUT", "DELETE", "OPTIONS",
+		"PATCH", "CONNECT", "TRACE",
+	}
+)
+
+// The decoded layers of a packet. Layers which are not present are
+// left empty.
+type DecodedPacket struct {
+	SrcMAC    string
+	DstMAC    string
+	EtherType uint16
+	VLAN      []uint16
+
+	SrcIP      string
+	DstIP      string
+	IPVersion  int
+	TTL        uint8
+	Protocol   string
+	protocol   uint8
+	Fragmented bool
+
+	SrcPort  uint16
+	DstPort  uint16
+	TCPFlags string
+	Seq      uint32
+	Ack      uint32
+	Window   uint16
+
+	ICMPType uint8
+	ICMPCode uint8
+
+	Payload []byte
+	DNS     *ordereddict.Dict
+	HTTP    *ordereddict.Dict
+}
+
+// Decode as many layers of the packet as we understand. Truncated or
+// unknown layers simply stop the decoding.
+func DecodePacket(link_type uint32, data []byte) *DecodedPacket {
+	result := &DecodedPacket{}
+
+	switch link_type {
+	case LINKTYPE_ETHERNET:
+		result.decodeEthernet(data)
+
+	case LINKTYPE_RAW, LINKTYPE_IPV4, LINKTYPE_IPV6:
+		result.decodeIP(data)
+
+	case LINKTYPE_NULL, LINKTYPE_LOOP:
+		// A 4 byte address family in host (NULL) or network (LOOP)
+		// byte order - we just check the IP version.
+		if len(data) > 4 {
+			result.decodeIP(data[4:])
+		}
+
+	case LINKTYPE_LINUX_SLL:
+		if len(data) >= 16 {
+			result.SrcMAC = formatLinkAddress(data[4:6], data[6:16])
+			result.decodeEtherType(binary.BigEndian.Uint16(data[14:]), data[16:])
+		}
+
+	case LINKTYPE_SLL2:
+		if len(data) >= 20 {
+			result.SrcMAC = formatLinkAddress(data[10:12], data[12:20])
+			result.decodeEtherType(binary.BigEndian.Uint16(data), data[20:])
+		}
+	}
+
+	return result
+}
+
+func formatLinkAddress(length []byte, address []byte) string {
+	n := int(binary.BigEndian.Uint16(length))
+	if n > len(address) {
+		n = len(address)
+	}
+	return net.HardwareAddr(address[:n]).String()
+}
+
+func (self *DecodedPacket) decodeEthernet(data []byte) {
+	if len(data) < 14 {
+		return
+	}
+
+	self.DstMAC = net.HardwareAddr(data[0:6]).String()
+	self.SrcMAC = net.HardwareAddr(data[6:12]).String()
+
+	ether_type := binary.BigEndian.Uint16(data[12:])
+	data = data[14:]
+
+	// Strip any VLAN tags.
+	for i := 0; i < MAX_VLAN_LAYERS &&
+		(ether_type == ETHERTYPE_VLAN || ether_type == ETHERTYPE_QINQ); i++ {
+		if len(data) < 4 {
+			return
+		}
+		self.VLAN = append(self.VLAN, binary.BigEndian.Uint16(data)&0x0fff)
+		ether_type = binary.BigEndian.Uint16(data[2:])
+		data = data[4:]
+	}
+
+	self.decodeEtherType(ether_type, data)
+}
+
+func (self *DecodedPacket) decodeEtherType(ether_type uint16, data []byte) {
+	self.EtherType = ether_type
+
+	switch ether_type {
+	case ETHERTYPE_IPV4, ETHERTYPE_IPV6:
+		self.decodeIP(data)
+
+	case ETHERTYPE_ARP:
+		self.Protocol = "ARP"
+	}
+}
+
+func (self *DecodedPacket) decodeIP(data []byte) {
+	if len(data) < 1 {
+		return
+	}
+
+	switch data[0] >> 4 {
+	case 4:
+		self.decodeIPv4(data)
+	case 6:
+		self.decodeIPv6(data)
+	}
+}
+
+func (self *DecodedPacket) decodeIPv4(data []byte) {
+	if len(data) < 20 {
+		return
+	}
+
+	header_length := int(data[0]&0x0f) * 4
+	if header_length < 20 || header_length > len(data) {
+		return
+	}
+
+	self.IPVersion = 4
+	self.TTL = data[8]
+	self.SrcIP = net.IP(data[12:16]).String()
+	self.DstIP = net.IP(data[16:20]).String()
+
+	// Ignore any link layer padding after the IP packet.
+	total_length := int(binary.BigEndian.Uint16(data[2:]))
+	if total_length >= header_length && total_length < len(data) {
+		data = data[:total_length]
+	}
+
+	// Only the first fragment carries the transport header.
+	flags_offset := binary.BigEndian.Uint16(data[6:])
+	fragment_offset := flags_offset & 0x1fff
+	more_fragments := flags_offset&0x2000 != 0
+	self.Fragmented = more_fragments || fragment_offset != 0
+
+	self.setProtocol(data[9])
+	if fragment_offset == 0 {
+		self.decodeTransport(data[header_length:])
+	}
+}
+
+func (self *DecodedPacket) decodeIPv6(data []byte) {
+	if len(data) < 40 {
+		return
+	}
+
+	self.IPVersion = 6
+	self.TTL = data[7]
+	self.SrcIP = net.IP(data[8:24]).String()
+	self.DstIP = net.IP(data[24:40]).String()
+
+	payload_length := int(binary.BigEndian.Uint16(data[4:]))
+	next_header := data[6]
+	data = data[40:]
+	if payload_length > 0 && payload_length < len(data) {
+		data = data[:payload_length]
+	}
+
+	// Skip extension headers.
+headers:
+	for i := 0; i < 8; i++ {
+		switch next_header {
+		case 0, 43, 60: // Hop by hop, routing and destination options
+			if len(data) < 8 {
+				return
+			}
+			length := (int(data[1]) + 1) * 8
+			if length > len(data) {
+				return
+			}
+			next_header = data[0]
+			data = data[length:]
+			continue
+
+		case 44: // Fragment
+			if len(data) < 8 {
+				return
+			}
+			fragment_offset := binary.BigEndian.Uint16(data[2:]) >> 3
+			self.Fragmented = true
+			next_header = data[0]
+			data = data[8:]
+			if fragment_offset != 0 {
+				self.setProtocol(next_header)
+				return
+			}
+			continue
+		}
+		break headers
+	}
+
+	self.setProtocol(next_header)
+	self.decodeTransport(data)
+}
+
+func (self *DecodedPacket) setProtocol(protocol uint8) {
+	self.protocol = protocol
+	name, pres := ipProtocolNames[protocol]
+	if !pres {
+		name = fmt.Sprintf("%d", protocol)
+	}
+	self.Protocol = name
+}
+
+func (self *DecodedPacket) decodeTransport(data []byte) {
+	switch self.protocol {
+	case IPPROTO_TCP:
+		self.decodeTCP(data)
+
+	case IPPROTO_UDP:
+		self.decodeUDP(data)
+
+	case IPPROTO_ICMP, IPPROTO_ICMPV6:
+		if len(data) >= 4 {
+			self.ICMPType = data[0]
+			self.ICMPCode = data[1]
+			self.Payload = data[4:]
+		}
+	}
+}
+
+func (self *DecodedPacket) decodeTCP(data []byte) {
+	if len(data) < 20 {
+		return
+	}
+
+	self.SrcPort = binary.BigEndian.Uint16(data[0:])
+	self.DstPort = binary.BigEndian.Uint16(data[2:])
+	self.Seq = binary.BigEndian.Uint32(data[4:])
+	self.Ack = binary.BigEndian.Uint32(data[8:])
+	self.Window = binary.BigEndian.Uint16(data[14:])
+	self.TCPFlags = formatTCPFlags(data[13])
+
+	offset := int(data[12]>>4) * 4
+	if offset < 20 || offset > len(data) {
+		return
+	}
+	self.Payload = data[offset:]
+
+	if len(self.Payload) == 0 {
+		return
+	}
+
+	// DNS over TCP has a two byte length prefix.
+	if self.isDNS() && len(self.Payload) > 2 {
+		self.DNS = decodeDNS(self.Payload[2:])
+		return
+	}
+
+	self.HTTP = decodeHTTPRequest(self.Payload)
+}
+
+func formatTCPFlags(flags uint8) string {
+	names := []string{"FIN", "SYN", "RST", "PSH", "ACK", "URG", "ECE", "CWR"}
+	var result []string
+	for i, name := range names {
+		if flags&(1<<uint(i)) != 0 {
+			result = append(result, name)
+		}
+	}
+	return strings.Join(result, ",")
+}
+
+func (self *DecodedPacket) decodeUDP(data []byte) {
+	if len(data) < 8 {
+		return
+	}
+
+	self.SrcPort = binary.BigEndian.Uint16(data[0:])
+	self.DstPort = binary.BigEndian.Uint16(data[2:])
+
+	length := int(binary.BigEndian.Uint16(data[4:]))
+	data = data[8:]
+	if length >= 8 && length-8 < len(data) {
+		data = data[:length-8]
+	}
+	self.Payload = data
+
+	if self.isDNS() {
+		self.DNS = decodeDNS(data)
+	}
+}
+
+func (self *DecodedPacket) isDNS() bool {
+	return self.SrcPort == DNS_PORT || self.DstPort == DNS_PORT ||
+		self.SrcPort == MDNS_PORT || self.DstPort == MDNS_PORT
+}
+
+func decodeDNS(data []byte) *ordereddict.Dict {
+	var msg dnsmessage.Message
+	err := msg.Unpack(data)
+	if err != nil {
+		return nil
+	}
+
+	questions := make([]*ordereddict.Dict, 0, len(msg.Questions))
+	for _, q := range msg.Questions {
+		questions = append(questions, ordereddict.NewDict().
+			Set("Name", q.Name.String()).
+			Set("Type", dnsTypeName(q.Type)))
+	}
+
+	answers := make([]*ordereddict.Dict, 0, len(msg.Answers))
+	for _, a := range msg.Answers {
+		answers = append(answers, ordereddict.NewDict().
+			Set("Name", a.Header.Name.String()).
+			Set("Type", dnsTypeName(a.Header.Type)).
+			Set("TTL", a.Header.TTL).
+			Set("Data", dnsResourceData(a.Body)))
+	}
+
+	return ordereddict.NewDict().
+		Set("ID", msg.Header.ID).
+		Set("Response", msg.Header.Response).
+		Set("Opcode", int(msg.Header.OpCode)).
+		Set("RCode", strings.TrimPrefix(msg.Header.RCode.String(), "RCode")).
+		Set("Questions", questions).
+		Set("Answers", answers)
+}
+
+func dnsTypeName(t dnsmessage.Type) string {
+	return strings.TrimPrefix(t.String(), "Type")
+}
+
+func dnsResourceData(body dnsmessage.ResourceBody) string {
+	switch t := body.(type) {
+	case *dnsmessage.AResource:
+		return net.IP(t.A[:]).String()
+	case *dnsmessage.AAAAResource:
+		return net.IP(t.AAAA[:]).String()
+	case *dnsmessage.CNAMEResource:
+		return t.CNAME.String()
+	case *dnsmessage.NSResource:
+		return t.NS.String()
+	case *dnsmessage.PTRResource:
+		return t.PTR.String()
+	case *dnsmessage.MXResource:
+		return fmt.Sprintf("%d %s", t.Pref, t.MX.String())
+	case *dnsmessage.TXTResource:
+		return strings.Join(t.TXT, " ")
+	case *dnsmessage.SRVResource:
+		return fmt.Sprintf("%d %d %d %s",
+			t.Priority, t.Weight, t.Port, t.Target.String())
+	case *dnsmessage.SOAResource:
+		return fmt.Sprintf("%s %s %d", t.NS.String(), t.MBox.String(), t.Serial)
+	case nil:
+		return ""
+	}
+	return body.GoString()
+}
+
+// Decode the start of an HTTP request. Only the request line and
+// headers in this packet are considered.
+func decodeHTTPRequest(data []byte) *ordereddict.Dict {
+	if len(data) > MAX_HTTP_HEADER {
+		data = data[:MAX_HTTP_HEADER]
+	}
+
+	idx := bytes.IndexByte(data, ' ')
+	if idx <= 0 || !isHTTPMethod(string(data[:idx])) {
+		return nil
+	}
+
+	lines := strings.Split(string(data), "\r\n")
+	parts := strings.SplitN(lines[0], " ", 3)
+	if len(parts) != 3 || !strings.HasPrefix(parts[2], "HTTP/") {
+		return nil
+	}
+
+	headers := ordereddict.NewDict()
+	for _, line := range lines[1:] {
+		// An empty line ends the headers.
+		if line == "" {
+			break
+		}
+
+		idx := strings.IndexByte(line, ':')
+		if idx <= 0 {
+			continue
+		}
+		headers.Set(line[:idx], strings.TrimSpace(line[idx+1:]))
+	}
+
+	host, _ := headers.GetString("Host")
+	user_agent, _ := headers.GetString("User-Agent")
+
+	return ordereddict.NewDict().
+		Set("Method", parts[0]).
+		Set("URI", parts[1]).
+		Set("Version", parts[2]).
+		Set("Host", host).
+		Set("UserAgent", user_agent).
+		Set("Headers", headers)
+}
+
+func isHTTPMethod(method string) bool {
+	for _, m := range httpMethods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,236 @@
+package pcap
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Velocidex/ordereddict"
+	"www.velocidex.com/golang/velociraptor/accessors"
+	"www.velocidex.com/golang/velociraptor/utils"
+	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
+	vfilter "www.velocidex.com/golang/vfilter"
+	"www.velocidex.com/golang/vfilter/arg_parser"
+)
+
+type _ParsePcapPluginArgs struct {
+	Filenames []*accessors.OSPath `vfilter:"required,field=filename,doc=A list of pcap or pcapng files to parse."`
+	Accessor  string              `vfilter:"optional,field=accessor,doc=The accessor to use."`
+	Flows     bool                `vfilter:"optional,field=flows,doc=If set emit one row per flow (protocol, source and destination address and port) instead of one row per packet."`
+}
+
+type _ParsePcapPlugin struct{}
+
+func (self _ParsePcapPlugin) Call(
+	ctx context.Context,
+	scope vfilter.Scope,
+	args *ordereddict.Dict) <-chan vfilter.Row {
+	output_chan := make(chan vfilter.Row)
+
+	go func() {
+		defer close(output_chan)
+
+		arg := &_ParsePcapPluginArgs{}
+		err := arg_parser.ExtractArgsWithContext(ctx, scope, args, arg)
+		if err != nil {
+			scope.Log("parse_pcap: %s", err.Error())
+			return
+		}
+
+		err = vql_subsystem.CheckFilesystemAccess(scope, arg.Accessor)
+		if err != nil {
+			scope.Log("parse_pcap: %s", err)
+			return
+		}
+
+		accessor, err := accessors.GetAccessor(arg.Accessor, scope)
+		if err != nil {
+			scope.Log("parse_pcap: %v", err)
+			return
+		}
+
+		for _, filename := range arg.Filenames {
+			func() {
+				defer utils.RecoverVQL(scope)
+
+				fd, err := accessor.OpenWithOSPath(filename)
+				if err != nil {
+					scope.Log("Unable to open file %s: %v",
+						filename, err)
+					return
+				}
+				defer fd.Close()
+
+				reader, err := NewPacketReader(fd)
+				if err != nil {
+					scope.Log("parse_pcap: Unable to parse file %s: %v",
+						filename, err)
+					return
+				}
+
+				if arg.Flows {
+					err = emitFlows(ctx, reader, output_chan)
+				} else {
+					err = emitPackets(ctx, reader, output_chan)
+				}
+
+				if err != nil && ctx.Err() == nil {
+					scope.Log("parse_pcap: %s: %v", filename, err)
+				}
+			}()
+		}
+	}()
+
+	return output_chan
+}
+
+func (self _ParsePcapPlugin) Info(scope vfilter.Scope, type_map *vfilter.TypeMap) *vfilter.PluginInfo {
+	return &vfilter.PluginInfo{
+		Name:    "parse_pcap",
+		Doc:     "Parses packets from a pcap or pcapng file.",
+		ArgType: type_map.AddType(scope, &_ParsePcapPluginArgs{}),
+	}
+}
+
+func emitPackets(ctx context.Context,
+	reader PacketReader, output_chan chan vfilter.Row) error {
+	for {
+		packet, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		decoded := DecodePacket(packet.LinkType, packet.Data)
+
+		var vlan interface{}
+		if len(decoded.VLAN) > 0 {
+			vlan = decoded.VLAN
+		}
+
+		row := ordereddict.NewDict().
+			Set("Timestamp", packet.Timestamp).
+			Set("Interface", packet.Interface).
+			Set("Length", packet.Length).
+			Set("CaptureLength", len(packet.Data)).
+			Set("SrcMAC", decoded.SrcMAC).
+			Set("DstMAC", decoded.DstMAC).
+			Set("EtherType", fmt.Sprintf("%#04x", decoded.EtherType)).
+			Set("VLAN", vlan).
+			Set("IPVersion", decoded.IPVersion).
+			Set("SrcIP", decoded.SrcIP).
+			Set("DstIP", decoded.DstIP).
+			Set("TTL", decoded.TTL).
+			Set("Fragmented", decoded.Fragmented).
+			Set("Protocol", decoded.Protocol).
+			Set("SrcPort", decoded.SrcPort).
+			Set("DstPort", decoded.DstPort).
+			Set("TCPFlags", decoded.TCPFlags).
+			Set("Seq", decoded.Seq).
+			Set("Ack", decoded.Ack).
+			Set("Window", decoded.Window).
+			Set("ICMPType", decoded.ICMPType).
+			Set("ICMPCode", decoded.ICMPCode).
+			Set("PayloadSize", len(decoded.Payload)).
+			Set("DNS", decoded.DNS).
+			Set("HTTP", decoded.HTTP)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case output_chan <- row:
+		}
+	}
+}
+
+type flowKey struct {
+	Protocol string
+	SrcIP    string
+	SrcPort  uint16
+	DstIP    string
+	DstPort  uint16
+}
+
+type flow struct {
+	key       flowKey
+	FirstSeen time.Time
+	LastSeen  time.Time
+	Packets   uint64
+	Bytes     uint64
+}
+
+// Aggregate IP packets by their 5-tuple. Flows are emitted in the
+// order they were first seen once the whole file is read.
+func emitFlows(ctx context.Context,
+	reader PacketReader, output_chan chan vfilter.Row) error {
+	flows := make(map[flowKey]*flow)
+	var order []*flow
+
+	for {
+		packet, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		decoded := DecodePacket(packet.LinkType, packet.Data)
+		if decoded.IPVersion == 0 {
+			continue
+		}
+
+		key := flowKey{
+			Protocol: decoded.Protocol,
+			SrcIP:    decoded.SrcIP,
+			SrcPort:  decoded.SrcPort,
+			DstIP:    decoded.DstIP,
+			DstPort:  decoded.DstPort,
+		}
+
+		record, pres := flows[key]
+		if !pres {
+			record = &flow{key: key, FirstSeen: packet.Timestamp}
+			flows[key] = record
+			order = append(order, record)
+		}
+
+		if packet.Timestamp.After(record.LastSeen) {
+			record.LastSeen = packet.Timestamp
+		}
+		if packet.Timestamp.Before(record.FirstSeen) {
+			record.FirstSeen = packet.Timestamp
+		}
+		record.Packets++
+		record.Bytes += uint64(packet.Length)
+	}
+
+	for _, record := range order {
+		row := ordereddict.NewDict().
+			Set("Protocol", record.key.Protocol).
+			Set("SrcIP", record.key.SrcIP).
+			Set("SrcPort", record.key.SrcPort).
+			Set("DstIP", record.key.DstIP).
+			Set("DstPort", record.key.DstPort).
+			Set("FirstSeen", record.FirstSeen).
+			Set("LastSeen", record.LastSeen).
+			Set("Duration", record.LastSeen.Sub(record.FirstSeen).Seconds()).
+			Set("Packets", record.Packets).
+			Set("Bytes", record.Bytes)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case output_chan <- row:
+		}
+	}
+
+	return nil
+}
+
+func init() {
+	vql_subsystem.RegisterPlugin(&_ParsePcapPlugin{})
+}
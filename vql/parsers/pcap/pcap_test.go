@@ -0,0 +1,70 @@
+package pcap
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/Velocidex/ordereddict"
+	"github.com/alecthomas/assert"
+	"github.com/sebdah/goldie"
+	"github.com/stretchr/testify/suite"
+	"www.velocidex.com/golang/velociraptor/file_store/test_utils"
+	"www.velocidex.com/golang/velociraptor/json"
+
+	_ "www.velocidex.com/golang/velociraptor/accessors/file"
+)
+
+type PcapTestSuite struct {
+	test_utils.TestSuite
+}
+
+func (self *PcapTestSuite) parse(filename string, flows bool) []*ordereddict.Dict {
+	path, _ := filepath.Abs("../../../artifacts/testdata/files/" + filename)
+	rows, err := test_utils.RunQuery(self.ConfigObj, `
+SELECT * FROM parse_pcap(filename=Filename, flows=Flows)`,
+		ordereddict.NewDict().
+			Set("Filename", path).
+			Set("Flows", flows))
+	assert.NoError(self.T(), err)
+	return rows
+}
+
+func (self *PcapTestSuite) TestParsePcap() {
+	packets := self.parse("test.pcap", false)
+	assert.Equal(self.T(), 10, len(packets))
+
+	// The pcapng file holds the same packets with nanosecond
+	// timestamps.
+	assert.Equal(self.T(), json.MustMarshalString(packets),
+		json.MustMarshalString(self.parse("test.pcapng", false)))
+
+	flows := self.parse("test.pcap", true)
+	assert.Equal(self.T(), json.MustMarshalString(flows),
+		json.MustMarshalString(self.parse("test.pcapng", true)))
+
+	goldie.Assert(self.T(), "TestParsePcap", json.MustMarshalIndent(
+		ordereddict.NewDict().
+			Set("Packets", packets).
+			Set("Flows", flows)))
+}
+
+func TestDecodeTruncated(t *testing.T) {
+	// Truncated packets decode as far as possible without errors.
+	packet := DecodePacket(LINKTYPE_ETHERNET, []byte{
+		2, 0, 0, 0, 0, 1, 2, 0, 0, 0, 0, 2, 0x08, 0x00, 0x45, 0})
+	assert.Equal(t, "02:00:00:00:00:02", packet.SrcMAC)
+	assert.Equal(t, 0, packet.IPVersion)
+
+	_, err := NewPacketReader(nil_reader{})
+	assert.Equal(t, NotPcapError, err)
+}
+
+type nil_reader struct{}
+
+func (self nil_reader) Read(buf []byte) (int, error) {
+	return copy(buf, "not a pcap file"), nil
+}
+
+func TestPcap(t *testing.T) {
+	suite.Run(t, &PcapTestSuite{})
+}
@@ -0,0 +1,352 @@
+// Readers for the classic libpcap and the pcapng capture file formats.
+
+// The formats are documented at
+// https://www.tcpdump.org/manpages/pcap-savefile.5.txt
+// https://www.ietf.org/archive/id/draft-tuexen-opsawg-pcapng-05.html
+
+package pcap
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+	"time"
+)
+
+const (
+	PCAP_MAGIC_MICROSECONDS = 0xa1b2c3d4
+	PCAP_MAGIC_NANOSECONDS  = 0xa1b23c4d
+
+	PCAPNG_SECTION_HEADER_BLOCK     = 0x0a0d0d0a
+	PCAPNG_INTERFACE_BLOCK          = 1
+	PCAPNG_OBSOLETE_PACKET_BLOCK    = 2
+	PCAPNG_SIMPLE_PACKET_BLOCK      = 3
+	PCAPNG_ENHANCED_PACKET_BLOCK    = 6
+	PCAPNG_BYTE_ORDER_MAGIC         = 0x1a2b3c4d
+	PCAPNG_OPTION_END               = 0
+	PCAPNG_OPTION_IF_TSRESOL        = 9
+	PCAPNG_DEFAULT_TSRESOL_EXPONENT = 6
+
+	// Limits to protect against corrupt files.
+	MAX_PACKET_SIZE = 256 * 1024
+	MAX_BLOCK_SIZE  = 16 * 1024 * 1024
+)
+
+var (
+	NotPcapError = errors.New("Not a pcap or pcapng file")
+)
+
+type Packet struct {
+	Timestamp time.Time
+
+	// The original length of the packet on the wire.
+	Length int
+
+	// The packet data - may be shorter than Length if the capture
+	// was truncated to the snap length.
+	Data []byte
+
+	LinkType  uint32
+	Interface int
+}
+
+type PacketReader interface {
+	// Returns io.EOF when there are no more packets.
+	Next() (*Packet, error)
+}
+
+// Detect the file format from its magic and return a reader for it.
+func NewPacketReader(reader io.Reader) (PacketReader, error) {
+	buffered := bufio.NewReader(reader)
+	magic, err := buffered.Peek(4)
+	if err != nil {
+		return nil, NotPcapError
+	}
+
+	if binary.LittleEndian.Uint32(magic) == PCAPNG_SECTION_HEADER_BLOCK {
+		return newPcapngReader(buffered)
+	}
+
+	for _, order := range []binary.ByteOrder{
+		binary.LittleEndian, binary.BigEndian} {
+		switch order.Uint32(magic) {
+		case PCAP_MAGIC_MICROSECONDS:
+			return newPcapReader(buffered, order, time.Microsecond)
+		case PCAP_MAGIC_NANOSECONDS:
+			return newPcapReader(buffered, order, time.Nanosecond)
+		}
+	}
+
+	return nil, NotPcapError
+}
+
+type pcapReader struct {
+	reader     io.Reader
+	order      binary.ByteOrder
+	resolution time.Duration
+	link_type  uint32
+}
+
+func newPcapReader(reader io.Reader, order binary.ByteOrder,
+	resolution time.Duration) (*pcapReader, error) {
+	header := make([]byte, 24)
+	_, err := io.ReadFull(reader, header)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pcapReader{
+		reader:     reader,
+		order:      order,
+		resolution: resolution,
+		link_type:  order.Uint32(header[20:]) & 0xffff,
+	}, nil
+}
+
+func (self *pcapReader) Next() (*Packet, error) {
+	header := make([]byte, 16)
+	_, err := io.ReadFull(self.reader, header)
+	if err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, io.EOF
+		}
+		return nil, err
+	}
+
+	seconds := self.order.Uint32(header[0:])
+	fraction := self.order.Uint32(header[4:])
+	captured := self.order.Uint32(header[8:])
+	length := self.order.Uint32(header[12:])
+
+	if captured > MAX_PACKET_SIZE {
+		return nil, fmt.Errorf("Packet size %v too large", captured)
+	}
+
+	data := make([]byte, captured)
+	_, err = io.ReadFull(self.reader, data)
+	if err != nil {
+		return nil, io.EOF
+	}
+
+	return &Packet{
+		Timestamp: time.Unix(int64(seconds),
+			int64(fraction)*int64(self.resolution)).UTC(),
+		Length:   int(length),
+		Data:     data,
+		LinkType: self.link_type,
+	}, nil
+}
+
+type pcapngInterface struct {
+	link_type uint32
+
+	// Timestamp units per second.
+	units_per_second uint64
+}
+
+type pcapngReader struct {
+	reader     io.Reader
+	order      binary.ByteOrder
+	interfaces []pcapngInterface
+}
+
+func newPcapngReader(reader io.Reader) (*pcapngReader, error) {
+	return &pcapngReader{
+		reader: reader,
+		order:  binary.LittleEndian,
+	}, nil
+}
+
+// Read the next block returning its type and body (without the
+// type and length fields).
+func (self *pcapngReader) readBlock() (uint32, []byte, error) {
+	header := make([]byte, 8)
+	_, err := io.ReadFull(self.reader, header)
+	if err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return 0, nil, io.EOF
+		}
+		return 0, nil, err
+	}
+
+	block_type := self.order.Uint32(header)
+
+	// A section header may change the byte order so we need to
+	// check the byte order magic before we can read the length.
+	if block_type == PCAPNG_SECTION_HEADER_BLOCK {
+		magic := make([]byte, 4)
+		_, err := io.ReadFull(self.reader, magic)
+		if err != nil {
+			return 0, nil, io.EOF
+		}
+
+		switch uint32(PCAPNG_BYTE_ORDER_MAGIC) {
+		case binary.LittleEndian.Uint32(magic):
+			self.order = binary.LittleEndian
+		case binary.BigEndian.Uint32(magic):
+			self.order = binary.BigEndian
+		default:
+			return 0, nil, NotPcapError
+		}
+
+		// Interfaces are scoped to their section.
+		self.interfaces = nil
+
+		length := self.order.Uint32(header[4:])
+		if length < 16 || length > MAX_BLOCK_SIZE || length%4 != 0 {
+			return 0, nil, fmt.Errorf("Invalid block length %v", length)
+		}
+
+		body := make([]byte, length-12)
+		_, err = io.ReadFull(self.reader, body)
+		if err != nil {
+			return 0, nil, io.EOF
+		}
+		return block_type, append(magic, body[:len(body)-4]...), nil
+	}
+
+	length := self.order.Uint32(header[4:])
+	if length < 12 || length > MAX_BLOCK_SIZE || length%4 != 0 {
+		return 0, nil, fmt.Errorf("Invalid block length %v", length)
+	}
+
+	body := make([]byte, length-8)
+	_, err = io.ReadFull(self.reader, body)
+	if err != nil {
+		return 0, nil, io.EOF
+	}
+
+	// Strip the trailing copy of the length.
+	return block_type, body[:len(body)-4], nil
+}
+
+func (self *pcapngReader) Next() (*Packet, error) {
+	for {
+		block_type, body, err := self.readBlock()
+		if err != nil {
+			return nil, err
+		}
+
+		switch block_type {
+		case PCAPNG_INTERFACE_BLOCK:
+			if len(body) < 8 {
+				return nil, errors.New("Interface block too short")
+			}
+			self.interfaces = append(self.interfaces, pcapngInterface{
+				link_type:        uint32(self.order.Uint16(body)),
+				units_per_second: self.parseTsresol(body[8:]),
+			})
+
+		case PCAPNG_ENHANCED_PACKET_BLOCK, PCAPNG_OBSOLETE_PACKET_BLOCK:
+			if len(body) < 20 {
+				return nil, errors.New("Packet block too short")
+			}
+
+			// The obsolete packet block has a 16 bit interface id
+			// followed by a drops count.
+			interface_id := int(self.order.Uint32(body))
+			if block_type == PCAPNG_OBSOLETE_PACKET_BLOCK {
+				interface_id = int(self.order.Uint16(body))
+			}
+
+			iface, err := self.getInterface(interface_id)
+			if err != nil {
+				return nil, err
+			}
+
+			timestamp := uint64(self.order.Uint32(body[4:]))<<32 |
+				uint64(self.order.Uint32(body[8:]))
+			captured := int(self.order.Uint32(body[12:]))
+			if captured > len(body)-20 {
+				return nil, fmt.Errorf("Packet size %v too large", captured)
+			}
+
+			return &Packet{
+				Timestamp: iface.timestamp(timestamp),
+				Length:    int(self.order.Uint32(body[16:])),
+				Data:      body[20 : 20+captured],
+				LinkType:  iface.link_type,
+				Interface: interface_id,
+			}, nil
+
+		case PCAPNG_SIMPLE_PACKET_BLOCK:
+			if len(body) < 4 {
+				return nil, errors.New("Packet block too short")
+			}
+
+			iface, err := self.getInterface(0)
+			if err != nil {
+				return nil, err
+			}
+
+			// Simple packets have no timestamp and the data is
+			// truncated to the snap length.
+			length := int(self.order.Uint32(body))
+			data := body[4:]
+			if length < len(data) {
+				data = data[:length]
+			}
+
+			return &Packet{
+				Length:   length,
+				Data:     data,
+				LinkType: iface.link_type,
+			}, nil
+		}
+
+		// Skip other block types.
+	}
+}
+
+func (self *pcapngReader) getInterface(id int) (*pcapngInterface, error) {
+	if id < 0 || id >= len(self.interfaces) {
+		return nil, fmt.Errorf("Packet refers to unknown interface %v", id)
+	}
+	return &self.interfaces[id], nil
+}
+
+// Find the timestamp resolution in the interface options.
+func (self *pcapngReader) parseTsresol(options []byte) uint64 {
+	exponent := uint8(PCAPNG_DEFAULT_TSRESOL_EXPONENT)
+	for len(options) >= 4 {
+		code := self.order.Uint16(options)
+		length := int(self.order.Uint16(options[2:]))
+		if code == PCAPNG_OPTION_END || 4+length > len(options) {
+			break
+		}
+
+		if code == PCAPNG_OPTION_IF_TSRESOL && length >= 1 {
+			exponent = options[4]
+		}
+
+		// Options are padded to 32 bits.
+		options = options[4+(length+3)/4*4:]
+	}
+
+	// The high bit selects a power of 2 instead of a power of 10.
+	var result float64
+	if exponent&0x80 != 0 {
+		result = math.Pow(2, float64(exponent&0x7f))
+	} else {
+		result = math.Pow(10, float64(exponent))
+	}
+
+	if result < 1 || result > 1e18 {
+		return 1000000
+	}
+	return uint64(result)
+}
+
+func (self *pcapngInterface) timestamp(units uint64) time.Time {
+	seconds := units / self.units_per_second
+	fraction := units % self.units_per_second
+
+	// Avoid overflow for very fine resolutions.
+	hi, lo := bits.Mul64(fraction, 1000000000)
+	nanoseconds, _ := bits.Div64(hi, lo, self.units_per_second)
+	return time.Unix(int64(seconds), int64(nanoseconds)).UTC()
+}
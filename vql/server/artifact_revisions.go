@@ -0,0 +1,169 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Velocidex/ordereddict"
+	"www.velocidex.com/golang/velociraptor/acls"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/services"
+	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
+	"www.velocidex.com/golang/vfilter"
+	"www.velocidex.com/golang/vfilter/arg_parser"
+)
+
+type ArtifactRevisionsPluginArgs struct {
+	Name string `vfilter:"required,field=name,doc=The artifact to show the history of"`
+}
+
+type ArtifactRevisionsPlugin struct{}
+
+func (self ArtifactRevisionsPlugin) Call(
+	ctx context.Context,
+	scope vfilter.Scope,
+	args *ordereddict.Dict) <-chan vfilter.Row {
+	output_chan := make(chan vfilter.Row)
+	go func() {
+		defer close(output_chan)
+
+		err := vql_subsystem.CheckAccess(scope, acls.READ_RESULTS)
+		if err != nil {
+			scope.Log("artifact_revisions: %v", err)
+			return
+		}
+
+		arg := &ArtifactRevisionsPluginArgs{}
+		err = arg_parser.ExtractArgsWithContext(ctx, scope, args, arg)
+		if err != nil {
+			scope.Log("artifact_revisions: %v", err)
+			return
+		}
+
+		config_obj, ok := vql_subsystem.GetServerConfig(scope)
+		if !ok {
+			scope.Log("artifact_revisions: Command can only run on the server")
+			return
+		}
+
+		manager, err := services.GetRepositoryManager(config_obj)
+		if err != nil {
+			scope.Log("artifact_revisions: %v", err)
+			return
+		}
+
+		revisions, err := manager.ListArtifactRevisions(ctx, config_obj, arg.Name)
+		if err != nil {
+			scope.Log("artifact_revisions: %v", err)
+			return
+		}
+
+		for _, revision := range revisions {
+			select {
+			case <-ctx.Done():
+				return
+			case output_chan <- json.ConvertProtoToOrderedDict(revision):
+			}
+		}
+	}()
+
+	return output_chan
+}
+
+func (self ArtifactRevisionsPlugin) Info(scope vfilter.Scope, type_map *vfilter.TypeMap) *vfilter.PluginInfo {
+	return &vfilter.PluginInfo{
+		Name:    "artifact_revisions",
+		Doc:     "List the revision history of a custom artifact (most recent first).",
+		ArgType: type_map.AddType(scope, &ArtifactRevisionsPluginArgs{}),
+	}
+}
+
+type ArtifactRestoreFunctionArgs struct {
+	Name     string `vfilter:"required,field=name,doc=The artifact to restore"`
+	Revision string `vfilter:"required,field=revision,doc=The revision to restore (may be a unique prefix)"`
+}
+
+type ArtifactRestoreFunction struct{}
+
+func (self *ArtifactRestoreFunction) Call(ctx context.Context,
+	scope vfilter.Scope,
+	args *ordereddict.Dict) vfilter.Any {
+
+	arg := &ArtifactRestoreFunctionArgs{}
+	err := arg_parser.ExtractArgsWithContext(ctx, scope, args, arg)
+	if err != nil {
+		scope.Log("artifact_restore: %v", err)
+		return vfilter.Null{}
+	}
+
+	config_obj, ok := vql_subsystem.GetServerConfig(scope)
+	if !ok {
+		scope.Log("artifact_restore: Command can only run on the server")
+		return vfilter.Null{}
+	}
+
+	manager, _ := services.GetRepositoryManager(config_obj)
+	if manager == nil {
+		scope.Log("artifact_restore: Command can only run on the server")
+		return vfilter.Null{}
+	}
+
+	revision, err := manager.GetArtifactRevision(
+		ctx, config_obj, arg.Name, arg.Revision)
+	if err != nil {
+		scope.Log("artifact_restore: %v", err)
+		return vfilter.Null{}
+	}
+
+	tmp_repository := manager.NewRepository()
+	definition, err := tmp_repository.LoadYaml(
+		revision.Definition, true /* validate */, false /* built_in */)
+	if err != nil {
+		scope.Log("artifact_restore: %v", err)
+		return vfilter.Null{}
+	}
+
+	// Determine the permission required based on the type of the artifact.
+	var permission acls.ACL_PERMISSION
+
+	switch strings.ToLower(definition.Type) {
+	case "client", "client_event", "":
+		permission = acls.ARTIFACT_WRITER
+	case "server", "server_event":
+		permission = acls.SERVER_ARTIFACT_WRITER
+	default:
+		scope.Log("artifact_restore: artifact type %v invalid", definition.Type)
+		return vfilter.Null{}
+	}
+
+	err = vql_subsystem.CheckAccess(scope, permission)
+	if err != nil {
+		scope.Log("artifact_restore: %s", err)
+		return vfilter.Null{}
+	}
+
+	principal := vql_subsystem.GetPrincipal(scope)
+
+	definition, err = manager.RestoreArtifactRevision(ctx,
+		config_obj, principal, arg.Name, arg.Revision)
+	if err != nil {
+		scope.Log("artifact_restore: %s", err)
+		return vfilter.Null{}
+	}
+
+	return json.ConvertProtoToOrderedDict(definition)
+}
+
+func (self ArtifactRestoreFunction) Info(
+	scope vfilter.Scope, type_map *vfilter.TypeMap) *vfilter.FunctionInfo {
+	return &vfilter.FunctionInfo{
+		Name:    "artifact_restore",
+		Doc:     "Restores an old revision of an artifact into the global repository.",
+		ArgType: type_map.AddType(scope, &ArtifactRestoreFunctionArgs{}),
+	}
+}
+
+func init() {
+	vql_subsystem.RegisterPlugin(&ArtifactRevisionsPlugin{})
+	vql_subsystem.RegisterFunction(&ArtifactRestoreFunction{})
+}
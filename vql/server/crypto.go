@@ -2,9 +2,12 @@ package server
 
 import (
 	"context"
+	"time"
 
 	"github.com/Velocidex/ordereddict"
 	"www.velocidex.com/golang/velociraptor/acls"
+	crypto_utils "www.velocidex.com/golang/velociraptor/crypto/utils"
+	"www.velocidex.com/golang/velociraptor/utils"
 	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
 	"www.velocidex.com/golang/vfilter"
 	"www.velocidex.com/golang/vfilter/arg_parser"
@@ -44,6 +47,73 @@ func (self ServerFrontendCertFunction) Info(
 	}
 }
 
+type CollectionUploadTokenArgs struct {
+	Expires int64 `vfilter:"optional,field=expires,doc=How many seconds the token is valid for (default 30 days)."`
+	MaxSize int64 `vfilter:"optional,field=max_size,doc=The total number of bytes that may be uploaded with the token (default 10GB)."`
+}
+
+type CollectionUploadTokenFunction struct{}
+
+func (self *CollectionUploadTokenFunction) Call(ctx context.Context,
+	scope vfilter.Scope,
+	args *ordereddict.Dict) vfilter.Any {
+	err := vql_subsystem.CheckAccess(scope, acls.COLLECT_SERVER)
+	if err != nil {
+		scope.Log("ERROR:collection_upload_token: %s", err)
+		return vfilter.Null{}
+	}
+
+	arg := &CollectionUploadTokenArgs{}
+	err = arg_parser.ExtractArgsWithContext(ctx, scope, args, arg)
+	if err != nil {
+		scope.Log("ERROR:collection_upload_token: %s", err.Error())
+		return vfilter.Null{}
+	}
+
+	config_obj, ok := vql_subsystem.GetServerConfig(scope)
+	if !ok {
+		scope.Log("ERROR:collection_upload_token: Must be run on server")
+		return vfilter.Null{}
+	}
+
+	if arg.Expires == 0 {
+		arg.Expires = 30 * 24 * 60 * 60
+	}
+
+	if arg.MaxSize == 0 {
+		arg.MaxSize = crypto_utils.DEFAULT_UPLOAD_TOKEN_MAX_SIZE
+	}
+
+	expires := utils.GetTime().Now().Add(time.Duration(arg.Expires) * time.Second)
+	token, err := crypto_utils.NewUploadToken(config_obj, config_obj.OrgId,
+		vql_subsystem.GetPrincipal(scope), expires, arg.MaxSize)
+	if err != nil {
+		scope.Log("ERROR:collection_upload_token: %s", err)
+		return vfilter.Null{}
+	}
+
+	url := ""
+	if config_obj.Client != nil && len(config_obj.Client.ServerUrls) > 0 {
+		url = config_obj.Client.ServerUrls[0] + "upload_collection"
+	}
+
+	return ordereddict.NewDict().
+		Set("URL", url).
+		Set("Token", token).
+		Set("Expires", expires.UTC()).
+		Set("MaxSize", arg.MaxSize)
+}
+
+func (self CollectionUploadTokenFunction) Info(
+	scope vfilter.Scope, type_map *vfilter.TypeMap) *vfilter.FunctionInfo {
+	return &vfilter.FunctionInfo{
+		Name:    "collection_upload_token",
+		Doc:     "Create a token allowing an offline collector to upload its collection to the server.",
+		ArgType: type_map.AddType(scope, &CollectionUploadTokenArgs{}),
+	}
+}
+
 func init() {
 	vql_subsystem.RegisterFunction(&ServerFrontendCertFunction{})
+	vql_subsystem.RegisterFunction(&CollectionUploadTokenFunction{})
 }
@@ -87,7 +87,11 @@ func (self *ScheduleCollectionFunction) Call(ctx context.Context,
 
 	// Which org should this be collected on
 	if arg.OrgId == "" {
-		err = vql_subsystem.CheckAccess(scope, permission)
+		err = vql_subsystem.CheckAccessWithResource(ctx, scope,
+			&acls.Resource{
+				Artifacts: arg.Artifacts,
+				ClientId:  arg.ClientId,
+			}, permission)
 		if err != nil {
 			scope.Log("collect_client: %v", err)
 			return vfilter.Null{}
@@ -23,12 +23,14 @@ import (
 
 	"github.com/Velocidex/ordereddict"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
 	"www.velocidex.com/golang/velociraptor/acls"
 	api_proto "www.velocidex.com/golang/velociraptor/api/proto"
 	flows_proto "www.velocidex.com/golang/velociraptor/flows/proto"
 	"www.velocidex.com/golang/velociraptor/json"
 	"www.velocidex.com/golang/velociraptor/logging"
 	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/services/hunt_manager"
 	"www.velocidex.com/golang/velociraptor/utils"
 	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
 	"www.velocidex.com/golang/velociraptor/vql/acl_managers"
@@ -55,6 +57,13 @@ type ScheduleHuntFunctionArg struct {
 	ExcludeLabels []string         `vfilter:"optional,field=exclude_labels,doc=If specified exclude these labels"`
 	OS            string           `vfilter:"optional,field=os,doc=If specified target this OS"`
 	OrgIds        []string         `vfilter:"optional,field=org_id,doc=If set the collection will be started in the specified orgs."`
+	Condition     vfilter.Any      `vfilter:"optional,field=condition,doc=A condition tree to match clients (e.g. dict(and=[dict(os=dict(os='WINDOWS')), dict(metadata=dict(key='department', value='finance'))]))"`
+	Schedule      string           `vfilter:"optional,field=schedule,doc=If set, the hunt is a template which spawns a new hunt on this cron schedule (e.g. '0 2 * * *' in UTC)"`
+	Interval      uint64           `vfilter:"optional,field=interval,doc=If set, the hunt is a template which spawns a new hunt every interval seconds"`
+	RetainRuns    uint64           `vfilter:"optional,field=retain_runs,doc=For scheduled hunts, the number of runs to keep before archiving (default 10)"`
+	RunDuration   uint64           `vfilter:"optional,field=run_duration,doc=For scheduled hunts, how long each run remains active in seconds (default the hunt expiry)"`
+	ClientLimit   uint64           `vfilter:"optional,field=client_limit,doc=Total number of clients this hunt will run on"`
+	Rollout       vfilter.Any      `vfilter:"optional,field=rollout,doc=A staged rollout (e.g. dict(stages=[dict(client_count=10), dict(percentage=50, require_approval=TRUE)], expected_clients=1000, max_error_ratio=0.1))"`
 }
 
 type ScheduleHuntFunction struct{}
@@ -153,6 +162,7 @@ func (self *ScheduleHuntFunction) Call(ctx context.Context,
 		StartRequest:    request,
 		Expires:         expires,
 		State:           state,
+		ClientLimit:     arg.ClientLimit,
 	}
 
 	if len(arg.IncludeLabels) > 0 {
@@ -209,6 +219,36 @@ func (self *ScheduleHuntFunction) Call(ctx context.Context,
 		return vfilter.Null{}
 	}
 
+	if !utils.IsNil(arg.Condition) {
+		expression, err := parseConditionExpression(arg.Condition)
+		if err != nil {
+			scope.Log("hunt: invalid condition: %v", err)
+			return vfilter.Null{}
+		}
+
+		if hunt_request.Condition == nil {
+			hunt_request.Condition = &api_proto.HuntCondition{}
+		}
+		hunt_request.Condition.Expression = expression
+	}
+
+	if !utils.IsNil(arg.Rollout) {
+		hunt_request.Rollout, err = parseRollout(arg.Rollout)
+		if err != nil {
+			scope.Log("hunt: invalid rollout: %v", err)
+			return vfilter.Null{}
+		}
+	}
+
+	if arg.Schedule != "" || arg.Interval > 0 {
+		hunt_request.Schedule = &api_proto.HuntSchedule{
+			Cron:           arg.Schedule,
+			IntervalSec:    arg.Interval,
+			RetainRuns:     arg.RetainRuns,
+			RunDurationSec: arg.RunDuration,
+		}
+	}
+
 	org_manager, err := services.GetOrgManager()
 	if err != nil {
 		scope.Log("hunt: %v", err)
@@ -266,6 +306,34 @@ func (self *ScheduleHuntFunction) Call(ctx context.Context,
 		Set("Request", hunt_request)
 }
 
+// Convert the VQL condition (usually a dict) into a condition tree.
+func parseConditionExpression(
+	condition vfilter.Any) (*api_proto.HuntConditionExpression, error) {
+	serialized, err := json.Marshal(condition)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &api_proto.HuntConditionExpression{}
+	err = protojson.Unmarshal(serialized, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, hunt_manager.ValidateHuntCondition(
+		&api_proto.HuntCondition{Expression: result})
+}
+
+func parseRollout(rollout vfilter.Any) (*api_proto.HuntRollout, error) {
+	serialized, err := json.Marshal(rollout)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &api_proto.HuntRollout{}
+	return result, protojson.Unmarshal(serialized, result)
+}
+
 func (self ScheduleHuntFunction) Info(scope vfilter.Scope, type_map *vfilter.TypeMap) *vfilter.FunctionInfo {
 	return &vfilter.FunctionInfo{
 		Name:    "hunt",
@@ -25,6 +25,7 @@ import (
 	"github.com/Velocidex/ordereddict"
 	"www.velocidex.com/golang/velociraptor/acls"
 	api_proto "www.velocidex.com/golang/velociraptor/api/proto"
+	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
 	"www.velocidex.com/golang/velociraptor/file_store"
 	"www.velocidex.com/golang/velociraptor/json"
 	"www.velocidex.com/golang/velociraptor/paths"
@@ -41,6 +42,8 @@ type HuntsPluginArgs struct {
 	HuntId string `vfilter:"optional,field=hunt_id,doc=A hunt id to read, if not specified we list all of them."`
 	Offset uint64 `vfilter:"optional,field=offset,doc=Start offset."`
 	Count  uint64 `vfilter:"optional,field=count,doc=Max number of results to return."`
+
+	ParentHuntId string `vfilter:"optional,field=parent_hunt_id,doc=If specified only list the runs spawned from this scheduled hunt."`
 }
 
 type HuntsPlugin struct{}
@@ -99,8 +102,9 @@ func (self HuntsPlugin) Call(
 		// Show all hunts.
 		hunts, err := hunt_dispatcher.ListHunts(
 			ctx, config_obj, &api_proto.ListHuntsRequest{
-				Count:  count,
-				Offset: arg.Offset,
+				Count:        count,
+				Offset:       arg.Offset,
+				ParentHuntId: arg.ParentHuntId,
 			})
 		if err != nil {
 			scope.Log("hunts: %v", err)
@@ -145,14 +149,8 @@ func (self HuntResultsPlugin) Call(
 	go func() {
 		defer close(output_chan)
 
-		err := vql_subsystem.CheckAccess(scope, acls.READ_RESULTS)
-		if err != nil {
-			scope.Log("hunt_results: %s", err)
-			return
-		}
-
 		arg := &HuntResultsPluginArgs{}
-		err = arg_parser.ExtractArgsWithContext(ctx, scope, args, arg)
+		err := arg_parser.ExtractArgsWithContext(ctx, scope, args, arg)
 		if err != nil {
 			scope.Log("hunt_results: %v", err)
 			return
@@ -164,6 +162,12 @@ func (self HuntResultsPlugin) Call(
 			return
 		}
 
+		err = checkHuntReadAccess(ctx, scope, config_obj, arg.HuntId)
+		if err != nil {
+			scope.Log("hunt_results: %s", err)
+			return
+		}
+
 		// If no artifact is specified, get the first one from
 		// the hunt.
 		if arg.Artifact == "" {
@@ -300,14 +304,8 @@ func (self HuntFlowsPlugin) Call(
 	go func() {
 		defer close(output_chan)
 
-		err := vql_subsystem.CheckAccess(scope, acls.READ_RESULTS)
-		if err != nil {
-			scope.Log("hunt_flows: %s", err)
-			return
-		}
-
 		arg := &HuntFlowsPluginArgs{}
-		err = arg_parser.ExtractArgsWithContext(ctx, scope, args, arg)
+		err := arg_parser.ExtractArgsWithContext(ctx, scope, args, arg)
 		if err != nil {
 			scope.Log("hunt_flows: %v", err)
 			return
@@ -319,6 +317,12 @@ func (self HuntFlowsPlugin) Call(
 			return
 		}
 
+		err = checkHuntReadAccess(ctx, scope, config_obj, arg.HuntId)
+		if err != nil {
+			scope.Log("hunt_flows: %s", err)
+			return
+		}
+
 		hunt_dispatcher, err := services.GetHuntDispatcher(config_obj)
 		if err != nil {
 			scope.Log("hunt_flows: %v", err)
@@ -366,3 +370,20 @@ func init() {
 	vql_subsystem.RegisterPlugin(&HuntResultsPlugin{})
 	vql_subsystem.RegisterPlugin(&HuntFlowsPlugin{})
 }
+
+// Users with a scoped grant may only read the results of some hunts.
+func checkHuntReadAccess(ctx context.Context, scope vfilter.Scope,
+	config_obj *config_proto.Config, hunt_id string) error {
+	var resource *acls.Resource
+
+	hunt_dispatcher_service, err := services.GetHuntDispatcher(config_obj)
+	if err == nil {
+		hunt_obj, pres := hunt_dispatcher_service.GetHunt(hunt_id)
+		if pres {
+			resource = hunt_dispatcher.GetHuntResource(hunt_obj)
+		}
+	}
+
+	return vql_subsystem.CheckAccessWithResource(
+		ctx, scope, resource, acls.READ_RESULTS)
+}
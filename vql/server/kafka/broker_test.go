@@ -0,0 +1,331 @@
+package kafka
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zstd"
+)
+
+// An in-process stand in for a Kafka broker which supports just
+// enough of the protocol to test the producer.
+type fakeBroker struct {
+	listener   net.Listener
+	partitions int32
+
+	// If set require SASL PLAIN authentication
+	username, password string
+
+	mu       sync.Mutex
+	messages map[topicPartition][]*Message
+	codecs   []int16
+
+	// Fail this many produce requests with NOT_LEADER_FOR_PARTITION
+	fail_produce int
+}
+
+func newFakeBroker(partitions int32,
+	username, password string) (*fakeBroker, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	self := &fakeBroker{
+		listener:   listener,
+		partitions: partitions,
+		username:   username,
+		password:   password,
+		messages:   make(map[topicPartition][]*Message),
+	}
+	go self.serve()
+	return self, nil
+}
+
+func (self *fakeBroker) Addr() string {
+	return self.listener.Addr().String()
+}
+
+func (self *fakeBroker) Close() {
+	self.listener.Close()
+}
+
+func (self *fakeBroker) FailProduce(count int) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	self.fail_produce = count
+}
+
+func (self *fakeBroker) Messages(topic string, partition int32) []*Message {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	return self.messages[topicPartition{topic, partition}]
+}
+
+func (self *fakeBroker) serve() {
+	for {
+		conn, err := self.listener.Accept()
+		if err != nil {
+			return
+		}
+		go self.handle(conn)
+	}
+}
+
+func (self *fakeBroker) handle(conn net.Conn) {
+	defer conn.Close()
+
+	authenticated := self.username == ""
+
+	for {
+		size_buf := make([]byte, 4)
+		_, err := io.ReadFull(conn, size_buf)
+		if err != nil {
+			return
+		}
+
+		request := make([]byte, binary.BigEndian.Uint32(size_buf))
+		_, err = io.ReadFull(conn, request)
+		if err != nil {
+			return
+		}
+
+		d := &decoder{data: request}
+		api_key := d.int16()
+		version := d.int16()
+		correlation_id := d.int32()
+		_ = d.string() // client_id
+
+		response := &encoder{}
+		switch api_key {
+		case API_SASL_HANDSHAKE:
+			mechanism := d.string()
+			if mechanism == "PLAIN" {
+				response.int16(0)
+			} else {
+				response.int16(33)
+			}
+			response.int32(1)
+			response.string("PLAIN")
+
+		case API_SASL_AUTHENTICATE:
+			auth := d.bytes()
+			if string(auth) == "\x00"+self.username+"\x00"+self.password {
+				authenticated = true
+				response.int16(0)
+				response.nullString()
+			} else {
+				response.int16(58)
+				response.string("Authentication failed")
+			}
+			response.bytes(nil)
+
+		case API_METADATA:
+			if !authenticated {
+				return
+			}
+			self.metadata(d, response)
+
+		case API_PRODUCE:
+			if !authenticated {
+				return
+			}
+			acks := self.produce(d, version, response)
+			if acks == 0 {
+				continue
+			}
+
+		default:
+			return
+		}
+
+		header := &encoder{}
+		header.int32(int32(response.buf.Len() + 4))
+		header.int32(correlation_id)
+		header.buf.Write(response.Bytes())
+		_, err = conn.Write(header.Bytes())
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (self *fakeBroker) metadata(d *decoder, response *encoder) {
+	host, port_str, _ := net.SplitHostPort(self.Addr())
+	port, _ := strconv.Atoi(port_str)
+
+	response.int32(1)
+	response.int32(0) // node_id
+	response.string(host)
+	response.int32(int32(port))
+	response.nullString() // rack
+	response.int32(0)     // controller_id
+
+	count := d.arrayLength()
+	response.int32(int32(count))
+	for i := 0; i < count; i++ {
+		response.int16(0)
+		response.string(d.string())
+		response.int8(0)
+		response.int32(self.partitions)
+		for p := int32(0); p < self.partitions; p++ {
+			response.int16(0)
+			response.int32(p)
+			response.int32(0) // leader
+			response.int32(1)
+			response.int32(0) // replicas
+			response.int32(1)
+			response.int32(0) // isr
+		}
+	}
+}
+
+func (self *fakeBroker) produce(
+	d *decoder, version int16, response *encoder) int16 {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	fail := self.fail_produce > 0
+	if fail {
+		self.fail_produce--
+	}
+
+	_ = d.string() // transactional_id
+	acks := d.int16()
+	_ = d.int32() // timeout
+
+	topics := d.arrayLength()
+	response.int32(int32(topics))
+	for i := 0; i < topics; i++ {
+		topic := d.string()
+		response.string(topic)
+
+		partitions := d.arrayLength()
+		response.int32(int32(partitions))
+		for j := 0; j < partitions; j++ {
+			partition := d.int32()
+			tp := topicPartition{topic, partition}
+			base_offset := int64(len(self.messages[tp]))
+
+			messages, codec, err := decodeRecordBatch(d.bytes())
+			error_code := int16(0)
+			if err != nil {
+				error_code = 2 // CORRUPT_MESSAGE
+			} else if fail {
+				error_code = 6 // NOT_LEADER_FOR_PARTITION
+			} else {
+				for _, message := range messages {
+					message.Topic = topic
+				}
+				self.messages[tp] = append(self.messages[tp], messages...)
+				self.codecs = append(self.codecs, codec)
+			}
+
+			response.int32(partition)
+			response.int16(error_code)
+			response.int64(base_offset)
+			response.int64(-1) // log_append_time
+			if version >= 5 {
+				response.int64(0) // log_start_offset
+			}
+		}
+	}
+	response.int32(0) // throttle_time_ms
+
+	return acks
+}
+
+func decodeRecordBatch(data []byte) ([]*Message, int16, error) {
+	d := &decoder{data: data}
+	_ = d.int64() // base_offset
+	length := int(d.int32())
+	_ = d.int32() // partition_leader_epoch
+	if d.int8() != RECORD_BATCH_MAGIC {
+		return nil, 0, fmt.Errorf("invalid magic")
+	}
+	crc := uint32(d.int32())
+	if d.err != nil || 12+length != len(data) {
+		return nil, 0, fmt.Errorf("invalid length")
+	}
+
+	if crc32.Checksum(data[RECORD_BATCH_CRC_START:], castagnoli) != crc {
+		return nil, 0, fmt.Errorf("invalid crc")
+	}
+
+	codec := d.int16() & 0x7
+	_ = d.int32() // last_offset_delta
+	first_timestamp := d.int64()
+	_ = d.int64() // max_timestamp
+	_ = d.int64() // producer_id
+	_ = d.int16() // producer_epoch
+	_ = d.int32() // base_sequence
+	count := int(d.int32())
+	if d.err != nil {
+		return nil, 0, d.err
+	}
+
+	records, err := decompress(codec, data[d.offset:])
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var result []*Message
+	r := &decoder{data: records}
+	for i := 0; i < count; i++ {
+		_ = r.varint() // length
+		_ = r.int8()   // attributes
+		timestamp := first_timestamp + r.varint()
+		_ = r.varint() // offset_delta
+		key := r.varintBytes()
+		value := r.varintBytes()
+		for headers := r.varint(); headers > 0; headers-- {
+			r.varintBytes()
+			r.varintBytes()
+		}
+		if r.err != nil {
+			return nil, 0, r.err
+		}
+
+		result = append(result, &Message{
+			Key:       key,
+			Value:     value,
+			Timestamp: timeFromMillis(timestamp),
+		})
+	}
+	return result, codec, nil
+}
+
+func decompress(codec int16, data []byte) ([]byte, error) {
+	switch codec {
+	case COMPRESSION_NONE:
+		return data, nil
+
+	case COMPRESSION_GZIP:
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		return ioutil.ReadAll(reader)
+
+	case COMPRESSION_SNAPPY:
+		return s2.Decode(nil, data)
+
+	case COMPRESSION_ZSTD:
+		decoder, err := zstd.NewReader(nil)
+		if err != nil {
+			return nil, err
+		}
+		defer decoder.Close()
+		return decoder.DecodeAll(data, nil)
+	}
+	return nil, fmt.Errorf("unsupported codec %v", codec)
+}
@@ -0,0 +1,637 @@
+package kafka
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"www.velocidex.com/golang/velociraptor/utils"
+)
+
+type Config struct {
+	// Bootstrap brokers as host:port
+	Brokers  []string
+	ClientID string
+
+	// If set connections use TLS.
+	TLSConfig *tls.Config
+
+	// If set connections authenticate with SASL
+	SASLMechanism string
+	Username      string
+	Password      string
+
+	// -1 waits for all in sync replicas, 1 for the leader only and
+	// 0 does not wait for a response at all.
+	RequiredAcks int16
+	Timeout      time.Duration
+	Compression  string
+
+	// How many times to retry retriable errors (e.g. leadership
+	// changes) before giving up on a batch.
+	MaxRetries   int
+	RetryBackoff time.Duration
+}
+
+type ProduceResult struct {
+	Topic      string
+	Partition  int32
+	Messages   int
+	BaseOffset int64
+	Error      error
+}
+
+// A connection to a single broker. Requests are sent one at a time.
+type brokerConn struct {
+	conn           net.Conn
+	client_id      string
+	timeout        time.Duration
+	correlation_id int32
+}
+
+func (self *brokerConn) send(api_key, version int16, body []byte) error {
+	self.correlation_id++
+
+	header := &encoder{}
+	header.int32(0) // Size placeholder
+	header.int16(api_key)
+	header.int16(version)
+	header.int32(self.correlation_id)
+	header.string(self.client_id)
+	header.buf.Write(body)
+
+	request := header.Bytes()
+	binary.BigEndian.PutUint32(request, uint32(len(request)-4))
+
+	err := self.conn.SetDeadline(time.Now().Add(self.timeout))
+	if err != nil {
+		return err
+	}
+
+	_, err = self.conn.Write(request)
+	return err
+}
+
+func (self *brokerConn) roundTrip(
+	api_key, version int16, body []byte) (*decoder, error) {
+	err := self.send(api_key, version, body)
+	if err != nil {
+		return nil, err
+	}
+
+	size_buf := make([]byte, 4)
+	_, err = io.ReadFull(self.conn, size_buf)
+	if err != nil {
+		return nil, err
+	}
+
+	size := binary.BigEndian.Uint32(size_buf)
+	if size < 4 || size > MAX_RESPONSE_SIZE {
+		return nil, fmt.Errorf("kafka: invalid response size %v", size)
+	}
+
+	response := make([]byte, size)
+	_, err = io.ReadFull(self.conn, response)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &decoder{data: response}
+	if d.int32() != self.correlation_id {
+		return nil, errors.New("kafka: response correlation id mismatch")
+	}
+
+	return d, nil
+}
+
+func (self *brokerConn) authenticate(mechanism saslMechanism) error {
+	e := &encoder{}
+	e.string(mechanism.Name())
+	d, err := self.roundTrip(API_SASL_HANDSHAKE, 1, e.Bytes())
+	if err != nil {
+		return err
+	}
+
+	code := KafkaError(d.int16())
+	var supported []string
+	for i := d.arrayLength(); i > 0; i-- {
+		supported = append(supported, d.string())
+	}
+	if d.err != nil {
+		return d.err
+	}
+	if code != 0 {
+		return fmt.Errorf("%w: broker supports %v", code, supported)
+	}
+
+	var challenge []byte
+	for {
+		response, done, err := mechanism.Step(challenge)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		e := &encoder{}
+		e.bytes(response)
+		d, err := self.roundTrip(API_SASL_AUTHENTICATE, 0, e.Bytes())
+		if err != nil {
+			return err
+		}
+
+		code := KafkaError(d.int16())
+		message := d.string()
+		challenge = d.bytes()
+		if d.err != nil {
+			return d.err
+		}
+		if code != 0 {
+			return fmt.Errorf("%w: %v", code, message)
+		}
+	}
+}
+
+func (self *brokerConn) Close() {
+	self.conn.Close()
+}
+
+type Client struct {
+	config Config
+	codec  int16
+
+	mu sync.Mutex
+
+	// Open connections by broker address.
+	conns map[string]*brokerConn
+
+	// Broker addresses by node id.
+	brokers map[int32]string
+
+	// Partitions by topic, sorted by partition id.
+	topics map[string][]partitionMetadata
+
+	// Round robin counter for messages without keys.
+	next_partition map[string]int
+}
+
+func NewClient(config Config) (*Client, error) {
+	if len(config.Brokers) == 0 {
+		return nil, errors.New("kafka: no brokers specified")
+	}
+
+	codec, pres := compressionCodecs[config.Compression]
+	if !pres {
+		return nil, fmt.Errorf(
+			"kafka: unsupported compression %v (should be none, gzip, snappy or zstd)",
+			config.Compression)
+	}
+
+	if config.SASLMechanism != "" {
+		_, err := newSASLMechanism(config.SASLMechanism, "", "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if config.ClientID == "" {
+		config.ClientID = "velociraptor"
+	}
+
+	if config.Timeout == 0 {
+		config.Timeout = 30 * time.Second
+	}
+
+	if config.RetryBackoff == 0 {
+		config.RetryBackoff = time.Second
+	}
+
+	return &Client{
+		config:         config,
+		codec:          codec,
+		conns:          make(map[string]*brokerConn),
+		brokers:        make(map[int32]string),
+		topics:         make(map[string][]partitionMetadata),
+		next_partition: make(map[string]int),
+	}, nil
+}
+
+func (self *Client) Close() {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	for addr, conn := range self.conns {
+		conn.Close()
+		delete(self.conns, addr)
+	}
+}
+
+func (self *Client) connect(ctx context.Context, addr string) (*brokerConn, error) {
+	conn, pres := self.conns[addr]
+	if pres {
+		return conn, nil
+	}
+
+	dialer := &net.Dialer{Timeout: self.config.Timeout}
+	var net_conn net.Conn
+	var err error
+
+	if self.config.TLSConfig != nil {
+		net_conn, err = (&tls.Dialer{
+			NetDialer: dialer,
+			Config:    self.config.TLSConfig,
+		}).DialContext(ctx, "tcp", addr)
+	} else {
+		net_conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	conn = &brokerConn{
+		conn:      net_conn,
+		client_id: self.config.ClientID,
+		timeout:   self.config.Timeout,
+	}
+
+	if self.config.SASLMechanism != "" {
+		mechanism, err := newSASLMechanism(self.config.SASLMechanism,
+			self.config.Username, self.config.Password)
+		if err == nil {
+			err = conn.authenticate(mechanism)
+		}
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	self.conns[addr] = conn
+	return conn, nil
+}
+
+func (self *Client) closeConn(addr string) {
+	conn, pres := self.conns[addr]
+	if pres {
+		conn.Close()
+		delete(self.conns, addr)
+	}
+}
+
+// Fetch metadata for the topics from the first broker that
+// answers. Topics are auto-created by brokers configured to do so,
+// in which case they report LEADER_NOT_AVAILABLE until ready.
+func (self *Client) refreshMetadata(
+	ctx context.Context, topics []string) (map[string]error, error) {
+
+	// Prefer brokers we already know about
+	var addrs []string
+	for _, addr := range self.brokers {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	addrs = append(addrs, self.config.Brokers...)
+
+	var last_err error
+	for _, addr := range addrs {
+		conn, err := self.connect(ctx, addr)
+		if err != nil {
+			last_err = err
+			continue
+		}
+
+		d, err := conn.roundTrip(API_METADATA, METADATA_VERSION,
+			encodeMetadataRequest(topics))
+		if err != nil {
+			self.closeConn(addr)
+			last_err = err
+			continue
+		}
+
+		response, err := decodeMetadataResponse(d)
+		if err != nil {
+			self.closeConn(addr)
+			last_err = err
+			continue
+		}
+
+		for _, b := range response.Brokers {
+			self.brokers[b.NodeID] = net.JoinHostPort(
+				b.Host, strconv.Itoa(int(b.Port)))
+		}
+
+		topic_errors := make(map[string]error)
+		for _, topic := range response.Topics {
+			if topic.Error != 0 {
+				topic_errors[topic.Name] = topic.Error
+				continue
+			}
+
+			partitions := topic.Partitions
+			sort.Slice(partitions, func(i, j int) bool {
+				return partitions[i].Partition < partitions[j].Partition
+			})
+			self.topics[topic.Name] = partitions
+		}
+		return topic_errors, nil
+	}
+
+	if last_err == nil {
+		last_err = errors.New("kafka: no brokers available")
+	}
+	return nil, last_err
+}
+
+// Make sure we have metadata for all the topics, retrying while new
+// topics are being created.
+func (self *Client) ensureMetadata(
+	ctx context.Context, topics []string) map[string]error {
+	topic_errors := make(map[string]error)
+
+	for attempt := 0; ; attempt++ {
+		var missing []string
+		for _, topic := range topics {
+			_, pres := self.topics[topic]
+			if !pres {
+				missing = append(missing, topic)
+			}
+		}
+
+		if len(missing) == 0 {
+			return nil
+		}
+
+		errs, err := self.refreshMetadata(ctx, missing)
+		retry := false
+		for _, topic := range missing {
+			if err != nil {
+				topic_errors[topic] = err
+				retry = true
+				continue
+			}
+
+			topic_err, pres := errs[topic]
+			if pres {
+				topic_errors[topic] = topic_err
+				kafka_err, ok := topic_err.(KafkaError)
+				if ok && kafka_err.Retriable() {
+					retry = true
+				}
+			}
+		}
+
+		if !retry || attempt >= self.config.MaxRetries ||
+			!self.sleep(ctx) {
+			return topic_errors
+		}
+	}
+}
+
+func (self *Client) sleep(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-utils.GetTime().After(self.config.RetryBackoff):
+		return true
+	}
+}
+
+func (self *Client) partitionFor(message *Message) (int32, bool) {
+	partitions := self.topics[message.Topic]
+	if len(partitions) == 0 {
+		return 0, false
+	}
+
+	if message.Key != nil {
+		return partitions[partitionForKey(message.Key, len(partitions))].Partition, true
+	}
+
+	idx := self.next_partition[message.Topic] % len(partitions)
+	self.next_partition[message.Topic] = idx + 1
+	return partitions[idx].Partition, true
+}
+
+func (self *Client) leaderFor(tp topicPartition) (string, bool) {
+	for _, partition := range self.topics[tp.Topic] {
+		if partition.Partition == tp.Partition {
+			addr, pres := self.brokers[partition.Leader]
+			return addr, pres
+		}
+	}
+	return "", false
+}
+
+// Produce the messages returning one result per topic partition
+// written. Retriable errors are retried after refreshing metadata.
+func (self *Client) Produce(
+	ctx context.Context, messages []*Message) []*ProduceResult {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	var topics []string
+	seen := make(map[string]bool)
+	for _, message := range messages {
+		if !seen[message.Topic] {
+			seen[message.Topic] = true
+			topics = append(topics, message.Topic)
+		}
+	}
+
+	var results []*ProduceResult
+	topic_errors := self.ensureMetadata(ctx, topics)
+
+	pending := make(map[topicPartition][]*Message)
+	failed_topics := make(map[string]int)
+	for _, message := range messages {
+		partition, ok := self.partitionFor(message)
+		if !ok {
+			failed_topics[message.Topic]++
+			continue
+		}
+		tp := topicPartition{message.Topic, partition}
+		pending[tp] = append(pending[tp], message)
+	}
+
+	for topic, count := range failed_topics {
+		err := topic_errors[topic]
+		if err == nil {
+			err = KafkaError(3) // UNKNOWN_TOPIC_OR_PARTITION
+		}
+		results = append(results, &ProduceResult{
+			Topic:      topic,
+			Partition:  -1,
+			Messages:   count,
+			BaseOffset: -1,
+			Error:      err,
+		})
+	}
+
+	for attempt := 0; len(pending) > 0; attempt++ {
+		var retry map[topicPartition]error
+		retry, results = self.send(ctx, pending, results)
+		if len(retry) == 0 {
+			break
+		}
+
+		if attempt >= self.config.MaxRetries || !self.sleep(ctx) {
+			for tp, err := range retry {
+				results = append(results, &ProduceResult{
+					Topic:      tp.Topic,
+					Partition:  tp.Partition,
+					Messages:   len(pending[tp]),
+					BaseOffset: -1,
+					Error:      err,
+				})
+			}
+			break
+		}
+
+		var retry_topics []string
+		next := make(map[topicPartition][]*Message)
+		for tp := range retry {
+			next[tp] = pending[tp]
+			if !utils.InString(retry_topics, tp.Topic) {
+				retry_topics = append(retry_topics, tp.Topic)
+			}
+		}
+		pending = next
+
+		// Leadership may have moved.
+		_, _ = self.refreshMetadata(ctx, retry_topics)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Topic != results[j].Topic {
+			return results[i].Topic < results[j].Topic
+		}
+		return results[i].Partition < results[j].Partition
+	})
+
+	return results
+}
+
+// Send one produce request to each leader. Returns the partitions
+// which should be retried.
+func (self *Client) send(ctx context.Context,
+	pending map[topicPartition][]*Message,
+	results []*ProduceResult) (map[topicPartition]error, []*ProduceResult) {
+
+	retry := make(map[topicPartition]error)
+	by_leader := make(map[string]map[topicPartition][]byte)
+
+	for tp, messages := range pending {
+		addr, pres := self.leaderFor(tp)
+		if !pres {
+			retry[tp] = KafkaError(5) // LEADER_NOT_AVAILABLE
+			continue
+		}
+
+		batch, err := encodeRecordBatch(messages, self.codec)
+		if err != nil {
+			results = append(results, &ProduceResult{
+				Topic:      tp.Topic,
+				Partition:  tp.Partition,
+				Messages:   len(messages),
+				BaseOffset: -1,
+				Error:      err,
+			})
+			continue
+		}
+
+		batches, pres := by_leader[addr]
+		if !pres {
+			batches = make(map[topicPartition][]byte)
+			by_leader[addr] = batches
+		}
+		batches[tp] = batch
+	}
+
+	version := int16(PRODUCE_VERSION)
+	if self.codec == COMPRESSION_ZSTD {
+		version = PRODUCE_ZSTD_VERSION
+	}
+
+	for addr, batches := range by_leader {
+		responses, err := self.produceToBroker(ctx, addr, version, batches)
+		for tp := range batches {
+			if err != nil {
+				retry[tp] = err
+				continue
+			}
+
+			response, pres := responses[tp]
+			if !pres {
+				retry[tp] = truncatedError
+				continue
+			}
+
+			if response.Error.Retriable() {
+				retry[tp] = response.Error
+				continue
+			}
+
+			result := &ProduceResult{
+				Topic:      tp.Topic,
+				Partition:  tp.Partition,
+				Messages:   len(pending[tp]),
+				BaseOffset: response.BaseOffset,
+			}
+			if response.Error != 0 {
+				result.BaseOffset = -1
+				result.Error = response.Error
+			}
+			results = append(results, result)
+		}
+	}
+
+	return retry, results
+}
+
+func (self *Client) produceToBroker(ctx context.Context, addr string,
+	version int16, batches map[topicPartition][]byte) (
+	map[topicPartition]partitionResponse, error) {
+	conn, err := self.connect(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	request := encodeProduceRequest(self.config.RequiredAcks,
+		int32(self.config.Timeout/time.Millisecond), batches)
+
+	// With acks=0 the broker does not respond at all so offsets are
+	// unknown.
+	if self.config.RequiredAcks == 0 {
+		err = conn.send(API_PRODUCE, version, request)
+		if err != nil {
+			self.closeConn(addr)
+			return nil, err
+		}
+
+		responses := make(map[topicPartition]partitionResponse)
+		for tp := range batches {
+			responses[tp] = partitionResponse{BaseOffset: -1}
+		}
+		return responses, nil
+	}
+
+	d, err := conn.roundTrip(API_PRODUCE, version, request)
+	if err != nil {
+		self.closeConn(addr)
+		return nil, err
+	}
+
+	responses, err := decodeProduceResponse(d, version)
+	if err != nil {
+		self.closeConn(addr)
+	}
+	return responses, err
+}
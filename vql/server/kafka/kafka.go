@@ -0,0 +1,311 @@
+package kafka
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/Velocidex/ordereddict"
+	"www.velocidex.com/golang/velociraptor/acls"
+	"www.velocidex.com/golang/velociraptor/artifacts"
+	"www.velocidex.com/golang/velociraptor/crypto"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/utils"
+	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
+	"www.velocidex.com/golang/velociraptor/vql/functions"
+	vfilter "www.velocidex.com/golang/vfilter"
+	"www.velocidex.com/golang/vfilter/arg_parser"
+)
+
+type _KafkaPluginArgs struct {
+	Query           vfilter.StoredQuery `vfilter:"required,field=query,doc=Source for rows to upload."`
+	Brokers         []string            `vfilter:"required,field=brokers,doc=A list of bootstrap brokers (host:port)."`
+	Topic           string              `vfilter:"optional,field=topic,doc=The topic to produce to. May contain {Column} placeholders which are expanded from each row (e.g. velociraptor.{Artifact}). If not specified ensure a column is named '_topic'."`
+	Key             string              `vfilter:"optional,field=key,doc=A column to use as the message key. Messages with the same key are sent to the same partition."`
+	TimestampField  string              `vfilter:"optional,field=timestamp_field,doc=Field to use as the message timestamp (default the current time)."`
+	ChunkSize       int64               `vfilter:"optional,field=chunk_size,doc=The number of rows to send at the time (default 1000)."`
+	WaitTime        int64               `vfilter:"optional,field=wait_time,doc=Batch kafka upload this long (2 sec)."`
+	MaxMemoryBuffer uint64              `vfilter:"optional,field=max_memory_buffer,doc=Send the batch early once it grows this large (default 10mb)."`
+	Compression     string              `vfilter:"optional,field=compression,doc=Compression codec: none, gzip, snappy or zstd (default none)."`
+	RequiredAcks    int64               `vfilter:"optional,field=required_acks,doc=Acknowledgements required: -1 all in sync replicas (default), 1 leader only, 0 none."`
+	Timeout         int64               `vfilter:"optional,field=timeout,doc=Timeout in seconds for broker requests (default 30)."`
+	MaxRetries      int64               `vfilter:"optional,field=max_retries,doc=How many times to retry a batch after a retriable error (default 3)."`
+	ClientID        string              `vfilter:"optional,field=client_id,doc=The client id to report to the broker (default velociraptor)."`
+	UseTLS          bool                `vfilter:"optional,field=tls,doc=Connect to the brokers over TLS."`
+	SkipVerify      bool                `vfilter:"optional,field=skip_verify,doc=Skip TLS verification (default: False)."`
+	RootCerts       string              `vfilter:"optional,field=root_ca,doc=As a better alternative to skip_verify, allows root ca certs to be added here."`
+	SASLMechanism   string              `vfilter:"optional,field=sasl_mechanism,doc=Authenticate with SASL: PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512."`
+	Username        string              `vfilter:"optional,field=username,doc=Username for SASL authentication."`
+	Password        string              `vfilter:"optional,field=password,doc=Password for SASL authentication."`
+}
+
+type _KafkaPlugin struct{}
+
+func (self _KafkaPlugin) Call(ctx context.Context,
+	scope vfilter.Scope,
+	args *ordereddict.Dict) <-chan vfilter.Row {
+	output_chan := make(chan vfilter.Row)
+
+	go func() {
+		defer close(output_chan)
+
+		err := vql_subsystem.CheckAccess(scope, acls.COLLECT_SERVER)
+		if err != nil {
+			scope.Log("kafka_upload: %v", err)
+			return
+		}
+
+		arg := _KafkaPluginArgs{
+			RequiredAcks: -1,
+			MaxRetries:   3,
+		}
+		err = arg_parser.ExtractArgsWithContext(ctx, scope, args, &arg)
+		if err != nil {
+			scope.Log("kafka_upload: %v", err)
+			return
+		}
+
+		if arg.ChunkSize == 0 {
+			arg.ChunkSize = 1000
+		}
+
+		if arg.WaitTime == 0 {
+			arg.WaitTime = 2
+		}
+
+		if arg.MaxMemoryBuffer == 0 {
+			arg.MaxMemoryBuffer = 10 * 1024 * 1024
+		}
+
+		if arg.Timeout == 0 {
+			arg.Timeout = 30
+		}
+
+		config := Config{
+			Brokers:       arg.Brokers,
+			ClientID:      arg.ClientID,
+			SASLMechanism: arg.SASLMechanism,
+			Username:      arg.Username,
+			Password:      arg.Password,
+			RequiredAcks:  int16(arg.RequiredAcks),
+			Timeout:       time.Duration(arg.Timeout) * time.Second,
+			Compression:   arg.Compression,
+			MaxRetries:    int(arg.MaxRetries),
+		}
+
+		if arg.UseTLS {
+			CA_Pool := x509.NewCertPool()
+			crypto.AddPublicRoots(CA_Pool)
+
+			config_obj, ok := artifacts.GetConfig(scope)
+			if ok {
+				err := crypto.AddDefaultCerts(config_obj, CA_Pool)
+				if err != nil {
+					scope.Log("kafka_upload: %v", err)
+					return
+				}
+			}
+
+			if arg.RootCerts != "" &&
+				!CA_Pool.AppendCertsFromPEM([]byte(arg.RootCerts)) {
+				scope.Log("kafka_upload: Unable to add root certs")
+				return
+			}
+
+			config.TLSConfig = &tls.Config{
+				RootCAs:            CA_Pool,
+				InsecureSkipVerify: arg.SkipVerify,
+			}
+		}
+
+		client, err := NewClient(config)
+		if err != nil {
+			scope.Log("kafka_upload: %v", err)
+			return
+		}
+		defer client.Close()
+
+		upload_rows(ctx, scope, output_chan, client,
+			arg.Query.Eval(ctx, scope), &arg)
+	}()
+
+	return output_chan
+}
+
+// Copy rows from row_chan to a local buffer and push it up to
+// kafka. Sending blocks the query so a slow broker slows down the
+// query rather than growing the buffer.
+func upload_rows(
+	ctx context.Context,
+	scope vfilter.Scope,
+	output_chan chan vfilter.Row,
+	client *Client,
+	row_chan <-chan vfilter.Row,
+	arg *_KafkaPluginArgs) {
+
+	var buf []*Message
+	var buf_size uint64
+
+	opts := vql_subsystem.EncOptsFromScope(scope)
+
+	wait_time := time.Duration(arg.WaitTime) * time.Second
+	next_send_time := time.After(wait_time)
+
+	flush := func() {
+		send_to_kafka(ctx, output_chan, client, buf)
+		buf = nil
+		buf_size = 0
+		next_send_time = time.After(wait_time)
+	}
+
+	// Batch sending to kafka: Either when we get to chunksize or
+	// wait time whichever comes first.
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case row, ok := <-row_chan:
+			if !ok {
+				// Flush any remaining rows
+				flush()
+				return
+			}
+
+			message, err := row_to_message(ctx, scope, row, arg, opts)
+			if err != nil {
+				scope.Log("kafka_upload: %v", err)
+				continue
+			}
+
+			buf = append(buf, message)
+			buf_size += uint64(len(message.Key) + len(message.Value))
+
+			if int64(len(buf)) >= arg.ChunkSize ||
+				buf_size >= arg.MaxMemoryBuffer {
+				flush()
+			}
+
+		case <-next_send_time:
+			flush()
+		}
+	}
+}
+
+func row_to_message(
+	ctx context.Context,
+	scope vfilter.Scope,
+	row vfilter.Row,
+	arg *_KafkaPluginArgs, opts *json.EncOpts) (*Message, error) {
+
+	row_dict := vfilter.RowToDict(ctx, scope, row)
+
+	topic := expand_topic(arg.Topic, row_dict)
+	topic_any, pres := row_dict.Get("_topic")
+	if pres {
+		topic = sanitize_topic(fmt.Sprintf("%v", topic_any))
+		row_dict.Delete("_topic")
+	}
+
+	if topic == "" {
+		return nil, fmt.Errorf("No topic specified for row")
+	}
+
+	message := &Message{
+		Topic:     topic,
+		Timestamp: utils.GetTime().Now(),
+	}
+
+	if arg.Key != "" {
+		key, pres := row_dict.Get(arg.Key)
+		if pres {
+			message.Key = []byte(utils.ToString(key))
+		}
+	}
+
+	if arg.TimestampField != "" {
+		ts, pres := row_dict.Get(arg.TimestampField)
+		if pres {
+			timestamp, err := functions.TimeFromAny(scope, ts)
+			if err == nil {
+				message.Timestamp = timestamp
+			}
+		}
+	}
+
+	data, err := json.MarshalWithOptions(row_dict, opts)
+	if err != nil {
+		return nil, err
+	}
+	message.Value = data
+
+	return message, nil
+}
+
+func send_to_kafka(
+	ctx context.Context,
+	output_chan chan vfilter.Row,
+	client *Client, buf []*Message) {
+	if len(buf) == 0 {
+		return
+	}
+
+	for _, result := range client.Produce(ctx, buf) {
+		var error_message interface{}
+		if result.Error != nil {
+			error_message = result.Error.Error()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+
+		case output_chan <- ordereddict.NewDict().
+			Set("Topic", result.Topic).
+			Set("Partition", result.Partition).
+			Set("Messages", result.Messages).
+			Set("BaseOffset", result.BaseOffset).
+			Set("Error", error_message):
+		}
+	}
+}
+
+var (
+	topic_placeholder_re = regexp.MustCompile(`\{([^{}]+)\}`)
+	sanitize_topic_re    = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+)
+
+// Expand {Column} placeholders in the topic from the row.
+func expand_topic(topic string, row *ordereddict.Dict) string {
+	return sanitize_topic(topic_placeholder_re.ReplaceAllStringFunc(topic,
+		func(match string) string {
+			value, _ := row.Get(match[1 : len(match)-1])
+			if utils.IsNil(value) {
+				return ""
+			}
+			return utils.ToString(value)
+		}))
+}
+
+// Kafka topics may only contain ASCII alphanumerics, '.', '_' and
+// '-'.
+func sanitize_topic(name string) string {
+	return sanitize_topic_re.ReplaceAllLiteralString(name, "_")
+}
+
+func (self _KafkaPlugin) Info(
+	scope vfilter.Scope,
+	type_map *vfilter.TypeMap) *vfilter.PluginInfo {
+	return &vfilter.PluginInfo{
+		Name:    "kafka_upload",
+		Doc:     "Upload rows to kafka.",
+		ArgType: type_map.AddType(scope, &_KafkaPluginArgs{}),
+	}
+}
+
+func init() {
+	vql_subsystem.RegisterPlugin(&_KafkaPlugin{})
+}
@@ -0,0 +1,206 @@
+package kafka
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/Velocidex/ordereddict"
+	"github.com/alecthomas/assert"
+	"github.com/sebdah/goldie"
+	"github.com/stretchr/testify/suite"
+	"www.velocidex.com/golang/velociraptor/file_store/test_utils"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/utils"
+)
+
+type KafkaTestSuite struct {
+	test_utils.TestSuite
+}
+
+func (self *KafkaTestSuite) TestKafkaUpload() {
+	closer := utils.MockTime(&utils.MockClock{MockNow: time.Unix(1672531200, 0)})
+	defer closer()
+
+	rows := []*ordereddict.Dict{}
+	for i := 0; i < 6; i++ {
+		rows = append(rows, ordereddict.NewDict().
+			Set("Artifact", "Windows.Test").
+			Set("ClientId", "C."+string(rune('a'+i%3))).
+			Set("Count", i))
+	}
+	rows = append(rows, ordereddict.NewDict().
+		Set("_topic", "Other Topic").
+		Set("ClientId", "C.100").
+		Set("Count", 6))
+
+	var golden []*ordereddict.Dict
+
+	for _, compression := range []string{"none", "gzip", "snappy", "zstd"} {
+		broker, err := newFakeBroker(3, "admin", "secret")
+		assert.NoError(self.T(), err)
+		defer broker.Close()
+
+		result, err := test_utils.RunQuery(self.ConfigObj, `
+SELECT * FROM kafka_upload(query={
+   SELECT * FROM foreach(row=Rows)
+}, brokers=[Broker], topic="velociraptor.{Artifact}", key="ClientId",
+   compression=Compression, sasl_mechanism="PLAIN",
+   username="admin", password="secret")`,
+			ordereddict.NewDict().
+				Set("Rows", rows).
+				Set("Broker", broker.Addr()).
+				Set("Compression", compression))
+		assert.NoError(self.T(), err)
+
+		// All rows go to a single topic partition for each key.
+		total := 0
+		for partition := int32(0); partition < 3; partition++ {
+			for _, message := range broker.Messages(
+				"velociraptor.Windows.Test", partition) {
+				total++
+				assert.Equal(self.T(), partition,
+					partitionForKey(message.Key, 3))
+
+				value := ordereddict.NewDict()
+				assert.NoError(self.T(), value.UnmarshalJSON(message.Value))
+				client_id, _ := value.GetString("ClientId")
+				assert.Equal(self.T(), client_id, string(message.Key))
+				assert.Equal(self.T(), int64(1672531200),
+					message.Timestamp.Unix())
+			}
+		}
+		assert.Equal(self.T(), 6, total)
+
+		// The _topic column overrides the topic and is removed.
+		other := broker.Messages("Other_Topic", partitionForKey([]byte("C.100"), 3))
+		assert.Equal(self.T(), 1, len(other))
+		assert.Equal(self.T(), `{"ClientId":"C.100","Count":6}`,
+			string(other[0].Value))
+
+		assert.Equal(self.T(), compressionCodecs[compression], broker.codecs[0])
+
+		if golden == nil {
+			golden = result
+		}
+		assert.Equal(self.T(), json.MustMarshalString(golden),
+			json.MustMarshalString(result))
+	}
+
+	goldie.Assert(self.T(), "TestKafkaUpload", json.MustMarshalIndent(golden))
+}
+
+func TestKafkaRetries(t *testing.T) {
+	broker, err := newFakeBroker(1, "", "")
+	assert.NoError(t, err)
+	defer broker.Close()
+
+	// A transient error is retried.
+	broker.FailProduce(1)
+
+	client, err := NewClient(Config{
+		Brokers:      []string{broker.Addr()},
+		RequiredAcks: -1,
+		MaxRetries:   1,
+		RetryBackoff: time.Millisecond,
+	})
+	assert.NoError(t, err)
+	defer client.Close()
+
+	ctx := context.Background()
+	results := client.Produce(ctx, []*Message{
+		{Topic: "test", Value: []byte("hello")},
+	})
+	assert.Equal(t, 1, len(results))
+	assert.NoError(t, results[0].Error)
+	assert.Equal(t, 1, len(broker.Messages("test", 0)))
+
+	// Too many errors give up.
+	broker.FailProduce(2)
+	results = client.Produce(ctx, []*Message{
+		{Topic: "test", Value: []byte("world")},
+	})
+	assert.Equal(t, 1, len(results))
+	assert.Equal(t, KafkaError(6), results[0].Error)
+	assert.Equal(t, 1, len(broker.Messages("test", 0)))
+
+	// With acks=0 there is no response.
+	client.config.RequiredAcks = 0
+	results = client.Produce(ctx, []*Message{
+		{Topic: "test", Value: []byte("again")},
+	})
+	assert.Equal(t, 1, len(results))
+	assert.NoError(t, results[0].Error)
+	assert.Equal(t, int64(-1), results[0].BaseOffset)
+}
+
+func TestKafkaAuthFailure(t *testing.T) {
+	broker, err := newFakeBroker(1, "admin", "secret")
+	assert.NoError(t, err)
+	defer broker.Close()
+
+	client, err := NewClient(Config{
+		Brokers:       []string{broker.Addr()},
+		SASLMechanism: "PLAIN",
+		Username:      "admin",
+		Password:      "wrong",
+	})
+	assert.NoError(t, err)
+	defer client.Close()
+
+	results := client.Produce(context.Background(), []*Message{
+		{Topic: "test", Value: []byte("hello")},
+	})
+	assert.Equal(t, 1, len(results))
+	assert.Contains(t, results[0].Error.Error(), "SASL_AUTHENTICATION_FAILED")
+}
+
+// Test vectors from the Java client's UtilsTest.
+func TestMurmur2(t *testing.T) {
+	for _, test := range []struct {
+		data string
+		hash int32
+	}{
+		{"21", -973932308},
+		{"foobar", -790332482},
+		{"a-little-bit-long-string", -985981536},
+		{"a-little-bit-longer-string", -1486304829},
+		{"lkjh234lh9fiuh90y23oiuhsafujhadof229phr9h19h89h8", -58897971},
+		{"abc", 479470107},
+	} {
+		assert.Equal(t, test.hash, murmur2([]byte(test.data)), test.data)
+	}
+}
+
+// Test vector from RFC 7677
+func TestScramSHA256(t *testing.T) {
+	mechanism := newScramMechanism("SCRAM-SHA-256", sha256.New,
+		"user", "pencil", "rOprNGfwEbeRWgbNEkqO")
+
+	message, done, err := mechanism.Step(nil)
+	assert.NoError(t, err)
+	assert.False(t, done)
+	assert.Equal(t, "n,,n=user,r=rOprNGfwEbeRWgbNEkqO", string(message))
+
+	message, done, err = mechanism.Step([]byte(
+		"r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0," +
+			"s=W22ZaJ0SNY7soEsUEjb6gQ==,i=4096"))
+	assert.NoError(t, err)
+	assert.False(t, done)
+	assert.Equal(t, "c=biws,r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,"+
+		"p=dHzbZapWIk4jUhN+Ute9ytag9zjfMHgsqmmiz7AndVQ=", string(message))
+
+	_, done, err = mechanism.Step([]byte(
+		"v=6rriTRBi23WpRR/wtup+mMhUZUn/dB5nLTJRsjl95G4="))
+	assert.NoError(t, err)
+	assert.True(t, done)
+}
+
+func timeFromMillis(ms int64) time.Time {
+	return time.Unix(0, ms*1000000).UTC()
+}
+
+func TestKafka(t *testing.T) {
+	suite.Run(t, &KafkaTestSuite{})
+}
@@ -0,0 +1,373 @@
+// A minimal implementation of the Kafka wire protocol - just enough
+// to discover partition leaders and produce messages. We do not
+// depend on a full client library because we only ever produce.
+
+// See https://kafka.apache.org/protocol
+
+package kafka
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	API_PRODUCE           = 0
+	API_METADATA          = 3
+	API_SASL_HANDSHAKE    = 17
+	API_SASL_AUTHENTICATE = 36
+
+	// Produce v3 is the first version supporting record batches
+	// (Kafka 0.11), v7 is needed for zstd compression (Kafka 2.1).
+	PRODUCE_VERSION      = 3
+	PRODUCE_ZSTD_VERSION = 7
+	METADATA_VERSION     = 1
+
+	// Largest response we are prepared to read.
+	MAX_RESPONSE_SIZE = 100 * 1024 * 1024
+)
+
+var (
+	truncatedError = errors.New("kafka: response truncated")
+)
+
+// Error codes returned by the broker.
+type KafkaError int16
+
+var kafkaErrors = map[KafkaError]string{
+	1:  "OFFSET_OUT_OF_RANGE",
+	2:  "CORRUPT_MESSAGE",
+	3:  "UNKNOWN_TOPIC_OR_PARTITION",
+	5:  "LEADER_NOT_AVAILABLE",
+	6:  "NOT_LEADER_FOR_PARTITION",
+	7:  "REQUEST_TIMED_OUT",
+	10: "MESSAGE_TOO_LARGE",
+	17: "INVALID_TOPIC_EXCEPTION",
+	18: "RECORD_LIST_TOO_LARGE",
+	19: "NOT_ENOUGH_REPLICAS",
+	20: "NOT_ENOUGH_REPLICAS_AFTER_APPEND",
+	29: "TOPIC_AUTHORIZATION_FAILED",
+	33: "UNSUPPORTED_SASL_MECHANISM",
+	34: "ILLEGAL_SASL_STATE",
+	35: "UNSUPPORTED_VERSION",
+	58: "SASL_AUTHENTICATION_FAILED",
+	76: "UNSUPPORTED_COMPRESSION_TYPE",
+}
+
+func (self KafkaError) Error() string {
+	name, pres := kafkaErrors[self]
+	if !pres {
+		name = "UNKNOWN"
+	}
+	return fmt.Sprintf("kafka: %s (%d)", name, int16(self))
+}
+
+// Errors which are likely to go away after refreshing metadata.
+func (self KafkaError) Retriable() bool {
+	switch self {
+	case 3, 5, 6, 7, 19, 20:
+		return true
+	}
+	return false
+}
+
+type encoder struct {
+	buf bytes.Buffer
+}
+
+func (self *encoder) int8(v int8) {
+	self.buf.WriteByte(byte(v))
+}
+
+func (self *encoder) int16(v int16) {
+	_ = binary.Write(&self.buf, binary.BigEndian, v)
+}
+
+func (self *encoder) int32(v int32) {
+	_ = binary.Write(&self.buf, binary.BigEndian, v)
+}
+
+func (self *encoder) int64(v int64) {
+	_ = binary.Write(&self.buf, binary.BigEndian, v)
+}
+
+func (self *encoder) string(v string) {
+	self.int16(int16(len(v)))
+	self.buf.WriteString(v)
+}
+
+func (self *encoder) nullString() {
+	self.int16(-1)
+}
+
+func (self *encoder) bytes(v []byte) {
+	self.int32(int32(len(v)))
+	self.buf.Write(v)
+}
+
+// Zig-zag encoded variable length integers used in record batches.
+func (self *encoder) varint(v int64) {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(tmp[:], v)
+	self.buf.Write(tmp[:n])
+}
+
+func (self *encoder) varintBytes(v []byte) {
+	if v == nil {
+		self.varint(-1)
+		return
+	}
+	self.varint(int64(len(v)))
+	self.buf.Write(v)
+}
+
+func (self *encoder) Bytes() []byte {
+	return self.buf.Bytes()
+}
+
+// A bounds checked decoder. The first error sticks and all further
+// reads return zero values.
+type decoder struct {
+	data   []byte
+	offset int
+	err    error
+}
+
+func (self *decoder) read(length int) []byte {
+	if self.err != nil {
+		return nil
+	}
+	if length < 0 || self.offset+length > len(self.data) {
+		self.err = truncatedError
+		return nil
+	}
+	result := self.data[self.offset : self.offset+length]
+	self.offset += length
+	return result
+}
+
+func (self *decoder) int8() int8 {
+	b := self.read(1)
+	if b == nil {
+		return 0
+	}
+	return int8(b[0])
+}
+
+func (self *decoder) int16() int16 {
+	b := self.read(2)
+	if b == nil {
+		return 0
+	}
+	return int16(binary.BigEndian.Uint16(b))
+}
+
+func (self *decoder) int32() int32 {
+	b := self.read(4)
+	if b == nil {
+		return 0
+	}
+	return int32(binary.BigEndian.Uint32(b))
+}
+
+func (self *decoder) int64() int64 {
+	b := self.read(8)
+	if b == nil {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(b))
+}
+
+func (self *decoder) string() string {
+	length := self.int16()
+	if length < 0 {
+		return ""
+	}
+	return string(self.read(int(length)))
+}
+
+func (self *decoder) bytes() []byte {
+	length := self.int32()
+	if length < 0 {
+		return nil
+	}
+	return self.read(int(length))
+}
+
+// Array lengths are bounded by the remaining data to avoid huge
+// allocations from corrupted responses.
+func (self *decoder) arrayLength() int {
+	length := int(self.int32())
+	if length < 0 {
+		return 0
+	}
+	if length > len(self.data)-self.offset {
+		self.err = truncatedError
+		return 0
+	}
+	return length
+}
+
+func (self *decoder) varint() int64 {
+	if self.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(self.data[self.offset:])
+	if n <= 0 {
+		self.err = truncatedError
+		return 0
+	}
+	self.offset += n
+	return v
+}
+
+func (self *decoder) varintBytes() []byte {
+	length := self.varint()
+	if length < 0 {
+		return nil
+	}
+	return self.read(int(length))
+}
+
+type broker struct {
+	NodeID int32
+	Host   string
+	Port   int32
+}
+
+type partitionMetadata struct {
+	Error     KafkaError
+	Partition int32
+	Leader    int32
+}
+
+type topicMetadata struct {
+	Error      KafkaError
+	Name       string
+	Partitions []partitionMetadata
+}
+
+type metadataResponse struct {
+	Brokers []broker
+	Topics  []topicMetadata
+}
+
+func encodeMetadataRequest(topics []string) []byte {
+	e := &encoder{}
+	e.int32(int32(len(topics)))
+	for _, topic := range topics {
+		e.string(topic)
+	}
+	return e.Bytes()
+}
+
+func decodeMetadataResponse(d *decoder) (*metadataResponse, error) {
+	result := &metadataResponse{}
+
+	for i := d.arrayLength(); i > 0; i-- {
+		b := broker{
+			NodeID: d.int32(),
+			Host:   d.string(),
+			Port:   d.int32(),
+		}
+		_ = d.string() // rack
+		result.Brokers = append(result.Brokers, b)
+	}
+
+	_ = d.int32() // controller_id
+
+	for i := d.arrayLength(); i > 0; i-- {
+		topic := topicMetadata{
+			Error: KafkaError(d.int16()),
+			Name:  d.string(),
+		}
+		_ = d.int8() // is_internal
+
+		for j := d.arrayLength(); j > 0; j-- {
+			partition := partitionMetadata{
+				Error:     KafkaError(d.int16()),
+				Partition: d.int32(),
+				Leader:    d.int32(),
+			}
+
+			// Replicas and in sync replicas
+			for k := d.arrayLength(); k > 0; k-- {
+				d.int32()
+			}
+			for k := d.arrayLength(); k > 0; k-- {
+				d.int32()
+			}
+			topic.Partitions = append(topic.Partitions, partition)
+		}
+		result.Topics = append(result.Topics, topic)
+	}
+
+	return result, d.err
+}
+
+type topicPartition struct {
+	Topic     string
+	Partition int32
+}
+
+type partitionResponse struct {
+	Error      KafkaError
+	BaseOffset int64
+}
+
+func encodeProduceRequest(acks int16, timeout_ms int32,
+	batches map[topicPartition][]byte) []byte {
+
+	// Group the partitions by topic
+	by_topic := make(map[string][]int32)
+	var topics []string
+	for tp := range batches {
+		_, pres := by_topic[tp.Topic]
+		if !pres {
+			topics = append(topics, tp.Topic)
+		}
+		by_topic[tp.Topic] = append(by_topic[tp.Topic], tp.Partition)
+	}
+
+	e := &encoder{}
+	e.nullString() // transactional_id
+	e.int16(acks)
+	e.int32(timeout_ms)
+	e.int32(int32(len(topics)))
+	for _, topic := range topics {
+		e.string(topic)
+		partitions := by_topic[topic]
+		e.int32(int32(len(partitions)))
+		for _, partition := range partitions {
+			e.int32(partition)
+			e.bytes(batches[topicPartition{topic, partition}])
+		}
+	}
+	return e.Bytes()
+}
+
+func decodeProduceResponse(d *decoder, version int16) (
+	map[topicPartition]partitionResponse, error) {
+	result := make(map[topicPartition]partitionResponse)
+
+	for i := d.arrayLength(); i > 0; i-- {
+		topic := d.string()
+		for j := d.arrayLength(); j > 0; j-- {
+			partition := d.int32()
+			response := partitionResponse{
+				Error:      KafkaError(d.int16()),
+				BaseOffset: d.int64(),
+			}
+			_ = d.int64() // log_append_time_ms
+			if version >= 5 {
+				_ = d.int64() // log_start_offset
+			}
+			result[topicPartition{topic, partition}] = response
+		}
+	}
+	_ = d.int32() // throttle_time_ms
+
+	return result, d.err
+}
@@ -0,0 +1,183 @@
+package kafka
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"hash/crc32"
+	"time"
+
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Compression codecs stored in the record batch attributes.
+const (
+	COMPRESSION_NONE   = 0
+	COMPRESSION_GZIP   = 1
+	COMPRESSION_SNAPPY = 2
+	COMPRESSION_LZ4    = 3
+	COMPRESSION_ZSTD   = 4
+
+	RECORD_BATCH_MAGIC = 2
+
+	// Offset of the attributes field - the CRC covers everything
+	// from here to the end of the batch.
+	RECORD_BATCH_CRC_START = 21
+)
+
+var (
+	castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+	compressionCodecs = map[string]int16{
+		"":       COMPRESSION_NONE,
+		"none":   COMPRESSION_NONE,
+		"gzip":   COMPRESSION_GZIP,
+		"snappy": COMPRESSION_SNAPPY,
+		"zstd":   COMPRESSION_ZSTD,
+	}
+)
+
+type Message struct {
+	Topic     string
+	Key       []byte
+	Value     []byte
+	Timestamp time.Time
+}
+
+// Encode messages into a v2 record batch.
+// https://kafka.apache.org/documentation/#recordbatch
+func encodeRecordBatch(messages []*Message, codec int16) ([]byte, error) {
+	if len(messages) == 0 {
+		return nil, nil
+	}
+
+	first_timestamp := messages[0].Timestamp.UnixNano() / 1000000
+	max_timestamp := first_timestamp
+
+	records := &encoder{}
+	for idx, message := range messages {
+		timestamp := message.Timestamp.UnixNano() / 1000000
+		if timestamp > max_timestamp {
+			max_timestamp = timestamp
+		}
+
+		record := &encoder{}
+		record.int8(0) // attributes
+		record.varint(timestamp - first_timestamp)
+		record.varint(int64(idx))
+		record.varintBytes(message.Key)
+		record.varintBytes(message.Value)
+		record.varint(0) // headers
+
+		records.varint(int64(record.buf.Len()))
+		records.buf.Write(record.Bytes())
+	}
+
+	payload, err := compress(codec, records.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	// Everything after the CRC field.
+	body := &encoder{}
+	body.int16(codec)
+	body.int32(int32(len(messages) - 1)) // last_offset_delta
+	body.int64(first_timestamp)
+	body.int64(max_timestamp)
+	body.int64(-1) // producer_id
+	body.int16(-1) // producer_epoch
+	body.int32(-1) // base_sequence
+	body.int32(int32(len(messages)))
+	body.buf.Write(payload)
+
+	batch := &encoder{}
+	batch.int64(0) // base_offset is assigned by the broker
+
+	// batch_length counts from partition_leader_epoch
+	batch.int32(int32(4 + 1 + 4 + body.buf.Len()))
+	batch.int32(-1) // partition_leader_epoch
+	batch.int8(RECORD_BATCH_MAGIC)
+	batch.int32(int32(crc32.Checksum(body.Bytes(), castagnoli)))
+	batch.buf.Write(body.Bytes())
+
+	return batch.Bytes(), nil
+}
+
+func compress(codec int16, data []byte) ([]byte, error) {
+	switch codec {
+	case COMPRESSION_NONE:
+		return data, nil
+
+	case COMPRESSION_GZIP:
+		var buf bytes.Buffer
+		writer := gzip.NewWriter(&buf)
+		_, err := writer.Write(data)
+		if err != nil {
+			return nil, err
+		}
+		err = writer.Close()
+		return buf.Bytes(), err
+
+	case COMPRESSION_SNAPPY:
+		// Brokers accept raw snappy blocks as well as the xerial
+		// framing.
+		return s2.EncodeSnappy(nil, data), nil
+
+	case COMPRESSION_ZSTD:
+		encoder, err := zstd.NewWriter(nil)
+		if err != nil {
+			return nil, err
+		}
+		defer encoder.Close()
+		return encoder.EncodeAll(data, nil), nil
+	}
+
+	return nil, fmt.Errorf("kafka: unsupported compression codec %v", codec)
+}
+
+// The murmur2 hash used by the Java client's default partitioner so
+// keys land on the same partitions as with other producers.
+func murmur2(data []byte) int32 {
+	const (
+		seed uint32 = 0x9747b28c
+		m    uint32 = 0x5bd1e995
+		r           = 24
+	)
+
+	length := len(data)
+	h := seed ^ uint32(length)
+
+	for i := 0; i+4 <= length; i += 4 {
+		k := uint32(data[i]) | uint32(data[i+1])<<8 |
+			uint32(data[i+2])<<16 | uint32(data[i+3])<<24
+		k *= m
+		k ^= k >> r
+		k *= m
+		h *= m
+		h ^= k
+	}
+
+	tail := length &^ 3
+	switch length % 4 {
+	case 3:
+		h ^= uint32(data[tail+2]) << 16
+		fallthrough
+	case 2:
+		h ^= uint32(data[tail+1]) << 8
+		fallthrough
+	case 1:
+		h ^= uint32(data[tail])
+		h *= m
+	}
+
+	h ^= h >> 13
+	h *= m
+	h ^= h >> 15
+
+	return int32(h)
+}
+
+func partitionForKey(key []byte, partitions int) int32 {
+	return int32((murmur2(key) & 0x7fffffff) % int32(partitions))
+}
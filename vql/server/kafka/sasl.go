@@ -0,0 +1,184 @@
+package kafka
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// A SASL mechanism is a sequence of challenges and responses. Step
+// receives the server's last message (nil at the start) and returns
+// the next message to send, or done when the exchange is complete.
+type saslMechanism interface {
+	Name() string
+	Step(challenge []byte) (response []byte, done bool, err error)
+}
+
+func newSASLMechanism(name, username, password string) (saslMechanism, error) {
+	switch strings.ToUpper(name) {
+	case "PLAIN":
+		return &plainMechanism{username: username, password: password}, nil
+
+	case "SCRAM-SHA-256":
+		return newScramMechanism("SCRAM-SHA-256", sha256.New,
+			username, password, ""), nil
+
+	case "SCRAM-SHA-512":
+		return newScramMechanism("SCRAM-SHA-512", sha512.New,
+			username, password, ""), nil
+	}
+
+	return nil, fmt.Errorf(
+		"kafka: unsupported SASL mechanism %v (should be PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512)",
+		name)
+}
+
+// RFC 4616
+type plainMechanism struct {
+	username, password string
+	sent               bool
+}
+
+func (self *plainMechanism) Name() string {
+	return "PLAIN"
+}
+
+func (self *plainMechanism) Step(challenge []byte) ([]byte, bool, error) {
+	if self.sent {
+		return nil, true, nil
+	}
+	self.sent = true
+	return []byte("\x00" + self.username + "\x00" + self.password), false, nil
+}
+
+// RFC 5802 and RFC 7677
+type scramMechanism struct {
+	name               string
+	hash               func() hash.Hash
+	username, password string
+	nonce              string
+
+	step              int
+	client_first_bare string
+	server_signature  []byte
+}
+
+func newScramMechanism(name string, hash func() hash.Hash,
+	username, password, nonce string) *scramMechanism {
+	if nonce == "" {
+		buf := make([]byte, 24)
+		_, _ = rand.Read(buf)
+		nonce = base64.RawStdEncoding.EncodeToString(buf)
+	}
+
+	return &scramMechanism{
+		name:     name,
+		hash:     hash,
+		username: username,
+		password: password,
+		nonce:    nonce,
+	}
+}
+
+func (self *scramMechanism) Name() string {
+	return self.name
+}
+
+func (self *scramMechanism) Step(challenge []byte) ([]byte, bool, error) {
+	self.step++
+
+	switch self.step {
+	case 1:
+		username := strings.NewReplacer("=", "=3D", ",", "=2C").
+			Replace(self.username)
+		self.client_first_bare = "n=" + username + ",r=" + self.nonce
+		return []byte("n,," + self.client_first_bare), false, nil
+
+	case 2:
+		return self.clientFinal(string(challenge))
+
+	case 3:
+		attrs := parseScramAttributes(string(challenge))
+		if attrs["e"] != "" {
+			return nil, false, fmt.Errorf("kafka: SCRAM: %v", attrs["e"])
+		}
+
+		signature, err := base64.StdEncoding.DecodeString(attrs["v"])
+		if err != nil || !hmac.Equal(signature, self.server_signature) {
+			return nil, false, errors.New(
+				"kafka: SCRAM: invalid server signature")
+		}
+		return nil, true, nil
+	}
+
+	return nil, true, nil
+}
+
+func (self *scramMechanism) clientFinal(server_first string) ([]byte, bool, error) {
+	attrs := parseScramAttributes(server_first)
+
+	nonce := attrs["r"]
+	if !strings.HasPrefix(nonce, self.nonce) {
+		return nil, false, errors.New("kafka: SCRAM: invalid server nonce")
+	}
+
+	salt, err := base64.StdEncoding.DecodeString(attrs["s"])
+	if err != nil {
+		return nil, false, fmt.Errorf("kafka: SCRAM: invalid salt: %w", err)
+	}
+
+	iterations, err := strconv.Atoi(attrs["i"])
+	if err != nil || iterations <= 0 {
+		return nil, false, errors.New("kafka: SCRAM: invalid iteration count")
+	}
+
+	salted_password := pbkdf2.Key([]byte(self.password), salt,
+		iterations, self.hash().Size(), self.hash)
+
+	client_key := self.hmac(salted_password, "Client Key")
+	h := self.hash()
+	h.Write(client_key)
+	stored_key := h.Sum(nil)
+
+	// c=biws is the base64 of the "n,," GS2 header.
+	client_final_without_proof := "c=biws,r=" + nonce
+	auth_message := self.client_first_bare + "," + server_first + "," +
+		client_final_without_proof
+
+	client_signature := self.hmac(stored_key, auth_message)
+	proof := make([]byte, len(client_key))
+	for i := range client_key {
+		proof[i] = client_key[i] ^ client_signature[i]
+	}
+
+	server_key := self.hmac(salted_password, "Server Key")
+	self.server_signature = self.hmac(server_key, auth_message)
+
+	return []byte(client_final_without_proof + ",p=" +
+		base64.StdEncoding.EncodeToString(proof)), false, nil
+}
+
+func (self *scramMechanism) hmac(key []byte, message string) []byte {
+	mac := hmac.New(self.hash, key)
+	mac.Write([]byte(message))
+	return mac.Sum(nil)
+}
+
+func parseScramAttributes(message string) map[string]string {
+	result := make(map[string]string)
+	for _, part := range strings.Split(message, ",") {
+		if len(part) >= 2 && part[1] == '=' {
+			result[part[:1]] = part[2:]
+		}
+	}
+	return result
+}
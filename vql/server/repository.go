@@ -9,6 +9,7 @@ import (
 	artifacts_proto "www.velocidex.com/golang/velociraptor/artifacts/proto"
 	"www.velocidex.com/golang/velociraptor/json"
 	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/services/repository"
 	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
 	"www.velocidex.com/golang/vfilter"
 	"www.velocidex.com/golang/vfilter/arg_parser"
@@ -295,7 +296,118 @@ func (self ArtifactsPlugin) Info(scope vfilter.Scope, type_map *vfilter.TypeMap)
 	}
 }
 
+type ArtifactVerifyPluginArgs struct {
+	Paths       []string `vfilter:"optional,field=paths,doc=Paths to artifact YAML files or directories to load and verify."`
+	Definitions []string `vfilter:"optional,field=definitions,doc=Artifact definitions (YAML) to load and verify."`
+	Names       []string `vfilter:"optional,field=names,doc=Names of artifacts in the repository to verify."`
+}
+
+type ArtifactVerifyPlugin struct{}
+
+func (self ArtifactVerifyPlugin) Call(
+	ctx context.Context,
+	scope vfilter.Scope,
+	args *ordereddict.Dict) <-chan vfilter.Row {
+	output_chan := make(chan vfilter.Row)
+	go func() {
+		defer close(output_chan)
+
+		// Verifying is part of authoring artifacts.
+		err := vql_subsystem.CheckAccess(scope, acls.ARTIFACT_WRITER)
+		if err != nil {
+			scope.Log("artifact_verify: %v", err)
+			return
+		}
+
+		arg := &ArtifactVerifyPluginArgs{}
+		err = arg_parser.ExtractArgsWithContext(ctx, scope, args, arg)
+		if err != nil {
+			scope.Log("artifact_verify: %v", err)
+			return
+		}
+
+		if len(arg.Paths) > 0 {
+			err := vql_subsystem.CheckFilesystemAccess(scope, "file")
+			if err != nil {
+				scope.Log("artifact_verify: %v", err)
+				return
+			}
+		}
+
+		config_obj, ok := vql_subsystem.GetServerConfig(scope)
+		if !ok {
+			scope.Log("artifact_verify: Command can only run on the server")
+			return
+		}
+
+		manager, err := services.GetRepositoryManager(config_obj)
+		if err != nil {
+			scope.Log("artifact_verify: %v", err)
+			return
+		}
+		global_repository, err := manager.GetGlobalRepository(config_obj)
+		if err != nil {
+			scope.Log("artifact_verify: %v", err)
+			return
+		}
+
+		verifier := repository.NewArtifactVerifier(config_obj, global_repository)
+
+		// With no args at all verify the entire repository.
+		var names []string
+		if len(arg.Paths) > 0 || len(arg.Definitions) > 0 || len(arg.Names) > 0 {
+			names = append([]string{}, arg.Names...)
+		}
+
+		for _, path := range arg.Paths {
+			loaded, err := verifier.LoadPath(path)
+			if err != nil {
+				scope.Log("artifact_verify: %v", err)
+				return
+			}
+			names = append(names, loaded...)
+		}
+
+		for _, definition := range arg.Definitions {
+			name, err := verifier.LoadYaml(definition, "")
+			if err == nil {
+				names = append(names, name)
+			}
+		}
+
+		issues, err := verifier.Verify(ctx, names)
+		if err != nil {
+			scope.Log("artifact_verify: %v", err)
+			return
+		}
+
+		for _, issue := range issues {
+			select {
+			case <-ctx.Done():
+				return
+			case output_chan <- ordereddict.NewDict().
+				Set("Artifact", issue.Artifact).
+				Set("File", issue.File).
+				Set("Severity", issue.Severity).
+				Set("Type", issue.Type).
+				Set("Message", issue.Message):
+			}
+		}
+	}()
+
+	return output_chan
+}
+
+func (self ArtifactVerifyPlugin) Info(scope vfilter.Scope, type_map *vfilter.TypeMap) *vfilter.PluginInfo {
+	return &vfilter.PluginInfo{
+		Name:    "artifact_verify",
+		Doc:     "Statically verify artifact definitions.",
+		ArgType: type_map.AddType(scope, &ArtifactVerifyPluginArgs{}),
+	}
+}
+
 func init() {
+	vql_subsystem.RegisterPlugin(&ArtifactVerifyPlugin{})
 	vql_subsystem.RegisterPlugin(&ArtifactsPlugin{})
 	vql_subsystem.RegisterFunction(&ArtifactSetFunction{})
 	vql_subsystem.RegisterFunction(&ArtifactDeleteFunction{})
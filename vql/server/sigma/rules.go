@@ -0,0 +1,175 @@
+package sigma
+
+import (
+	"context"
+
+	"github.com/Velocidex/ordereddict"
+	"www.velocidex.com/golang/velociraptor/acls"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/services"
+	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
+	vfilter "www.velocidex.com/golang/vfilter"
+	"www.velocidex.com/golang/vfilter/arg_parser"
+)
+
+type SigmaRuleSetFunctionArgs struct {
+	Rule string `vfilter:"required,field=rule,doc=The Sigma rule in YAML format."`
+}
+
+type SigmaRuleSetFunction struct{}
+
+func (self *SigmaRuleSetFunction) Call(ctx context.Context,
+	scope vfilter.Scope,
+	args *ordereddict.Dict) vfilter.Any {
+
+	err := vql_subsystem.CheckAccess(scope, acls.SERVER_ARTIFACT_WRITER)
+	if err != nil {
+		scope.Log("sigma_rule_set: %v", err)
+		return vfilter.Null{}
+	}
+
+	arg := &SigmaRuleSetFunctionArgs{}
+	err = arg_parser.ExtractArgsWithContext(ctx, scope, args, arg)
+	if err != nil {
+		scope.Log("sigma_rule_set: %v", err)
+		return vfilter.Null{}
+	}
+
+	config_obj, ok := vql_subsystem.GetServerConfig(scope)
+	if !ok {
+		scope.Log("sigma_rule_set: Command can only run on the server")
+		return vfilter.Null{}
+	}
+
+	service, err := services.GetSigmaService(config_obj)
+	if err != nil {
+		scope.Log("sigma_rule_set: %v", err)
+		return vfilter.Null{}
+	}
+
+	principal := vql_subsystem.GetPrincipal(scope)
+	rule, err := service.SetRule(ctx, principal, arg.Rule)
+	if err != nil {
+		scope.Log("sigma_rule_set: %v", err)
+		return vfilter.Null{}
+	}
+
+	return json.ConvertProtoToOrderedDict(rule)
+}
+
+func (self SigmaRuleSetFunction) Info(
+	scope vfilter.Scope, type_map *vfilter.TypeMap) *vfilter.FunctionInfo {
+	return &vfilter.FunctionInfo{
+		Name:    "sigma_rule_set",
+		Doc:     "Stores a Sigma rule on the server, replacing any rule with the same id.",
+		ArgType: type_map.AddType(scope, &SigmaRuleSetFunctionArgs{}),
+	}
+}
+
+type SigmaRuleDeleteFunctionArgs struct {
+	Id string `vfilter:"required,field=id,doc=The id of the rule to delete."`
+}
+
+type SigmaRuleDeleteFunction struct{}
+
+func (self *SigmaRuleDeleteFunction) Call(ctx context.Context,
+	scope vfilter.Scope,
+	args *ordereddict.Dict) vfilter.Any {
+
+	err := vql_subsystem.CheckAccess(scope, acls.SERVER_ARTIFACT_WRITER)
+	if err != nil {
+		scope.Log("sigma_rule_delete: %v", err)
+		return vfilter.Null{}
+	}
+
+	arg := &SigmaRuleDeleteFunctionArgs{}
+	err = arg_parser.ExtractArgsWithContext(ctx, scope, args, arg)
+	if err != nil {
+		scope.Log("sigma_rule_delete: %v", err)
+		return vfilter.Null{}
+	}
+
+	config_obj, ok := vql_subsystem.GetServerConfig(scope)
+	if !ok {
+		scope.Log("sigma_rule_delete: Command can only run on the server")
+		return vfilter.Null{}
+	}
+
+	service, err := services.GetSigmaService(config_obj)
+	if err != nil {
+		scope.Log("sigma_rule_delete: %v", err)
+		return vfilter.Null{}
+	}
+
+	err = service.DeleteRule(ctx, arg.Id)
+	if err != nil {
+		scope.Log("sigma_rule_delete: %v", err)
+		return vfilter.Null{}
+	}
+
+	return arg.Id
+}
+
+func (self SigmaRuleDeleteFunction) Info(
+	scope vfilter.Scope, type_map *vfilter.TypeMap) *vfilter.FunctionInfo {
+	return &vfilter.FunctionInfo{
+		Name:    "sigma_rule_delete",
+		Doc:     "Deletes a Sigma rule from the server.",
+		ArgType: type_map.AddType(scope, &SigmaRuleDeleteFunctionArgs{}),
+	}
+}
+
+type SigmaRulesPlugin struct{}
+
+func (self SigmaRulesPlugin) Call(
+	ctx context.Context,
+	scope vfilter.Scope,
+	args *ordereddict.Dict) <-chan vfilter.Row {
+	output_chan := make(chan vfilter.Row)
+
+	go func() {
+		defer close(output_chan)
+
+		err := vql_subsystem.CheckAccess(scope, acls.READ_RESULTS)
+		if err != nil {
+			scope.Log("sigma_rules: %v", err)
+			return
+		}
+
+		config_obj, ok := vql_subsystem.GetServerConfig(scope)
+		if !ok {
+			scope.Log("sigma_rules: Command can only run on the server")
+			return
+		}
+
+		service, err := services.GetSigmaService(config_obj)
+		if err != nil {
+			scope.Log("sigma_rules: %v", err)
+			return
+		}
+
+		for _, rule := range service.ListRules(ctx) {
+			select {
+			case <-ctx.Done():
+				return
+			case output_chan <- json.ConvertProtoToOrderedDict(rule):
+			}
+		}
+	}()
+
+	return output_chan
+}
+
+func (self SigmaRulesPlugin) Info(
+	scope vfilter.Scope, type_map *vfilter.TypeMap) *vfilter.PluginInfo {
+	return &vfilter.PluginInfo{
+		Name: "sigma_rules",
+		Doc:  "Lists the Sigma rules stored on the server.",
+	}
+}
+
+func init() {
+	vql_subsystem.RegisterFunction(&SigmaRuleSetFunction{})
+	vql_subsystem.RegisterFunction(&SigmaRuleDeleteFunction{})
+	vql_subsystem.RegisterPlugin(&SigmaRulesPlugin{})
+}
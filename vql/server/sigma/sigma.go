@@ -0,0 +1,146 @@
+package sigma
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Velocidex/ordereddict"
+	"www.velocidex.com/golang/velociraptor/services"
+	sigma_service "www.velocidex.com/golang/velociraptor/services/sigma"
+	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
+	vfilter "www.velocidex.com/golang/vfilter"
+	"www.velocidex.com/golang/vfilter/arg_parser"
+)
+
+type _SigmaPluginArgs struct {
+	Query        vfilter.StoredQuery `vfilter:"required,field=query,doc=Source for rows to match."`
+	Rules        []string            `vfilter:"optional,field=rules,doc=Sigma rules in YAML format. Defaults to the rules stored on the server."`
+	FieldMapping *ordereddict.Dict   `vfilter:"optional,field=field_mapping,doc=A dict mapping Sigma field names to columns in the rows."`
+	Product      string              `vfilter:"optional,field=product,doc=Only apply rules for this logsource product."`
+	Category     string              `vfilter:"optional,field=category,doc=Only apply rules for this logsource category."`
+	Service      string              `vfilter:"optional,field=service,doc=Only apply rules for this logsource service."`
+}
+
+type _SigmaPlugin struct{}
+
+func (self _SigmaPlugin) Call(ctx context.Context,
+	scope vfilter.Scope,
+	args *ordereddict.Dict) <-chan vfilter.Row {
+	output_chan := make(chan vfilter.Row)
+
+	go func() {
+		defer close(output_chan)
+
+		arg := _SigmaPluginArgs{}
+		err := arg_parser.ExtractArgsWithContext(ctx, scope, args, &arg)
+		if err != nil {
+			scope.Log("sigma: %v", err)
+			return
+		}
+
+		rules, err := getRules(ctx, scope, &arg)
+		if err != nil {
+			scope.Log("sigma: %v", err)
+			return
+		}
+
+		mapping := getFieldMapping(scope, &arg)
+
+		for row := range arg.Query.Eval(ctx, scope) {
+			for _, rule := range rules {
+				if !rule.Match(ctx, scope, row, mapping) {
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case output_chan <- rule.Detection().Set("Event", row):
+				}
+			}
+		}
+	}()
+
+	return output_chan
+}
+
+// Compile the rules given in the args or the rules stored on the
+// server. Only rules for the requested logsource are used.
+func getRules(ctx context.Context, scope vfilter.Scope,
+	arg *_SigmaPluginArgs) ([]*sigma_service.Rule, error) {
+	texts := arg.Rules
+	if len(texts) == 0 {
+		config_obj, ok := vql_subsystem.GetServerConfig(scope)
+		if !ok {
+			return nil, fmt.Errorf("No rules specified")
+		}
+
+		service, err := services.GetSigmaService(config_obj)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rule := range service.ListRules(ctx) {
+			texts = append(texts, rule.Rule)
+		}
+	}
+
+	result := []*sigma_service.Rule{}
+	for _, text := range texts {
+		rules, err := sigma_service.CompileRules(text)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rule := range rules {
+			if (arg.Product == "" && arg.Category == "" && arg.Service == "") ||
+				rule.MatchesLogSource(arg.Product, arg.Category, arg.Service) {
+				result = append(result, rule)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// Use the field mapping from the args, or from the configured log
+// source on the server.
+func getFieldMapping(scope vfilter.Scope,
+	arg *_SigmaPluginArgs) map[string]string {
+	result := make(map[string]string)
+
+	if arg.FieldMapping != nil {
+		for _, k := range arg.FieldMapping.Keys() {
+			v, _ := arg.FieldMapping.GetString(k)
+			result[k] = v
+		}
+		return result
+	}
+
+	config_obj, ok := vql_subsystem.GetServerConfig(scope)
+	if !ok {
+		return result
+	}
+
+	log_source := sigma_service.FindLogSource(config_obj,
+		arg.Product, arg.Category, arg.Service)
+	if log_source != nil {
+		return sigma_service.GetFieldMapping(log_source)
+	}
+
+	return result
+}
+
+func (self _SigmaPlugin) Info(
+	scope vfilter.Scope,
+	type_map *vfilter.TypeMap) *vfilter.PluginInfo {
+	return &vfilter.PluginInfo{
+		Name:    "sigma",
+		Doc:     "Match rows against Sigma rules.",
+		ArgType: type_map.AddType(scope, &_SigmaPluginArgs{}),
+	}
+}
+
+func init() {
+	vql_subsystem.RegisterPlugin(&_SigmaPlugin{})
+}
@@ -0,0 +1,126 @@
+package sigma
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Velocidex/ordereddict"
+	"github.com/alecthomas/assert"
+	"github.com/sebdah/goldie"
+	"github.com/stretchr/testify/suite"
+	"www.velocidex.com/golang/velociraptor/file_store/test_utils"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/vql/acl_managers"
+	"www.velocidex.com/golang/vfilter"
+
+	_ "www.velocidex.com/golang/velociraptor/vql/common"
+)
+
+var (
+	stored_rule = `
+title: Stored Rule
+id: stored
+level: low
+logsource:
+  product: linux
+detection:
+  selection:
+    Name|startswith: 'ssh'
+  condition: selection
+`
+)
+
+type SigmaPluginTestSuite struct {
+	test_utils.TestSuite
+}
+
+func (self *SigmaPluginTestSuite) SetupTest() {
+	self.ConfigObj = self.LoadConfig()
+	self.ConfigObj.Services.SigmaService = true
+
+	self.TestSuite.SetupTest()
+}
+
+func (self *SigmaPluginTestSuite) runQuery(query string) []vfilter.Row {
+	builder := services.ScopeBuilder{
+		Config:     self.ConfigObj,
+		ACLManager: acl_managers.NullACLManager{},
+		Env: ordereddict.NewDict().
+			Set("StoredRule", stored_rule),
+	}
+
+	manager, err := services.GetRepositoryManager(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	scope := manager.BuildScope(builder)
+	defer scope.Close()
+
+	sub_ctx, cancel := context.WithTimeout(self.Ctx, 5*time.Second)
+	defer cancel()
+
+	multi_vql, err := vfilter.MultiParse(query)
+	assert.NoError(self.T(), err)
+
+	result := []vfilter.Row{}
+	for _, vql := range multi_vql {
+		for row := range vql.Eval(sub_ctx, scope) {
+			result = append(result, row)
+		}
+	}
+	return result
+}
+
+func (self *SigmaPluginTestSuite) TestSigmaPlugin() {
+	golden := ordereddict.NewDict()
+
+	// Rules given inline with a field mapping.
+	golden.Set("Inline", self.runQuery(`
+LET Rule = '''
+title: Inline Rule
+id: inline
+detection:
+  selection:
+    Command|contains: passwd
+  condition: selection
+'''
+SELECT * FROM sigma(rules=Rule,
+  field_mapping=dict(Command="CommandLine"),
+  query={
+    SELECT * FROM foreach(row=[
+      dict(CommandLine="cat /etc/passwd"),
+      dict(CommandLine="ls /tmp")])
+  })
+`))
+
+	// Rules are stored on the server and used by default.
+	golden.Set("Set", self.runQuery(`
+SELECT sigma_rule_set(rule=StoredRule).id AS Id FROM scope()`))
+
+	golden.Set("List", self.runQuery(`
+SELECT id, title, level FROM sigma_rules()`))
+
+	golden.Set("Stored", self.runQuery(`
+SELECT RuleId, Event FROM sigma(product="linux", query={
+  SELECT * FROM foreach(row=[dict(Name="sshd"), dict(Name="bash")])
+})`))
+
+	// Rules for other log sources are not applied.
+	golden.Set("OtherLogSource", self.runQuery(`
+SELECT RuleId FROM sigma(product="windows", query={
+  SELECT * FROM foreach(row=[dict(Name="sshd")])
+})`))
+
+	golden.Set("Delete", self.runQuery(`
+SELECT sigma_rule_delete(id="stored") AS Deleted FROM scope()`))
+
+	golden.Set("ListAfterDelete", self.runQuery(`
+SELECT id FROM sigma_rules()`))
+
+	goldie.Assert(self.T(), "TestSigmaPlugin", json.MustMarshalIndent(golden))
+}
+
+func TestSigmaPlugins(t *testing.T) {
+	suite.Run(t, &SigmaPluginTestSuite{})
+}
@@ -0,0 +1,272 @@
+package syslog
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Velocidex/ordereddict"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/utils"
+)
+
+const (
+	FORMAT_RFC5424 = "rfc5424"
+	FORMAT_CEF     = "cef"
+	FORMAT_LEEF    = "leef"
+
+	NILVALUE = "-"
+)
+
+var (
+	facilities = map[string]int{
+		"kern": 0, "user": 1, "mail": 2, "daemon": 3,
+		"auth": 4, "syslog": 5, "lpr": 6, "news": 7,
+		"uucp": 8, "cron": 9, "authpriv": 10, "ftp": 11,
+		"local0": 16, "local1": 17, "local2": 18, "local3": 19,
+		"local4": 20, "local5": 21, "local6": 22, "local7": 23,
+	}
+
+	severities = map[string]int{
+		"emerg": 0, "emergency": 0, "alert": 1, "crit": 2, "critical": 2,
+		"err": 3, "error": 3, "warning": 4, "warn": 4, "notice": 5,
+		"info": 6, "informational": 6, "debug": 7,
+	}
+
+	// CEF and LEEF severities are 0-10 with 10 the most severe.
+	siemSeverity = []int{10, 9, 8, 7, 5, 3, 1, 0}
+
+	placeholder_re = regexp.MustCompile(`\{([^{}]+)\}`)
+
+	// RFC5424 SD-NAMEs are printable ASCII except '=', ' ', ']' and '"'
+	sd_name_re = regexp.MustCompile(`[^!#-<>-\\^-~]`)
+
+	// CEF and LEEF keys should be alphanumeric.
+	ext_key_re = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+	cef_header_escaper = strings.NewReplacer(`\`, `\\`, `|`, `\|`)
+	cef_value_escaper  = strings.NewReplacer(
+		`\`, `\\`, `=`, `\=`, "\n", `\n`, "\r", `\r`)
+	leef_value_escaper = strings.NewReplacer("\t", " ", "\n", " ", "\r", " ")
+	sd_value_escaper   = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+)
+
+func parseFacility(name string) (int, error) {
+	if name == "" {
+		return facilities["user"], nil
+	}
+
+	facility, pres := facilities[strings.ToLower(name)]
+	if pres {
+		return facility, nil
+	}
+
+	facility, err := strconv.Atoi(name)
+	if err != nil || facility < 0 || facility > 23 {
+		return 0, fmt.Errorf("Invalid facility %v", name)
+	}
+	return facility, nil
+}
+
+// Severity may be a name or a number. Unknown severities are info.
+func parseSeverity(value interface{}) int {
+	switch t := value.(type) {
+	case string:
+		severity, pres := severities[strings.ToLower(t)]
+		if pres {
+			return severity
+		}
+		number, err := strconv.Atoi(t)
+		if err == nil {
+			return parseSeverity(number)
+		}
+
+	default:
+		number, ok := utils.ToInt64(value)
+		if ok && number >= 0 && number <= 7 {
+			return int(number)
+		}
+	}
+	return severities["info"]
+}
+
+type formatter struct {
+	format   string
+	hostname string
+	app_name string
+	msg_id   string
+	facility int
+
+	// RFC5424 structured data
+	sd_id     string
+	sd_fields []string
+
+	// CEF and LEEF headers
+	vendor      string
+	product     string
+	version     string
+	event_class string
+	event_name  string
+
+	opts *json.EncOpts
+}
+
+// Format a row as an RFC5424 syslog message. CEF and LEEF events
+// are carried as the message part.
+func (self *formatter) Format(row *ordereddict.Dict,
+	timestamp time.Time, severity int) ([]byte, error) {
+	var structured_data, message string
+
+	switch self.format {
+	case FORMAT_CEF:
+		structured_data = NILVALUE
+		message = self.formatCEF(row, timestamp, severity)
+
+	case FORMAT_LEEF:
+		structured_data = NILVALUE
+		message = self.formatLEEF(row, timestamp, severity)
+
+	default:
+		structured_data = self.structuredData(row)
+		data, err := json.MarshalWithOptions(row, self.opts)
+		if err != nil {
+			return nil, err
+		}
+		message = string(data)
+	}
+
+	return []byte(fmt.Sprintf("<%d>1 %s %s %s %s %s %s %s",
+		self.facility*8+severity,
+		timestamp.UTC().Format("2006-01-02T15:04:05.000000Z07:00"),
+		header_field(self.hostname, 255),
+		header_field(self.app_name, 48),
+		NILVALUE, // PROCID
+		header_field(expand(self.msg_id, row), 32),
+		structured_data, message)), nil
+}
+
+func (self *formatter) structuredData(row *ordereddict.Dict) string {
+	if len(self.sd_fields) == 0 {
+		return NILVALUE
+	}
+
+	result := "[" + sd_name(self.sd_id)
+	for _, field := range self.sd_fields {
+		value, pres := row.Get(field)
+		if !pres {
+			continue
+		}
+		result += fmt.Sprintf(` %s="%s"`, sd_name(field),
+			sd_value_escaper.Replace(self.toString(value)))
+	}
+	return result + "]"
+}
+
+// CEF:Version|Device Vendor|Device Product|Device Version|Signature ID|Name|Severity|Extension
+func (self *formatter) formatCEF(row *ordereddict.Dict,
+	timestamp time.Time, severity int) string {
+	var extension []string
+	extension = append(extension, fmt.Sprintf("rt=%d",
+		timestamp.UnixNano()/1000000))
+
+	for _, key := range row.Keys() {
+		value, _ := row.Get(key)
+		extension = append(extension, ext_key_re.ReplaceAllString(key, "_")+
+			"="+cef_value_escaper.Replace(self.toString(value)))
+	}
+
+	return fmt.Sprintf("CEF:0|%s|%s|%s|%s|%s|%d|%s",
+		cef_header_escaper.Replace(self.vendor),
+		cef_header_escaper.Replace(self.product),
+		cef_header_escaper.Replace(self.version),
+		cef_header_escaper.Replace(expand(self.event_class, row)),
+		cef_header_escaper.Replace(expand(self.event_name, row)),
+		siemSeverity[severity], strings.Join(extension, " "))
+}
+
+// LEEF:Version|Vendor|Product|Version|EventID|Attributes
+func (self *formatter) formatLEEF(row *ordereddict.Dict,
+	timestamp time.Time, severity int) string {
+	attributes := []string{
+		"devTime=" + timestamp.UTC().Format("2006-01-02T15:04:05.000Z07:00"),
+		"devTimeFormat=yyyy-MM-dd'T'HH:mm:ss.SSSX",
+		fmt.Sprintf("sev=%d", siemSeverity[severity]),
+	}
+
+	for _, key := range row.Keys() {
+		value, _ := row.Get(key)
+		attributes = append(attributes, ext_key_re.ReplaceAllString(key, "_")+
+			"="+leef_value_escaper.Replace(self.toString(value)))
+	}
+
+	return fmt.Sprintf("LEEF:1.0|%s|%s|%s|%s|%s",
+		leef_header(self.vendor), leef_header(self.product),
+		leef_header(self.version),
+		leef_header(expand(self.event_class, row)),
+		strings.Join(attributes, "\t"))
+}
+
+// Strings are sent as is, anything else is JSON encoded.
+func (self *formatter) toString(value interface{}) string {
+	if utils.IsNil(value) {
+		return ""
+	}
+
+	switch t := value.(type) {
+	case string:
+		return t
+	case []byte:
+		return string(t)
+	}
+
+	data, err := json.MarshalWithOptions(value, self.opts)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
+
+// Expand {Column} placeholders from the row.
+func expand(template string, row *ordereddict.Dict) string {
+	return placeholder_re.ReplaceAllStringFunc(template,
+		func(match string) string {
+			value, _ := row.Get(match[1 : len(match)-1])
+			if utils.IsNil(value) {
+				return ""
+			}
+			return utils.ToString(value)
+		})
+}
+
+// RFC5424 header fields are printable ASCII without spaces.
+func header_field(value string, max_length int) string {
+	result := strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return '_'
+		}
+		return r
+	}, value)
+
+	if result == "" {
+		return NILVALUE
+	}
+
+	if len(result) > max_length {
+		result = result[:max_length]
+	}
+	return result
+}
+
+func sd_name(name string) string {
+	name = sd_name_re.ReplaceAllString(name, "_")
+	if len(name) > 32 {
+		name = name[:32]
+	}
+	return name
+}
+
+func leef_header(value string) string {
+	return strings.ReplaceAll(value, "|", "_")
+}
@@ -0,0 +1,383 @@
+package syslog
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Velocidex/ordereddict"
+	"www.velocidex.com/golang/velociraptor/acls"
+	"www.velocidex.com/golang/velociraptor/artifacts"
+	"www.velocidex.com/golang/velociraptor/constants"
+	"www.velocidex.com/golang/velociraptor/crypto"
+	"www.velocidex.com/golang/velociraptor/utils"
+	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
+	"www.velocidex.com/golang/velociraptor/vql/functions"
+	vfilter "www.velocidex.com/golang/vfilter"
+	"www.velocidex.com/golang/vfilter/arg_parser"
+)
+
+type _SyslogPluginArgs struct {
+	Query          vfilter.StoredQuery `vfilter:"required,field=query,doc=Source for rows to send."`
+	Address        string              `vfilter:"required,field=address,doc=The syslog collector to send to (host:port)."`
+	Protocol       string              `vfilter:"optional,field=protocol,doc=One of udp (default), tcp or tls."`
+	Framing        string              `vfilter:"optional,field=framing,doc=Framing for tcp and tls: octet-counting (default) or newline."`
+	Format         string              `vfilter:"optional,field=format,doc=Message format: rfc5424 (default), cef or leef."`
+	Facility       string              `vfilter:"optional,field=facility,doc=Syslog facility name or number (default user)."`
+	Severity       string              `vfilter:"optional,field=severity,doc=Syslog severity name or number (default info)."`
+	SeverityField  string              `vfilter:"optional,field=severity_field,doc=Field holding the severity of each row. Overrides severity."`
+	TimestampField string              `vfilter:"optional,field=timestamp_field,doc=Field to use as the event timestamp (default the current time)."`
+	Hostname       string              `vfilter:"optional,field=hostname,doc=Hostname for the syslog header. Defaults to the server hostname."`
+	AppName        string              `vfilter:"optional,field=app_name,doc=Application name for the syslog header (default velociraptor)."`
+	MsgID          string              `vfilter:"optional,field=msg_id,doc=Message id for the syslog header. May contain {Column} placeholders."`
+	SDID           string              `vfilter:"optional,field=sd_id,doc=The RFC5424 structured data id (default velociraptor@32473)."`
+	SDFields       []string            `vfilter:"optional,field=sd_fields,doc=Fields to include as RFC5424 structured data."`
+	Vendor         string              `vfilter:"optional,field=vendor,doc=CEF/LEEF device vendor (default Velocidex)."`
+	Product        string              `vfilter:"optional,field=product,doc=CEF/LEEF device product (default Velociraptor)."`
+	EventClass     string              `vfilter:"optional,field=event_class,doc=CEF signature id or LEEF event id. May contain {Column} placeholders (default velociraptor)."`
+	EventName      string              `vfilter:"optional,field=event_name,doc=CEF event name. May contain {Column} placeholders (default the event class)."`
+	SkipVerify     bool                `vfilter:"optional,field=skip_verify,doc=Skip TLS verification (default: False)."`
+	RootCerts      string              `vfilter:"optional,field=root_ca,doc=As a better alternative to skip_verify, allows root ca certs to be added here."`
+	WaitTime       int64               `vfilter:"optional,field=wait_time,doc=Report how many messages were sent this often (default 10 sec)."`
+}
+
+type _SyslogPlugin struct{}
+
+func (self _SyslogPlugin) Call(ctx context.Context,
+	scope vfilter.Scope,
+	args *ordereddict.Dict) <-chan vfilter.Row {
+	output_chan := make(chan vfilter.Row)
+
+	go func() {
+		defer close(output_chan)
+
+		err := vql_subsystem.CheckAccess(scope, acls.COLLECT_SERVER)
+		if err != nil {
+			scope.Log("syslog_upload: %v", err)
+			return
+		}
+
+		arg := _SyslogPluginArgs{}
+		err = arg_parser.ExtractArgsWithContext(ctx, scope, args, &arg)
+		if err != nil {
+			scope.Log("syslog_upload: %v", err)
+			return
+		}
+
+		formatter, err := newFormatter(scope, &arg)
+		if err != nil {
+			scope.Log("syslog_upload: %v", err)
+			return
+		}
+
+		sender, err := newSender(scope, &arg)
+		if err != nil {
+			scope.Log("syslog_upload: %v", err)
+			return
+		}
+		defer sender.Close()
+
+		if arg.WaitTime == 0 {
+			arg.WaitTime = 10
+		}
+
+		send_rows(ctx, scope, output_chan, formatter, sender,
+			arg.Query.Eval(ctx, scope), &arg)
+	}()
+
+	return output_chan
+}
+
+func newFormatter(scope vfilter.Scope,
+	arg *_SyslogPluginArgs) (*formatter, error) {
+	format := strings.ToLower(arg.Format)
+	switch format {
+	case "":
+		format = FORMAT_RFC5424
+	case FORMAT_RFC5424, FORMAT_CEF, FORMAT_LEEF:
+	default:
+		return nil, fmt.Errorf("Unsupported format %v (should be rfc5424, cef or leef)",
+			arg.Format)
+	}
+
+	facility, err := parseFacility(arg.Facility)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &formatter{
+		format:      format,
+		hostname:    arg.Hostname,
+		app_name:    arg.AppName,
+		msg_id:      arg.MsgID,
+		facility:    facility,
+		sd_id:       arg.SDID,
+		sd_fields:   arg.SDFields,
+		vendor:      arg.Vendor,
+		product:     arg.Product,
+		version:     constants.VERSION,
+		event_class: arg.EventClass,
+		event_name:  arg.EventName,
+		opts:        vql_subsystem.EncOptsFromScope(scope),
+	}
+
+	if result.hostname == "" {
+		result.hostname, _ = os.Hostname()
+	}
+
+	if result.app_name == "" {
+		result.app_name = "velociraptor"
+	}
+
+	if result.sd_id == "" {
+		result.sd_id = "velociraptor@32473"
+	}
+
+	if result.vendor == "" {
+		result.vendor = "Velocidex"
+	}
+
+	if result.product == "" {
+		result.product = "Velociraptor"
+	}
+
+	if result.event_class == "" {
+		result.event_class = "velociraptor"
+	}
+
+	if result.event_name == "" {
+		result.event_name = result.event_class
+	}
+
+	return result, nil
+}
+
+// Send each row as it arrives, periodically reporting how many
+// messages were sent and any errors.
+func send_rows(
+	ctx context.Context,
+	scope vfilter.Scope,
+	output_chan chan vfilter.Row,
+	formatter *formatter,
+	sender *sender,
+	row_chan <-chan vfilter.Row,
+	arg *_SyslogPluginArgs) {
+
+	var sent, errors int
+	var last_error error
+
+	default_severity := parseSeverity(arg.Severity)
+	wait_time := time.Duration(arg.WaitTime) * time.Second
+	next_report_time := time.After(wait_time)
+
+	report := func() {
+		if sent > 0 || errors > 0 {
+			var error_message interface{}
+			if last_error != nil {
+				error_message = last_error.Error()
+			}
+
+			select {
+			case <-ctx.Done():
+			case output_chan <- ordereddict.NewDict().
+				Set("Sent", sent).
+				Set("Errors", errors).
+				Set("LastError", error_message):
+			}
+		}
+		sent = 0
+		errors = 0
+		last_error = nil
+		next_report_time = time.After(wait_time)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case row, ok := <-row_chan:
+			if !ok {
+				report()
+				return
+			}
+
+			row_dict := vfilter.RowToDict(ctx, scope, row)
+
+			severity := default_severity
+			if arg.SeverityField != "" {
+				value, pres := row_dict.Get(arg.SeverityField)
+				if pres {
+					severity = parseSeverity(value)
+				}
+			}
+
+			timestamp := utils.GetTime().Now()
+			if arg.TimestampField != "" {
+				value, pres := row_dict.Get(arg.TimestampField)
+				if pres {
+					ts, err := functions.TimeFromAny(scope, value)
+					if err == nil {
+						timestamp = ts
+					}
+				}
+			}
+
+			message, err := formatter.Format(row_dict, timestamp, severity)
+			if err == nil {
+				err = sender.Send(message)
+			}
+
+			if err != nil {
+				errors++
+				last_error = err
+				continue
+			}
+			sent++
+
+		case <-next_report_time:
+			report()
+		}
+	}
+}
+
+type sender struct {
+	protocol   string
+	address    string
+	framing    string
+	tls_config *tls.Config
+	conn       net.Conn
+}
+
+func newSender(scope vfilter.Scope, arg *_SyslogPluginArgs) (*sender, error) {
+	result := &sender{
+		protocol: strings.ToLower(arg.Protocol),
+		address:  arg.Address,
+		framing:  strings.ToLower(arg.Framing),
+	}
+
+	switch result.protocol {
+	case "":
+		result.protocol = "udp"
+	case "udp", "tcp":
+	case "tls":
+		CA_Pool := x509.NewCertPool()
+		crypto.AddPublicRoots(CA_Pool)
+
+		config_obj, ok := artifacts.GetConfig(scope)
+		if ok {
+			err := crypto.AddDefaultCerts(config_obj, CA_Pool)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if arg.RootCerts != "" &&
+			!CA_Pool.AppendCertsFromPEM([]byte(arg.RootCerts)) {
+			return nil, fmt.Errorf("Unable to add root certs")
+		}
+
+		result.tls_config = &tls.Config{
+			RootCAs:            CA_Pool,
+			InsecureSkipVerify: arg.SkipVerify,
+		}
+	default:
+		return nil, fmt.Errorf("Unsupported protocol %v (should be udp, tcp or tls)",
+			arg.Protocol)
+	}
+
+	switch result.framing {
+	case "":
+		result.framing = "octet-counting"
+	case "octet-counting", "newline":
+	default:
+		return nil, fmt.Errorf("Unsupported framing %v (should be octet-counting or newline)",
+			arg.Framing)
+	}
+
+	return result, nil
+}
+
+func (self *sender) connect() error {
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+
+	var err error
+	switch self.protocol {
+	case "tls":
+		self.conn, err = tls.DialWithDialer(
+			dialer, "tcp", self.address, self.tls_config)
+	case "tcp":
+		self.conn, err = dialer.Dial("tcp", self.address)
+	default:
+		self.conn, err = dialer.Dial("udp", self.address)
+	}
+	return err
+}
+
+// Each UDP datagram carries a single message. Stream transports are
+// framed as described in RFC 6587.
+func (self *sender) frame(message []byte) []byte {
+	if self.protocol == "udp" {
+		return message
+	}
+
+	if self.framing == "newline" {
+		return append(message, '\n')
+	}
+
+	return append([]byte(fmt.Sprintf("%d ", len(message))), message...)
+}
+
+// Send a message reconnecting once if the connection was dropped.
+func (self *sender) Send(message []byte) error {
+	data := self.frame(message)
+
+	var err error
+	for attempt := 0; attempt < 2; attempt++ {
+		if self.conn == nil {
+			err = self.connect()
+			if err != nil {
+				self.conn = nil
+				continue
+			}
+		}
+
+		err = self.conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+		if err == nil {
+			_, err = self.conn.Write(data)
+		}
+		if err == nil {
+			return nil
+		}
+
+		self.Close()
+	}
+
+	return err
+}
+
+func (self *sender) Close() {
+	if self.conn != nil {
+		self.conn.Close()
+		self.conn = nil
+	}
+}
+
+func (self _SyslogPlugin) Info(
+	scope vfilter.Scope,
+	type_map *vfilter.TypeMap) *vfilter.PluginInfo {
+	return &vfilter.PluginInfo{
+		Name:    "syslog_upload",
+		Doc:     "Send rows to a syslog collector as RFC5424, CEF or LEEF messages.",
+		ArgType: type_map.AddType(scope, &_SyslogPluginArgs{}),
+	}
+}
+
+func init() {
+	vql_subsystem.RegisterPlugin(&_SyslogPlugin{})
+}
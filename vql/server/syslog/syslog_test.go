@@ -0,0 +1,184 @@
+package syslog
+
+import (
+	"bufio"
+	"crypto/tls"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Velocidex/ordereddict"
+	"github.com/alecthomas/assert"
+	"github.com/sebdah/goldie"
+	"github.com/stretchr/testify/suite"
+	"www.velocidex.com/golang/velociraptor/constants"
+	"www.velocidex.com/golang/velociraptor/crypto"
+	"www.velocidex.com/golang/velociraptor/file_store/test_utils"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/utils"
+)
+
+type SyslogTestSuite struct {
+	test_utils.TestSuite
+}
+
+func (self *SyslogTestSuite) send(query string, address string) []*ordereddict.Dict {
+	rows, err := test_utils.RunQuery(self.ConfigObj, query,
+		ordereddict.NewDict().
+			Set("Address", address).
+			Set("Rows", []*ordereddict.Dict{
+				ordereddict.NewDict().
+					Set("Artifact", "Server.Monitor.Test").
+					Set("Level", "warning").
+					Set("Message", "Hello | world = \"quoted\"]").
+					Set("Data", ordereddict.NewDict().Set("Count", 1)),
+				ordereddict.NewDict().
+					Set("Artifact", "Server.Monitor.Test").
+					Set("Level", 2).
+					Set("Message", "Line 1\nLine 2").
+					Set("Data", nil),
+			}))
+	assert.NoError(self.T(), err)
+	return rows
+}
+
+// Read octet counted or newline framed messages from the first
+// connection.
+func (self *SyslogTestSuite) listen(listener net.Listener, octet_counting bool) chan []string {
+	result := make(chan []string)
+
+	go func() {
+		var messages []string
+		defer func() {
+			result <- messages
+		}()
+
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		for {
+			if !octet_counting {
+				line, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				messages = append(messages, strings.TrimSuffix(line, "\n"))
+				continue
+			}
+
+			length_str, err := reader.ReadString(' ')
+			if err != nil {
+				return
+			}
+			length, err := strconv.Atoi(strings.TrimSpace(length_str))
+			assert.NoError(self.T(), err)
+
+			message := make([]byte, length)
+			_, err = io.ReadFull(reader, message)
+			assert.NoError(self.T(), err)
+			messages = append(messages, string(message))
+		}
+	}()
+
+	return result
+}
+
+func (self *SyslogTestSuite) TestSyslogUpload() {
+	closer := utils.MockTime(&utils.MockClock{MockNow: time.Unix(1672531200, 0)})
+	defer closer()
+
+	golden := ordereddict.NewDict()
+
+	// RFC5424 over UDP
+	udp, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.NoError(self.T(), err)
+	defer udp.Close()
+
+	rows := self.send(`
+SELECT * FROM syslog_upload(query={ SELECT * FROM foreach(row=Rows) },
+   address=Address, hostname="test-host", msg_id="{Artifact}",
+   severity_field="Level", facility="local0",
+   sd_fields=["Artifact", "Message"])`, udp.LocalAddr().String())
+	golden.Set("Result", rows)
+
+	var messages []string
+	buf := make([]byte, 65536)
+	for len(messages) < 2 {
+		_ = udp.SetReadDeadline(time.Now().Add(5 * time.Second))
+		n, _, err := udp.ReadFrom(buf)
+		assert.NoError(self.T(), err)
+		messages = append(messages, string(buf[:n]))
+	}
+	golden.Set("RFC5424", messages)
+
+	// CEF over TCP with octet counting
+	tcp, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(self.T(), err)
+	defer tcp.Close()
+
+	result := self.listen(tcp, true)
+	self.send(`
+SELECT * FROM syslog_upload(query={ SELECT * FROM foreach(row=Rows) },
+   address=Address, protocol="tcp", format="cef", hostname="test-host",
+   event_class="{Artifact}", event_name="Monitoring event",
+   severity_field="Level")`, tcp.Addr().String())
+	golden.Set("CEF", <-result)
+
+	// LEEF over TLS with newline framing
+	bundle, err := crypto.GenerateCACert(2048)
+	assert.NoError(self.T(), err)
+
+	cert, err := tls.X509KeyPair([]byte(bundle.Cert), []byte(bundle.PrivateKey))
+	assert.NoError(self.T(), err)
+
+	tls_listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{cert},
+	})
+	assert.NoError(self.T(), err)
+	defer tls_listener.Close()
+
+	result = self.listen(tls_listener, false)
+	self.send(`
+SELECT * FROM syslog_upload(query={ SELECT * FROM foreach(row=Rows) },
+   address=Address, protocol="tls", framing="newline", skip_verify=TRUE,
+   format="leef", hostname="test-host", event_class="{Artifact}")`,
+		tls_listener.Addr().String())
+	golden.Set("LEEF", <-result)
+
+	// The version changes with each release.
+	goldie.Assert(self.T(), "TestSyslogUpload", []byte(strings.ReplaceAll(
+		string(json.MustMarshalIndent(golden)), constants.VERSION, "VERSION")))
+}
+
+func TestSyslogErrors(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	address := listener.Addr().String()
+	listener.Close()
+
+	sender, err := newSender(nil, &_SyslogPluginArgs{
+		Address: address, Protocol: "tcp"})
+	assert.NoError(t, err)
+	assert.Error(t, sender.Send([]byte("hello")))
+
+	_, err = newSender(nil, &_SyslogPluginArgs{Protocol: "http"})
+	assert.Error(t, err)
+
+	_, err = parseFacility("local9")
+	assert.Error(t, err)
+
+	assert.Equal(t, 4, parseSeverity("WARNING"))
+	assert.Equal(t, 2, parseSeverity(int64(2)))
+	assert.Equal(t, 6, parseSeverity("unknown"))
+}
+
+func TestSyslog(t *testing.T) {
+	suite.Run(t, &SyslogTestSuite{})
+}
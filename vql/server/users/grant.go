@@ -2,6 +2,7 @@ package users
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/Velocidex/ordereddict"
 	acl_proto "www.velocidex.com/golang/velociraptor/acls/proto"
@@ -18,6 +19,7 @@ type GrantFunctionArgs struct {
 	Roles    []string          `vfilter:"optional,field=roles,doc=List of roles to give the user."`
 	OrgIds   []string          `vfilter:"optional,field=orgs,doc=One or more org IDs to grant access to. If not specified we use current org"`
 	Policy   *ordereddict.Dict `vfilter:"optional,field=policy,doc=A dict of permissions to set (e.g. as obtained from the gui_users() function)."`
+	Scopes   vfilter.Any       `vfilter:"optional,field=scopes,doc=One or more scoped grants (e.g. dict(permissions=['COLLECT_CLIENT'], artifacts=['Windows.Triage.*'], labels=['Helpdesk']) or dict(permissions=['READ_RESULTS'], own_only=TRUE))."`
 }
 
 type GrantFunction struct{}
@@ -59,12 +61,21 @@ func (self GrantFunction) Call(
 			scope.Log("user_grant: %s", err)
 			return vfilter.Null{}
 		}
-	} else if len(arg.Roles) == 0 {
-		scope.Log("user_grant: You must provide either roles or a policy object")
+	} else if len(arg.Roles) == 0 && utils.IsNil(arg.Scopes) {
+		scope.Log("user_grant: You must provide either roles, scopes or a policy object")
 		return vfilter.Null{}
 	}
 	policy.Roles = arg.Roles
 
+	if !utils.IsNil(arg.Scopes) {
+		scopes, err := parseScopes(arg.Scopes)
+		if err != nil {
+			scope.Log("user_grant: %s", err)
+			return vfilter.Null{}
+		}
+		policy.ScopedGrants = append(policy.ScopedGrants, scopes...)
+	}
+
 	principal := vql_subsystem.GetPrincipal(scope)
 	err = users.GrantUserToOrg(ctx, principal, arg.Username, orgs, policy)
 	if err != nil {
@@ -75,10 +86,30 @@ func (self GrantFunction) Call(
 	return arg.Username
 }
 
+// Scopes may be given as a single dict or a list of dicts.
+func parseScopes(scopes vfilter.Any) ([]*acl_proto.ScopedGrant, error) {
+	_, is_dict := scopes.(*ordereddict.Dict)
+	if is_dict {
+		scopes = []vfilter.Any{scopes}
+	}
+
+	serialized, err := json.Marshal(scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*acl_proto.ScopedGrant{}
+	err = json.Unmarshal(serialized, &result)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid scopes: %w", err)
+	}
+	return result, nil
+}
+
 func (self GrantFunction) Info(scope vfilter.Scope, type_map *vfilter.TypeMap) *vfilter.FunctionInfo {
 	return &vfilter.FunctionInfo{
 		Name:    "user_grant",
-		Doc:     "Grants the user the specified roles.",
+		Doc:     "Grants the user the specified roles and scoped permissions.",
 		ArgType: type_map.AddType(scope, &GrantFunctionArgs{}),
 	}
 }
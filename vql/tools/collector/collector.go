@@ -35,6 +35,7 @@ type CollectPluginArgs struct {
 	Report              string              `vfilter:"optional,field=report,doc=A path to write the report on (deprecated and ignored)."`
 	Args                vfilter.Any         `vfilter:"optional,field=args,doc=Optional parameters."`
 	Password            string              `vfilter:"optional,field=password,doc=An optional password to encrypt the collection zip."`
+	PublicKey           string              `vfilter:"optional,field=public_key,doc=An optional X509 certificate or RSA public key (PEM encoded). The collection zip is encrypted with a random password which is stored in metadata.json wrapped with this key."`
 	Format              string              `vfilter:"optional,field=format,doc=Output format (csv, jsonl, csv_only)."`
 	ArtifactDefinitions vfilter.Any         `vfilter:"optional,field=artifact_definitions,doc=Optional additional custom artifacts."`
 	Template            string              `vfilter:"optional,field=template,doc=The name of a template artifact (i.e. one which has report of type HTML)."`
@@ -140,9 +141,24 @@ func (self CollectPlugin) configureCollection(
 			manager.SetMetadata(arg.Metadata)
 		}
 
+		// Encrypt the container with a random password that only
+		// the holder of the private key can recover.
+		password := arg.Password
+		if arg.PublicKey != "" {
+			if password != "" {
+				return nil, errors.New(
+					"Only one of password or public_key may be specified")
+			}
+
+			password, err = manager.SetPublicKey(arg.PublicKey)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		// Build the container to receive the output from the
 		// queries. The container may be password protected.
-		err = manager.MakeContainer(arg.Output, arg.Password, arg.Level)
+		err = manager.MakeContainer(arg.Output, password, arg.Level)
 		if err != nil {
 			return nil, err
 		}
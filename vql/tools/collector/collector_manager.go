@@ -2,6 +2,9 @@ package collector
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
 	"log"
 	"sync"
 	"time"
@@ -16,6 +19,7 @@ import (
 	"www.velocidex.com/golang/velociraptor/config"
 	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
 	crypto_proto "www.velocidex.com/golang/velociraptor/crypto/proto"
+	crypto_utils "www.velocidex.com/golang/velociraptor/crypto/utils"
 	"www.velocidex.com/golang/velociraptor/file_store/path_specs"
 	"www.velocidex.com/golang/velociraptor/flows"
 	flows_proto "www.velocidex.com/golang/velociraptor/flows/proto"
@@ -179,6 +183,36 @@ func (self *collectionManager) SetMetadata(metadata vfilter.StoredQuery) {
 	self.metadata = types.Materialize(self.ctx, self.scope, metadata)
 }
 
+// Generate a random password for the container and record it in the
+// metadata wrapped with the public key. The collector accessor uses
+// the server's private key to recover it when reading the container.
+func (self *collectionManager) SetPublicKey(public_key string) (string, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	buf := make([]byte, 25)
+	_, err := rand.Read(buf)
+	if err != nil {
+		return "", err
+	}
+	password := hex.EncodeToString(buf)
+
+	encrypted, err := crypto_utils.EncryptWithPemPublicKey(
+		[]byte(password), []byte(public_key))
+	if err != nil {
+		return "", err
+	}
+
+	self.metadata = append(self.metadata, ordereddict.NewDict().
+		Set("EncryptedPass", base64.StdEncoding.EncodeToString(encrypted)).
+		Set("Scheme", "X509").
+		Set("PublicKey", public_key))
+
+	self.scope.Log("Container password will be encrypted with the public key")
+
+	return password, nil
+}
+
 func (self *collectionManager) SetFormat(
 	format reporting.ContainerFormat) error {
 	self.mu.Lock()
@@ -13,6 +13,7 @@ import (
 	"github.com/sebdah/goldie"
 	"github.com/stretchr/testify/suite"
 	config_proto "www.velocidex.com/golang/velociraptor/config/proto"
+	crypto_utils "www.velocidex.com/golang/velociraptor/crypto/utils"
 	"www.velocidex.com/golang/velociraptor/file_store"
 	"www.velocidex.com/golang/velociraptor/file_store/api"
 	"www.velocidex.com/golang/velociraptor/file_store/test_utils"
@@ -337,6 +338,94 @@ func (self *TestSuite) TestCollectionWithUpload() {
 		json.MustMarshalIndent(golden))
 }
 
+func (self *TestSuite) TestCollectionWithPublicKey() {
+	output_file, err := ioutil.TempFile(os.TempDir(), "zip")
+	assert.NoError(self.T(), err)
+	output_file.Close()
+	defer os.Remove(output_file.Name())
+
+	builder := services.ScopeBuilder{
+		Config:     self.ConfigObj,
+		ACLManager: acl_managers.NullACLManager{},
+		Logger:     logging.NewPlainLogger(self.ConfigObj, &logging.FrontendComponent),
+		Env:        ordereddict.NewDict(),
+	}
+
+	manager, err := services.GetRepositoryManager(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	scope := manager.BuildScope(builder)
+	defer scope.Close()
+
+	// A password and a public key are mutually exclusive.
+	results := []vfilter.Row{}
+	for row := range (CollectPlugin{}).Call(context.Background(),
+		scope, ordereddict.NewDict().
+			Set("artifacts", simpleCollectorArgs.Artifacts).
+			Set("output", output_file.Name()).
+			Set("password", "hunter2").
+			Set("public_key", self.ConfigObj.Frontend.Certificate)) {
+		results = append(results, row)
+	}
+	assert.Equal(self.T(), 0, len(results))
+
+	for row := range (CollectPlugin{}).Call(context.Background(),
+		scope, ordereddict.NewDict().
+			Set("artifacts", simpleCollectorArgs.Artifacts).
+			Set("output", output_file.Name()).
+			Set("public_key", self.ConfigObj.Frontend.Certificate)) {
+		results = append(results, row)
+	}
+	assert.Equal(self.T(), 1, len(results))
+
+	// The outer zip only contains the metadata and the encrypted
+	// data.zip.
+	r, err := zip.OpenReader(output_file.Name())
+	assert.NoError(self.T(), err)
+	defer r.Close()
+
+	names := []string{}
+	for _, f := range r.File {
+		names = append(names, f.Name)
+	}
+	assert.Equal(self.T(), []string{"metadata.json", "data.zip"}, names)
+
+	fd, err := r.File[0].Open()
+	assert.NoError(self.T(), err)
+	serialized, err := ioutil.ReadAll(fd)
+	assert.NoError(self.T(), err)
+
+	rows, err := utils.ParseJsonToDicts(serialized)
+	assert.NoError(self.T(), err)
+	assert.Equal(self.T(), 1, len(rows))
+
+	scheme, _ := rows[0].GetString("Scheme")
+	assert.Equal(self.T(), "X509", scheme)
+
+	// The password is wrapped with the server's public key.
+	encrypted_pass, _ := rows[0].GetString("EncryptedPass")
+	key, err := crypto_utils.ParseRsaPrivateKeyFromPemStr(
+		[]byte(self.ConfigObj.Frontend.PrivateKey))
+	assert.NoError(self.T(), err)
+
+	password, err := crypto_utils.Base64DecryptRSAOAEP(key, encrypted_pass)
+	assert.NoError(self.T(), err)
+	assert.Equal(self.T(), 50, len(password))
+
+	// The server can import the collection using its private key.
+	result := ImportCollectionFunction{}.Call(self.Ctx, scope,
+		ordereddict.NewDict().
+			Set("client_id", "auto").
+			Set("hostname", "EncryptedHost").
+			Set("filename", output_file.Name()))
+	context, ok := result.(*proto.ArtifactCollectorContext)
+	assert.True(self.T(), ok)
+
+	assert.Equal(self.T(), simpleCollectorArgs.Artifacts,
+		context.ArtifactsWithResults)
+	assert.Equal(self.T(), uint64(1), context.TotalCollectedRows)
+}
+
 func readImportedFile(ctx context.Context,
 	scope vfilter.Scope,
 	config_obj *config_proto.Config,
@@ -42,6 +42,7 @@ type ImportCollectionFunctionArgs struct {
 	ClientId string `vfilter:"required,field=client_id,doc=The client id to import to. Use 'auto' to generate a new client id."`
 	Hostname string `vfilter:"optional,field=hostname,doc=When creating a new client, set this as the hostname."`
 	Filename string `vfilter:"required,field=filename,doc=Path on server to the collector zip."`
+	Accessor string `vfilter:"optional,field=accessor,doc=The accessor to use to read the collector zip (default file)."`
 }
 
 type ImportCollectionFunction struct{}
@@ -77,6 +78,16 @@ func (self ImportCollectionFunction) Call(ctx context.Context,
 		return vfilter.Null{}
 	}
 
+	if arg.Accessor == "" {
+		arg.Accessor = "file"
+	}
+
+	err = vql_subsystem.CheckFilesystemAccess(scope, arg.Accessor)
+	if err != nil {
+		scope.Log("import_collection: %v", err)
+		return vfilter.Null{}
+	}
+
 	db, err := datastore.GetDB(config_obj)
 	if err != nil {
 		scope.Log("import_collection: %v", err)
@@ -97,7 +108,7 @@ func (self ImportCollectionFunction) Call(ctx context.Context,
 	}
 
 	root.SetPathSpec(&accessors.PathSpec{
-		DelegateAccessor: "file",
+		DelegateAccessor: arg.Accessor,
 		DelegatePath:     arg.Filename,
 	})
 
@@ -0,0 +1,635 @@
+/*
+  Upload a collection container in chunks.
+
+  Each chunk acknowledged by the target is recorded in a local state
+  file. If the collector is interrupted (e.g. the network goes down or
+  the machine is rebooted) running the upload again resumes from the
+  last acknowledged chunk instead of starting over.
+
+  Chunks may be sent to any upload function (e.g. upload_s3()) via a
+  lambda, or directly to the Velociraptor frontend which reassembles
+  the container and imports it as a new collection.
+*/
+
+package collector
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Velocidex/ordereddict"
+	"www.velocidex.com/golang/velociraptor/accessors"
+	"www.velocidex.com/golang/velociraptor/acls"
+	"www.velocidex.com/golang/velociraptor/artifacts"
+	"www.velocidex.com/golang/velociraptor/constants"
+	"www.velocidex.com/golang/velociraptor/crypto"
+	crypto_utils "www.velocidex.com/golang/velociraptor/crypto/utils"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/utils"
+	vql_subsystem "www.velocidex.com/golang/velociraptor/vql"
+	"www.velocidex.com/golang/velociraptor/vql/networking"
+	"www.velocidex.com/golang/vfilter"
+	"www.velocidex.com/golang/vfilter/arg_parser"
+	"www.velocidex.com/golang/vfilter/types"
+)
+
+const (
+	DEFAULT_CHUNK_SIZE  = 5 * 1024 * 1024
+	DEFAULT_MAX_RETRIES = 5
+	DEFAULT_RETRY_WAIT  = 10
+)
+
+var (
+	// The receiver could not reassemble the container from the
+	// chunks we sent so the whole upload must be repeated.
+	manifestRejectedError = errors.New("Manifest rejected")
+)
+
+type UploadResumableArgs struct {
+	File       *accessors.OSPath `vfilter:"optional,field=file,doc=The container to upload. If not set we resume the upload recorded in the state file."`
+	Accessor   string            `vfilter:"optional,field=accessor,doc=The accessor to use to read the file (default auto)."`
+	Name       string            `vfilter:"optional,field=name,doc=The name of the upload (default the basename of the file)."`
+	StateFile  string            `vfilter:"required,field=state_file,doc=A local file recording the progress of the upload."`
+	ChunkSize  int64             `vfilter:"optional,field=chunk_size,doc=The size of each chunk (default 5Mb)."`
+	Upload     *vfilter.Lambda   `vfilter:"optional,field=upload,doc=A lambda called with each chunk (Name, Index, Offset, Data) and finally with the manifest. It should upload the Data using an upload function such as upload_s3() and return its result."`
+	URL        string            `vfilter:"optional,field=url,doc=Upload to this Velociraptor frontend endpoint instead (e.g. https://www.example.com:8000/upload_collection)."`
+	Token      string            `vfilter:"optional,field=token,doc=The token authorizing the upload to the frontend (see collection_upload_token())."`
+	Hostname   string            `vfilter:"optional,field=hostname,doc=The hostname to record in the manifest (default this host's name)."`
+	MaxRetries int64             `vfilter:"optional,field=max_retries,doc=How many times to retry each chunk (default 5)."`
+	RetryWait  int64             `vfilter:"optional,field=retry_wait,doc=Seconds to wait between retries (default 10)."`
+	SkipVerify bool              `vfilter:"optional,field=skip_verify,doc=Skip TLS verification when uploading to the frontend (default: False)."`
+	RootCerts  string            `vfilter:"optional,field=root_ca,doc=As a better alternative to skip_verify, allows root ca certs to be added here."`
+}
+
+// Written to the state file each time a chunk is acknowledged.
+type resumableState struct {
+	File      string `json:"file"`
+	Accessor  string `json:"accessor"`
+	Name      string `json:"name"`
+	Size      int64  `json:"size"`
+	Sha256    string `json:"sha256"`
+	ChunkSize int64  `json:"chunk_size"`
+	Hostname  string `json:"hostname"`
+
+	// The number of chunks acknowledged by the target so far.
+	Acknowledged int64 `json:"acknowledged"`
+}
+
+func (self *resumableState) Chunks() int64 {
+	return (self.Size + self.ChunkSize - 1) / self.ChunkSize
+}
+
+// Sent after the last chunk so the receiver can reassemble and verify
+// the container.
+type uploadManifest struct {
+	Name      string `json:"name"`
+	Size      int64  `json:"size"`
+	Sha256    string `json:"sha256"`
+	ChunkSize int64  `json:"chunk_size"`
+	Chunks    int64  `json:"chunks"`
+	Hostname  string `json:"hostname"`
+}
+
+type chunkUploader interface {
+	UploadChunk(ctx context.Context, state *resumableState,
+		index int64, data []byte) error
+	Complete(ctx context.Context, manifest *uploadManifest) (vfilter.Any, error)
+}
+
+type UploadResumablePlugin struct{}
+
+func (self UploadResumablePlugin) Call(
+	ctx context.Context,
+	scope vfilter.Scope,
+	args *ordereddict.Dict) <-chan vfilter.Row {
+	output_chan := make(chan vfilter.Row)
+
+	go func() {
+		defer close(output_chan)
+
+		// We need to write the state file.
+		err := vql_subsystem.CheckAccess(scope, acls.FILESYSTEM_WRITE)
+		if err != nil {
+			scope.Log("upload_resumable: %s", err)
+			return
+		}
+
+		arg := &UploadResumableArgs{}
+		err = arg_parser.ExtractArgsWithContext(ctx, scope, args, arg)
+		if err != nil {
+			scope.Log("upload_resumable: %v", err)
+			return
+		}
+
+		uploader, err := newChunkUploader(scope, arg)
+		if err != nil {
+			scope.Log("upload_resumable: %v", err)
+			return
+		}
+
+		state, err := self.getState(scope, arg)
+		if err != nil {
+			scope.Log("upload_resumable: %v", err)
+			return
+		}
+
+		// Nothing to resume
+		if state == nil {
+			return
+		}
+
+		row := self.upload(ctx, scope, uploader, state, arg)
+		select {
+		case <-ctx.Done():
+		case output_chan <- row:
+		}
+	}()
+
+	return output_chan
+}
+
+// Work out where to start the upload from. If the state file refers
+// to the same container we continue from the last acknowledged chunk,
+// otherwise we start again.
+func (self UploadResumablePlugin) getState(
+	scope vfilter.Scope, arg *UploadResumableArgs) (*resumableState, error) {
+	previous, err := loadResumableState(arg.StateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if arg.File == nil || len(arg.File.Components) == 0 {
+		if previous != nil {
+			scope.Log("upload_resumable: Resuming upload of %v from chunk %v",
+				previous.File, previous.Acknowledged)
+		}
+		return previous, nil
+	}
+
+	if arg.Accessor == "" {
+		arg.Accessor = "auto"
+	}
+
+	err = vql_subsystem.CheckFilesystemAccess(scope, arg.Accessor)
+	if err != nil {
+		return nil, err
+	}
+
+	if arg.ChunkSize <= 0 {
+		arg.ChunkSize = DEFAULT_CHUNK_SIZE
+	}
+
+	if arg.Name == "" {
+		arg.Name = arg.File.Basename()
+	}
+
+	if arg.Hostname == "" {
+		arg.Hostname, _ = os.Hostname()
+	}
+
+	state := &resumableState{
+		File:      arg.File.String(),
+		Accessor:  arg.Accessor,
+		Name:      arg.Name,
+		ChunkSize: arg.ChunkSize,
+		Hostname:  arg.Hostname,
+	}
+
+	state.Size, state.Sha256, err = hashFile(scope, state)
+	if err != nil {
+		return nil, err
+	}
+
+	if previous != nil && previous.File == state.File &&
+		previous.Name == state.Name &&
+		previous.Sha256 == state.Sha256 &&
+		previous.ChunkSize == state.ChunkSize {
+		scope.Log("upload_resumable: Resuming upload of %v from chunk %v",
+			state.File, previous.Acknowledged)
+		state.Acknowledged = previous.Acknowledged
+	}
+
+	return state, saveResumableState(arg.StateFile, state)
+}
+
+func (self UploadResumablePlugin) upload(
+	ctx context.Context, scope vfilter.Scope,
+	uploader chunkUploader, state *resumableState,
+	arg *UploadResumableArgs) *ordereddict.Dict {
+
+	resumed := state.Acknowledged
+	result := ordereddict.NewDict().
+		Set("Name", state.Name).
+		Set("Size", state.Size).
+		Set("Sha256", state.Sha256).
+		Set("Chunks", state.Chunks()).
+		Set("Resumed", resumed)
+
+	err := self.uploadChunks(ctx, scope, uploader, state, arg)
+	if err != nil {
+		scope.Log("upload_resumable: %v", err)
+		return result.Set("Uploaded", state.Acknowledged-resumed).
+			Set("Complete", false).
+			Set("Error", err.Error())
+	}
+
+	manifest := &uploadManifest{
+		Name:      state.Name,
+		Size:      state.Size,
+		Sha256:    state.Sha256,
+		ChunkSize: state.ChunkSize,
+		Chunks:    state.Chunks(),
+		Hostname:  state.Hostname,
+	}
+
+	var response vfilter.Any
+	err = retry(ctx, arg, func() error {
+		response, err = uploader.Complete(ctx, manifest)
+		if errors.Is(err, manifestRejectedError) {
+			return &permanentError{err}
+		}
+		return err
+	})
+
+	result.Set("Uploaded", state.Acknowledged-resumed)
+	if err != nil {
+		// The chunks the receiver has are no good - next time we
+		// start from the beginning.
+		if errors.Is(err, manifestRejectedError) {
+			state.Acknowledged = 0
+			_ = saveResumableState(arg.StateFile, state)
+		}
+
+		scope.Log("upload_resumable: %v", err)
+		return result.Set("Complete", false).Set("Error", err.Error())
+	}
+
+	// The upload is done so there is nothing left to resume.
+	err = os.Remove(arg.StateFile)
+	if err != nil {
+		scope.Log("upload_resumable: %v", err)
+	}
+
+	return result.Set("Complete", true).Set("Response", response)
+}
+
+func (self UploadResumablePlugin) uploadChunks(
+	ctx context.Context, scope vfilter.Scope,
+	uploader chunkUploader, state *resumableState,
+	arg *UploadResumableArgs) error {
+
+	accessor, err := accessors.GetAccessor(state.Accessor, scope)
+	if err != nil {
+		return err
+	}
+
+	fd, err := accessor.Open(state.File)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	chunks := state.Chunks()
+	buffer := make([]byte, state.ChunkSize)
+	for index := state.Acknowledged; index < chunks; index++ {
+		_, err := fd.Seek(index*state.ChunkSize, io.SeekStart)
+		if err != nil {
+			return err
+		}
+
+		// The last chunk may be short.
+		n, err := io.ReadFull(fd, buffer)
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return err
+		}
+
+		err = retry(ctx, arg, func() error {
+			return uploader.UploadChunk(ctx, state, index, buffer[:n])
+		})
+		if err != nil {
+			return fmt.Errorf("Uploading chunk %v of %v: %w",
+				index, state.Name, err)
+		}
+
+		state.Acknowledged = index + 1
+		err = saveResumableState(arg.StateFile, state)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (self UploadResumablePlugin) Info(
+	scope vfilter.Scope, type_map *vfilter.TypeMap) *vfilter.PluginInfo {
+	return &vfilter.PluginInfo{
+		Name:    "upload_resumable",
+		Doc:     "Upload a collection container in chunks, resuming interrupted uploads.",
+		ArgType: type_map.AddType(scope, &UploadResumableArgs{}),
+	}
+}
+
+// Errors which will not go away by trying again.
+type permanentError struct {
+	err error
+}
+
+func (self *permanentError) Error() string {
+	return self.err.Error()
+}
+
+func (self *permanentError) Unwrap() error {
+	return self.err
+}
+
+func retry(ctx context.Context, arg *UploadResumableArgs, cb func() error) error {
+	max_retries := arg.MaxRetries
+	if max_retries == 0 {
+		max_retries = DEFAULT_MAX_RETRIES
+	}
+
+	retry_wait := arg.RetryWait
+	if retry_wait == 0 {
+		retry_wait = DEFAULT_RETRY_WAIT
+	}
+
+	var err error
+	for i := int64(0); i <= max_retries; i++ {
+		err = cb()
+		if err == nil {
+			return nil
+		}
+
+		_, ok := err.(*permanentError)
+		if ok || ctx.Err() != nil {
+			return err
+		}
+
+		if i < max_retries {
+			utils.SleepWithCtx(ctx, time.Duration(retry_wait)*time.Second)
+		}
+	}
+	return err
+}
+
+func hashFile(scope vfilter.Scope, state *resumableState) (int64, string, error) {
+	accessor, err := accessors.GetAccessor(state.Accessor, scope)
+	if err != nil {
+		return 0, "", err
+	}
+
+	fd, err := accessor.Open(state.File)
+	if err != nil {
+		return 0, "", err
+	}
+	defer fd.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, fd)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return size, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// Returns nil if there is no upload in progress.
+func loadResumableState(filename string) (*resumableState, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	result := &resumableState{}
+	err = json.Unmarshal(data, result)
+	if err != nil || result.ChunkSize <= 0 {
+		return nil, fmt.Errorf("Invalid state file %v", filename)
+	}
+
+	return result, nil
+}
+
+// Replace the state file atomically so an interruption never leaves
+// it half written.
+func saveResumableState(filename string, state *resumableState) error {
+	serialized, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	tmp_filename := filename + ".tmp"
+	err = ioutil.WriteFile(tmp_filename, serialized, 0600)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp_filename, filename)
+}
+
+func newChunkUploader(
+	scope vfilter.Scope, arg *UploadResumableArgs) (chunkUploader, error) {
+	if arg.Upload != nil && arg.URL != "" {
+		return nil, errors.New("Only one of upload or url may be specified")
+	}
+
+	if arg.Upload != nil {
+		return &lambdaUploader{scope: scope, lambda: arg.Upload}, nil
+	}
+
+	if arg.URL == "" {
+		return nil, errors.New("One of upload or url must be specified")
+	}
+
+	return newFrontendUploader(scope, arg)
+}
+
+// Sends each chunk to a VQL lambda, usually wrapping one of the
+// upload functions. Chunks are named <name>.000000, <name>.000001
+// etc. and the manifest is uploaded last as <name>.manifest.json
+type lambdaUploader struct {
+	scope  vfilter.Scope
+	lambda *vfilter.Lambda
+}
+
+func (self *lambdaUploader) call(ctx context.Context,
+	name string, index, offset int64, data []byte) (vfilter.Any, error) {
+	result := self.lambda.Reduce(ctx, self.scope, []types.Any{
+		ordereddict.NewDict().
+			Set("Name", name).
+			Set("Index", index).
+			Set("Offset", offset).
+			Set("Data", string(data)),
+	})
+
+	if utils.IsNil(result) || !self.scope.Bool(result) {
+		return nil, errors.New("Upload failed")
+	}
+
+	// Upload functions report errors in the Error field.
+	upload_error, _ := self.scope.Associative(result, "Error")
+	error_message, _ := upload_error.(string)
+	if error_message != "" {
+		return nil, errors.New(error_message)
+	}
+
+	return result, nil
+}
+
+func (self *lambdaUploader) UploadChunk(ctx context.Context,
+	state *resumableState, index int64, data []byte) error {
+	_, err := self.call(ctx, fmt.Sprintf("%s.%06d", state.Name, index),
+		index, index*state.ChunkSize, data)
+	return err
+}
+
+func (self *lambdaUploader) Complete(ctx context.Context,
+	manifest *uploadManifest) (vfilter.Any, error) {
+	serialized, err := json.MarshalIndent(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	return self.call(ctx, manifest.Name+".manifest.json",
+		manifest.Chunks, manifest.Size, serialized)
+}
+
+// Sends the chunks to the frontend's upload_collection handler.
+type frontendUploader struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+func newFrontendUploader(scope vfilter.Scope,
+	arg *UploadResumableArgs) (*frontendUploader, error) {
+	if arg.Token == "" {
+		return nil, errors.New("A token is required to upload to the frontend")
+	}
+
+	CA_Pool := x509.NewCertPool()
+	crypto.AddPublicRoots(CA_Pool)
+
+	config_obj, ok := artifacts.GetConfig(scope)
+	if ok {
+		err := crypto.AddDefaultCerts(config_obj, CA_Pool)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if arg.RootCerts != "" &&
+		!CA_Pool.AppendCertsFromPEM([]byte(arg.RootCerts)) {
+		return nil, errors.New("Unable to add root certs")
+	}
+
+	return &frontendUploader{
+		url:   arg.URL,
+		token: arg.Token,
+		client: &http.Client{
+			Timeout: 5 * time.Minute,
+			Transport: &http.Transport{
+				Proxy: networking.GetProxy(),
+				TLSClientConfig: &tls.Config{
+					RootCAs:            CA_Pool,
+					InsecureSkipVerify: arg.SkipVerify,
+				},
+			},
+		},
+	}, nil
+}
+
+func (self *frontendUploader) post(ctx context.Context,
+	params url.Values, data []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST",
+		self.url+"?"+params.Encode(), bytes.NewReader(data))
+	if err != nil {
+		return nil, &permanentError{err}
+	}
+
+	req.Header.Set("User-Agent", constants.USER_AGENT)
+	req.Header.Set(crypto_utils.UPLOAD_TOKEN_HEADER, self.token)
+
+	resp, err := self.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, 10000))
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return body, nil
+
+	case resp.StatusCode == http.StatusConflict:
+		return nil, fmt.Errorf("%w: %v", manifestRejectedError,
+			strings.TrimSpace(string(body)))
+
+	// The request itself is bad - e.g. the token expired.
+	case resp.StatusCode >= 400 && resp.StatusCode < 500 &&
+		resp.StatusCode != http.StatusRequestTimeout &&
+		resp.StatusCode != http.StatusTooManyRequests:
+		return nil, &permanentError{fmt.Errorf("HTTP status %v: %v",
+			resp.StatusCode, strings.TrimSpace(string(body)))}
+	}
+
+	return nil, fmt.Errorf("HTTP status %v: %v",
+		resp.StatusCode, strings.TrimSpace(string(body)))
+}
+
+func (self *frontendUploader) UploadChunk(ctx context.Context,
+	state *resumableState, index int64, data []byte) error {
+	_, err := self.post(ctx, url.Values{
+		"name":  []string{state.Name},
+		"index": []string{strconv.FormatInt(index, 10)},
+	}, data)
+	return err
+}
+
+func (self *frontendUploader) Complete(ctx context.Context,
+	manifest *uploadManifest) (vfilter.Any, error) {
+	serialized, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := self.post(ctx, url.Values{
+		"name":     []string{manifest.Name},
+		"manifest": []string{"1"},
+	}, serialized)
+	if err != nil {
+		return nil, err
+	}
+
+	result := ordereddict.NewDict()
+	err = result.UnmarshalJSON(body)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func init() {
+	vql_subsystem.RegisterPlugin(&UploadResumablePlugin{})
+}
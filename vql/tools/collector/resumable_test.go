@@ -0,0 +1,127 @@
+package collector
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/Velocidex/ordereddict"
+	"github.com/alecthomas/assert"
+	"github.com/sebdah/goldie"
+	"www.velocidex.com/golang/velociraptor/accessors"
+	"www.velocidex.com/golang/velociraptor/json"
+	"www.velocidex.com/golang/velociraptor/logging"
+	"www.velocidex.com/golang/velociraptor/services"
+	"www.velocidex.com/golang/velociraptor/vql/acl_managers"
+	"www.velocidex.com/golang/vfilter"
+)
+
+type testChunkUploader struct {
+	chunks   []string
+	manifest *uploadManifest
+
+	// Fail uploading this chunk.
+	fail_at int64
+}
+
+func (self *testChunkUploader) UploadChunk(ctx context.Context,
+	state *resumableState, index int64, data []byte) error {
+	if index == self.fail_at {
+		return &permanentError{errors.New("Connection reset")}
+	}
+	self.chunks = append(self.chunks, string(data))
+	return nil
+}
+
+func (self *testChunkUploader) Complete(ctx context.Context,
+	manifest *uploadManifest) (vfilter.Any, error) {
+	self.manifest = manifest
+	return "OK", nil
+}
+
+func (self *TestSuite) TestResumableUpload() {
+	tmpdir, err := ioutil.TempDir("", "tmp")
+	assert.NoError(self.T(), err)
+	defer os.RemoveAll(tmpdir)
+
+	container := filepath.Join(tmpdir, "Collection.zip")
+	err = ioutil.WriteFile(container,
+		[]byte("0123456789abcdefghijklmno"), 0600)
+	assert.NoError(self.T(), err)
+
+	manager, err := services.GetRepositoryManager(self.ConfigObj)
+	assert.NoError(self.T(), err)
+
+	scope := manager.BuildScope(services.ScopeBuilder{
+		Config:     self.ConfigObj,
+		ACLManager: acl_managers.NullACLManager{},
+		Logger: logging.NewPlainLogger(
+			self.ConfigObj, &logging.FrontendComponent),
+		Env: ordereddict.NewDict(),
+	})
+	defer scope.Close()
+
+	file, err := accessors.NewGenericOSPath(container)
+	assert.NoError(self.T(), err)
+
+	arg := &UploadResumableArgs{
+		File:      file,
+		Accessor:  "file",
+		StateFile: filepath.Join(tmpdir, "Collection.upload.json"),
+		ChunkSize: 10,
+		Hostname:  "MyHost",
+	}
+
+	plugin := UploadResumablePlugin{}
+	uploader := &testChunkUploader{fail_at: 1}
+
+	// The upload is interrupted after the first chunk.
+	state, err := plugin.getState(scope, arg)
+	assert.NoError(self.T(), err)
+
+	golden := ordereddict.NewDict().
+		Set("Interrupted", plugin.upload(
+			self.Ctx, scope, uploader, state, arg))
+
+	saved, err := loadResumableState(arg.StateFile)
+	assert.NoError(self.T(), err)
+	assert.Equal(self.T(), int64(1), saved.Acknowledged)
+
+	// Resuming without the file picks up from the state file.
+	uploader.fail_at = -1
+	arg.File = nil
+	state, err = plugin.getState(scope, arg)
+	assert.NoError(self.T(), err)
+
+	golden.Set("Resumed", plugin.upload(
+		self.Ctx, scope, uploader, state, arg))
+	golden.Set("Chunks", uploader.chunks)
+	golden.Set("Manifest", uploader.manifest)
+
+	// The state file is removed once the upload completes.
+	_, err = os.Stat(arg.StateFile)
+	assert.True(self.T(), os.IsNotExist(err))
+
+	// Nothing left to resume.
+	state, err = plugin.getState(scope, arg)
+	assert.NoError(self.T(), err)
+	assert.Nil(self.T(), state)
+
+	// Chunks may be uploaded by a VQL lambda.
+	rows := []vfilter.Row{}
+	for row := range plugin.Call(self.Ctx, scope, ordereddict.NewDict().
+		Set("file", container).
+		Set("accessor", "file").
+		Set("state_file", arg.StateFile).
+		Set("chunk_size", 10).
+		Set("hostname", "MyHost").
+		Set("upload", "x=>dict(Path=x.Name, Size=len(list=x.Data))")) {
+		rows = append(rows, row)
+	}
+	golden.Set("Lambda", rows)
+
+	goldie.Assert(self.T(), "TestResumableUpload",
+		json.MustMarshalIndent(golden))
+}
@@ -34,6 +34,9 @@ import (
 	_ "www.velocidex.com/golang/velociraptor/vql/parsers/csv"
 	_ "www.velocidex.com/golang/velociraptor/vql/parsers/ese"
 	_ "www.velocidex.com/golang/velociraptor/vql/parsers/event_logs"
+	_ "www.velocidex.com/golang/velociraptor/vql/parsers/journald"
+	_ "www.velocidex.com/golang/velociraptor/vql/parsers/lnk"
+	_ "www.velocidex.com/golang/velociraptor/vql/parsers/pcap"
 	_ "www.velocidex.com/golang/velociraptor/vql/parsers/syslog"
 	_ "www.velocidex.com/golang/velociraptor/vql/parsers/usn"
 	_ "www.velocidex.com/golang/velociraptor/vql/protocols"
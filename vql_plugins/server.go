@@ -30,9 +30,12 @@ import (
 	_ "www.velocidex.com/golang/velociraptor/vql/server/favorites"
 	_ "www.velocidex.com/golang/velociraptor/vql/server/flows"
 	_ "www.velocidex.com/golang/velociraptor/vql/server/hunts"
+	_ "www.velocidex.com/golang/velociraptor/vql/server/kafka"
 	_ "www.velocidex.com/golang/velociraptor/vql/server/monitoring"
 	_ "www.velocidex.com/golang/velociraptor/vql/server/notebooks"
 	_ "www.velocidex.com/golang/velociraptor/vql/server/orgs"
+	_ "www.velocidex.com/golang/velociraptor/vql/server/sigma"
+	_ "www.velocidex.com/golang/velociraptor/vql/server/syslog"
 	_ "www.velocidex.com/golang/velociraptor/vql/server/timelines"
 	_ "www.velocidex.com/golang/velociraptor/vql/server/users"
 )